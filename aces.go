@@ -0,0 +1,207 @@
+package colorful
+
+import "math"
+
+// The color spaces of the Academy Color Encoding System (ACES), which are
+// all relative to the ACES white point, close to D60. Conversions from and to
+// D65 use the Bradford chromatic adaptation, like the ACES input transforms
+// and OpenColorIO do.
+// Colors outside of sRGB are kept as invalid colors, so that they can be
+// converted between ACES and the wide-gamut spaces without loss, for example
+// AcesCg(r, g, b).Rec2020().
+// https://docs.acescentral.com/
+
+// AcesWhite is the reference white of the ACES color spaces.
+var AcesWhite = XyToWhiteRef(0.32168, 0.33767)
+
+// The adaptations between the ACES white and the D65 of sRGB and the wide-gamut spaces.
+var (
+	acesToD65 = AdaptationMatrix(AcesWhite, XyToWhiteRef(0.3127, 0.3290), Bradford)
+	d65ToAces = invMat3(acesToD65)
+)
+
+// XyzAcesToXyz adapts XYZ relative to AcesWhite to XYZ relative to D65.
+func XyzAcesToXyz(x, y, z float64) (xo, yo, zo float64) {
+	return mulMat3Vec(acesToD65, x, y, z)
+}
+
+// XyzToXyzAces adapts XYZ relative to D65 to XYZ relative to AcesWhite.
+func XyzToXyzAces(x, y, z float64) (xo, yo, zo float64) {
+	return mulMat3Vec(d65ToAces, x, y, z)
+}
+
+/// ACES2065-1 ///
+//////////////////
+// Linear, with the AP0 primaries which enclose all colors, for archival and interchange.
+
+func Aces2065ToXyzAces(r, g, b float64) (x, y, z float64) {
+	x = 0.9525523959381859*r + 0.00009367863166046855*b
+	y = 0.3439664497650751*r + 0.7281660966134856*g - 0.07213254637856079*b
+	z = 1.0088251843515859 * b
+	return
+}
+
+func XyzAcesToAces2065(x, y, z float64) (r, g, b float64) {
+	r = 1.0498110174979742*x - 0.00009748454057925287*z
+	g = -0.4959030230773199*x + 1.3733130458157066*y + 0.09824003605730999*z
+	b = 0.991252018200499 * z
+	return
+}
+
+// Aces2065 generates a color from ACES2065-1 values, which are scene-linear
+// with 1 as diffuse white.
+func Aces2065(r, g, b float64) Color {
+	return Xyz(XyzAcesToXyz(Aces2065ToXyzAces(r, g, b)))
+}
+
+// Aces2065 returns the ACES2065-1 values of the color.
+func (col Color) Aces2065() (r, g, b float64) {
+	return XyzAcesToAces2065(XyzToXyzAces(col.Xyz()))
+}
+
+// BlendAces2065 blends two colors in ACES2065-1, which is linear like BlendLinearRgb.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendAces2065(c2 Color, t float64) Color {
+	r1, g1, b1 := c1.Aces2065()
+	r2, g2, b2 := c2.Aces2065()
+	return Aces2065(
+		r1+t*(r2-r1),
+		g1+t*(g2-g1),
+		b1+t*(b2-b1),
+	)
+}
+
+/// ACEScg ///
+//////////////
+// Linear, with the AP1 primaries which are close to those of Rec. 2020, for
+// rendering and compositing.
+
+func AcesCgToXyzAces(r, g, b float64) (x, y, z float64) {
+	x = 0.6624541811085054*r + 0.1340042064564331*g + 0.1561876870049078*b
+	y = 0.27222871678091454*r + 0.6740817658111484*g + 0.05368951740793705*b
+	z = -0.005574649490394155*r + 0.0040607335289828215*g + 1.0103391003129971*b
+	return
+}
+
+func XyzAcesToAcesCg(x, y, z float64) (r, g, b float64) {
+	r = 1.6410233796943254*x - 0.3248032941847899*y - 0.2364246952376122*z
+	g = -0.6636628587229829*x + 1.615331591657338*y + 0.01675634768553013*z
+	b = 0.011721894328375445*x - 0.008284441996237417*y + 0.9883948585390215*z
+	return
+}
+
+// AcesCg generates a color from ACEScg values, which are scene-linear with 1
+// as diffuse white.
+func AcesCg(r, g, b float64) Color {
+	return Xyz(XyzAcesToXyz(AcesCgToXyzAces(r, g, b)))
+}
+
+// AcesCg returns the ACEScg values of the color.
+func (col Color) AcesCg() (r, g, b float64) {
+	return XyzAcesToAcesCg(XyzToXyzAces(col.Xyz()))
+}
+
+// BlendAcesCg blends two colors in ACEScg, which is linear like BlendLinearRgb.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendAcesCg(c2 Color, t float64) Color {
+	r1, g1, b1 := c1.AcesCg()
+	r2, g2, b2 := c2.AcesCg()
+	return AcesCg(
+		r1+t*(r2-r1),
+		g1+t*(g2-g1),
+		b1+t*(b2-b1),
+	)
+}
+
+/// ACEScc ///
+//////////////
+// A logarithmic encoding of ACEScg for color grading.
+// https://docs.acescentral.com/specifications/acescc/
+
+// The largest value of a half float, at which ACEScc and ACEScct saturate.
+const acesHalfMax = 65504.0
+
+func acesCcEncode(v float64) float64 {
+	if v <= 0.0 {
+		return (-16.0 + 9.72) / 17.52
+	} else if v < math.Exp2(-15.0) {
+		return (math.Log2(math.Exp2(-16.0)+v*0.5) + 9.72) / 17.52
+	}
+	return (math.Log2(v) + 9.72) / 17.52
+}
+
+func acesCcDecode(v float64) float64 {
+	if v <= (9.72-15.0)/17.52 {
+		return (math.Exp2(v*17.52-9.72) - math.Exp2(-16.0)) * 2.0
+	} else if v < (math.Log2(acesHalfMax)+9.72)/17.52 {
+		return math.Exp2(v*17.52 - 9.72)
+	}
+	return acesHalfMax
+}
+
+func AcesCgToAcesCc(r, g, b float64) (rc, gc, bc float64) {
+	return acesCcEncode(r), acesCcEncode(g), acesCcEncode(b)
+}
+
+func AcesCcToAcesCg(r, g, b float64) (rl, gl, bl float64) {
+	return acesCcDecode(r), acesCcDecode(g), acesCcDecode(b)
+}
+
+// AcesCc generates a color from ACEScc values.
+func AcesCc(r, g, b float64) Color {
+	return AcesCg(AcesCcToAcesCg(r, g, b))
+}
+
+// AcesCc returns the ACEScc values of the color. Black has a value of about
+// -0.358 and white of about 0.555.
+func (col Color) AcesCc() (r, g, b float64) {
+	return AcesCgToAcesCc(col.AcesCg())
+}
+
+/// ACEScct ///
+///////////////
+// Like ACEScc, but with a linear toe instead of the steep fall to black,
+// which grades more like the log encodings of cameras.
+// https://docs.acescentral.com/specifications/acescct/
+
+const (
+	acesCctXBreak = 0.0078125
+	acesCctYBreak = 0.155251141552511
+	acesCctA      = 10.5402377416545
+	acesCctB      = 0.0729055341958355
+)
+
+func acesCctEncode(v float64) float64 {
+	if v <= acesCctXBreak {
+		return acesCctA*v + acesCctB
+	}
+	return (math.Log2(v) + 9.72) / 17.52
+}
+
+func acesCctDecode(v float64) float64 {
+	if v <= acesCctYBreak {
+		return (v - acesCctB) / acesCctA
+	} else if v < (math.Log2(acesHalfMax)+9.72)/17.52 {
+		return math.Exp2(v*17.52 - 9.72)
+	}
+	return acesHalfMax
+}
+
+func AcesCgToAcesCct(r, g, b float64) (rc, gc, bc float64) {
+	return acesCctEncode(r), acesCctEncode(g), acesCctEncode(b)
+}
+
+func AcesCctToAcesCg(r, g, b float64) (rl, gl, bl float64) {
+	return acesCctDecode(r), acesCctDecode(g), acesCctDecode(b)
+}
+
+// AcesCct generates a color from ACEScct values.
+func AcesCct(r, g, b float64) Color {
+	return AcesCg(AcesCctToAcesCg(r, g, b))
+}
+
+// AcesCct returns the ACEScct values of the color. Black has a value of
+// about 0.0729 and white of about 0.555.
+func (col Color) AcesCct() (r, g, b float64) {
+	return AcesCgToAcesCct(col.AcesCg())
+}
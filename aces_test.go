@@ -0,0 +1,104 @@
+package colorful
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAces(t *testing.T) {
+	// The matrix from linear sRGB to ACEScg as published with ACES.
+	want := [3][3]float64{
+		{0.6131, 0.3395, 0.0474},
+		{0.0702, 0.9164, 0.0134},
+		{0.0206, 0.1096, 0.8698},
+	}
+	for j := 0; j < 3; j++ {
+		var lin [3]float64
+		lin[j] = 1.0
+		r, g, b := LinearRgb(lin[0], lin[1], lin[2]).AcesCg()
+		for i, v := range []float64{r, g, b} {
+			if math.Abs(v-want[i][j]) > 1e-4 {
+				t.Errorf("AcesCg() of linear sRGB primary %v => (%v, %v, %v), want column %v", j, r, g, b, want)
+				break
+			}
+		}
+	}
+
+	// The primaries of AP0 and AP1, and the ACES white.
+	for _, tt := range []struct {
+		x, y, wantx, wanty float64
+	}{
+		{0, 0, 0.7347, 0.2653}, {0, 1, 0.0, 1.0}, {0, 2, 0.0001, -0.0770},
+		{1, 0, 0.713, 0.293}, {1, 1, 0.165, 0.830}, {1, 2, 0.128, 0.044},
+	} {
+		var rgb [3]float64
+		rgb[int(tt.y)] = 1.0
+		var x, y, z float64
+		if tt.x == 0 {
+			x, y, z = Aces2065ToXyzAces(rgb[0], rgb[1], rgb[2])
+		} else {
+			x, y, z = AcesCgToXyzAces(rgb[0], rgb[1], rgb[2])
+		}
+		if xc, yc, _ := XyzToXyy(x, y, z); !almosteq(xc, tt.wantx) || !almosteq(yc, tt.wanty) {
+			t.Errorf("primary %v of AP%v => (%v, %v), want (%v, %v)", tt.y, tt.x, xc, yc, tt.wantx, tt.wanty)
+		}
+	}
+
+	white := Color{1.0, 1.0, 1.0}
+	for name, f := range map[string]func() (float64, float64, float64){"Aces2065": white.Aces2065, "AcesCg": white.AcesCg} {
+		if r, g, b := f(); !almosteq(r, 1.0) || !almosteq(g, 1.0) || !almosteq(b, 1.0) {
+			t.Errorf("white.%v() => (%v, %v, %v), want (1, 1, 1)", name, r, g, b)
+		}
+	}
+}
+
+func TestAcesLog(t *testing.T) {
+	// Reference values from the ACEScc and ACEScct specifications.
+	tests := []struct {
+		lin, cc, cct float64
+	}{
+		{0.0, -0.3584474886, 0.0729055342},
+		{0.0078125, 0.1552511416, 0.1552511416},
+		{0.18, 0.4135884025, 0.4135884025},
+		{1.0, 0.5547945205, 0.5547945205},
+		{65504.0, 1.4679963120, 1.4679963120},
+	}
+	for _, tt := range tests {
+		if cc, _, _ := AcesCgToAcesCc(tt.lin, 0, 0); !almosteq(cc, tt.cc) {
+			t.Errorf("AcesCgToAcesCc(%v) => %v, want %v", tt.lin, cc, tt.cc)
+		}
+		if cct, _, _ := AcesCgToAcesCct(tt.lin, 0, 0); !almosteq(cct, tt.cct) {
+			t.Errorf("AcesCgToAcesCct(%v) => %v, want %v", tt.lin, cct, tt.cct)
+		}
+		if lin, _, _ := AcesCctToAcesCg(tt.cct, 0, 0); !almosteq(lin, tt.lin) {
+			t.Errorf("AcesCctToAcesCg(%v) => %v, want %v", tt.cct, lin, tt.lin)
+		}
+		if lin, _, _ := AcesCcToAcesCg(tt.cc, 0, 0); tt.lin > 0 && !almosteq_eps(lin, tt.lin, 1e-6) {
+			t.Errorf("AcesCcToAcesCg(%v) => %v, want %v", tt.cc, lin, tt.lin)
+		}
+	}
+}
+
+func TestAcesRoundtrip(t *testing.T) {
+	for _, tt := range vals {
+		if c := Aces2065(tt.c.Aces2065()); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("Aces2065(%v.Aces2065()) => %v", tt.c, c)
+		}
+		if c := AcesCg(tt.c.AcesCg()); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("AcesCg(%v.AcesCg()) => %v", tt.c, c)
+		}
+		if c := AcesCc(tt.c.AcesCc()); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("AcesCc(%v.AcesCc()) => %v", tt.c, c)
+		}
+		if c := AcesCct(tt.c.AcesCct()); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("AcesCct(%v.AcesCct()) => %v", tt.c, c)
+		}
+	}
+
+	// Colors outside of sRGB survive the way through Color to the wide-gamut spaces.
+	r, g, b := AcesCg(0.9, 0.05, 0.02).Rec2020()
+	c := Rec2020(r, g, b)
+	if r2, g2, b2 := c.AcesCg(); !almosteq(r2, 0.9) || !almosteq(g2, 0.05) || !almosteq(b2, 0.02) {
+		t.Errorf("AcesCg through Rec2020 => (%v, %v, %v), want (0.9, 0.05, 0.02)", r2, g2, b2)
+	}
+}
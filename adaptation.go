@@ -0,0 +1,106 @@
+package colorful
+
+// Chromatic adaptation transforms (CAT), which predict the color that looks
+// the same under another illuminant. They are von Kries transforms, which
+// scale the responses of a cone space by the ratio of the whites.
+// http://www.brucelindbloom.com/index.html?Eqn_ChromAdapt.html
+
+// A ChromaticAdaptation is the matrix from XYZ to the cone space in which the
+// adaptation happens.
+type ChromaticAdaptation [3][3]float64
+
+var (
+	// Bradford is the most widely used transform, for example by ICC profiles.
+	Bradford = ChromaticAdaptation{
+		{0.8951, 0.2664, -0.1614},
+		{-0.7502, 1.7135, 0.0367},
+		{0.0389, -0.0685, 1.0296},
+	}
+
+	// VonKries uses the Hunt-Pointer-Estevez cone fundamentals.
+	VonKries = ChromaticAdaptation{
+		{0.40024, 0.70760, -0.08081},
+		{-0.22630, 1.16532, 0.04570},
+		{0.0, 0.0, 0.91822},
+	}
+
+	// CAT02 is the transform of CIECAM02.
+	CAT02 = ChromaticAdaptation{
+		{0.7328, 0.4296, -0.1624},
+		{-0.7036, 1.6975, 0.0061},
+		{0.0030, 0.0136, 0.9834},
+	}
+
+	// CAT16 is the transform of CAM16.
+	CAT16 = ChromaticAdaptation{
+		{0.401288, 0.650173, -0.051461},
+		{-0.250268, 1.204414, 0.045854},
+		{-0.002079, 0.048952, 0.953127},
+	}
+
+	// XyzScaling scales XYZ directly, which is the least accurate.
+	XyzScaling = ChromaticAdaptation{
+		{1.0, 0.0, 0.0},
+		{0.0, 1.0, 0.0},
+		{0.0, 0.0, 1.0},
+	}
+)
+
+func mulMat3Vec(m [3][3]float64, x, y, z float64) (xo, yo, zo float64) {
+	xo = m[0][0]*x + m[0][1]*y + m[0][2]*z
+	yo = m[1][0]*x + m[1][1]*y + m[1][2]*z
+	zo = m[2][0]*x + m[2][1]*y + m[2][2]*z
+	return
+}
+
+func mulMat3(a, b [3][3]float64) (m [3][3]float64) {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			m[i][j] = a[i][0]*b[0][j] + a[i][1]*b[1][j] + a[i][2]*b[2][j]
+		}
+	}
+	return
+}
+
+func invMat3(m [3][3]float64) (inv [3][3]float64) {
+	// The adjugate divided by the determinant.
+	inv[0][0] = m[1][1]*m[2][2] - m[1][2]*m[2][1]
+	inv[0][1] = m[0][2]*m[2][1] - m[0][1]*m[2][2]
+	inv[0][2] = m[0][1]*m[1][2] - m[0][2]*m[1][1]
+	inv[1][0] = m[1][2]*m[2][0] - m[1][0]*m[2][2]
+	inv[1][1] = m[0][0]*m[2][2] - m[0][2]*m[2][0]
+	inv[1][2] = m[0][2]*m[1][0] - m[0][0]*m[1][2]
+	inv[2][0] = m[1][0]*m[2][1] - m[1][1]*m[2][0]
+	inv[2][1] = m[0][1]*m[2][0] - m[0][0]*m[2][1]
+	inv[2][2] = m[0][0]*m[1][1] - m[0][1]*m[1][0]
+	det := m[0][0]*inv[0][0] + m[0][1]*inv[1][0] + m[0][2]*inv[2][0]
+	for i := range inv {
+		for j := range inv[i] {
+			inv[i][j] /= det
+		}
+	}
+	return
+}
+
+// AdaptationMatrix returns the matrix which adapts XYZ from the reference
+// white `from` to the reference white `to` using the given method. When
+// adapting many colors, this is faster than calling Adapt for each.
+func AdaptationMatrix(from, to [3]float64, method ChromaticAdaptation) [3][3]float64 {
+	m := [3][3]float64(method)
+	rs, gs, bs := mulMat3Vec(m, from[0], from[1], from[2])
+	rd, gd, bd := mulMat3Vec(m, to[0], to[1], to[2])
+	scale := [3][3]float64{
+		{rd / rs, 0.0, 0.0},
+		{0.0, gd / gs, 0.0},
+		{0.0, 0.0, bd / bs},
+	}
+	return mulMat3(invMat3(m), mulMat3(scale, m))
+}
+
+// Adapt converts the XYZ values of a color seen under the reference white
+// `from` to those of the corresponding color under the reference white `to`,
+// using the given method, for example Bradford. Any of the Illuminants can be
+// used as reference white.
+func Adapt(x, y, z float64, from, to [3]float64, method ChromaticAdaptation) (xo, yo, zo float64) {
+	return mulMat3Vec(AdaptationMatrix(from, to, method), x, y, z)
+}
@@ -0,0 +1,82 @@
+package colorful
+
+import (
+	"testing"
+)
+
+func TestAdaptBradford(t *testing.T) {
+	// Reference matrix from Bruce Lindbloom, which uses the same whites.
+	want := [3][3]float64{
+		{1.0478112, 0.0228866, -0.0501270},
+		{0.0295424, 0.9904844, -0.0170491},
+		{-0.0092345, 0.0150436, 0.7521316},
+	}
+	m := AdaptationMatrix(D65, D50, Bradford)
+	for i := range m {
+		for j := range m[i] {
+			if !almosteq_eps(m[i][j], want[i][j], 1e-5) {
+				t.Errorf("AdaptationMatrix(D65, D50, Bradford)[%v][%v] => %v, want %v", i, j, m[i][j], want[i][j])
+			}
+		}
+	}
+
+	// The same as the fixed transform of the wide-gamut color spaces.
+	for i, tt := range vals {
+		x1, y1, z1 := Adapt(tt.xyz[0], tt.xyz[1], tt.xyz[2], D50, D65, Bradford)
+		x2, y2, z2 := D50ToD65(tt.xyz[0], tt.xyz[1], tt.xyz[2])
+		if !almosteq_eps(x1, x2, 1e-4) || !almosteq_eps(y1, y2, 1e-4) || !almosteq_eps(z1, z2, 1e-4) {
+			t.Errorf("%v. Adapt(%v, D50, D65, Bradford) => (%v, %v, %v), want (%v, %v, %v)", i, tt.xyz, x1, y1, z1, x2, y2, z2)
+		}
+	}
+}
+
+func TestAdaptWhites(t *testing.T) {
+	methods := map[string]ChromaticAdaptation{
+		"Bradford": Bradford, "VonKries": VonKries, "CAT02": CAT02, "CAT16": CAT16, "XyzScaling": XyzScaling,
+	}
+	for name, method := range methods {
+		for illum, from := range Illuminants {
+			// The white of one illuminant is adapted to the white of the other.
+			x, y, z := Adapt(from[0], from[1], from[2], from, D65, method)
+			if !almosteq(x, D65[0]) || !almosteq(y, D65[1]) || !almosteq(z, D65[2]) {
+				t.Errorf("Adapt(%v, %v, D65, %v) => (%v, %v, %v), want %v", illum, illum, name, x, y, z, D65)
+			}
+
+			// And back again.
+			x, y, z = Adapt(0.2, 0.3, 0.4, from, D65, method)
+			x, y, z = Adapt(x, y, z, D65, from, method)
+			if !almosteq(x, 0.2) || !almosteq(y, 0.3) || !almosteq(z, 0.4) {
+				t.Errorf("Adapt roundtrip %v <-> D65 with %v => (%v, %v, %v), want (0.2, 0.3, 0.4)", illum, name, x, y, z)
+			}
+		}
+	}
+}
+
+func TestIlluminants(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y float64
+	}{
+		{"A", 0.44757, 0.40745},
+		{"D65", 0.31271, 0.32902},
+		{"E", 1.0 / 3.0, 1.0 / 3.0},
+		{"F2", 0.37208, 0.37529},
+		{"LED-B3", 0.3756, 0.3723},
+	}
+	for _, tt := range tests {
+		w := Illuminants[tt.name]
+		x, y, Y := XyzToXyy(w[0], w[1], w[2])
+		if !almosteq_eps(x, tt.x, 1e-4) || !almosteq_eps(y, tt.y, 1e-4) || Y != 1.0 {
+			t.Errorf("Illuminants[%v] => (%v, %v, %v), want (%v, %v, 1)", tt.name, x, y, Y, tt.x, tt.y)
+		}
+	}
+	if len(Illuminants10) != 21 {
+		t.Errorf("len(Illuminants10) => %v, want 21", len(Illuminants10))
+	}
+
+	// Using an illuminant as reference white makes itself the neutral color.
+	w := Illuminants["A"]
+	if _, a, b := XyzToLabWhiteRef(w[0], w[1], w[2], w); !almosteq(a, 0.0) || !almosteq(b, 0.0) {
+		t.Errorf("XyzToLabWhiteRef(A, A) => a=%v, b=%v, want 0", a, b)
+	}
+}
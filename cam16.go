@@ -0,0 +1,319 @@
+package colorful
+
+import "math"
+
+// The CIECAM16 color appearance model and its uniform color space CAM16-UCS.
+// https://doi.org/10.1002/col.22131
+//
+// Unlike the color spaces, a color appearance model predicts how a color looks
+// under given viewing conditions, so all functions need a ViewingConditions.
+// The correlates are in the scales used in the literature, e.g. J in [0..100].
+
+// A Surround describes the relative luminance of the area around the viewing field.
+type Surround struct {
+	F, C, Nc float64
+}
+
+// The standard surrounds: average for viewing surface colors, dim for
+// watching television and dark for a cinema.
+var (
+	SurroundAverage = Surround{1.0, 0.69, 1.0}
+	SurroundDim     = Surround{0.9, 0.59, 0.9}
+	SurroundDark    = Surround{0.8, 0.525, 0.8}
+)
+
+// ViewingConditions holds everything CAM16 needs to know about the viewing
+// environment. Create it using NewViewingConditions, as it also caches values
+// derived from the parameters.
+type ViewingConditions struct {
+	whitePoint          [3]float64
+	adaptingLuminance   float64
+	backgroundLuminance float64
+	surround            Surround
+	discounting         bool
+
+	n, aw, nbb, ncb, z, fl, flRoot float64
+	rgbD                           [3]float64
+}
+
+// WhitePoint returns the white point, with Y = 1.
+func (vc ViewingConditions) WhitePoint() [3]float64 {
+	return vc.whitePoint
+}
+
+// AdaptingLuminance returns the luminance of the adapting field in cd/m²,
+// usually 20% of the luminance of a white object in the scene.
+func (vc ViewingConditions) AdaptingLuminance() float64 {
+	return vc.adaptingLuminance
+}
+
+// BackgroundLuminance returns the relative luminance of the background in [0..1].
+func (vc ViewingConditions) BackgroundLuminance() float64 {
+	return vc.backgroundLuminance
+}
+
+// Surround returns the surround of the viewing field.
+func (vc ViewingConditions) Surround() Surround {
+	return vc.surround
+}
+
+// Discounting returns whether the observer is fully adapted to the illuminant.
+func (vc ViewingConditions) Discounting() bool {
+	return vc.discounting
+}
+
+// NewViewingConditions computes the viewing conditions for the given white
+// point (with Y = 1), adapting luminance in cd/m², relative background
+// luminance in [0..1] and surround. If discounting is true, the observer is
+// assumed to be fully adapted to the illuminant.
+func NewViewingConditions(white [3]float64, adaptingLuminance, backgroundLuminance float64, surround Surround, discounting bool) ViewingConditions {
+	vc := ViewingConditions{
+		whitePoint:          white,
+		adaptingLuminance:   adaptingLuminance,
+		backgroundLuminance: backgroundLuminance,
+		surround:            surround,
+		discounting:         discounting,
+	}
+
+	// CAM16 works with Y in [0..100].
+	rw, gw, bw := xyzToCam16Rgb(white[0]*100.0, white[1]*100.0, white[2]*100.0)
+	yw := white[1] * 100.0
+
+	d := 1.0
+	if !discounting {
+		d = clamp01(surround.F * (1.0 - 1.0/3.6*math.Exp((-adaptingLuminance-42.0)/92.0)))
+	}
+	vc.rgbD = [3]float64{
+		d*yw/rw + 1.0 - d,
+		d*yw/gw + 1.0 - d,
+		d*yw/bw + 1.0 - d,
+	}
+
+	k := 1.0 / (5.0*adaptingLuminance + 1.0)
+	k4 := k * k * k * k
+	vc.fl = k4*adaptingLuminance + 0.1*sq(1.0-k4)*math.Cbrt(5.0*adaptingLuminance)
+	vc.flRoot = math.Pow(vc.fl, 0.25)
+
+	vc.n = backgroundLuminance / white[1]
+	vc.z = 1.48 + math.Sqrt(vc.n)
+	vc.nbb = 0.725 / math.Pow(vc.n, 0.2)
+	vc.ncb = vc.nbb
+
+	ra := cam16Compress(vc.rgbD[0]*rw, vc.fl)
+	ga := cam16Compress(vc.rgbD[1]*gw, vc.fl)
+	ba := cam16Compress(vc.rgbD[2]*bw, vc.fl)
+	vc.aw = (2.0*ra + ga + 0.05*ba) * vc.nbb
+	return vc
+}
+
+// DefaultViewingConditions are those used by Material Design: a D65 white,
+// a gray background of L* 50, an adapting luminance of 11.72 cd/m² (a 200 lux
+// office) and an average surround.
+var DefaultViewingConditions = NewViewingConditions(D65, 200.0/math.Pi*lab_finv(66.0/116.0), lab_finv(66.0/116.0), SurroundAverage, false)
+
+func xyzToCam16Rgb(x, y, z float64) (r, g, b float64) {
+	r = 0.401288*x + 0.650173*y - 0.051461*z
+	g = -0.250268*x + 1.204414*y + 0.045854*z
+	b = -0.002079*x + 0.048952*y + 0.953127*z
+	return
+}
+
+func cam16RgbToXyz(r, g, b float64) (x, y, z float64) {
+	x = 1.8620678550872327*r - 1.0112546305316843*g + 0.14918677544445172*b
+	y = 0.38752654323613717*r + 0.6214474419314754*g - 0.008973985167612518*b
+	z = -0.015841498849333856*r - 0.03412293802851556*g + 1.0499644368778493*b
+	return
+}
+
+// The post-adaptation non-linear response compression. The usual +0.1 is
+// left out here and in the achromatic responses, where it cancels out.
+func cam16Compress(v, fl float64) float64 {
+	f := math.Pow(fl*math.Abs(v)/100.0, 0.42)
+	return math.Copysign(400.0*f/(f+27.13), v)
+}
+
+func cam16Decompress(v, fl float64) float64 {
+	base := math.Max(0.0, 27.13*math.Abs(v)/(400.0-math.Abs(v)))
+	return math.Copysign(100.0/fl*math.Pow(base, 1.0/0.42), v)
+}
+
+// Cam16 holds all the appearance correlates of a color under some viewing conditions.
+type Cam16 struct {
+	J             float64 // Lightness in [0..100]
+	C             float64 // Chroma
+	Hue           float64 // Hue angle h in [0..360]
+	M             float64 // Colorfulness
+	S             float64 // Saturation s
+	Q             float64 // Brightness
+	HueQuadrature float64 // Hue composition H in [0..400]
+}
+
+var cam16UniqueHues = [5][3]float64{
+	// h_i, e_i, H_i
+	{20.14, 0.8, 0.0},
+	{90.00, 0.7, 100.0},
+	{164.25, 1.0, 200.0},
+	{237.53, 1.2, 300.0},
+	{380.14, 0.8, 400.0},
+}
+
+func cam16HueQuadrature(h float64) float64 {
+	if h < cam16UniqueHues[0][0] {
+		h += 360.0
+	}
+	i := 0
+	for i < 3 && h >= cam16UniqueHues[i+1][0] {
+		i++
+	}
+	lo, hi := cam16UniqueHues[i], cam16UniqueHues[i+1]
+	t1 := (h - lo[0]) / lo[1]
+	t2 := (hi[0] - h) / hi[1]
+	return lo[2] + 100.0*t1/(t1+t2)
+}
+
+func cam16Eccentricity(h float64) float64 {
+	return 0.25 * (math.Cos(h*math.Pi/180.0+2.0) + 3.8)
+}
+
+// XyzToCam16 computes the CAM16 correlates of a color given in CIE XYZ (with
+// the white point's Y being 1) under the given viewing conditions.
+func XyzToCam16(x, y, z float64, vc ViewingConditions) Cam16 {
+	r, g, b := xyzToCam16Rgb(x*100.0, y*100.0, z*100.0)
+	ra := cam16Compress(vc.rgbD[0]*r, vc.fl)
+	ga := cam16Compress(vc.rgbD[1]*g, vc.fl)
+	ba := cam16Compress(vc.rgbD[2]*b, vc.fl)
+
+	// Opponent color dimensions.
+	a := (11.0*ra - 12.0*ga + ba) / 11.0
+	bb := (ra + ga - 2.0*ba) / 9.0
+	u := (20.0*ra + 20.0*ga + 21.0*ba) / 20.0
+	p2 := (40.0*ra + 20.0*ga + ba) / 20.0
+
+	h := math.Atan2(bb, a) * 180.0 / math.Pi
+	if h < 0.0 {
+		h += 360.0
+	}
+
+	ac := p2 * vc.nbb
+	j := 100.0 * math.Pow(math.Max(ac/vc.aw, 0.0), vc.surround.C*vc.z)
+	q := 4.0 / vc.surround.C * math.Sqrt(j/100.0) * (vc.aw + 4.0) * vc.flRoot
+
+	p1 := 50000.0 / 13.0 * cam16Eccentricity(h) * vc.surround.Nc * vc.ncb
+	t := p1 * math.Sqrt(a*a+bb*bb) / (u + 0.305)
+	alpha := math.Pow(t, 0.9) * math.Pow(1.64-math.Pow(0.29, vc.n), 0.73)
+	c := alpha * math.Sqrt(j/100.0)
+	m := c * vc.flRoot
+	s := 50.0 * math.Sqrt(alpha*vc.surround.C/(vc.aw+4.0))
+
+	return Cam16{J: j, C: c, Hue: h, M: m, S: s, Q: q, HueQuadrature: cam16HueQuadrature(h)}
+}
+
+// Cam16JChToXyz converts from CAM16 lightness J, chroma C and hue angle h under
+// the given viewing conditions to CIE XYZ, with the white point's Y being 1.
+func Cam16JChToXyz(j, c, h float64, vc ViewingConditions) (x, y, z float64) {
+	alpha := 0.0
+	if j > 0.0 {
+		alpha = c / math.Sqrt(j/100.0)
+	}
+	t := math.Pow(alpha/math.Pow(1.64-math.Pow(0.29, vc.n), 0.73), 1.0/0.9)
+
+	ac := vc.aw * math.Pow(j/100.0, 1.0/(vc.surround.C*vc.z))
+	p1 := 50000.0 / 13.0 * cam16Eccentricity(h) * vc.surround.Nc * vc.ncb
+	p2 := ac / vc.nbb
+
+	hSin, hCos := math.Sincos(h * math.Pi / 180.0)
+	gamma := 23.0 * (p2 + 0.305) * t / (23.0*p1 + 11.0*t*hCos + 108.0*t*hSin)
+	a := gamma * hCos
+	b := gamma * hSin
+
+	ra := (460.0*p2 + 451.0*a + 288.0*b) / 1403.0
+	ga := (460.0*p2 - 891.0*a - 261.0*b) / 1403.0
+	ba := (460.0*p2 - 220.0*a - 6300.0*b) / 1403.0
+
+	x, y, z = cam16RgbToXyz(
+		cam16Decompress(ra, vc.fl)/vc.rgbD[0],
+		cam16Decompress(ga, vc.fl)/vc.rgbD[1],
+		cam16Decompress(ba, vc.fl)/vc.rgbD[2])
+	return x / 100.0, y / 100.0, z / 100.0
+}
+
+// Cam16 returns the CAM16 appearance correlates of the color under the given
+// viewing conditions.
+func (col Color) Cam16(vc ViewingConditions) Cam16 {
+	x, y, z := col.Xyz()
+	return XyzToCam16(x, y, z, vc)
+}
+
+// Cam16JCh creates a new Color given the CAM16 lightness J in [0..100], chroma
+// C and hue angle h in [0..360] under the given viewing conditions.
+// WARNING: many combinations of `j`, `c`, and `h` values do not have corresponding
+// valid RGB values.
+func Cam16JCh(j, c, h float64, vc ViewingConditions) Color {
+	x, y, z := Cam16JChToXyz(j, c, h, vc)
+	return Xyz(x, y, z)
+}
+
+/// CAM16-UCS ///
+/////////////////
+// J', a' and b' are divided by 100 like L*a*b*, so that J' is in [0..1] and
+// a', b' are in about [-0.5..0.5].
+
+// Cam16ToCam16Ucs computes the CAM16-UCS coordinates from the CAM16 correlates.
+func Cam16ToCam16Ucs(cam Cam16) (j, a, b float64) {
+	j = 1.7 * cam.J / (1.0 + 0.007*cam.J) / 100.0
+	m := math.Log1p(0.0228*cam.M) / 0.0228 / 100.0
+	hSin, hCos := math.Sincos(cam.Hue * math.Pi / 180.0)
+	return j, m * hCos, m * hSin
+}
+
+// Cam16UcsToCam16JCh computes the CAM16 lightness J, chroma C and hue angle h
+// from CAM16-UCS coordinates under the given viewing conditions.
+func Cam16UcsToCam16JCh(j, a, b float64, vc ViewingConditions) (J, C, h float64) {
+	j *= 100.0
+	J = j / (1.7 - 0.007*j)
+	M := math.Expm1(0.0228*100.0*math.Sqrt(sq(a)+sq(b))) / 0.0228
+	C = M / vc.flRoot
+	h = math.Atan2(b, a) * 180.0 / math.Pi
+	if h < 0.0 {
+		h += 360.0
+	}
+	return
+}
+
+// Cam16Ucs returns the CAM16-UCS coordinates J', a' and b' of the color under
+// the given viewing conditions.
+func (col Color) Cam16Ucs(vc ViewingConditions) (j, a, b float64) {
+	return Cam16ToCam16Ucs(col.Cam16(vc))
+}
+
+// Cam16Ucs creates a new Color given CAM16-UCS coordinates under the given
+// viewing conditions.
+func Cam16Ucs(j, a, b float64, vc ViewingConditions) Color {
+	J, C, h := Cam16UcsToCam16JCh(j, a, b, vc)
+	return Cam16JCh(J, C, h, vc)
+}
+
+// BlendCam16Ucs blends two colors in CAM16-UCS under the default viewing conditions.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendCam16Ucs(c2 Color, t float64) Color {
+	j1, a1, b1 := c1.Cam16Ucs(DefaultViewingConditions)
+	j2, a2, b2 := c2.Cam16Ucs(DefaultViewingConditions)
+	return Cam16Ucs(j1+t*(j2-j1),
+		a1+t*(a2-a1),
+		b1+t*(b2-b1), DefaultViewingConditions)
+}
+
+// DistanceCam16Ucs computes the Euclidean distance in CAM16-UCS under the
+// default viewing conditions. Like DistanceCIEDE2000, this is a good measure
+// of visual similarity, and it is on the same scale.
+func (c1 Color) DistanceCam16Ucs(c2 Color) float64 {
+	return c1.DistanceCam16UcsVc(c2, DefaultViewingConditions)
+}
+
+// DistanceCam16UcsVc computes the Euclidean distance in CAM16-UCS under the
+// given viewing conditions.
+func (c1 Color) DistanceCam16UcsVc(c2 Color, vc ViewingConditions) float64 {
+	j1, a1, b1 := c1.Cam16Ucs(vc)
+	j2, a2, b2 := c2.Cam16Ucs(vc)
+	return math.Sqrt(sq(j1-j2) + sq(a1-a2) + sq(b1-b2))
+}
@@ -0,0 +1,90 @@
+package colorful
+
+import (
+	"math"
+	"testing"
+)
+
+// Reference values from Material Color Utilities, which uses the default viewing conditions.
+// Its sRGB to XYZ matrix differs slightly from ours, which matters most close to gray.
+var cam16vals = []struct {
+	hex string
+	cam Cam16
+	eps float64
+}{
+	{"#ff0000", Cam16{J: 46.445, C: 113.357, Hue: 27.408, M: 89.494, S: 91.889, Q: 105.988}, 1e-3},
+	{"#00ff00", Cam16{J: 79.332, C: 108.410, Hue: 142.139, M: 85.587, S: 78.604, Q: 138.520}, 1e-3},
+	{"#0000ff", Cam16{J: 25.465, C: 87.230, Hue: 282.788, M: 68.867, S: 93.674, Q: 78.481}, 1e-3},
+	{"#ffffff", Cam16{J: 100.0, C: 2.869, Hue: 209.492, M: 2.265, S: 12.068, Q: 155.521}, 1e-2},
+}
+
+func TestCam16Conversion(t *testing.T) {
+	for i, tt := range cam16vals {
+		c, _ := Hex(tt.hex)
+		cam := c.Cam16(DefaultViewingConditions)
+		if !almosteq_eps(cam.J, tt.cam.J, tt.eps) || !almosteq_eps(cam.C, tt.cam.C, tt.eps) || !almosteq_eps(cam.Hue, tt.cam.Hue, tt.eps) ||
+			!almosteq_eps(cam.M, tt.cam.M, tt.eps) || !almosteq_eps(cam.S, tt.cam.S, tt.eps) || !almosteq_eps(cam.Q, tt.cam.Q, tt.eps) {
+			t.Errorf("%v. %v.Cam16() => (%+v), want %+v", i, tt.hex, cam, tt.cam)
+		}
+	}
+}
+
+func TestCam16Black(t *testing.T) {
+	cam := Color{0.0, 0.0, 0.0}.Cam16(DefaultViewingConditions)
+	if cam.J != 0.0 || cam.C != 0.0 || cam.M != 0.0 || cam.S != 0.0 || cam.Q != 0.0 {
+		t.Errorf("black.Cam16() => (%+v), want all zero", cam)
+	}
+}
+
+func TestCam16HueQuadrature(t *testing.T) {
+	for _, tt := range cam16UniqueHues[:4] {
+		if h := cam16HueQuadrature(tt[0]); !almosteq_eps(h, tt[2], 1e-9) {
+			t.Errorf("cam16HueQuadrature(%v) => %v, want %v", tt[0], h, tt[2])
+		}
+	}
+}
+
+func TestViewingConditions(t *testing.T) {
+	vc := NewViewingConditions(D50, 64.0, 0.2, SurroundDim, true)
+	if vc.WhitePoint() != D50 || vc.AdaptingLuminance() != 64.0 || vc.BackgroundLuminance() != 0.2 || vc.Surround() != SurroundDim || !vc.Discounting() {
+		t.Errorf("NewViewingConditions(D50, 64, 0.2, SurroundDim, true) => %v, %v, %v, %v, %v",
+			vc.WhitePoint(), vc.AdaptingLuminance(), vc.BackgroundLuminance(), vc.Surround(), vc.Discounting())
+	}
+}
+
+func TestCam16Roundtrip(t *testing.T) {
+	vcs := []ViewingConditions{
+		DefaultViewingConditions,
+		NewViewingConditions(D50, 64.0, 0.2, SurroundDim, false),
+		NewViewingConditions(D65, 318.31, 0.2, SurroundDark, true),
+	}
+	for _, vc := range vcs {
+		for i, tt := range vals {
+			cam := tt.c.Cam16(vc)
+			c := Cam16JCh(cam.J, cam.C, cam.Hue, vc)
+			if !c.AlmostEqualRgb(tt.c) {
+				t.Errorf("%v. Cam16JCh(%v) => (%v), want %v (delta %v)", i, cam, c, tt.c, delta)
+			}
+
+			j, a, b := tt.c.Cam16Ucs(vc)
+			c = Cam16Ucs(j, a, b, vc)
+			if !c.AlmostEqualRgb(tt.c) {
+				t.Errorf("%v. Cam16Ucs(%v, %v, %v) => (%v), want %v (delta %v)", i, j, a, b, c, tt.c, delta)
+			}
+		}
+	}
+}
+
+func TestCam16UcsDistance(t *testing.T) {
+	c1, _ := Hex("#ff0000")
+	c2, _ := Hex("#00ff00")
+	j1, a1, b1 := c1.Cam16Ucs(DefaultViewingConditions)
+	j2, a2, b2 := c2.Cam16Ucs(DefaultViewingConditions)
+	want := math.Sqrt(sq(j1-j2) + sq(a1-a2) + sq(b1-b2))
+	if d := c1.DistanceCam16Ucs(c2); !almosteq(d, want) {
+		t.Errorf("DistanceCam16Ucs => %v, want %v", d, want)
+	}
+	if d := c1.DistanceCam16Ucs(c1); d != 0.0 {
+		t.Errorf("DistanceCam16Ucs with itself => %v, want 0", d)
+	}
+}
@@ -0,0 +1,238 @@
+package colorful
+
+import (
+	"math"
+	"sync"
+)
+
+// Color temperature: the colors of blackbody radiators and daylight, and the
+// correlated color temperature (CCT) of other colors, which is the temperature
+// of the closest blackbody in the CIE 1960 UCS (see Uv1960), together with the
+// signed distance Duv to it, positive above the Planckian locus (greenish) and
+// negative below (pinkish).
+
+// The range of temperatures in K, in which CCT finds the correlated color temperature.
+const (
+	PlanckianMinTemperature = 1000.0
+	PlanckianMaxTemperature = 15000.0
+)
+
+// planckianUv returns the chromaticity of a blackbody in the CIE 1960 UCS,
+// integrated from Planck's law.
+func planckianUv(t float64) (u, v float64) {
+	x, y, _ := XyzToXyy(BlackbodySpectrum(t).Xyz(CIE1931))
+	return XyToUv1960(x, y)
+}
+
+// PlanckianXy returns the CIE 1931 chromaticity of a blackbody radiator of the
+// given temperature in K.
+func PlanckianXy(t float64) (x, y float64) {
+	return Uv1960ToXy(planckianUv(t))
+}
+
+// DaylightXy returns the chromaticity of the CIE daylight illuminant of the
+// given correlated color temperature in K, which is defined in [4000..25000].
+// Note that, for example, D65 has a temperature of 6504 K due to a revision of
+// physical constants.
+func DaylightXy(t float64) (x, y float64) {
+	if t <= 7000.0 {
+		x = -4.6070e9/(t*t*t) + 2.9678e6/(t*t) + 0.09911e3/t + 0.244063
+	} else {
+		x = -2.0064e9/(t*t*t) + 1.9018e6/(t*t) + 0.24748e3/t + 0.237040
+	}
+	y = -3.0*x*x + 2.870*x - 0.275
+	return
+}
+
+// PlanckianDuvXy returns the chromaticity at the given distance Duv from the
+// Planckian locus at the temperature t in K, along the isotemperature line.
+func PlanckianDuvXy(t, duv float64) (x, y float64) {
+	u, v := planckianUv(t)
+	if duv != 0.0 {
+		// The isotemperature line is perpendicular to the locus, on which u
+		// decreases with temperature.
+		const dt = 0.01
+		u1, v1 := planckianUv(t - dt)
+		u2, v2 := planckianUv(t + dt)
+		du, dv := u2-u1, v2-v1
+		n := math.Sqrt(du*du + dv*dv)
+		u += duv * dv / n
+		v -= duv * du / n
+	}
+	return Uv1960ToXy(u, v)
+}
+
+// brightestWithChromaticity returns the brightest color in sRGB which has the
+// given chromaticity. It may be invalid if the chromaticity is out of gamut.
+func brightestWithChromaticity(x, y float64) Color {
+	r, g, b := XyzToLinearRgb(XyyToXyz(x, y, 1.0))
+	max := math.Max(r, math.Max(g, b))
+	return LinearRgb(r/max, g/max, b/max)
+}
+
+// Kelvin returns the brightest color with the chromaticity of a blackbody
+// radiator of the given temperature in K, as for example
+// the light of an incandescent lamp.
+// WARNING: temperatures below about 1900 K are outside of sRGB, so this
+// returns an invalid color for them, use Clamped if needed.
+func Kelvin(t float64) Color {
+	return brightestWithChromaticity(PlanckianXy(t))
+}
+
+// KelvinDuv is like Kelvin, but the chromaticity is at the distance Duv from
+// the Planckian locus, as is often specified for light sources.
+func KelvinDuv(t, duv float64) Color {
+	return brightestWithChromaticity(PlanckianDuvXy(t, duv))
+}
+
+// KelvinDaylight returns the brightest color with the chromaticity of CIE
+// daylight of the given correlated color temperature in K, in [4000..25000].
+func KelvinDaylight(t float64) Color {
+	return brightestWithChromaticity(DaylightXy(t))
+}
+
+// CCT returns the correlated color temperature in K and the distance Duv to
+// the Planckian locus of the color, using the method of Ohno (2013) with a
+// cascade of tables, which is accurate to 0.01 K within [1000..15000] K and
+// a Duv of ±0.05.
+// https://doi.org/10.1080/15502724.2014.839020
+func (col Color) CCT() (cct, duv float64) {
+	x, y, _ := col.Xyy()
+	return XyToCCT(x, y)
+}
+
+// CCTMcCamy returns the correlated color temperature in K of the color using
+// the cubic approximation of McCamy (1992). It is much faster than CCT, but
+// only accurate to a few K for colors close to the Planckian locus and
+// temperatures in [2000..12500] K.
+// https://doi.org/10.1002/col.5080170211
+func (col Color) CCTMcCamy() float64 {
+	x, y, _ := col.Xyy()
+	return XyToCCTMcCamy(x, y)
+}
+
+// XyToCCTMcCamy is CCTMcCamy for a chromaticity given in CIE 1931 xy.
+func XyToCCTMcCamy(x, y float64) float64 {
+	n := (x - 0.3320) / (y - 0.1858)
+	return -449.0*n*n*n + 3525.0*n*n - 6823.3*n + 5520.33
+}
+
+// A point of the Planckian locus in the CIE 1960 UCS.
+type planckianPoint struct {
+	t, u, v float64
+}
+
+func newPlanckianPoint(t float64) planckianPoint {
+	u, v := planckianUv(t)
+	return planckianPoint{t, u, v}
+}
+
+// The Planckian locus in relative steps of ohnoStep, computed once on first
+// use. Every tenth entry makes Ohno's first table of 1% steps; his cascades of
+// finer tables are then interpolated from it rather than integrated anew.
+const ohnoStep = 0.001
+
+var (
+	ohnoTableOnce sync.Once
+	ohnoTable     []planckianPoint
+)
+
+func planckianTable() []planckianPoint {
+	ohnoTableOnce.Do(func() {
+		for t := PlanckianMinTemperature; t <= PlanckianMaxTemperature*(1.0+ohnoStep); t *= 1.0 + ohnoStep {
+			ohnoTable = append(ohnoTable, newPlanckianPoint(t))
+		}
+	})
+	return ohnoTable
+}
+
+// planckianInterp returns the point at temperature t of the quadratic through
+// three neighbouring points of the locus.
+func planckianInterp(p0, p1, p2 planckianPoint, t float64) planckianPoint {
+	// In mireds the locus is much closer to a parabola than in kelvins.
+	r, r0, r1, r2 := 1.0/t, 1.0/p0.t, 1.0/p1.t, 1.0/p2.t
+	l0 := (r - r1) * (r - r2) / ((r0 - r1) * (r0 - r2))
+	l1 := (r - r0) * (r - r2) / ((r1 - r0) * (r1 - r2))
+	l2 := (r - r0) * (r - r1) / ((r2 - r0) * (r2 - r1))
+	return planckianPoint{t, l0*p0.u + l1*p1.u + l2*p2.u, l0*p0.v + l1*p1.v + l2*p2.v}
+}
+
+// ohnoCorrection returns the factor by which the CCT of the parabolic solution
+// through points of the given relative step is corrected. Ohno uses 0.99991
+// for steps of 1%, the bias shrinks with the square of the step.
+func ohnoCorrection(step float64) float64 {
+	return 1.0 - 0.00009*sq(step/0.01)
+}
+
+// XyToCCT is CCT for a chromaticity given in CIE 1931 xy.
+func XyToCCT(x, y float64) (cct, duv float64) {
+	u, v := XyToUv1960(x, y)
+	dist := func(p planckianPoint) float64 {
+		return math.Sqrt(sq(u-p.u) + sq(v-p.v))
+	}
+
+	// Find the closest entry of the table, but not one at its ends: first
+	// among every coarse-th entry, then among the entries around that one.
+	const coarse = 10
+	table := planckianTable()
+	m, dm := 1, dist(table[1])
+	for i := coarse; i < len(table)-1; i += coarse {
+		if d := dist(table[i]); d < dm {
+			m, dm = i, d
+		}
+	}
+	lo, hi := m-coarse, m+coarse
+	if lo < 1 {
+		lo = 1
+	}
+	if hi > len(table)-2 {
+		hi = len(table) - 2
+	}
+	for i := lo; i <= hi; i++ {
+		if d := dist(table[i]); d < dm {
+			m, dm = i, d
+		}
+	}
+	p0, p1, p2 := table[m-1], table[m], table[m+1]
+
+	// Then refine twice with Ohno's cascade, tables of ten steps between p0
+	// and p2, taking the locus there to be the parabola through them.
+	var fine [11]planckianPoint
+	for k := 0; k < 2; k++ {
+		for i := range fine {
+			fine[i] = planckianInterp(p0, p1, p2, p0.t+(p2.t-p0.t)*float64(i)/10.0)
+		}
+		m, dm = 1, dist(fine[1])
+		for i := 2; i < len(fine)-1; i++ {
+			if d := dist(fine[i]); d < dm {
+				m, dm = i, d
+			}
+		}
+		p0, p1, p2 = fine[m-1], fine[m], fine[m+1]
+	}
+	t0, t1, t2 := p0.t, p1.t, p2.t
+	d0, d1, d2 := dist(p0), dist(p1), dist(p2)
+
+	// The triangular solution, for colors close to the locus.
+	l := math.Sqrt(sq(p2.u-p0.u) + sq(p2.v-p0.v))
+	xl := (d0*d0 - d2*d2 + l*l) / (2.0 * l)
+	cct = t0 + (t2-t0)*xl/l
+	vx := p0.v + (p2.v-p0.v)*xl/l
+	duv = math.Copysign(math.Sqrt(math.Max(d0*d0-xl*xl, 0.0)), v-vx)
+	if math.Abs(duv) < 0.002 {
+		return
+	}
+
+	// The parabolic solution, for colors further away.
+	X := (t2 - t1) * (t0 - t2) * (t1 - t0)
+	a := (t0*(d2-d1) + t1*(d0-d2) + t2*(d1-d0)) / X
+	b := -(t0*t0*(d2-d1) + t1*t1*(d0-d2) + t2*t2*(d1-d0)) / X
+	c := -(d0*(t2-t1)*t1*t2 + d1*(t0-t2)*t0*t2 + d2*(t1-t0)*t0*t1) / X
+	cct = -b / (2.0 * a)
+	duv = a*cct*cct + b*cct + c
+
+	// After the cascades the step is so small that this is nearly 1.
+	cct *= ohnoCorrection((t2 - t0) / (2.0 * t1))
+	duv = math.Copysign(duv, v-vx)
+	return
+}
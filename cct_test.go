@@ -0,0 +1,95 @@
+package colorful
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCCT(t *testing.T) {
+	tests := []struct {
+		name     string
+		x, y     float64
+		cct, duv float64
+	}{
+		{"A", 0.44757, 0.40745, 2856.0, 0.0},
+		{"D50", 0.34567, 0.35850, 5003.0, 0.0032},
+		{"D65", 0.31271, 0.32902, 6505.0, 0.0032},
+	}
+	for _, tt := range tests {
+		cct, duv := XyToCCT(tt.x, tt.y)
+		if math.Abs(cct-tt.cct) > 2.0 || math.Abs(duv-tt.duv) > 1e-4 {
+			t.Errorf("XyToCCT(%v) => (%v, %v), want (%v, %v)", tt.name, cct, duv, tt.cct, tt.duv)
+		}
+		if mc := XyToCCTMcCamy(tt.x, tt.y); math.Abs(mc-tt.cct) > 5.0 {
+			t.Errorf("XyToCCTMcCamy(%v) => %v, want %v", tt.name, mc, tt.cct)
+		}
+	}
+
+	// sRGB white is D65.
+	cct, duv := Color{1.0, 1.0, 1.0}.CCT()
+	if math.Abs(cct-6504.0) > 5.0 || math.Abs(duv-0.0032) > 1e-4 {
+		t.Errorf("white.CCT() => (%v, %v), want (6504, 0.0032)", cct, duv)
+	}
+}
+
+func TestCCTRoundtrip(t *testing.T) {
+	for _, temp := range []float64{1000, 1500, 2700, 4000, 6500, 10000, 15000} {
+		for _, duv := range []float64{-0.05, -0.01, -0.001, 0.0, 0.001, 0.01, 0.05} {
+			cct, duv2 := XyToCCT(PlanckianDuvXy(temp, duv))
+			if math.Abs(cct-temp) > 0.01 || math.Abs(duv2-duv) > 1e-6 {
+				t.Errorf("XyToCCT(PlanckianDuvXy(%v, %v)) => (%v, %v)", temp, duv, cct, duv2)
+			}
+		}
+	}
+}
+
+func TestKelvin(t *testing.T) {
+	for _, temp := range []float64{2000, 2700, 4000, 6500, 10000} {
+		c := Kelvin(temp)
+		if !c.IsValid() || !almosteq(math.Max(c.R, math.Max(c.G, c.B)), 1.0) {
+			t.Errorf("Kelvin(%v) => (%v), want a valid color with a maximum component of 1", temp, c)
+		}
+		if cct, duv := c.CCT(); math.Abs(cct-temp) > 0.01 || math.Abs(duv) > 1e-6 {
+			t.Errorf("Kelvin(%v).CCT() => (%v, %v)", temp, cct, duv)
+		}
+		if cct, duv := KelvinDuv(temp, 0.01).CCT(); math.Abs(cct-temp) > 0.01 || math.Abs(duv-0.01) > 1e-6 {
+			t.Errorf("KelvinDuv(%v, 0.01).CCT() => (%v, %v)", temp, cct, duv)
+		}
+	}
+
+	// Warm light is red, cold light is blue.
+	if c := Kelvin(2700); !almosteq(c.R, 1.0) || c.B > 0.5 {
+		t.Errorf("Kelvin(2700) => (%v), want orange", c)
+	}
+	if c := Kelvin(10000); !almosteq(c.B, 1.0) || c.R > 0.9 {
+		t.Errorf("Kelvin(10000) => (%v), want blueish", c)
+	}
+}
+
+func TestDaylight(t *testing.T) {
+	// D65 is defined with the old value of 6500 K. Its tabulated spectrum has a
+	// slightly different chromaticity than given by the formula.
+	x, y := DaylightXy(6500.0 * 1.4388 / 1.438)
+	if !almosteq_eps(x, 0.31271, 1e-3) || !almosteq_eps(y, 0.32902, 1e-3) {
+		t.Errorf("DaylightXy(6504) => (%v, %v), want (0.31271, 0.32902)", x, y)
+	}
+	if c := KelvinDaylight(6500.0 * 1.4388 / 1.438); !c.AlmostEqualRgb(Color{1.0, 1.0, 1.0}) {
+		t.Errorf("KelvinDaylight(6504) => (%v), want white", c)
+	}
+}
+
+func TestOhnoCorrection(t *testing.T) {
+	if f := ohnoCorrection(0.01); math.Abs(f-0.99991) > 1e-12 {
+		t.Errorf("ohnoCorrection(1%%) => %v, want 0.99991", f)
+	}
+	if f := ohnoCorrection(0.0001); math.Abs(f-1.0) > 1e-8 {
+		t.Errorf("ohnoCorrection(0.01%%) => %v, want about 1", f)
+	}
+}
+
+func BenchmarkXyToCCT(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		XyToCCT(0.31, 0.33)
+	}
+}
@@ -0,0 +1,124 @@
+// This file provides device-CMYK conversions for print output.
+
+package colorful
+
+import (
+	"image/color"
+	"math"
+)
+
+// Device CMYK uses the same naive model as image/color, that is
+// R = (1-C)(1-K) and so on. This is not a colorimetric conversion (that would
+// need an ICC profile of the press), but it is what most software does.
+// All of c, m, y and k are in [0..1].
+
+// CmykSettings controls how the black (K) channel is separated out of the
+// cyan, magenta and yellow inks.
+type CmykSettings struct {
+	// GrayComponentReplacement in [0..1] is how much of the gray component
+	// (the part shared by all of C, M and Y) is printed in black ink.
+	// 0 produces pure CMY, 1 replaces all of it (as far as BlackGeneration allows).
+	GrayComponentReplacement float64
+
+	// UnderColorRemoval in [0..1] is how much of the C, M and Y inks lying
+	// under the generated black is removed. 1 keeps the color unchanged,
+	// 0 prints the black on top of the full CMY, darkening the color.
+	UnderColorRemoval float64
+
+	// BlackGeneration maps the gray component in [0..1] to the amount of
+	// black in [0..1] before GrayComponentReplacement is applied. The result
+	// is clamped to the gray component. If nil, the identity is used.
+	BlackGeneration func(gray float64) float64
+
+	// TotalAreaCoverage is the maximum allowed sum c+m+y+k in [0..4], also
+	// known as total ink limit. If exceeded, the C, M and Y inks are scaled
+	// down, keeping black. Zero means no limit.
+	TotalAreaCoverage float64
+}
+
+// SkeletonBlack returns a black generation curve which starts adding black
+// once the gray component exceeds start, and then ramps up linearly to full
+// black. This is what is typically meant by a "UCR" separation, as opposed to
+// "GCR", since black is only used in the dark neutrals.
+func SkeletonBlack(start float64) func(gray float64) float64 {
+	return func(gray float64) float64 {
+		if gray <= start || start >= 1.0 {
+			return 0.0
+		}
+		return (gray - start) / (1.0 - start)
+	}
+}
+
+// Cmyk returns the device CMYK values of the color, using full gray component
+// replacement. This is the same as image/color.RGBToCMYK, but without the loss
+// of precision. All values are in [0..1].
+func (col Color) Cmyk() (c, m, y, k float64) {
+	return col.CmykEx(CmykSettings{GrayComponentReplacement: 1.0, UnderColorRemoval: 1.0})
+}
+
+// CmykEx returns the device CMYK values of the color, separating the black
+// channel according to the given settings. All values are in [0..1].
+// The color is clamped to the valid RGB range first.
+func (col Color) CmykEx(settings CmykSettings) (c, m, y, k float64) {
+	col = col.Clamped()
+
+	// The amount of ink needed without using any black.
+	c0, m0, y0 := 1.0-col.R, 1.0-col.G, 1.0-col.B
+	gray := math.Min(math.Min(c0, m0), y0)
+
+	bg := gray
+	if settings.BlackGeneration != nil {
+		bg = settings.BlackGeneration(gray)
+	}
+	k = math.Max(0.0, math.Min(clamp01(settings.GrayComponentReplacement)*bg, gray))
+
+	// Full removal needs to undo the multiplication by (1-k) of the inverse.
+	ucr := clamp01(settings.UnderColorRemoval)
+	removed := func(v float64) float64 {
+		full := 0.0
+		if k < 1.0 {
+			full = (v - k) / (1.0 - k)
+		}
+		return v - ucr*(v-full)
+	}
+	c, m, y = removed(c0), removed(m0), removed(y0)
+
+	if tac := settings.TotalAreaCoverage; tac > 0.0 && c+m+y+k > tac {
+		if k >= tac {
+			return 0.0, 0.0, 0.0, tac
+		}
+		scale := (tac - k) / (c + m + y)
+		c, m, y = c*scale, m*scale, y*scale
+	}
+	return
+}
+
+// Cmyk creates a new Color given device CMYK values in [0..1].
+func Cmyk(c, m, y, k float64) Color {
+	return Color{(1.0 - c) * (1.0 - k), (1.0 - m) * (1.0 - k), (1.0 - y) * (1.0 - k)}
+}
+
+// CMYK converts the color to the image/color.CMYK type, using full gray
+// component replacement. Going the other way is done by MakeColor.
+func (col Color) CMYK() color.CMYK {
+	c, m, y, k := col.Cmyk()
+	return color.CMYK{
+		C: uint8(c*255.0 + 0.5),
+		M: uint8(m*255.0 + 0.5),
+		Y: uint8(y*255.0 + 0.5),
+		K: uint8(k*255.0 + 0.5),
+	}
+}
+
+// BlendCmyk blends two colors in the device CMYK color-space, using full gray
+// component replacement. This roughly corresponds to mixing inks by halftoning.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendCmyk(c2 Color, t float64) Color {
+	cc1, m1, y1, k1 := c1.Cmyk()
+	cc2, m2, y2, k2 := c2.Cmyk()
+	return Cmyk(
+		cc1+t*(cc2-cc1),
+		m1+t*(m2-m1),
+		y1+t*(y2-y1),
+		k1+t*(k2-k1))
+}
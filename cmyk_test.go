@@ -0,0 +1,121 @@
+package colorful
+
+import (
+	"image/color"
+	"testing"
+)
+
+var cmykvals = []struct {
+	c    Color
+	cmyk [4]float64
+}{
+	{Color{1.0, 1.0, 1.0}, [4]float64{0.0, 0.0, 0.0, 0.0}},
+	{Color{0.0, 0.0, 0.0}, [4]float64{0.0, 0.0, 0.0, 1.0}},
+	{Color{1.0, 0.0, 0.0}, [4]float64{0.0, 1.0, 1.0, 0.0}},
+	{Color{0.0, 1.0, 1.0}, [4]float64{1.0, 0.0, 0.0, 0.0}},
+	{Color{0.5, 0.5, 0.5}, [4]float64{0.0, 0.0, 0.0, 0.5}},
+	{Color{0.5, 0.25, 0.25}, [4]float64{0.0, 0.5, 0.5, 0.5}},
+	{Color{0.2, 0.4, 0.8}, [4]float64{0.75, 0.5, 0.0, 0.2}},
+}
+
+func TestCmykConversion(t *testing.T) {
+	for i, tt := range cmykvals {
+		c, m, y, k := tt.c.Cmyk()
+		if !almosteq(c, tt.cmyk[0]) || !almosteq(m, tt.cmyk[1]) || !almosteq(y, tt.cmyk[2]) || !almosteq(k, tt.cmyk[3]) {
+			t.Errorf("%v. %v.Cmyk() => (%v), want %v (delta %v)", i, tt.c, []float64{c, m, y, k}, tt.cmyk, delta)
+		}
+	}
+}
+
+func TestCmykCreation(t *testing.T) {
+	for i, tt := range cmykvals {
+		c := Cmyk(tt.cmyk[0], tt.cmyk[1], tt.cmyk[2], tt.cmyk[3])
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. Cmyk(%v) => (%v), want %v (delta %v)", i, tt.cmyk, c, tt.c, delta)
+		}
+	}
+}
+
+func TestCmykExRoundtrip(t *testing.T) {
+	// As long as all undercolor is removed and there's no ink limit, any
+	// amount of black generation must keep the color.
+	settings := []CmykSettings{
+		{GrayComponentReplacement: 0.0, UnderColorRemoval: 1.0},
+		{GrayComponentReplacement: 0.5, UnderColorRemoval: 1.0},
+		{GrayComponentReplacement: 1.0, UnderColorRemoval: 1.0},
+		{GrayComponentReplacement: 1.0, UnderColorRemoval: 1.0, BlackGeneration: SkeletonBlack(0.5)},
+	}
+	for _, s := range settings {
+		for i, tt := range vals {
+			c := Cmyk(tt.c.CmykEx(s))
+			if !c.AlmostEqualRgb(tt.c) {
+				t.Errorf("%v. Cmyk(%v.CmykEx(%+v)) => (%v), want %v (delta %v)", i, tt.c, s, c, tt.c, delta)
+			}
+		}
+	}
+}
+
+func TestCmykExGrayComponentReplacement(t *testing.T) {
+	col := Color{0.5, 0.25, 0.25}
+
+	c, m, y, k := col.CmykEx(CmykSettings{})
+	if !almosteq(c, 0.5) || !almosteq(m, 0.75) || !almosteq(y, 0.75) || k != 0.0 {
+		t.Errorf("Without GCR, %v => %v, want [0.5 0.75 0.75 0]", col, []float64{c, m, y, k})
+	}
+
+	// Black printed on top of the unchanged CMY.
+	c, m, y, k = col.CmykEx(CmykSettings{GrayComponentReplacement: 1.0})
+	if !almosteq(c, 0.5) || !almosteq(m, 0.75) || !almosteq(y, 0.75) || !almosteq(k, 0.5) {
+		t.Errorf("Without UCR, %v => %v, want [0.5 0.75 0.75 0.5]", col, []float64{c, m, y, k})
+	}
+
+	// Light colors don't get any black with a skeleton black.
+	light := Color{0.9, 0.8, 0.7}
+	_, _, _, k = light.CmykEx(CmykSettings{GrayComponentReplacement: 1.0, UnderColorRemoval: 1.0, BlackGeneration: SkeletonBlack(0.5)})
+	if k != 0.0 {
+		t.Errorf("Skeleton black for %v => k = %v, want 0", light, k)
+	}
+}
+
+func TestCmykExTotalAreaCoverage(t *testing.T) {
+	col := Color{0.1, 0.05, 0.0}
+	s := CmykSettings{TotalAreaCoverage: 2.4}
+	c, m, y, k := col.CmykEx(s)
+	if tac := c + m + y + k; tac > s.TotalAreaCoverage+1e-12 {
+		t.Errorf("%v.CmykEx(%+v) => %v with coverage %v, want at most %v", col, s, []float64{c, m, y, k}, tac, s.TotalAreaCoverage)
+	}
+
+	s = CmykSettings{GrayComponentReplacement: 1.0, TotalAreaCoverage: 0.5}
+	c, m, y, k = Color{0.0, 0.0, 0.0}.CmykEx(s)
+	if c != 0.0 || m != 0.0 || y != 0.0 || k != 0.5 {
+		t.Errorf("Black with coverage limit 0.5 => %v, want [0 0 0 0.5]", []float64{c, m, y, k})
+	}
+}
+
+func TestCmykImageColor(t *testing.T) {
+	for i, tt := range vals {
+		// Go's conversion works on 8-bit values, so compare on those.
+		c8, _ := Hex(tt.hex)
+		cc, m, y, k := color.RGBToCMYK(c8.RGB255())
+		want := color.CMYK{C: cc, M: m, Y: y, K: k}
+		if got := c8.CMYK(); got != want {
+			t.Errorf("%v. %v.CMYK() => %v, want %v", i, c8, got, want)
+		}
+
+		c, ok := MakeColor(tt.c.CMYK())
+		if !ok || !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. MakeColor(%v.CMYK()) => %v, want %v", i, tt.c, c, tt.c)
+		}
+	}
+}
+
+func TestBlendCmykEndpoints(t *testing.T) {
+	c1, _ := Hex("#1a1a46")
+	c2, _ := Hex("#666666")
+	if b := c1.BlendCmyk(c2, 0).Hex(); b != c1.Hex() {
+		t.Errorf("BlendCmyk t=0: got %v, want %v", b, c1.Hex())
+	}
+	if b := c1.BlendCmyk(c2, 1).Hex(); b != c2.Hex() {
+		t.Errorf("BlendCmyk t=1: got %v, want %v", b, c2.Hex())
+	}
+}
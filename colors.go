@@ -354,6 +354,167 @@ func Hsl(h, s, l float64) Color {
 	return Color{r, g, b}
 }
 
+/// HWB ///
+///////////
+// https://www.w3.org/TR/css-color-4/#the-hwb-notation
+// Hue-Whiteness-Blackness, as used by CSS. h is in [0..359] and w,b in [0..1]
+
+// Hwb returns the Hue [0..359], Whiteness and Blackness [0..1] of the color.
+func (col Color) Hwb() (h, w, b float64) {
+	h, s, v := col.Hsv()
+	w = (1.0 - s) * v
+	b = 1.0 - v
+	return
+}
+
+// Hwb creates a new Color given a Hue in [0..359], a Whiteness and a Blackness in [0..1].
+// If w+b >= 1, the result is a shade of gray with the ratio of w to w+b as its value.
+func Hwb(h, w, b float64) Color {
+	if w+b >= 1.0 {
+		gray := w / (w + b)
+		return Color{gray, gray, gray}
+	}
+
+	v := 1.0 - b
+	return Hsv(h, 1.0-w/v, v)
+}
+
+// BlendHwb blends two colors in the HWB color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendHwb(c2 Color, t float64) Color {
+	h1, w1, b1 := c1.Hwb()
+	h2, w2, b2 := c2.Hwb()
+
+	// Like for HSV, grays have no meaningful hue.
+	if w1+b1 >= 1.0 && w2+b2 < 1.0 {
+		h1 = h2
+	} else if w2+b2 >= 1.0 && w1+b1 < 1.0 {
+		h2 = h1
+	}
+
+	return Hwb(interp_angle(h1, h2, t), w1+t*(w2-w1), b1+t*(b2-b1))
+}
+
+/// HSI ///
+///////////
+// From Gonzalez & Woods, "Digital Image Processing".
+// Note that h is in [0..359] and s,i in [0..1]
+// Unlike HSV and HSL, the hue is computed geometrically, so it differs
+// slightly from the HSV hue for colors in between the primaries.
+
+// Hsi returns the Hue [0..359], Saturation [0..1], and Intensity [0..1] of the color.
+func (col Color) Hsi() (h, s, i float64) {
+	min := math.Min(math.Min(col.R, col.G), col.B)
+	i = (col.R + col.G + col.B) / 3.0
+
+	if i == 0.0 || min == math.Max(math.Max(col.R, col.G), col.B) {
+		return 0.0, 0.0, i
+	}
+	s = 1.0 - min/i
+
+	num := 0.5 * ((col.R - col.G) + (col.R - col.B))
+	den := math.Sqrt(sq(col.R-col.G) + (col.R-col.B)*(col.G-col.B))
+	h = math.Acos(math.Max(-1.0, math.Min(num/den, 1.0))) * 180.0 / math.Pi
+	if col.B > col.G {
+		h = 360.0 - h
+	}
+	if h >= 360.0 {
+		h -= 360.0
+	}
+	return
+}
+
+// Hsi creates a new Color given a Hue in [0..359], a Saturation [0..1], and an Intensity in [0..1]
+// WARNING: high intensities with high saturations do not have corresponding
+// valid RGB values; use Clamped if you need a valid color.
+func Hsi(h, s, i float64) Color {
+	// Returns the "lead" channel of the sector, relative to its start.
+	lead := func(h float64) float64 {
+		hr := h * math.Pi / 180.0
+		return i * (1.0 + s*math.Cos(hr)/math.Cos(math.Pi/3.0-hr))
+	}
+
+	h = math.Mod(math.Mod(h, 360.0)+360.0, 360.0)
+	low := i * (1.0 - s)
+
+	switch {
+	case h < 120.0:
+		r := lead(h)
+		return Color{r, 3.0*i - r - low, low}
+	case h < 240.0:
+		g := lead(h - 120.0)
+		return Color{low, g, 3.0*i - g - low}
+	default:
+		b := lead(h - 240.0)
+		return Color{3.0*i - b - low, low, b}
+	}
+}
+
+// BlendHsi blends two colors in the HSI color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendHsi(c2 Color, t float64) Color {
+	h1, s1, i1 := c1.Hsi()
+	h2, s2, i2 := c2.Hsi()
+
+	if s1 == 0 && s2 != 0 {
+		h1 = h2
+	} else if s2 == 0 && s1 != 0 {
+		h2 = h1
+	}
+
+	return Hsi(interp_angle(h1, h2, t), s1+t*(s2-s1), i1+t*(i2-i1))
+}
+
+/// HSP ///
+///////////
+// http://alienryderflex.com/hsp.html
+// Hue and saturation are the same as in HSV, but the brightness is replaced by
+// the "perceived brightness" P. h is in [0..359] and s,p in [0..1]
+
+const (
+	hspPr = 0.299
+	hspPg = 0.587
+	hspPb = 0.114
+)
+
+func hspBrightness(r, g, b float64) float64 {
+	return math.Sqrt(hspPr*r*r + hspPg*g*g + hspPb*b*b)
+}
+
+// Hsp returns the Hue [0..359], Saturation [0..1], and Perceived brightness [0..1] of the color.
+func (col Color) Hsp() (h, s, p float64) {
+	h, s, _ = col.Hsv()
+	p = hspBrightness(col.R, col.G, col.B)
+	return
+}
+
+// Hsp creates a new Color given a Hue in [0..359], a Saturation [0..1], and a Perceived brightness in [0..1]
+// WARNING: bright, saturated colors of dark hues (such as blue) do not have
+// corresponding valid RGB values; use Clamped if you need a valid color.
+func Hsp(h, s, p float64) Color {
+	// Hue and saturation don't change when scaling a color, but brightness
+	// scales linearly, so we take the brightest color of that hue and
+	// saturation and scale it down to the requested brightness.
+	c := Hsv(h, s, 1.0)
+	k := p / hspBrightness(c.R, c.G, c.B)
+	return Color{c.R * k, c.G * k, c.B * k}
+}
+
+// BlendHsp blends two colors in the HSP color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendHsp(c2 Color, t float64) Color {
+	h1, s1, p1 := c1.Hsp()
+	h2, s2, p2 := c2.Hsp()
+
+	if s1 == 0 && s2 != 0 {
+		h1 = h2
+	} else if s2 == 0 && s1 != 0 {
+		h2 = h1
+	}
+
+	return Hsp(interp_angle(h1, h2, t), s1+t*(s2-s1), p1+t*(p2-p1))
+}
+
 /// Hex ///
 ///////////
 
@@ -684,7 +845,11 @@ func (c1 Color) DistanceCIE76(c2 Color) float64 {
 func (cl Color) DistanceCIE94(cr Color) float64 {
 	l1, a1, b1 := cl.Lab()
 	l2, a2, b2 := cr.Lab()
+	return labDistanceCIE94(l1, a1, b1, l2, a2, b2)
+}
 
+// labDistanceCIE94 is DistanceCIE94 of L*a*b* values.
+func labDistanceCIE94(l1, a1, b1, l2, a2, b2 float64) float64 {
 	// NOTE: Since all those formulas expect L,a,b values 100x larger than we
 	//       have them in this library, we either need to adjust all constants
 	//       in the formula, or convert the ranges of L,a,b before, and then
@@ -728,7 +893,11 @@ func (cl Color) DistanceCIEDE2000(cr Color) float64 {
 func (cl Color) DistanceCIEDE2000klch(cr Color, kl, kc, kh float64) float64 {
 	l1, a1, b1 := cl.Lab()
 	l2, a2, b2 := cr.Lab()
+	return labDistanceCIEDE2000(l1, a1, b1, l2, a2, b2, kl, kc, kh)
+}
 
+// labDistanceCIEDE2000 is DistanceCIEDE2000klch of L*a*b* values.
+func labDistanceCIEDE2000(l1, a1, b1, l2, a2, b2, kl, kc, kh float64) float64 {
 	// As with CIE94, we scale up the ranges of L,a,b beforehand and scale
 	// them down again afterwards.
 	l1, a1, b1 = l1*100.0, a1*100.0, b1*100.0
@@ -800,6 +969,47 @@ func (cl Color) DistanceCIEDE2000klch(cr Color, kl, kc, kh float64) float64 {
 	return math.Sqrt(sq(deltaLp/(kl*sl))+sq(deltaCp/(kc*sc))+sq(deltaHp/(kh*sh))+rt*(deltaCp/(kc*sc))*(deltaHp/(kh*sh))) * 0.01
 }
 
+// DistanceCMC uses the CMC l:c (1984) formula to calculate color distance,
+// with the lightness and chroma weights l and c. Use 2:1 for acceptability,
+// as is common for textiles, and 1:1 for perceptibility. Unlike the others,
+// it is not symmetric: c1 is the reference, usually the standard of a sample.
+func (c1 Color) DistanceCMC(c2 Color, l, c float64) float64 {
+	l1, a1, b1 := c1.Lab()
+	l2, a2, b2 := c2.Lab()
+	return labDistanceCMC(l1, a1, b1, l2, a2, b2, l, c)
+}
+
+// labDistanceCMC is DistanceCMC of L*a*b* values.
+func labDistanceCMC(l1, a1, b1, l2, a2, b2, l, c float64) float64 {
+	// As with CIE94, we scale up the ranges of L,a,b beforehand and scale
+	// them down again afterwards.
+	l1, a1, b1 = l1*100.0, a1*100.0, b1*100.0
+	l2, a2, b2 = l2*100.0, a2*100.0, b2*100.0
+
+	cab1 := math.Sqrt(sq(a1) + sq(b1))
+	cab2 := math.Sqrt(sq(a2) + sq(b2))
+	h1 := math.Mod(math.Atan2(b1, a1)*180.0/math.Pi+360.0, 360.0)
+
+	sl := 0.511
+	if l1 >= 16.0 {
+		sl = 0.040975 * l1 / (1.0 + 0.01765*l1)
+	}
+	sc := 0.0638*cab1/(1.0+0.0131*cab1) + 0.638
+	f := math.Sqrt(sq(sq(cab1)) / (sq(sq(cab1)) + 1900.0))
+	t := 0.36 + math.Abs(0.4*math.Cos((h1+35.0)*math.Pi/180.0))
+	if h1 >= 164.0 && h1 <= 345.0 {
+		t = 0.56 + math.Abs(0.2*math.Cos((h1+168.0)*math.Pi/180.0))
+	}
+	sh := sc * (f*t + 1.0 - f)
+
+	deltaL := l1 - l2
+	deltaCab := cab1 - cab2
+	// Not taking Sqrt here for stability, and it's unnecessary.
+	deltaHab2 := sq(a1-a2) + sq(b1-b2) - sq(deltaCab)
+
+	return math.Sqrt(sq(deltaL/(l*sl))+sq(deltaCab/(c*sc))+deltaHab2/sq(sh)) * 0.01
+}
+
 // BlendLab blends two colors in the L*a*b* color-space, which should result in a smoother blend.
 // t == 0 results in c1, t == 1 results in c2
 func (c1 Color) BlendLab(c2 Color, t float64) Color {
@@ -822,32 +1032,23 @@ func XyzToLuv(x, y, z float64) (l, a, b float64) {
 	return XyzToLuvWhiteRef(x, y, z, D65)
 }
 
+// XyzToLuvWhiteRef converts from CIE XYZ to CIE L*u*v* relative to the given
+// reference white. The u'v' chromaticities are those of XyzToUv, so black
+// has the chromaticity of D65, which does not matter as its L* is 0.
 func XyzToLuvWhiteRef(x, y, z float64, wref [3]float64) (l, u, v float64) {
 	if y/wref[1] <= 6.0/29.0*6.0/29.0*6.0/29.0 {
 		l = y / wref[1] * (29.0 / 3.0 * 29.0 / 3.0 * 29.0 / 3.0) / 100.0
 	} else {
 		l = 1.16*math.Cbrt(y/wref[1]) - 0.16
 	}
-	ubis, vbis := xyz_to_uv(x, y, z)
-	un, vn := xyz_to_uv(wref[0], wref[1], wref[2])
+	ubis, vbis := XyzToUv(x, y, z)
+	un, vn := XyzToUv(wref[0], wref[1], wref[2])
 	u = 13.0 * l * (ubis - un)
 	v = 13.0 * l * (vbis - vn)
 	return
 }
 
-// For this part, we do as R's graphics.hcl does, not as wikipedia does.
-// Or is it the same?
-func xyz_to_uv(x, y, z float64) (u, v float64) {
-	denom := x + 15.0*y + 3.0*z
-	if denom == 0.0 {
-		u, v = 0.0, 0.0
-	} else {
-		u = 4.0 * x / denom
-		v = 9.0 * y / denom
-	}
-	return
-}
-
+// LuvToXyz converts from CIE L*u*v* relative to D65 to CIE XYZ.
 func LuvToXyz(l, u, v float64) (x, y, z float64) {
 	// D65 white (see above).
 	return LuvToXyzWhiteRef(l, u, v, D65)
@@ -860,7 +1061,7 @@ func LuvToXyzWhiteRef(l, u, v float64, wref [3]float64) (x, y, z float64) {
 	} else {
 		y = wref[1] * cub((l+0.16)/1.16)
 	}
-	un, vn := xyz_to_uv(wref[0], wref[1], wref[2])
+	un, vn := XyzToUv(wref[0], wref[1], wref[2])
 	if l != 0.0 {
 		ubis := u/(13.0*l) + un
 		vbis := v/(13.0*l) + vn
@@ -980,8 +1181,14 @@ func HclWhiteRef(h, c, l float64, wref [3]float64) Color {
 // BlendHcl blends two colors in the CIE-L*C*h° color-space, which should result in a smoother blend.
 // t == 0 results in c1, t == 1 results in c2
 func (col1 Color) BlendHcl(col2 Color, t float64) Color {
-	h1, c1, l1 := col1.Hcl()
-	h2, c2, l2 := col2.Hcl()
+	return col1.BlendHclWhiteRef(col2, t, D65)
+}
+
+// BlendHclWhiteRef is BlendHcl in the HCL space of the given reference white,
+// for example one of the Illuminants.
+func (col1 Color) BlendHclWhiteRef(col2 Color, t float64, wref [3]float64) Color {
+	h1, c1, l1 := col1.HclWhiteRef(wref)
+	h2, c2, l2 := col2.HclWhiteRef(wref)
 
 	// https://github.com/lucasb-eyer/go-colorful/pull/60
 	if c1 <= 0.00015 && c2 >= 0.00015 {
@@ -991,7 +1198,7 @@ func (col1 Color) BlendHcl(col2 Color, t float64) Color {
 	}
 
 	// We know that h are both in [0..360]
-	return Hcl(interp_angle(h1, h2, t), c1+t*(c2-c1), l1+t*(l2-l1)).Clamped()
+	return HclWhiteRef(interp_angle(h1, h2, t), c1+t*(c2-c1), l1+t*(l2-l1), wref).Clamped()
 }
 
 // LuvLch
@@ -1048,11 +1255,17 @@ func LuvLChWhiteRef(l, c, h float64, wref [3]float64) Color {
 // BlendLuvLCh blends two colors in the cylindrical CIELUV color space.
 // t == 0 results in c1, t == 1 results in c2
 func (col1 Color) BlendLuvLCh(col2 Color, t float64) Color {
-	l1, c1, h1 := col1.LuvLCh()
-	l2, c2, h2 := col2.LuvLCh()
+	return col1.BlendLuvLChWhiteRef(col2, t, D65)
+}
+
+// BlendLuvLChWhiteRef is BlendLuvLCh in the LuvLCh space of the given
+// reference white, for example one of the Illuminants.
+func (col1 Color) BlendLuvLChWhiteRef(col2 Color, t float64, wref [3]float64) Color {
+	l1, c1, h1 := col1.LuvLChWhiteRef(wref)
+	l2, c2, h2 := col2.LuvLChWhiteRef(wref)
 
 	// We know that h are both in [0..360]
-	return LuvLCh(l1+t*(l2-l1), c1+t*(c2-c1), interp_angle(h1, h2, t))
+	return LuvLChWhiteRef(l1+t*(l2-l1), c1+t*(c2-c1), interp_angle(h1, h2, t), wref)
 }
 
 /// OkLab ///
@@ -151,6 +151,109 @@ func TestHslConversion(t *testing.T) {
 	}
 }
 
+/// HWB ///
+///////////
+
+// Reference values from the CSS Color Level 4 spec's hwbToRgb sample code.
+var hwbvals = []struct {
+	hwb [3]float64
+	hex string
+}{
+	{[3]float64{0.0, 0.0, 0.0}, "#ff0000"},
+	{[3]float64{60.0, 0.0, 0.0}, "#ffff00"},
+	{[3]float64{120.0, 0.0, 0.0}, "#00ff00"},
+	{[3]float64{180.0, 0.0, 0.0}, "#00ffff"},
+	{[3]float64{240.0, 0.0, 0.0}, "#0000ff"},
+	{[3]float64{300.0, 0.0, 0.0}, "#ff00ff"},
+	{[3]float64{0.0, 1.0, 0.0}, "#ffffff"},
+	{[3]float64{0.0, 0.0, 1.0}, "#000000"},
+	{[3]float64{0.0, 0.5, 0.5}, "#808080"},
+	{[3]float64{0.0, 0.6, 0.6}, "#808080"},
+	{[3]float64{30.0, 0.2, 0.3}, "#b37333"},
+	{[3]float64{90.0, 0.4, 0.2}, "#99cc66"},
+	{[3]float64{210.0, 0.1, 0.4}, "#1a5999"},
+	{[3]float64{330.0, 0.25, 0.25}, "#bf4080"},
+}
+
+func TestHwbCreation(t *testing.T) {
+	for i, tt := range hwbvals {
+		c := Hwb(tt.hwb[0], tt.hwb[1], tt.hwb[2])
+		if c.Hex() != tt.hex {
+			t.Errorf("%v. Hwb(%v) => (%v), want %v", i, tt.hwb, c.Hex(), tt.hex)
+		}
+	}
+}
+
+func TestHwbConversion(t *testing.T) {
+	for i, tt := range vals {
+		h, w, b := tt.c.Hwb()
+		want := [3]float64{tt.hsv[0], (1.0 - tt.hsv[1]) * tt.hsv[2], 1.0 - tt.hsv[2]}
+		if !almosteq(h, want[0]) || !almosteq(w, want[1]) || !almosteq(b, want[2]) {
+			t.Errorf("%v. %v.Hwb() => (%v), want %v (delta %v)", i, tt.c, []float64{h, w, b}, want, delta)
+		}
+		if c := Hwb(h, w, b); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. Hwb(%v) => (%v), want %v (delta %v)", i, []float64{h, w, b}, c, tt.c, delta)
+		}
+	}
+}
+
+/// HSI ///
+///////////
+
+var hsivals = []struct {
+	c   Color
+	hsi [3]float64
+}{
+	{Color{1.0, 0.0, 0.0}, [3]float64{0.0, 1.0, 1.0 / 3.0}},
+	{Color{0.0, 1.0, 0.0}, [3]float64{120.0, 1.0, 1.0 / 3.0}},
+	{Color{0.0, 0.0, 1.0}, [3]float64{240.0, 1.0, 1.0 / 3.0}},
+	{Color{1.0, 1.0, 0.0}, [3]float64{60.0, 1.0, 2.0 / 3.0}},
+	{Color{0.0, 1.0, 1.0}, [3]float64{180.0, 1.0, 2.0 / 3.0}},
+	{Color{1.0, 0.0, 1.0}, [3]float64{300.0, 1.0, 2.0 / 3.0}},
+	{Color{0.5, 0.5, 0.5}, [3]float64{0.0, 0.0, 0.5}},
+	{Color{1.0, 0.5, 0.5}, [3]float64{0.0, 0.25, 2.0 / 3.0}},
+	{Color{0.8, 0.4, 0.2}, [3]float64{19.106605, 0.571429, 0.466667}},
+	{Color{0.2, 0.3, 0.9}, [3]float64{232.410911, 0.571429, 0.466667}},
+	{Color{0.0, 0.0, 0.0}, [3]float64{0.0, 0.0, 0.0}},
+}
+
+func TestHsiCreation(t *testing.T) {
+	for i, tt := range hsivals {
+		c := Hsi(tt.hsi[0], tt.hsi[1], tt.hsi[2])
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. Hsi(%v) => (%v), want %v (delta %v)", i, tt.hsi, c, tt.c, delta)
+		}
+	}
+}
+
+func TestHsiConversion(t *testing.T) {
+	for i, tt := range hsivals {
+		h, s, in := tt.c.Hsi()
+		if !almosteq(h, tt.hsi[0]) || !almosteq(s, tt.hsi[1]) || !almosteq(in, tt.hsi[2]) {
+			t.Errorf("%v. %v.Hsi() => (%v), want %v (delta %v)", i, tt.c, []float64{h, s, in}, tt.hsi, delta)
+		}
+	}
+}
+
+/// HSP ///
+///////////
+
+func TestHspConversion(t *testing.T) {
+	for i, tt := range vals {
+		h, s, p := tt.c.Hsp()
+		if !almosteq(h, tt.hsv[0]) || !almosteq(s, tt.hsv[1]) {
+			t.Errorf("%v. %v.Hsp() => (%v), want hue and saturation of %v (delta %v)", i, tt.c, []float64{h, s, p}, tt.hsv, delta)
+		}
+		if c := Hsp(h, s, p); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. Hsp(%v) => (%v), want %v (delta %v)", i, []float64{h, s, p}, c, tt.c, delta)
+		}
+	}
+
+	if _, _, p := (Color{1.0, 0.0, 0.0}).Hsp(); !almosteq(p, math.Sqrt(0.299)) {
+		t.Errorf("Perceived brightness of red is %v, want %v", p, math.Sqrt(0.299))
+	}
+}
+
 /// Hex ///
 ///////////
 
@@ -430,6 +533,41 @@ func TestHclWhiteRefConversion(t *testing.T) {
 	}
 }
 
+func TestPolarWhiteRefIlluminants(t *testing.T) {
+	for _, name := range []string{"A", "C", "D50", "F2", "F11"} {
+		wref := Illuminants[name]
+		for i, tt := range vals {
+			if h, c, l := tt.c.HclWhiteRef(wref); !HclWhiteRef(h, c, l, wref).AlmostEqualRgb(tt.c) {
+				t.Errorf("%v. HclWhiteRef(%v.HclWhiteRef(%v), %v) => %v", i, tt.c, name, name, HclWhiteRef(h, c, l, wref))
+			}
+			if l, c, h := tt.c.LuvLChWhiteRef(wref); !LuvLChWhiteRef(l, c, h, wref).AlmostEqualRgb(tt.c) {
+				t.Errorf("%v. LuvLChWhiteRef(%v.LuvLChWhiteRef(%v), %v) => %v", i, tt.c, name, name, LuvLChWhiteRef(l, c, h, wref))
+			}
+		}
+
+		// The reference white is neutral in its own spaces.
+		if l, a, b := XyzToLabWhiteRef(wref[0], wref[1], wref[2], wref); math.Abs(l-1.0) > 1e-12 || math.Abs(a) > 1e-12 || math.Abs(b) > 1e-12 {
+			t.Errorf("Lab of %v relative to itself => (%v, %v, %v), want (1, 0, 0)", name, l, a, b)
+		}
+		if l, u, v := XyzToLuvWhiteRef(wref[0], wref[1], wref[2], wref); math.Abs(l-1.0) > 1e-12 || math.Abs(u) > 1e-12 || math.Abs(v) > 1e-12 {
+			t.Errorf("Luv of %v relative to itself => (%v, %v, %v), want (1, 0, 0)", name, l, u, v)
+		}
+
+		c1, c2 := Color{0.8, 0.2, 0.3}, Color{0.2, 0.5, 0.9}
+		if b := c1.BlendHclWhiteRef(c2, 0.0, wref); !b.AlmostEqualRgb(c1) {
+			t.Errorf("%v.BlendHclWhiteRef(%v, 0, %v) => %v", c1, c2, name, b)
+		}
+		if b := c1.BlendLuvLChWhiteRef(c2, 1.0, wref); !b.AlmostEqualRgb(c2) {
+			t.Errorf("%v.BlendLuvLChWhiteRef(%v, 1, %v) => %v", c1, c2, name, b)
+		}
+	}
+
+	c1, c2 := Color{0.8, 0.2, 0.3}, Color{0.2, 0.5, 0.9}
+	if c1.BlendHclWhiteRef(c2, 0.3, D65) != c1.BlendHcl(c2, 0.3) || c1.BlendLuvLChWhiteRef(c2, 0.3, D65) != c1.BlendLuvLCh(c2, 0.3) {
+		t.Errorf("The *WhiteRef blends with D65 differ from the default ones")
+	}
+}
+
 // / Oklab ///
 // ///////////
 
@@ -604,6 +742,53 @@ func TestCIEDE2000Distance(t *testing.T) {
 	}
 }
 
+func TestCMCDistance(t *testing.T) {
+	// Published pairs, in L*a*b* scaled to [0..1] like this package: the
+	// examples of colour-science for l:c of 2:1 and 1:1, which are the same
+	// as the lightnesses are, and the test of python-colormath for 2:1.
+	published := []struct {
+		c1, c2 Color
+		l, c   float64
+		d, eps float64
+	}{
+		{Lab(1.0, 0.2157210357, 2.722281935), Lab(1.0, 4.2667945353, 0.7239590835), 2.0, 1.0, 1.727047712, 1e-9},
+		{Lab(1.0, 0.2157210357, 2.722281935), Lab(1.0, 4.2667945353, 0.7239590835), 1.0, 1.0, 1.727047712, 1e-9},
+		{Lab(0.009, 0.163, -0.0222), Lab(0.007, 0.142, -0.018), 2.0, 1.0, 0.01443, 5e-6},
+	}
+	for i, tt := range published {
+		if d := tt.c1.DistanceCMC(tt.c2, tt.l, tt.c); math.Abs(d-tt.d) > tt.eps {
+			t.Errorf("%v. %v.DistanceCMC(%v, %v, %v) => (%v), want %v", i, tt.c1, tt.c2, tt.l, tt.c, d, tt.d)
+		}
+	}
+
+	// Pairs of the table above, and a dark color for the lightness weight
+	// below L* 16, computed independently from the formula.
+	tests := []struct {
+		c1, c2   Color
+		d21, d11 float64
+	}{
+		{Lab(1.000000, 0.000000, 0.000000), Lab(0.931390, -0.353319, -0.108946), 0.57998321, 0.58136749},
+		{Lab(0.720892, 0.651673, -0.422133), Lab(0.977637, -0.165795, 0.602017), 0.60968764, 0.63322757},
+		{Lab(0.590453, 0.332846, -0.637099), Lab(0.681085, 0.483884, 0.228328), 0.47214384, 0.47676941},
+		{Lab(0.906026, -0.600870, 0.498993), Lab(0.533890, 0.000000, 0.000000), 0.28356359, 0.36237873},
+		{Lab(0.911132, -0.480875, -0.141312), Lab(0.603242, 0.982343, -0.608249), 0.76421508, 0.78658988},
+		{Lab(0.971393, -0.215537, 0.944780), Lab(0.322970, 0.791875, -1.078602), 1.03988830, 1.10816730},
+		{Lab(0.877347, -0.861827, 0.831793), Lab(0.532408, 0.800925, 0.672032), 0.62338288, 0.65837219},
+		{Lab(0.10, 0.02, -0.03), Lab(0.12, 0.01, -0.02), 0.02567189, 0.04251986},
+	}
+	for i, tt := range tests {
+		if d := tt.c1.DistanceCMC(tt.c2, 2.0, 1.0); !almosteq_eps(d, tt.d21, 1e-6) {
+			t.Errorf("%v. %v.DistanceCMC(%v, 2, 1) => (%v), want %v", i, tt.c1, tt.c2, d, tt.d21)
+		}
+		if d := tt.c1.DistanceCMC(tt.c2, 1.0, 1.0); !almosteq_eps(d, tt.d11, 1e-6) {
+			t.Errorf("%v. %v.DistanceCMC(%v, 1, 1) => (%v), want %v", i, tt.c1, tt.c2, d, tt.d11)
+		}
+	}
+	if d := vals[0].c.DistanceCMC(vals[0].c, 2.0, 1.0); d != 0.0 {
+		t.Errorf("%v.DistanceCMC(itself) => %v, want 0", vals[0].c, d)
+	}
+}
+
 /// Test utilities ///
 //////////////////////
 
@@ -735,6 +920,33 @@ func TestIssue11(t *testing.T) {
 		t.Errorf("Issue11: %v --OkLab-> %v = %v, want %v", c1hex, c2hex, blend, c2hex)
 	}
 
+	blend = c1.BlendHwb(c2, 0).Hex()
+	if blend != c1hex {
+		t.Errorf("Issue11: %v --Hwb-> %v = %v, want %v", c1hex, c2hex, blend, c1hex)
+	}
+	blend = c1.BlendHwb(c2, 1).Hex()
+	if blend != c2hex {
+		t.Errorf("Issue11: %v --Hwb-> %v = %v, want %v", c1hex, c2hex, blend, c2hex)
+	}
+
+	blend = c1.BlendHsi(c2, 0).Hex()
+	if blend != c1hex {
+		t.Errorf("Issue11: %v --Hsi-> %v = %v, want %v", c1hex, c2hex, blend, c1hex)
+	}
+	blend = c1.BlendHsi(c2, 1).Hex()
+	if blend != c2hex {
+		t.Errorf("Issue11: %v --Hsi-> %v = %v, want %v", c1hex, c2hex, blend, c2hex)
+	}
+
+	blend = c1.BlendHsp(c2, 0).Hex()
+	if blend != c1hex {
+		t.Errorf("Issue11: %v --Hsp-> %v = %v, want %v", c1hex, c2hex, blend, c1hex)
+	}
+	blend = c1.BlendHsp(c2, 1).Hex()
+	if blend != c2hex {
+		t.Errorf("Issue11: %v --Hsp-> %v = %v, want %v", c1hex, c2hex, blend, c2hex)
+	}
+
 	blend = c1.BlendOkLch(c2, 0).Hex()
 	if blend != c1hex {
 		t.Errorf("Issue11: %v --OkLch-> %v = %v, want %v", c1hex, c2hex, blend, c1hex)
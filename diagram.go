@@ -0,0 +1,191 @@
+package colorful
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// Chromaticity diagrams, which show the chromaticities of all colors, bounded
+// by the spectral locus and the purple line, in CIE 1931 xy or CIE 1976 u'v',
+// for visualizing gamuts and where colors lie in them.
+
+// A Gamut is the triangle of the xy chromaticities of the primaries of an
+// RGB color space.
+type Gamut struct {
+	Name             string
+	Red, Green, Blue [2]float64
+}
+
+func gamutOf(name string, linearToXyz func(r, g, b float64) (x, y, z float64)) Gamut {
+	primary := func(r, g, b float64) [2]float64 {
+		x, y, _ := XyzToXyy(linearToXyz(r, g, b))
+		return [2]float64{x, y}
+	}
+	return Gamut{name, primary(1, 0, 0), primary(0, 1, 0), primary(0, 0, 1)}
+}
+
+// The gamuts of sRGB and of the wide-gamut RGB spaces.
+var (
+	GamutSrgb        = gamutOf("sRGB", LinearRgbToXyz)
+	GamutDisplayP3   = gamutOf("Display P3", LinearDisplayP3ToXyz)
+	GamutA98Rgb      = gamutOf("A98 RGB", LinearA98RgbToXyz)
+	GamutProPhotoRgb = gamutOf("ProPhoto RGB", LinearProPhotoRgbToXyzD50)
+	GamutRec2020     = gamutOf("Rec. 2020", LinearRec2020ToXyz)
+)
+
+// The colors in which the outlines of the gamuts are drawn, in order.
+var diagramGamutColors = []Color{
+	{0.0, 0.0, 0.0},
+	{0.85, 0.1, 0.1},
+	{0.1, 0.4, 0.9},
+	{0.9, 0.6, 0.0},
+	{0.5, 0.1, 0.7},
+	{0.0, 0.6, 0.3},
+}
+
+// A ChromaticityDiagram describes what to draw in a chromaticity diagram.
+type ChromaticityDiagram struct {
+	// Size is the width and height of the image in pixels.
+	Size int
+
+	// UV draws the CIE 1976 u'v' diagram instead of the CIE 1931 xy one.
+	UV bool
+
+	// Gamuts are outlined in black, red, blue, orange, purple and green,
+	// in this order.
+	Gamuts []Gamut
+
+	// Planckian draws the Planckian locus from PlanckianMinTemperature to
+	// PlanckianMaxTemperature.
+	Planckian bool
+
+	// Samples are drawn as dots of their own color.
+	Samples []Color
+}
+
+// Render draws the diagram. The area of all colors is filled with the
+// brightest color of each chromaticity, as far as sRGB can show them, on a
+// white background with a grid in steps of 0.1.
+func (d ChromaticityDiagram) Render() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, d.Size, d.Size))
+
+	// The diagrams span [0..0.9] in xy and [0..0.65] in u'v'.
+	extent := 0.9
+	toDiagram, fromDiagram := func(x, y float64) (float64, float64) { return x, y }, func(u, v float64) (float64, float64) { return u, v }
+	if d.UV {
+		extent = 0.65
+		toDiagram, fromDiagram = XyToUv, UvToXy
+	}
+	scale := float64(d.Size) / extent
+	toPixel := func(x, y float64) (float64, float64) {
+		u, v := toDiagram(x, y)
+		return u * scale, float64(d.Size) - v*scale
+	}
+
+	// The boundary of all colors in pixels.
+	var boundary [][2]float64
+	for _, p := range SpectralLocus(1.0) {
+		px, py := toPixel(p[0], p[1])
+		boundary = append(boundary, [2]float64{px, py})
+	}
+
+	for py := 0; py < d.Size; py++ {
+		for px := 0; px < d.Size; px++ {
+			var c color.Color = color.White
+			if pointInPolygon(float64(px)+0.5, float64(py)+0.5, boundary) {
+				x, y := fromDiagram((float64(px)+0.5)/scale, (float64(d.Size)-float64(py)-0.5)/scale)
+				c = diagramColor(x, y)
+			}
+			img.Set(px, py, c)
+		}
+	}
+
+	width := math.Max(1.0, float64(d.Size)/400.0)
+	gridColor := Color{0.8, 0.8, 0.8}
+	for i := 1; float64(i)*0.1 < extent-1e-9; i++ {
+		p := float64(i) * 0.1 * scale
+		drawLine(img, p, 0, p, float64(d.Size), width/2.0, gridColor)
+		drawLine(img, 0, float64(d.Size)-p, float64(d.Size), float64(d.Size)-p, width/2.0, gridColor)
+	}
+
+	black := Color{0.0, 0.0, 0.0}
+	drawPolygon(img, boundary, width, black)
+
+	if d.Planckian {
+		var locus [][2]float64
+		for t := PlanckianMinTemperature; t <= PlanckianMaxTemperature; t *= 1.05 {
+			px, py := toPixel(PlanckianXy(t))
+			locus = append(locus, [2]float64{px, py})
+		}
+		for i := 1; i < len(locus); i++ {
+			drawLine(img, locus[i-1][0], locus[i-1][1], locus[i][0], locus[i][1], width, black)
+		}
+	}
+
+	for i, gamut := range d.Gamuts {
+		var triangle [][2]float64
+		for _, p := range [][2]float64{gamut.Red, gamut.Green, gamut.Blue} {
+			px, py := toPixel(p[0], p[1])
+			triangle = append(triangle, [2]float64{px, py})
+		}
+		drawPolygon(img, triangle, width, diagramGamutColors[i%len(diagramGamutColors)])
+	}
+
+	radius := 3.0 * width
+	for _, sample := range d.Samples {
+		x, y, _ := sample.Xyy()
+		px, py := toPixel(x, y)
+		drawDot(img, px, py, radius+width, black)
+		drawDot(img, px, py, radius, sample.Clamped())
+	}
+
+	return img
+}
+
+// diagramColor returns the color used to show the chromaticity, which is
+// desaturated to sRGB by clipping the negative parts.
+func diagramColor(x, y float64) Color {
+	r, g, b := XyzToLinearRgb(XyyToXyz(x, y, 1.0))
+	r, g, b = math.Max(r, 0.0), math.Max(g, 0.0), math.Max(b, 0.0)
+	max := math.Max(r, math.Max(g, b))
+	return LinearRgb(r/max, g/max, b/max)
+}
+
+func pointInPolygon(x, y float64, polygon [][2]float64) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a[1] > y) != (b[1] > y) && x < (b[0]-a[0])*(y-a[1])/(b[1]-a[1])+a[0] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// drawDot fills the pixels within the radius of the center.
+func drawDot(img *image.RGBA, cx, cy, radius float64, c Color) {
+	for py := int(math.Floor(cy - radius)); py <= int(cy+radius); py++ {
+		for px := int(math.Floor(cx - radius)); px <= int(cx+radius); px++ {
+			if sq(float64(px)+0.5-cx)+sq(float64(py)+0.5-cy) <= radius*radius {
+				img.Set(px, py, c)
+			}
+		}
+	}
+}
+
+// drawLine draws a line of the given width by stamping dots along it.
+func drawLine(img *image.RGBA, x0, y0, x1, y1, width float64, c Color) {
+	n := int(math.Ceil(math.Hypot(x1-x0, y1-y0) * 2.0))
+	for i := 0; i <= n; i++ {
+		t := float64(i) / math.Max(float64(n), 1.0)
+		drawDot(img, x0+t*(x1-x0), y0+t*(y1-y0), math.Max(width/2.0, 0.5), c)
+	}
+}
+
+// drawPolygon draws the closed outline of the polygon.
+func drawPolygon(img *image.RGBA, polygon [][2]float64, width float64, c Color) {
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		drawLine(img, polygon[j][0], polygon[j][1], polygon[i][0], polygon[i][1], width, c)
+	}
+}
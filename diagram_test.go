@@ -0,0 +1,55 @@
+package colorful
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestGamuts(t *testing.T) {
+	tests := []struct {
+		gamut            Gamut
+		red, green, blue [2]float64
+	}{
+		{GamutSrgb, [2]float64{0.64, 0.33}, [2]float64{0.30, 0.60}, [2]float64{0.15, 0.06}},
+		{GamutDisplayP3, [2]float64{0.68, 0.32}, [2]float64{0.265, 0.69}, [2]float64{0.15, 0.06}},
+		{GamutA98Rgb, [2]float64{0.64, 0.33}, [2]float64{0.21, 0.71}, [2]float64{0.15, 0.06}},
+		{GamutProPhotoRgb, [2]float64{0.7347, 0.2653}, [2]float64{0.1596, 0.8404}, [2]float64{0.0366, 0.0001}},
+		{GamutRec2020, [2]float64{0.708, 0.292}, [2]float64{0.170, 0.797}, [2]float64{0.131, 0.046}},
+	}
+	for _, tt := range tests {
+		for i, p := range [][2][2]float64{{tt.gamut.Red, tt.red}, {tt.gamut.Green, tt.green}, {tt.gamut.Blue, tt.blue}} {
+			if math.Abs(p[0][0]-p[1][0]) > 1e-3 || math.Abs(p[0][1]-p[1][1]) > 1e-3 {
+				t.Errorf("%v primary %v => %v, want %v", tt.gamut.Name, i, p[0], p[1])
+			}
+		}
+	}
+}
+
+func TestChromaticityDiagram(t *testing.T) {
+	red := Color{1.0, 0.0, 0.0}
+	for _, uv := range []bool{false, true} {
+		d := ChromaticityDiagram{Size: 200, UV: uv, Gamuts: []Gamut{GamutSrgb}, Planckian: true, Samples: []Color{red}}
+		img := d.Render()
+		if b := img.Bounds(); b.Dx() != 200 || b.Dy() != 200 {
+			t.Errorf("Render() with UV %v has size %v", uv, b)
+		}
+
+		// The corners are outside of all colors.
+		if c := img.At(199, 0); c != (color.RGBA{255, 255, 255, 255}) {
+			t.Errorf("Render() with UV %v has %v in a corner, want white", uv, c)
+		}
+
+		// The sample is drawn in its color.
+		x, y, _ := red.Xyy()
+		extent := 0.9
+		if uv {
+			extent = 0.65
+			x, y = XyToUv(x, y)
+		}
+		px, py := int(x*200/extent), int(200-y*200/extent)
+		if c := img.At(px, py); c != (color.RGBA{255, 0, 0, 255}) {
+			t.Errorf("Render() with UV %v has %v at the sample, want red", uv, c)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package colorful
+
+import "math"
+
+// The DIN99 family of color spaces, which are logarithmically compressed and
+// rotated versions of CIE-L*a*b* in which the Euclidean distance ΔE99 is
+// about as perceptually uniform as DistanceCIEDE2000, but much cheaper.
+// DIN99 is that of DIN 6176, DIN99o the revised one of DIN 6176:2001, and
+// DIN99b, DIN99c and DIN99d those of Cui et al. 2002, of which DIN99c and
+// DIN99d also modify X before the conversion to L*a*b*.
+// https://doi.org/10.1002/col.10066
+//
+// As with L*a*b*, the values are a hundredth of the usual ones, so that L is
+// in [0..1] and a, b are roughly in [-1..1], and the distances are also a
+// hundredth of the usual ΔE99.
+
+// din99Space holds the constants of a variant.
+type din99Space struct {
+	// L99 = l1 ln(1 + l2 L)
+	l1, l2 float64
+	// The rotation of a*, b* in degrees and the scaling of the rotated b*.
+	angle, f float64
+	// C99 = c1 ln(1 + c2 G), with G the chroma of the rotated a*, b*.
+	c1, c2 float64
+	// The rotation of the hue in degrees after the compression.
+	hue float64
+	// X' = (1 + xz) X - xz Z.
+	xz float64
+}
+
+var (
+	din99  = din99Space{105.509, 0.0158, 16.0, 0.7, 1.0 / 0.045, 0.045, 0.0, 0.0}
+	din99o = din99Space{303.67, 0.0039, 26.0, 0.83, 1.0 / 0.0435, 0.075, 26.0, 0.0}
+	din99b = din99Space{303.67, 0.0039, 26.0, 0.83, 23.0, 0.075, 26.0, 0.0}
+	din99c = din99Space{317.65, 0.0037, 0.0, 0.94, 23.0, 0.066, 0.0, 0.1}
+	din99d = din99Space{325.22, 0.0036, 50.0, 1.14, 22.5, 0.06, 50.0, 0.12}
+)
+
+// whiteRef returns the reference white of the L*a*b* of the variant, which
+// has the same modification of X as the colors, so that grays stay neutral.
+func (s din99Space) whiteRef() [3]float64 {
+	return [3]float64{(1.0+s.xz)*D65[0] - s.xz*D65[2], D65[1], D65[2]}
+}
+
+func (s din99Space) fromXyz(x, y, z float64) (l, a, b float64) {
+	l, a, b = XyzToLabWhiteRef((1.0+s.xz)*x-s.xz*z, y, z, s.whiteRef())
+	l, a, b = l*100.0, a*100.0, b*100.0
+
+	sin, cos := math.Sincos(s.angle * math.Pi / 180.0)
+	e := a*cos + b*sin
+	f := s.f * (-a*sin + b*cos)
+	c := s.c1 * math.Log(1.0+s.c2*math.Sqrt(sq(e)+sq(f)))
+	h := math.Atan2(f, e) + s.hue*math.Pi/180.0
+
+	return s.l1 * math.Log(1.0+s.l2*l) / 100.0, c * math.Cos(h) / 100.0, c * math.Sin(h) / 100.0
+}
+
+func (s din99Space) toXyz(l, a, b float64) (x, y, z float64) {
+	l, a, b = l*100.0, a*100.0, b*100.0
+
+	g := (math.Exp(math.Sqrt(sq(a)+sq(b))/s.c1) - 1.0) / s.c2
+	h := math.Atan2(b, a) - s.hue*math.Pi/180.0
+	e := g * math.Cos(h)
+	f := g * math.Sin(h) / s.f
+	sin, cos := math.Sincos(s.angle * math.Pi / 180.0)
+
+	x, y, z = LabToXyzWhiteRef(
+		(math.Exp(l/s.l1)-1.0)/s.l2/100.0,
+		(e*cos-f*sin)/100.0,
+		(e*sin+f*cos)/100.0,
+		s.whiteRef())
+	return (x + s.xz*z) / (1.0 + s.xz), y, z
+}
+
+func (s din99Space) blend(c1, c2 Color, t float64) Color {
+	l1, a1, b1 := s.fromXyz(c1.Xyz())
+	l2, a2, b2 := s.fromXyz(c2.Xyz())
+	return Xyz(s.toXyz(
+		l1+t*(l2-l1),
+		a1+t*(a2-a1),
+		b1+t*(b2-b1),
+	))
+}
+
+func (s din99Space) distance(c1, c2 Color) float64 {
+	l1, a1, b1 := s.fromXyz(c1.Xyz())
+	l2, a2, b2 := s.fromXyz(c2.Xyz())
+	return math.Sqrt(sq(l1-l2) + sq(a1-a2) + sq(b1-b2))
+}
+
+/// DIN99 ///
+/////////////
+
+// Din99 generates a color from DIN99 values.
+func Din99(l, a, b float64) Color {
+	return Xyz(din99.toXyz(l, a, b))
+}
+
+// Din99 returns the DIN99 values of the color.
+func (col Color) Din99() (l, a, b float64) {
+	return din99.fromXyz(col.Xyz())
+}
+
+// BlendDin99 blends two colors in DIN99.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendDin99(c2 Color, t float64) Color {
+	return din99.blend(c1, c2, t)
+}
+
+// DistanceDin99 is the Euclidean distance ΔE99 in DIN99.
+func (c1 Color) DistanceDin99(c2 Color) float64 {
+	return din99.distance(c1, c2)
+}
+
+/// DIN99o ///
+//////////////
+
+// Din99o generates a color from DIN99o values.
+func Din99o(l, a, b float64) Color {
+	return Xyz(din99o.toXyz(l, a, b))
+}
+
+// Din99o returns the DIN99o values of the color.
+func (col Color) Din99o() (l, a, b float64) {
+	return din99o.fromXyz(col.Xyz())
+}
+
+// BlendDin99o blends two colors in DIN99o.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendDin99o(c2 Color, t float64) Color {
+	return din99o.blend(c1, c2, t)
+}
+
+// DistanceDin99o is the Euclidean distance ΔE99o in DIN99o, which is more
+// uniform than DistanceDin99.
+func (c1 Color) DistanceDin99o(c2 Color) float64 {
+	return din99o.distance(c1, c2)
+}
+
+/// DIN99b ///
+//////////////
+
+// Din99b generates a color from DIN99b values.
+func Din99b(l, a, b float64) Color {
+	return Xyz(din99b.toXyz(l, a, b))
+}
+
+// Din99b returns the DIN99b values of the color.
+func (col Color) Din99b() (l, a, b float64) {
+	return din99b.fromXyz(col.Xyz())
+}
+
+// BlendDin99b blends two colors in DIN99b.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendDin99b(c2 Color, t float64) Color {
+	return din99b.blend(c1, c2, t)
+}
+
+// DistanceDin99b is the Euclidean distance in DIN99b.
+func (c1 Color) DistanceDin99b(c2 Color) float64 {
+	return din99b.distance(c1, c2)
+}
+
+/// DIN99c ///
+//////////////
+
+// Din99c generates a color from DIN99c values.
+func Din99c(l, a, b float64) Color {
+	return Xyz(din99c.toXyz(l, a, b))
+}
+
+// Din99c returns the DIN99c values of the color.
+func (col Color) Din99c() (l, a, b float64) {
+	return din99c.fromXyz(col.Xyz())
+}
+
+// BlendDin99c blends two colors in DIN99c.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendDin99c(c2 Color, t float64) Color {
+	return din99c.blend(c1, c2, t)
+}
+
+// DistanceDin99c is the Euclidean distance in DIN99c.
+func (c1 Color) DistanceDin99c(c2 Color) float64 {
+	return din99c.distance(c1, c2)
+}
+
+/// DIN99d ///
+//////////////
+
+// Din99d generates a color from DIN99d values.
+func Din99d(l, a, b float64) Color {
+	return Xyz(din99d.toXyz(l, a, b))
+}
+
+// Din99d returns the DIN99d values of the color.
+func (col Color) Din99d() (l, a, b float64) {
+	return din99d.fromXyz(col.Xyz())
+}
+
+// BlendDin99d blends two colors in DIN99d.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendDin99d(c2 Color, t float64) Color {
+	return din99d.blend(c1, c2, t)
+}
+
+// DistanceDin99d is the Euclidean distance in DIN99d, which is the most
+// uniform of the DIN99 variants.
+func (c1 Color) DistanceDin99d(c2 Color) float64 {
+	return din99d.distance(c1, c2)
+}
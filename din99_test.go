@@ -0,0 +1,84 @@
+package colorful
+
+import (
+	"testing"
+)
+
+func TestDin99(t *testing.T) {
+	// The DIN99 values are from colour-science, the DIN99o and DIN99b ones
+	// computed with the formulas of DIN 6176 and Cui et al.
+	c := Lab(0.4152787529, 0.5263858304, 0.2692317922)
+	tests := []struct {
+		name    string
+		convert func() (l, a, b float64)
+		want    [3]float64
+	}{
+		{"Din99", c.Din99, [3]float64{0.5322821988, 0.2841634656, 0.0389839552}},
+		{"Din99o", c.Din99o, [3]float64{0.4558303137, 0.3470089448, 0.1760741778}},
+		{"Din99b", c.Din99b, [3]float64{0.4558303137, 0.3471824493, 0.1761622149}},
+	}
+	for _, tt := range tests {
+		l, a, b := tt.convert()
+		if !almosteq_eps(l, tt.want[0], 1e-6) || !almosteq_eps(a, tt.want[1], 1e-6) || !almosteq_eps(b, tt.want[2], 1e-6) {
+			t.Errorf("%v.%v() => (%v, %v, %v), want %v", c, tt.name, l, a, b, tt.want)
+		}
+	}
+}
+
+func TestDin99Roundtrip(t *testing.T) {
+	spaces := []struct {
+		name string
+		from func(col Color) (l, a, b float64)
+		to   func(l, a, b float64) Color
+	}{
+		{"Din99", Color.Din99, Din99},
+		{"Din99o", Color.Din99o, Din99o},
+		{"Din99b", Color.Din99b, Din99b},
+		{"Din99c", Color.Din99c, Din99c},
+		{"Din99d", Color.Din99d, Din99d},
+	}
+	for _, s := range spaces {
+		for i, tt := range vals {
+			l, a, b := s.from(tt.c)
+			if col := s.to(l, a, b); !col.AlmostEqualRgb(tt.c) {
+				t.Errorf("%v. %v(%v, %v, %v) => (%v), want %v (delta %v)", i, s.name, l, a, b, col, tt.c, delta)
+			}
+		}
+
+		// White is L 1 and neutral, up to the difference of the sRGB white and D65.
+		if l, a, b := s.from(Color{1.0, 1.0, 1.0}); !almosteq(l, 1.0) || !almosteq(a+1.0, 1.0) || !almosteq(b+1.0, 1.0) {
+			t.Errorf("white.%v() => (%v, %v, %v), want (1, 0, 0)", s.name, l, a, b)
+		}
+	}
+}
+
+func TestDin99Distances(t *testing.T) {
+	c1, c2 := Color{0.5, 0.5, 0.5}, Color{0.55, 0.5, 0.45}
+	spaces := []struct {
+		name     string
+		distance func(c1, c2 Color) float64
+		blend    func(c1, c2 Color, t float64) Color
+	}{
+		{"Din99", Color.DistanceDin99, Color.BlendDin99},
+		{"Din99o", Color.DistanceDin99o, Color.BlendDin99o},
+		{"Din99b", Color.DistanceDin99b, Color.BlendDin99b},
+		{"Din99c", Color.DistanceDin99c, Color.BlendDin99c},
+		{"Din99d", Color.DistanceDin99d, Color.BlendDin99d},
+	}
+	for _, s := range spaces {
+		d := s.distance(c1, c2)
+		if s.distance(c1, c1) != 0.0 || d <= 0.0 || d != s.distance(c2, c1) {
+			t.Errorf("Distance%v(%v, %v) => %v", s.name, c1, c2, d)
+		}
+		// The blend halfway is halfway.
+		if mid := s.blend(c1, c2, 0.5); !almosteq_eps(s.distance(c1, mid), d/2.0, 1e-9) {
+			t.Errorf("Distance%v(%v, Blend%v(%v, 0.5)) => %v, want %v", s.name, c1, s.name, c2, s.distance(c1, mid), d/2.0)
+		}
+	}
+
+	// DIN99 compresses the chroma, so saturated colors are closer than in L*a*b*.
+	red, blue := Color{1.0, 0.0, 0.0}, Color{0.0, 0.0, 1.0}
+	if red.DistanceDin99(blue) >= red.DistanceLab(blue)/2.0 {
+		t.Errorf("DistanceDin99(red, blue) => %v, DistanceLab %v", red.DistanceDin99(blue), red.DistanceLab(blue))
+	}
+}
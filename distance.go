@@ -0,0 +1,209 @@
+package colorful
+
+import (
+	"math"
+	"runtime"
+	"sync"
+)
+
+// A DistanceFunc is a metric for the distance between colors, which can be
+// passed to functions like DistanceMatrix that compute many distances. It
+// converts every color to the space of the metric only once, instead of once
+// per pair like the Distance* methods do.
+//
+// The Metric* variables hold all the metrics of the Distance* methods, and
+// NewDistanceFunc makes one of any function. The zero DistanceFunc is
+// MetricCIEDE2000.
+type DistanceFunc struct {
+	// convert returns the coordinates of a color in the space of the metric.
+	convert func(col Color) [3]float64
+	// distance returns the distance of two colors given their coordinates.
+	distance func(p1, p2 [3]float64) float64
+	// symmetric tells whether the distance from c1 to c2 is that from c2 to c1.
+	symmetric bool
+}
+
+// Distance returns the distance between the colors.
+func (m DistanceFunc) Distance(c1, c2 Color) float64 {
+	m = m.orDefault()
+	return m.distance(m.convert(c1), m.convert(c2))
+}
+
+// orDefault returns the metric, or MetricCIEDE2000 if it is the zero
+// DistanceFunc.
+func (m DistanceFunc) orDefault() DistanceFunc {
+	if m.convert == nil {
+		return MetricCIEDE2000
+	}
+	return m
+}
+
+// NewDistanceFunc makes a DistanceFunc of any function, for example one of
+// the Distance* methods with custom parameters. Such a function is not known
+// to be symmetric, so DistanceMatrix calls it for every ordered pair.
+func NewDistanceFunc(distance func(c1, c2 Color) float64) DistanceFunc {
+	return DistanceFunc{
+		convert: func(col Color) [3]float64 { return [3]float64{col.R, col.G, col.B} },
+		distance: func(p1, p2 [3]float64) float64 {
+			return distance(Color{p1[0], p1[1], p1[2]}, Color{p2[0], p2[1], p2[2]})
+		},
+	}
+}
+
+func euclideanDistance(p1, p2 [3]float64) float64 {
+	return math.Sqrt(sq(p1[0]-p2[0]) + sq(p1[1]-p2[1]) + sq(p1[2]-p2[2]))
+}
+
+// euclideanIn makes the DistanceFunc of the Euclidean distance in a space.
+func euclideanIn(convert func(col Color) (a, b, c float64)) DistanceFunc {
+	return DistanceFunc{
+		convert: func(col Color) [3]float64 {
+			a, b, c := convert(col)
+			return [3]float64{a, b, c}
+		},
+		distance:  euclideanDistance,
+		symmetric: true,
+	}
+}
+
+func labOf(col Color) [3]float64 {
+	l, a, b := col.Lab()
+	return [3]float64{l, a, b}
+}
+
+// MetricCIEDE2000klch is the DistanceFunc of DistanceCIEDE2000klch.
+func MetricCIEDE2000klch(kl, kc, kh float64) DistanceFunc {
+	return DistanceFunc{
+		convert: labOf,
+		distance: func(p1, p2 [3]float64) float64 {
+			return labDistanceCIEDE2000(p1[0], p1[1], p1[2], p2[0], p2[1], p2[2], kl, kc, kh)
+		},
+		symmetric: true,
+	}
+}
+
+// MetricCMC is the DistanceFunc of DistanceCMC, whose first color is the reference.
+func MetricCMC(l, c float64) DistanceFunc {
+	return DistanceFunc{
+		convert: labOf,
+		distance: func(p1, p2 [3]float64) float64 {
+			return labDistanceCMC(p1[0], p1[1], p1[2], p2[0], p2[1], p2[2], l, c)
+		},
+	}
+}
+
+// The DistanceFuncs of the Distance* methods of the same names.
+var (
+	MetricRgb       = euclideanIn(Color.values)
+	MetricLinearRgb = euclideanIn(Color.LinearRgb)
+	MetricRiemersma = DistanceFunc{
+		convert: func(col Color) [3]float64 { return [3]float64{col.R, col.G, col.B} },
+		distance: func(p1, p2 [3]float64) float64 {
+			return Color{p1[0], p1[1], p1[2]}.DistanceRiemersma(Color{p2[0], p2[1], p2[2]})
+		},
+		symmetric: true,
+	}
+	MetricLab   = euclideanIn(Color.Lab)
+	MetricCIE76 = MetricLab
+	MetricCIE94 = DistanceFunc{
+		convert: labOf,
+		distance: func(p1, p2 [3]float64) float64 {
+			return labDistanceCIE94(p1[0], p1[1], p1[2], p2[0], p2[1], p2[2])
+		},
+	}
+	MetricCIEDE2000 = MetricCIEDE2000klch(1.0, 1.0, 1.0)
+	MetricLuv       = euclideanIn(Color.Luv)
+	MetricHSLuv     = euclideanIn(func(col Color) (h, s, l float64) {
+		h, s, l = col.HSLuv()
+		return h / 100.0, s, l
+	})
+	MetricHPLuv = euclideanIn(func(col Color) (h, s, l float64) {
+		h, s, l = col.HPLuv()
+		return h / 100.0, s, l
+	})
+	MetricDin99     = euclideanIn(Color.Din99)
+	MetricDin99o    = euclideanIn(Color.Din99o)
+	MetricDin99b    = euclideanIn(Color.Din99b)
+	MetricDin99c    = euclideanIn(Color.Din99c)
+	MetricDin99d    = euclideanIn(Color.Din99d)
+	MetricHunterLab = euclideanIn(Color.HunterLab)
+	MetricCam16Ucs  = euclideanIn(func(col Color) (j, a, b float64) {
+		return col.Cam16Ucs(DefaultViewingConditions)
+	})
+	MetricITP = euclideanIn(func(col Color) (i, t, p float64) {
+		// T is half of Ct.
+		i, ct, cp := col.ICtCp()
+		return 720.0 * i, 360.0 * ct, 720.0 * cp
+	})
+	MetricJz = DistanceFunc{
+		convert: func(col Color) [3]float64 {
+			j, c, h := col.JzCzhz()
+			return [3]float64{j, c, h}
+		},
+		distance: func(p1, p2 [3]float64) float64 {
+			return jzCzhzDistance(p1[0], p1[1], p1[2], p2[0], p2[1], p2[2])
+		},
+		symmetric: true,
+	}
+	MetricUv = euclideanIn(func(col Color) (u, v, zero float64) {
+		u, v = col.Uv()
+		return u, v, 0.0
+	})
+)
+
+// DistanceMatrix returns the distances between all the colors under the
+// metric, with m[i][j] being the distance from colors[i] to colors[j]. The
+// colors are converted once and the distances computed in parallel. It needs
+// memory for len(colors)² distances.
+func DistanceMatrix(colors []Color, metric DistanceFunc) [][]float64 {
+	n := len(colors)
+	metric = metric.orDefault()
+	points := make([][3]float64, n)
+	parallelRows(n, func(i int) {
+		points[i] = metric.convert(colors[i])
+	})
+
+	m := make([][]float64, n)
+	all := make([]float64, n*n)
+	for i := range m {
+		m[i] = all[i*n : (i+1)*n]
+	}
+
+	// Symmetric metrics fill the upper triangle and mirror it.
+	parallelRows(n, func(i int) {
+		j0 := 0
+		if metric.symmetric {
+			j0 = i + 1
+		}
+		for j := j0; j < n; j++ {
+			if i == j {
+				continue
+			}
+			m[i][j] = metric.distance(points[i], points[j])
+			if metric.symmetric {
+				m[j][i] = m[i][j]
+			}
+		}
+	})
+	return m
+}
+
+// parallelRows calls row for every i in [0..n), spread over all CPUs. The rows
+// are interleaved, so that they are balanced for triangular matrices.
+func parallelRows(n int, row func(i int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if workers > n {
+		workers = n
+	}
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < n; i += workers {
+				row(i)
+			}
+		}(w)
+	}
+	wg.Wait()
+}
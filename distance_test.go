@@ -0,0 +1,98 @@
+package colorful
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var metrics = []struct {
+	name   string
+	metric DistanceFunc
+	method func(c1, c2 Color) float64
+}{
+	{"Rgb", MetricRgb, Color.DistanceRgb},
+	{"LinearRgb", MetricLinearRgb, Color.DistanceLinearRgb},
+	{"Riemersma", MetricRiemersma, Color.DistanceRiemersma},
+	{"Lab", MetricLab, Color.DistanceLab},
+	{"CIE76", MetricCIE76, Color.DistanceCIE76},
+	{"CIE94", MetricCIE94, Color.DistanceCIE94},
+	{"CIEDE2000", MetricCIEDE2000, Color.DistanceCIEDE2000},
+	{"CIEDE2000klch(2, 1, 1)", MetricCIEDE2000klch(2.0, 1.0, 1.0), func(c1, c2 Color) float64 { return c1.DistanceCIEDE2000klch(c2, 2.0, 1.0, 1.0) }},
+	{"CMC(2, 1)", MetricCMC(2.0, 1.0), func(c1, c2 Color) float64 { return c1.DistanceCMC(c2, 2.0, 1.0) }},
+	{"Luv", MetricLuv, Color.DistanceLuv},
+	{"HSLuv", MetricHSLuv, Color.DistanceHSLuv},
+	{"HPLuv", MetricHPLuv, Color.DistanceHPLuv},
+	{"Din99", MetricDin99, Color.DistanceDin99},
+	{"Din99o", MetricDin99o, Color.DistanceDin99o},
+	{"Din99b", MetricDin99b, Color.DistanceDin99b},
+	{"Din99c", MetricDin99c, Color.DistanceDin99c},
+	{"Din99d", MetricDin99d, Color.DistanceDin99d},
+	{"HunterLab", MetricHunterLab, Color.DistanceHunterLab},
+	{"Cam16Ucs", MetricCam16Ucs, Color.DistanceCam16Ucs},
+	{"ITP", MetricITP, Color.DistanceITP},
+	{"Jz", MetricJz, Color.DistanceJz},
+	{"Uv", MetricUv, Color.DistanceUv},
+	{"NewDistanceFunc", NewDistanceFunc(Color.DistanceRiemersma), Color.DistanceRiemersma},
+	{"zero", DistanceFunc{}, Color.DistanceCIEDE2000},
+}
+
+func TestMetrics(t *testing.T) {
+	for _, m := range metrics {
+		for i := 1; i < len(vals); i++ {
+			c1, c2 := vals[i-1].c, vals[i].c
+			if d, want := m.metric.Distance(c1, c2), m.method(c1, c2); !almosteq_eps(d, want, 1e-12) {
+				t.Errorf("Metric%v.Distance(%v, %v) => %v, want %v", m.name, c1, c2, d, want)
+			}
+		}
+	}
+}
+
+func TestDistanceMatrix(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	cs := make([]Color, 50)
+	for i := range cs {
+		cs[i] = Color{rnd.Float64(), rnd.Float64(), rnd.Float64()}
+	}
+
+	for _, m := range metrics {
+		dists := DistanceMatrix(cs, m.metric)
+		if len(dists) != len(cs) {
+			t.Fatalf("DistanceMatrix(Metric%v) has %v rows, want %v", m.name, len(dists), len(cs))
+		}
+		for i := range cs {
+			for j := range cs {
+				if want := m.method(cs[i], cs[j]); !almosteq_eps(dists[i][j], want, 1e-12) || (i == j && dists[i][j] != 0.0) {
+					t.Errorf("DistanceMatrix(Metric%v)[%v][%v] => %v, want %v", m.name, i, j, dists[i][j], want)
+				}
+			}
+		}
+	}
+
+	if dists := DistanceMatrix(nil, MetricLab); len(dists) != 0 {
+		t.Errorf("DistanceMatrix(nil) => %v, want empty", dists)
+	}
+}
+
+func TestSoftPaletteMetric(t *testing.T) {
+	settings := SoftPaletteSettings{nil, 20, false}
+	pal, err := SoftPaletteExWithMetricAndRand(8, settings, MetricCIEDE2000, rand.New(rand.NewSource(1)))
+	if err != nil || len(pal) != 8 {
+		t.Fatalf("SoftPaletteExWithMetric(8, MetricCIEDE2000) => %v, %v", pal, err)
+	}
+	for i, col := range pal {
+		if !col.IsValid() {
+			t.Errorf("Color %v of the palette is invalid: %v", i, col)
+		}
+	}
+}
+
+func BenchmarkDistanceMatrix(b *testing.B) {
+	cs := make([]Color, 500)
+	for i := range cs {
+		cs[i] = Color{rand.Float64(), rand.Float64(), rand.Float64()}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DistanceMatrix(cs, MetricCIEDE2000)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import "fmt"
+import "github.com/lucasb-eyer/go-colorful"
+import "image/png"
+import "os"
+
+func main() {
+	samples := []colorful.Color{}
+	for _, hex := range []string{"#fdffcc", "#242a42", "#e63946", "#2a9d8f", "#e9c46a"} {
+		c, _ := colorful.Hex(hex)
+		samples = append(samples, c)
+	}
+
+	for _, uv := range []bool{false, true} {
+		d := colorful.ChromaticityDiagram{
+			Size: 500,
+			UV:   uv,
+			Gamuts: []colorful.Gamut{
+				colorful.GamutSrgb,
+				colorful.GamutDisplayP3,
+				colorful.GamutA98Rgb,
+				colorful.GamutProPhotoRgb,
+				colorful.GamutRec2020,
+			},
+			Planckian: true,
+			Samples:   samples,
+		}
+
+		name := "chromaticity_xy.png"
+		if uv {
+			name = "chromaticity_uv.png"
+		}
+		toimg, err := os.Create(name)
+		if err != nil {
+			fmt.Printf("Error: %v", err)
+			return
+		}
+		png.Encode(toimg, d.Render())
+		toimg.Close()
+	}
+}
@@ -0,0 +1,85 @@
+// This program writes the Munsell renotation data of the real colors into a
+// Go source file, so that it is built into go-colorful.  It reads "real.dat"
+// of the Munsell Color Science Laboratory, whose lines are hue, value,
+// chroma, x, y and Y like the ones LoadMunsellRenotation reads.
+//
+// To regenerate munsell_data.go, download real.dat from the "Munsell
+// Renotation Data" of the educational resources of the laboratory,
+// https://www.rit.edu/science/munsell-color-science-lab-educational-resources
+// into the root of the repository and run go generate there.
+//
+// Usage: munselldata real.dat munsell_data.go
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: munselldata real.dat munsell_data.go")
+		os.Exit(2)
+	}
+	if err := generate(os.Args[1], os.Args[2]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func generate(in, out string) error {
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by doc/munselldata from %v. DO NOT EDIT.\n\n", filepath.Base(in))
+	fmt.Fprintln(&buf, "package colorful")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// The Munsell renotation data of the real colors, \"real.dat\" of the Munsell")
+	fmt.Fprintln(&buf, "// Color Science Laboratory, as rows of hue number, value, chroma, x and y")
+	fmt.Fprintln(&buf, "// under illuminant C.")
+	fmt.Fprintln(&buf, "var munsellRealData = [][5]float64{")
+
+	rows := 0
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || (line == 1 && fields[0] == "h") {
+			continue
+		}
+		if len(fields) < 5 {
+			return fmt.Errorf("%v:%v: expected hue, value, chroma, x and y", in, line)
+		}
+		hue, value, chroma, err := colorful.ParseMunsell(fields[0] + " " + fields[1] + "/" + fields[2])
+		if err != nil {
+			return fmt.Errorf("%v:%v: %v", in, line, err)
+		}
+		fmt.Fprintf(&buf, "\t{%v, %v, %v, %v, %v}, // %v %v/%v\n", hue, value, chroma, fields[3], fields[4], fields[0], fields[1], fields[2])
+		rows++
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("%v: no renotation data", in)
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(out, src, 0644)
+}
@@ -0,0 +1,224 @@
+package colorful
+
+import "math"
+
+/// HCT ///
+///////////
+// The color space of Material Design, combining the hue and chroma of CAM16
+// with the tone (CIE L*) of CIE-L*a*b*, both under the DefaultViewingConditions.
+// https://material.io/blog/science-of-color-design
+// Hue is in [0..360], chroma in about [0..150] and tone in [0..100], as in
+// Material Color Utilities, which this solver is a port of.
+//
+// Material Color Utilities uses a slightly different sRGB matrix than
+// LinearRgbToXyz. It is used here too, so that the results are identical.
+
+func hctLinearRgbToXyz(r, g, b float64) (x, y, z float64) {
+	x = 0.41233895*r + 0.35762064*g + 0.18051042*b
+	y = 0.2126*r + 0.7152*g + 0.0722*b
+	z = 0.01932141*r + 0.11916382*g + 0.95034478*b
+	return
+}
+
+func hctXyzToLinearRgb(x, y, z float64) (r, g, b float64) {
+	r = 3.2413774792388685*x - 1.5376652402851851*y - 0.49885366846268053*z
+	g = -0.9691452513005321*x + 1.8758853451067872*y + 0.04156585616912061*z
+	b = 0.05562093689691305*x - 0.20395524564742123*y + 1.0571799111220335*z
+	return
+}
+
+// Hct returns the hue in [0..360], chroma and tone in [0..100] of the color.
+func (col Color) Hct() (h, c, t float64) {
+	x, y, z := hctLinearRgbToXyz(col.LinearRgb())
+	cam := XyzToCam16(x, y, z, DefaultViewingConditions)
+	return cam.Hue, cam.C, 116.0*lab_f(y) - 16.0
+}
+
+// Hct creates a new Color given a hue in [0..360], chroma and tone in [0..100].
+// The tone is always kept; if the chroma is not reachable for this hue and
+// tone within sRGB, the most chromatic color with that hue and tone is
+// returned, so unlike the other color spaces, the result is always valid.
+func Hct(h, c, t float64) Color {
+	if c < 0.0001 || t < 0.0001 || t > 99.9999 {
+		return hctGray(t)
+	}
+
+	h = math.Mod(h, 360.0)
+	if h < 0.0 {
+		h += 360.0
+	}
+	y := lab_finv((t + 16.0) / 116.0)
+	if col, ok := hctFindByJ(h, c, y); ok {
+		return col
+	}
+	r, g, b := hctBisectToLimit(y, h*math.Pi/180.0)
+	return LinearRgb(r, g, b)
+}
+
+// BlendHct blends two colors in the HCT color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (col1 Color) BlendHct(col2 Color, t float64) Color {
+	h1, c1, t1 := col1.Hct()
+	h2, c2, t2 := col2.Hct()
+
+	// https://github.com/lucasb-eyer/go-colorful/pull/60
+	if c1 <= 0.015 && c2 >= 0.015 {
+		h1 = h2
+	} else if c2 <= 0.015 && c1 >= 0.015 {
+		h2 = h1
+	}
+
+	// We know that h are both in [0..360]
+	return Hct(interp_angle(h1, h2, t), c1+t*(c2-c1), t1+t*(t2-t1))
+}
+
+func hctGray(tone float64) Color {
+	v := delinearize(lab_finv((tone + 16.0) / 116.0))
+	return Color{v, v, v}
+}
+
+// hctFindByJ finds the color using Newton's method on J, with the luminance y
+// as target. This fails when the color is outside of sRGB.
+func hctFindByJ(h, c, y float64) (Color, bool) {
+	// Initial estimate of J, the relation to L* is roughly linear.
+	j := math.Sqrt(y) * 110.0
+	for i := 0; i < 5; i++ {
+		x, fnj, z := Cam16JChToXyz(j, c, h, DefaultViewingConditions)
+		r, g, b := hctXyzToLinearRgb(x, fnj, z)
+		if r < 0.0 || g < 0.0 || b < 0.0 || fnj <= 0.0 {
+			return Color{}, false
+		}
+		if i == 4 || math.Abs(fnj-y) < 0.00002 {
+			if r > 1.0001 || g > 1.0001 || b > 1.0001 {
+				return Color{}, false
+			}
+			return LinearRgb(r, g, b), true
+		}
+		j -= (fnj - y) * j / (2.0 * fnj)
+	}
+	return Color{}, false
+}
+
+// The CAM16 hue in radians of a linear RGB color, without normalization.
+func hctHueOf(rgb [3]float64) float64 {
+	vc := &DefaultViewingConditions
+	x, y, z := hctLinearRgbToXyz(rgb[0], rgb[1], rgb[2])
+	r, g, b := xyzToCam16Rgb(x*100.0, y*100.0, z*100.0)
+	ra := cam16Compress(vc.rgbD[0]*r, vc.fl)
+	ga := cam16Compress(vc.rgbD[1]*g, vc.fl)
+	ba := cam16Compress(vc.rgbD[2]*b, vc.fl)
+	return math.Atan2((ra+ga-2.0*ba)/9.0, (11.0*ra-12.0*ga+ba)/11.0)
+}
+
+func hctSanitizeRadians(angle float64) float64 {
+	return math.Mod(angle+math.Pi*8.0, math.Pi*2.0)
+}
+
+func hctInCyclicOrder(a, b, c float64) bool {
+	return hctSanitizeRadians(b-a) < hctSanitizeRadians(c-a)
+}
+
+// hctVertex returns the n-th of the 12 intersections of the plane of constant
+// luminance y with the edges of the linear RGB cube, and false if it does not exist.
+func hctVertex(y float64, n int) ([3]float64, bool) {
+	// The luminance coefficients, as in hctLinearRgbToXyz.
+	const kr, kg, kb = 0.2126, 0.7152, 0.0722
+	coordA := 0.0
+	if n%4 > 1 {
+		coordA = 1.0
+	}
+	coordB := 0.0
+	if n%2 == 1 {
+		coordB = 1.0
+	}
+
+	var rgb [3]float64
+	switch {
+	case n < 4:
+		rgb = [3]float64{(y - coordA*kg - coordB*kb) / kr, coordA, coordB}
+	case n < 8:
+		rgb = [3]float64{coordB, (y - coordB*kr - coordA*kb) / kg, coordA}
+	default:
+		rgb = [3]float64{coordA, coordB, (y - coordA*kr - coordB*kg) / kb}
+	}
+	for _, v := range rgb {
+		if v < 0.0 || v > 1.0 {
+			return rgb, false
+		}
+	}
+	return rgb, true
+}
+
+// hctBisectToSegment finds the edge of the polygon of constant luminance y
+// within the RGB cube on which the target hue lies.
+func hctBisectToSegment(y, targetHue float64) (left, right [3]float64) {
+	var leftHue, rightHue float64
+	initialized, uncut := false, true
+	for n := 0; n < 12; n++ {
+		mid, ok := hctVertex(y, n)
+		if !ok {
+			continue
+		}
+		midHue := hctHueOf(mid)
+		if !initialized {
+			left, right = mid, mid
+			leftHue, rightHue = midHue, midHue
+			initialized = true
+			continue
+		}
+		if uncut || hctInCyclicOrder(leftHue, midHue, rightHue) {
+			uncut = false
+			if hctInCyclicOrder(leftHue, targetHue, midHue) {
+				right, rightHue = mid, midHue
+			} else {
+				left, leftHue = mid, midHue
+			}
+		}
+	}
+	return
+}
+
+// The planes in linear RGB halfway between consecutive 8 bit sRGB values.
+var hctCriticalPlanes = func() (planes [255]float64) {
+	for i := range planes {
+		planes[i] = linearize((float64(i) + 0.5) / 255.0)
+	}
+	return
+}()
+
+// hctBisectToLimit finds the color with the target hue on the surface of the
+// sRGB gamut at luminance y, by bisecting along the critical planes.
+func hctBisectToLimit(y, targetHue float64) (r, g, b float64) {
+	left, right := hctBisectToSegment(y, targetHue)
+	leftHue := hctHueOf(left)
+	for axis := 0; axis < 3; axis++ {
+		if left[axis] == right[axis] {
+			continue
+		}
+		var lPlane, rPlane int
+		l, r := delinearize(left[axis])*255.0, delinearize(right[axis])*255.0
+		if left[axis] < right[axis] {
+			lPlane, rPlane = int(math.Floor(l-0.5)), int(math.Ceil(r-0.5))
+		} else {
+			lPlane, rPlane = int(math.Ceil(l-0.5)), int(math.Floor(r-0.5))
+		}
+		for i := 0; i < 8; i++ {
+			if rPlane-lPlane <= 1 && lPlane-rPlane <= 1 {
+				break
+			}
+			mPlane := int(math.Floor(float64(lPlane+rPlane) / 2.0))
+			t := (hctCriticalPlanes[mPlane] - left[axis]) / (right[axis] - left[axis])
+			var mid [3]float64
+			for k := range mid {
+				mid[k] = left[k] + (right[k]-left[k])*t
+			}
+			midHue := hctHueOf(mid)
+			if hctInCyclicOrder(leftHue, targetHue, midHue) {
+				right, rPlane = mid, mPlane
+			} else {
+				left, leftHue, lPlane = mid, midHue, mPlane
+			}
+		}
+	}
+	return (left[0] + right[0]) / 2.0, (left[1] + right[1]) / 2.0, (left[2] + right[2]) / 2.0
+}
@@ -0,0 +1,65 @@
+package colorful
+
+import (
+	"testing"
+)
+
+// Reference values from Material Color Utilities.
+var hctvals = []struct {
+	hex     string
+	h, c, t float64
+}{
+	{"#ff0000", 27.408, 113.358, 53.233},
+	{"#00ff00", 142.140, 108.410, 87.737},
+	{"#0000ff", 282.788, 87.231, 32.303},
+	{"#ffffff", 209.492, 2.869, 100.0},
+}
+
+func TestHctConversion(t *testing.T) {
+	for i, tt := range hctvals {
+		c, _ := Hex(tt.hex)
+		h, ch, tn := c.Hct()
+		if !almosteq_eps(h, tt.h, 1e-4) || !almosteq_eps(ch, tt.c, 1e-4) || !almosteq_eps(tn, tt.t, 1e-4) {
+			t.Errorf("%v. %v.Hct() => (%v, %v, %v), want (%v, %v, %v)", i, tt.hex, h, ch, tn, tt.h, tt.c, tt.t)
+		}
+	}
+}
+
+func TestHctRoundtrip(t *testing.T) {
+	for i, tt := range vals {
+		h, c, tn := tt.c.Hct()
+		col := Hct(h, c, tn)
+		if !col.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. Hct(%v, %v, %v) => (%v), want %v (delta %v)", i, h, c, tn, col, tt.c, delta)
+		}
+	}
+}
+
+func TestHctOutOfGamut(t *testing.T) {
+	// The tone is kept and the chroma reduced to stay valid.
+	for h := 0.0; h < 360.0; h += 15.0 {
+		for tn := 10.0; tn < 100.0; tn += 10.0 {
+			col := Hct(h, 200.0, tn)
+			if !col.IsValid() {
+				t.Errorf("Hct(%v, 200, %v) => (%v), want a valid color", h, tn, col)
+				continue
+			}
+			if _, _, tn2 := col.Hct(); !almosteq_eps(tn2, tn, 1e-2) {
+				t.Errorf("Hct(%v, 200, %v) has tone %v", h, tn, tn2)
+			}
+		}
+	}
+}
+
+func TestHctGray(t *testing.T) {
+	for tn := 0.0; tn <= 100.0; tn += 10.0 {
+		col := Hct(123.0, 0.0, tn)
+		if col.R != col.G || col.G != col.B {
+			t.Errorf("Hct(123, 0, %v) => (%v), want a gray", tn, col)
+		}
+		l, _, _ := col.Lab()
+		if !almosteq(l*100.0, tn) {
+			t.Errorf("Hct(123, 0, %v) has L* %v", tn, l*100.0)
+		}
+	}
+}
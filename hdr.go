@@ -0,0 +1,205 @@
+package colorful
+
+import "math"
+
+// HDR transfer functions and color spaces from ITU-R BT.2100.
+// https://www.itu.int/rec/R-REC-BT.2100
+//
+// Unlike all other color spaces in this library, HDR signals describe absolute
+// luminance in cd/m² (nits). To convert between these and a Color, whose white
+// has a luminance Y of 1, sRGB white is taken to be the HDR reference white.
+// Colors brighter than that have values above 1 and thus are not IsValid.
+
+// HdrReferenceWhite is the luminance in cd/m² of diffuse white in HDR content,
+// as recommended by ITU-R BT.2408. A Color with a Y of 1 has this luminance.
+const HdrReferenceWhite = 203.0
+
+// HlgNominalPeak is the nominal peak luminance in cd/m² of the reference HLG
+// display, which is used when no other peak is given.
+const HlgNominalPeak = 1000.0
+
+/// PQ ///
+//////////
+// SMPTE ST 2084 perceptual quantizer.
+
+const (
+	pqM1 = 2610.0 / 16384.0
+	pqM2 = 2523.0 / 4096.0 * 128.0
+	pqC1 = 3424.0 / 4096.0
+	pqC2 = 2413.0 / 4096.0 * 32.0
+	pqC3 = 2392.0 / 4096.0 * 32.0
+
+	// PqPeak is the luminance in cd/m² encoded by a PQ signal of 1.
+	PqPeak = 10000.0
+)
+
+// PqEncode is the inverse EOTF of PQ. It encodes the given luminance in cd/m²,
+// in [0..10000], into a non-linear signal in [0..1].
+func PqEncode(luminance float64) float64 {
+	y := math.Max(luminance/PqPeak, 0.0)
+	ym := math.Pow(y, pqM1)
+	return math.Pow((pqC1+pqC2*ym)/(1.0+pqC3*ym), pqM2)
+}
+
+// PqDecode is the EOTF of PQ. It decodes the non-linear signal in [0..1] into
+// a luminance in cd/m², in [0..10000].
+func PqDecode(signal float64) float64 {
+	em := math.Pow(math.Max(signal, 0.0), 1.0/pqM2)
+	return PqPeak * math.Pow(math.Max(em-pqC1, 0.0)/(pqC2-pqC3*em), 1.0/pqM1)
+}
+
+/// HLG ///
+///////////
+// ARIB STD-B67 hybrid log-gamma.
+
+const (
+	hlgA = 0.17883277
+	hlgB = 0.28466892 // 1 - 4a
+	hlgC = 0.55991073 // 0.5 - a*ln(4a)
+)
+
+// HlgEncode is the OETF of HLG. It encodes normalized scene light in [0..1]
+// into a non-linear signal in [0..1].
+func HlgEncode(e float64) float64 {
+	e = math.Max(e, 0.0)
+	if e <= 1.0/12.0 {
+		return math.Sqrt(3.0 * e)
+	}
+	return hlgA*math.Log(12.0*e-hlgB) + hlgC
+}
+
+// HlgDecode is the inverse OETF of HLG. It decodes the non-linear signal in
+// [0..1] into normalized scene light in [0..1].
+func HlgDecode(signal float64) float64 {
+	signal = math.Max(signal, 0.0)
+	if signal <= 0.5 {
+		return signal * signal / 3.0
+	}
+	return (math.Exp((signal-hlgC)/hlgA) + hlgB) / 12.0
+}
+
+// HlgSystemGamma returns the system gamma of the HLG OOTF for a display of
+// the given nominal peak luminance in cd/m². It is 1.2 for a 1000 cd/m² display.
+func HlgSystemGamma(peak float64) float64 {
+	return 1.2 + 0.42*math.Log10(peak/1000.0)
+}
+
+// HlgOotf applies the HLG OOTF, which maps normalized linear scene light of
+// the Rec. 2020 primaries in [0..1] to display light in cd/m² for a display of
+// the given nominal peak luminance.
+func HlgOotf(r, g, b, peak float64) (rd, gd, bd float64) {
+	ys := 0.2627*r + 0.6780*g + 0.0593*b
+	if ys <= 0.0 {
+		return 0.0, 0.0, 0.0
+	}
+	scale := peak * math.Pow(ys, HlgSystemGamma(peak)-1.0)
+	return scale * r, scale * g, scale * b
+}
+
+// HlgInverseOotf is the inverse of HlgOotf.
+func HlgInverseOotf(rd, gd, bd, peak float64) (r, g, b float64) {
+	yd := 0.2627*rd + 0.6780*gd + 0.0593*bd
+	if yd <= 0.0 {
+		return 0.0, 0.0, 0.0
+	}
+	gamma := HlgSystemGamma(peak)
+	scale := math.Pow(yd/peak, (1.0-gamma)/gamma) / peak
+	return scale * rd, scale * gd, scale * bd
+}
+
+/// Rec. 2100 PQ ///
+////////////////////
+
+// Rec2100PqToXyz converts PQ-encoded Rec. 2100 signals in [0..1] to absolute
+// CIE XYZ, that is with Y in cd/m².
+func Rec2100PqToXyz(r, g, b float64) (x, y, z float64) {
+	return LinearRec2020ToXyz(PqDecode(r), PqDecode(g), PqDecode(b))
+}
+
+// XyzToRec2100Pq converts absolute CIE XYZ, with Y in cd/m², to PQ-encoded
+// Rec. 2100 signals in [0..1].
+func XyzToRec2100Pq(x, y, z float64) (r, g, b float64) {
+	rl, gl, bl := XyzToLinearRec2020(x, y, z)
+	return PqEncode(rl), PqEncode(gl), PqEncode(bl)
+}
+
+// Rec2100Pq creates a new Color given PQ-encoded Rec. 2100 signals in [0..1].
+// Luminances above HdrReferenceWhite result in values above 1.
+func Rec2100Pq(r, g, b float64) Color {
+	x, y, z := Rec2100PqToXyz(r, g, b)
+	return Xyz(x/HdrReferenceWhite, y/HdrReferenceWhite, z/HdrReferenceWhite)
+}
+
+// Rec2100Pq returns the PQ-encoded Rec. 2100 signals of the color, in [0..1].
+func (col Color) Rec2100Pq() (r, g, b float64) {
+	x, y, z := col.Xyz()
+	return XyzToRec2100Pq(x*HdrReferenceWhite, y*HdrReferenceWhite, z*HdrReferenceWhite)
+}
+
+// BlendRec2100Pq blends two colors in the Rec. 2100 PQ color-space, which is
+// roughly perceptually uniform in lightness over the whole HDR range.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendRec2100Pq(c2 Color, t float64) Color {
+	r1, g1, b1 := c1.Rec2100Pq()
+	r2, g2, b2 := c2.Rec2100Pq()
+	return Rec2100Pq(
+		r1+t*(r2-r1),
+		g1+t*(g2-g1),
+		b1+t*(b2-b1))
+}
+
+/// Rec. 2100 HLG ///
+/////////////////////
+// HLG is scene-referred, so the display luminance depends on the peak
+// luminance of the display, which is why most functions take it as argument.
+
+// Rec2100HlgToXyz converts HLG-encoded Rec. 2100 signals in [0..1] to absolute
+// CIE XYZ, that is with Y in cd/m², as shown on a display of the given peak luminance.
+func Rec2100HlgToXyz(r, g, b, peak float64) (x, y, z float64) {
+	return LinearRec2020ToXyz(HlgOotf(HlgDecode(r), HlgDecode(g), HlgDecode(b), peak))
+}
+
+// XyzToRec2100Hlg converts absolute CIE XYZ, with Y in cd/m², to HLG-encoded
+// Rec. 2100 signals in [0..1] for a display of the given peak luminance.
+func XyzToRec2100Hlg(x, y, z, peak float64) (r, g, b float64) {
+	rl, gl, bl := XyzToLinearRec2020(x, y, z)
+	rs, gs, bs := HlgInverseOotf(rl, gl, bl, peak)
+	return HlgEncode(rs), HlgEncode(gs), HlgEncode(bs)
+}
+
+// Rec2100HlgPeak creates a new Color given HLG-encoded Rec. 2100 signals in
+// [0..1], as shown on a display of the given peak luminance in cd/m².
+func Rec2100HlgPeak(r, g, b, peak float64) Color {
+	x, y, z := Rec2100HlgToXyz(r, g, b, peak)
+	return Xyz(x/HdrReferenceWhite, y/HdrReferenceWhite, z/HdrReferenceWhite)
+}
+
+// Rec2100HlgPeak returns the HLG-encoded Rec. 2100 signals of the color, in
+// [0..1], for a display of the given peak luminance in cd/m².
+func (col Color) Rec2100HlgPeak(peak float64) (r, g, b float64) {
+	x, y, z := col.Xyz()
+	return XyzToRec2100Hlg(x*HdrReferenceWhite, y*HdrReferenceWhite, z*HdrReferenceWhite, peak)
+}
+
+// Rec2100Hlg creates a new Color given HLG-encoded Rec. 2100 signals in
+// [0..1], as shown on the reference display with a peak of HlgNominalPeak.
+func Rec2100Hlg(r, g, b float64) Color {
+	return Rec2100HlgPeak(r, g, b, HlgNominalPeak)
+}
+
+// Rec2100Hlg returns the HLG-encoded Rec. 2100 signals of the color, in
+// [0..1], for the reference display with a peak of HlgNominalPeak.
+func (col Color) Rec2100Hlg() (r, g, b float64) {
+	return col.Rec2100HlgPeak(HlgNominalPeak)
+}
+
+// BlendRec2100Hlg blends two colors in the Rec. 2100 HLG color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendRec2100Hlg(c2 Color, t float64) Color {
+	r1, g1, b1 := c1.Rec2100Hlg()
+	r2, g2, b2 := c2.Rec2100Hlg()
+	return Rec2100Hlg(
+		r1+t*(r2-r1),
+		g1+t*(g2-g1),
+		b1+t*(b2-b1))
+}
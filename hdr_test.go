@@ -0,0 +1,145 @@
+package colorful
+
+import (
+	"math"
+	"testing"
+)
+
+/// Transfer functions ///
+//////////////////////////
+
+func TestPqReferenceValues(t *testing.T) {
+	// Values from ITU-R BT.2100 and BT.2408.
+	for i, tt := range []struct {
+		luminance float64
+		signal    float64
+	}{
+		{0.0, 0.0000007},
+		{100.0, 0.5081},
+		{203.0, 0.5806},
+		{1000.0, 0.7518},
+		{10000.0, 1.0},
+	} {
+		if s := PqEncode(tt.luminance); math.Abs(s-tt.signal) > 1e-4 {
+			t.Errorf("%v. PqEncode(%v) => %v, want %v", i, tt.luminance, s, tt.signal)
+		}
+	}
+
+	if l := PqDecode(1.0); math.Abs(l-PqPeak) > 1e-9 {
+		t.Errorf("PqDecode(1) => %v, want %v", l, PqPeak)
+	}
+	if l := PqDecode(0.0); l != 0.0 {
+		t.Errorf("PqDecode(0) => %v, want 0", l)
+	}
+}
+
+func TestPqRoundtrip(t *testing.T) {
+	// Zero is special since PQ doesn't encode zero luminance as exactly zero.
+	for v := 0.01; v <= 1.0; v += 0.01 {
+		back := PqEncode(PqDecode(v))
+		if math.Abs(v-back) > 1e-10 {
+			t.Errorf("PQ roundtrip: %v -> %v -> %v", v, PqDecode(v), back)
+		}
+	}
+}
+
+func TestHlgReferenceValues(t *testing.T) {
+	if s := HlgEncode(1.0 / 12.0); math.Abs(s-0.5) > 1e-10 {
+		t.Errorf("HlgEncode(1/12) => %v, want 0.5", s)
+	}
+	if s := HlgEncode(1.0); math.Abs(s-1.0) > 1e-6 {
+		t.Errorf("HlgEncode(1) => %v, want 1", s)
+	}
+	if g := HlgSystemGamma(1000.0); math.Abs(g-1.2) > 1e-10 {
+		t.Errorf("HlgSystemGamma(1000) => %v, want 1.2", g)
+	}
+
+	// BT.2408: HLG reference white is at 75% signal, which is shown at 203 cd/m²
+	// on a 1000 cd/m² display.
+	e := HlgDecode(0.75)
+	_, y, _ := HlgOotf(e, e, e, 1000.0)
+	if math.Abs(y-HdrReferenceWhite) > 0.5 {
+		t.Errorf("HLG 75%% => %v cd/m², want %v", y, HdrReferenceWhite)
+	}
+}
+
+func TestHlgRoundtrip(t *testing.T) {
+	for v := 0.0; v <= 1.0; v += 0.01 {
+		back := HlgEncode(HlgDecode(v))
+		if math.Abs(v-back) > 1e-8 {
+			t.Errorf("HLG roundtrip: %v -> %v -> %v", v, HlgDecode(v), back)
+		}
+	}
+
+	for _, peak := range []float64{400.0, 1000.0, 2000.0} {
+		rd, gd, bd := HlgOotf(0.2, 0.5, 0.8, peak)
+		r, g, b := HlgInverseOotf(rd, gd, bd, peak)
+		if !almosteq(r, 0.2) || !almosteq(g, 0.5) || !almosteq(b, 0.8) {
+			t.Errorf("HLG OOTF roundtrip at %v cd/m²: (0.2, 0.5, 0.8) -> (%v, %v, %v)", peak, r, g, b)
+		}
+	}
+}
+
+/// Rec. 2100 ///
+/////////////////
+
+func TestRec2100PqRoundtrip(t *testing.T) {
+	for i, tt := range vals {
+		c := Rec2100Pq(tt.c.Rec2100Pq())
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. %v -> Rec2100Pq -> sRGB = %v", i, tt.c, c)
+		}
+	}
+}
+
+func TestRec2100HlgRoundtrip(t *testing.T) {
+	for _, peak := range []float64{400.0, 1000.0, 2000.0} {
+		for i, tt := range vals {
+			r, g, b := tt.c.Rec2100HlgPeak(peak)
+			c := Rec2100HlgPeak(r, g, b, peak)
+			if !c.AlmostEqualRgb(tt.c) {
+				t.Errorf("%v. %v -> Rec2100Hlg(%v) -> sRGB = %v", i, tt.c, peak, c)
+			}
+		}
+	}
+}
+
+func TestRec2100AbsoluteLuminance(t *testing.T) {
+	// sRGB white is the HDR reference white.
+	r, g, b := Color{1, 1, 1}.Rec2100Pq()
+	want := PqEncode(HdrReferenceWhite)
+	if !almosteq(r, want) || !almosteq(g, want) || !almosteq(b, want) {
+		t.Errorf("White.Rec2100Pq() => (%v, %v, %v), want %v", r, g, b, want)
+	}
+	r, g, b = Color{1, 1, 1}.Rec2100Hlg()
+	if !almosteq(r, 0.75) || !almosteq(g, 0.75) || !almosteq(b, 0.75) {
+		t.Errorf("White.Rec2100Hlg() => (%v, %v, %v), want 0.75", r, g, b)
+	}
+
+	// A 1000 cd/m² white is brighter than sRGB white.
+	s := PqEncode(1000.0)
+	_, y, _ := Rec2100PqToXyz(s, s, s)
+	if math.Abs(y-1000.0) > 1e-6 {
+		t.Errorf("Rec2100PqToXyz(%v) => Y = %v, want 1000", s, y)
+	}
+	if c := Rec2100Pq(s, s, s); c.IsValid() {
+		t.Errorf("Rec2100Pq(%v) => %v, want a color brighter than white", s, c)
+	}
+}
+
+func TestBlendRec2100Endpoints(t *testing.T) {
+	c1, _ := Hex("#1a1a46")
+	c2, _ := Hex("#666666")
+	if b := c1.BlendRec2100Pq(c2, 0).Hex(); b != c1.Hex() {
+		t.Errorf("BlendRec2100Pq t=0: got %v, want %v", b, c1.Hex())
+	}
+	if b := c1.BlendRec2100Pq(c2, 1).Hex(); b != c2.Hex() {
+		t.Errorf("BlendRec2100Pq t=1: got %v, want %v", b, c2.Hex())
+	}
+	if b := c1.BlendRec2100Hlg(c2, 0).Hex(); b != c1.Hex() {
+		t.Errorf("BlendRec2100Hlg t=0: got %v, want %v", b, c1.Hex())
+	}
+	if b := c1.BlendRec2100Hlg(c2, 1).Hex(); b != c2.Hex() {
+		t.Errorf("BlendRec2100Hlg t=1: got %v, want %v", b, c2.Hex())
+	}
+}
@@ -0,0 +1,90 @@
+package colorful
+
+import "math"
+
+/// Hunter Lab ///
+//////////////////
+// The Hunter L, a, b color scale of 1948, which predates CIE-L*a*b* and is
+// still reported by many colorimeters. Its a and b are scaled by the constants
+// Ka and Kb of the reference white. As with L*a*b*, the values are a hundredth
+// of the usual ones, so that L is in [0..1] and a, b are roughly in [-1..1].
+// https://en.wikipedia.org/wiki/CIELAB_color_space#Hunter_Lab
+
+// HunterLabK returns the constants Ka and Kb of Hunter Lab for the given
+// reference white, for example 175 and 70 for illuminant C and about 172.4
+// and 67.0 for D65, using the approximation of HunterLab.
+func HunterLabK(wref [3]float64) (ka, kb float64) {
+	ka = 175.0 / 198.04 * (wref[0] + wref[1]) * 100.0
+	kb = 70.0 / 218.11 * (wref[1] + wref[2]) * 100.0
+	return
+}
+
+func XyzToHunterLab(x, y, z float64) (l, a, b float64) {
+	return XyzToHunterLabWhiteRef(x, y, z, D65)
+}
+
+func XyzToHunterLabWhiteRef(x, y, z float64, wref [3]float64) (l, a, b float64) {
+	ka, kb := HunterLabK(wref)
+	l = math.Sqrt(y / wref[1])
+	if l == 0.0 {
+		return 0.0, 0.0, 0.0
+	}
+	a = ka / 100.0 * (x/wref[0] - y/wref[1]) / l
+	b = kb / 100.0 * (y/wref[1] - z/wref[2]) / l
+	return
+}
+
+func HunterLabToXyz(l, a, b float64) (x, y, z float64) {
+	return HunterLabToXyzWhiteRef(l, a, b, D65)
+}
+
+func HunterLabToXyzWhiteRef(l, a, b float64, wref [3]float64) (x, y, z float64) {
+	ka, kb := HunterLabK(wref)
+	x = wref[0] * (l*l + a*l/(ka/100.0))
+	y = wref[1] * l * l
+	z = wref[2] * (l*l - b*l/(kb/100.0))
+	return
+}
+
+// HunterLab converts the given color to Hunter Lab using D65 as reference white.
+func (col Color) HunterLab() (l, a, b float64) {
+	return XyzToHunterLab(col.Xyz())
+}
+
+// HunterLabWhiteRef converts the given color to Hunter Lab, taking into
+// account a given reference white, for example Illuminants["C"], which many
+// instruments use.
+func (col Color) HunterLabWhiteRef(wref [3]float64) (l, a, b float64) {
+	x, y, z := col.Xyz()
+	return XyzToHunterLabWhiteRef(x, y, z, wref)
+}
+
+// HunterLab generates a color from Hunter Lab values using D65 as reference white.
+// WARNING: many combinations of `l`, `a`, and `b` values do not have corresponding
+// valid RGB values, check the FAQ in the README if you're unsure.
+func HunterLab(l, a, b float64) Color {
+	return Xyz(HunterLabToXyz(l, a, b))
+}
+
+// HunterLabWhiteRef generates a color from Hunter Lab values, taking into
+// account a given reference white.
+func HunterLabWhiteRef(l, a, b float64, wref [3]float64) Color {
+	return Xyz(HunterLabToXyzWhiteRef(l, a, b, wref))
+}
+
+// DistanceHunterLab is the Euclidean distance in Hunter Lab, ΔE of Hunter.
+func (c1 Color) DistanceHunterLab(c2 Color) float64 {
+	l1, a1, b1 := c1.HunterLab()
+	l2, a2, b2 := c2.HunterLab()
+	return math.Sqrt(sq(l1-l2) + sq(a1-a2) + sq(b1-b2))
+}
+
+// BlendHunterLab blends two colors in Hunter Lab.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendHunterLab(c2 Color, t float64) Color {
+	l1, a1, b1 := c1.HunterLab()
+	l2, a2, b2 := c2.HunterLab()
+	return HunterLab(l1+t*(l2-l1),
+		a1+t*(a2-a1),
+		b1+t*(b2-b1))
+}
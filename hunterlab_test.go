@@ -0,0 +1,49 @@
+package colorful
+
+import (
+	"testing"
+)
+
+func TestHunterLabK(t *testing.T) {
+	if ka, kb := HunterLabK(Illuminants["C"]); !almosteq_eps(ka, 175.0, 1e-3) || !almosteq_eps(kb, 70.0, 1e-3) {
+		t.Errorf("HunterLabK(C) => (%v, %v), want (175, 70)", ka, kb)
+	}
+}
+
+func TestHunterLab(t *testing.T) {
+	// The example of colour-science, which uses the white of D65 tabulated by
+	// HunterLab with a Ka of 172.30 and Kb of 67.20, which the approximation
+	// of HunterLabK is within 0.3% of.
+	wref := [3]float64{0.9502, 1.0, 1.0882}
+	l, a, b := XyzToHunterLabWhiteRef(0.20654008, 0.12197225, 0.05136952, wref)
+	if !almosteq_eps(l, 0.3492452577, 1e-6) || !almosteq_eps(a, 0.4706189858, 3e-3) || !almosteq_eps(b, 0.1438615107, 3e-3) {
+		t.Errorf("XyzToHunterLabWhiteRef() => (%v, %v, %v), want (0.34925, 0.47062, 0.14386)", l, a, b)
+	}
+
+	for i, tt := range vals {
+		l, a, b := tt.c.HunterLab()
+		if c := HunterLab(l, a, b); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. HunterLab(%v, %v, %v) => (%v), want %v (delta %v)", i, l, a, b, c, tt.c, delta)
+		}
+		l, a, b = tt.c.HunterLabWhiteRef(Illuminants["C"])
+		if c := HunterLabWhiteRef(l, a, b, Illuminants["C"]); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. HunterLabWhiteRef(%v, %v, %v, C) => (%v), want %v (delta %v)", i, l, a, b, c, tt.c, delta)
+		}
+	}
+
+	w := Illuminants["A"]
+	if l, a, b := XyzToHunterLabWhiteRef(w[0], w[1], w[2], w); l != 1.0 || a != 0.0 || b != 0.0 {
+		t.Errorf("XyzToHunterLabWhiteRef(A, A) => (%v, %v, %v), want (1, 0, 0)", l, a, b)
+	}
+	if l, a, b := (Color{0.0, 0.0, 0.0}).HunterLab(); l != 0.0 || a != 0.0 || b != 0.0 {
+		t.Errorf("black.HunterLab() => (%v, %v, %v), want (0, 0, 0)", l, a, b)
+	}
+}
+
+func TestHunterLabBlendDistance(t *testing.T) {
+	c1, c2 := Color{0.8, 0.2, 0.3}, Color{0.2, 0.5, 0.9}
+	mid := c1.BlendHunterLab(c2, 0.5)
+	if !almosteq_eps(c1.DistanceHunterLab(mid), c1.DistanceHunterLab(c2)/2.0, 1e-9) {
+		t.Errorf("DistanceHunterLab(%v, %v) => %v, not halfway to %v", c1, mid, c1.DistanceHunterLab(mid), c2)
+	}
+}
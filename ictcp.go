@@ -0,0 +1,69 @@
+package colorful
+
+import "math"
+
+/// ICtCp ///
+/////////////
+// The HDR color representation of ITU-R BT.2100, using the PQ transfer function.
+// https://www.itu.int/rec/R-REC-BT.2100
+// Like the Rec. 2100 spaces, this works on absolute luminance; a Color's white
+// is taken to be HdrReferenceWhite. I is in [0..1], Ct and Cp in about [-0.5..0.5].
+
+// XyzToICtCp converts from absolute CIE XYZ, with Y in cd/m², to ICtCp.
+func XyzToICtCp(x, y, z float64) (i, ct, cp float64) {
+	r, g, b := XyzToLinearRec2020(x, y, z)
+	l := PqEncode((1688.0*r + 2146.0*g + 262.0*b) / 4096.0)
+	m := PqEncode((683.0*r + 2951.0*g + 462.0*b) / 4096.0)
+	s := PqEncode((99.0*r + 309.0*g + 3688.0*b) / 4096.0)
+
+	i = 0.5*l + 0.5*m
+	ct = (6610.0*l - 13613.0*m + 7003.0*s) / 4096.0
+	cp = (17933.0*l - 17390.0*m - 543.0*s) / 4096.0
+	return
+}
+
+// ICtCpToXyz converts from ICtCp to absolute CIE XYZ, with Y in cd/m².
+func ICtCpToXyz(i, ct, cp float64) (x, y, z float64) {
+	l := PqDecode(i + 0.008609037037932756*ct + 0.11102962500302596*cp)
+	m := PqDecode(i - 0.008609037037932756*ct - 0.11102962500302596*cp)
+	s := PqDecode(i + 0.5600313357106791*ct - 0.32062717498731885*cp)
+
+	r := 3.4366066943330784*l - 2.50645211865627*m + 0.06984542432319148*s
+	g := -0.7913295555989287*l + 1.9836004517922907*m - 0.192270896193362*s
+	b := -0.025949899690592672*l - 0.09891371471172644*m + 1.1248636144023192*s
+	return LinearRec2020ToXyz(r, g, b)
+}
+
+// ICtCp returns the I, Ct and Cp values of the color, whose white is taken
+// to have a luminance of HdrReferenceWhite.
+func (col Color) ICtCp() (i, ct, cp float64) {
+	x, y, z := col.Xyz()
+	return XyzToICtCp(x*HdrReferenceWhite, y*HdrReferenceWhite, z*HdrReferenceWhite)
+}
+
+// ICtCp creates a new Color given I, Ct and Cp values. Luminances above
+// HdrReferenceWhite result in values above 1.
+func ICtCp(i, ct, cp float64) Color {
+	x, y, z := ICtCpToXyz(i, ct, cp)
+	return Xyz(x/HdrReferenceWhite, y/HdrReferenceWhite, z/HdrReferenceWhite)
+}
+
+// BlendICtCp blends two colors in the ICtCp color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendICtCp(c2 Color, t float64) Color {
+	i1, ct1, cp1 := c1.ICtCp()
+	i2, ct2, cp2 := c2.ICtCp()
+	return ICtCp(i1+t*(i2-i1),
+		ct1+t*(ct2-ct1),
+		cp1+t*(cp2-cp1))
+}
+
+// DistanceITP computes the ΔE_ITP color difference of ITU-R BT.2124, which is
+// meant for HDR and wide-gamut content. A value of 1 is about one just
+// noticeable difference, so unlike the other distances, it is not in [0..1].
+func (c1 Color) DistanceITP(c2 Color) float64 {
+	i1, ct1, cp1 := c1.ICtCp()
+	i2, ct2, cp2 := c2.ICtCp()
+	// T is half of Ct.
+	return 720.0 * math.Sqrt(sq(i1-i2)+sq(0.5*(ct1-ct2))+sq(cp1-cp2))
+}
@@ -0,0 +1,67 @@
+package colorful
+
+import (
+	"math"
+	"testing"
+)
+
+func TestXyzToICtCp(t *testing.T) {
+	// Reference value from the colour-science Python package.
+	i, ct, cp := XyzToICtCp(LinearRec2020ToXyz(0.45620519, 0.03081071, 0.04091952))
+	if !almosteq(i, 0.0735136) || !almosteq(ct, 0.0047525) || !almosteq(cp, 0.0935159) {
+		t.Errorf("XyzToICtCp => (%v, %v, %v), want (0.0735136, 0.0047525, 0.0935159)", i, ct, cp)
+	}
+
+	// Achromatic colors have no chroma.
+	x, y, z := D65[0]*1000.0, D65[1]*1000.0, D65[2]*1000.0
+	i, ct, cp = XyzToICtCp(x, y, z)
+	if !almosteq(i, PqEncode(1000.0)) || math.Abs(ct) > 1e-4 || math.Abs(cp) > 1e-4 {
+		t.Errorf("XyzToICtCp(1000 cd/m² white) => (%v, %v, %v), want (%v, 0, 0)", i, ct, cp, PqEncode(1000.0))
+	}
+}
+
+func TestICtCpRoundtrip(t *testing.T) {
+	for i, tt := range vals {
+		c := ICtCp(tt.c.ICtCp())
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. %v -> ICtCp -> sRGB = %v", i, tt.c, c)
+		}
+	}
+
+	// Also for colors brighter than the reference white.
+	bright := Color{2.5, 3.0, 1.5}
+	if c := ICtCp(bright.ICtCp()); !c.AlmostEqualRgb(bright) {
+		t.Errorf("%v -> ICtCp -> sRGB = %v", bright, c)
+	}
+}
+
+func TestDistanceITP(t *testing.T) {
+	for i, tt := range dists {
+		d1 := tt.c1.DistanceITP(tt.c2)
+		d2 := tt.c2.DistanceITP(tt.c1)
+		if !almosteq(d1, d2) {
+			t.Errorf("%v. DistanceITP is not symmetric: %v vs. %v", i, d1, d2)
+		}
+		if (tt.d76 == 0.0) != (d1 == 0.0) {
+			t.Errorf("%v. %v.DistanceITP(%v) => %v, want zero only for equal colors", i, tt.c1, tt.c2, d1)
+		}
+	}
+
+	// A single 8-bit step in sRGB is about one just noticeable difference.
+	c1 := Color{0.5, 0.5, 0.5}
+	c2 := Color{0.5 + 1.0/255.0, 0.5, 0.5}
+	if d := c1.DistanceITP(c2); d < 0.2 || d > 2.0 {
+		t.Errorf("%v.DistanceITP(%v) => %v, want about 1", c1, c2, d)
+	}
+}
+
+func TestBlendICtCpEndpoints(t *testing.T) {
+	c1, _ := Hex("#1a1a46")
+	c2, _ := Hex("#666666")
+	if b := c1.BlendICtCp(c2, 0).Hex(); b != c1.Hex() {
+		t.Errorf("BlendICtCp t=0: got %v, want %v", b, c1.Hex())
+	}
+	if b := c1.BlendICtCp(c2, 1).Hex(); b != c2.Hex() {
+		t.Errorf("BlendICtCp t=1: got %v, want %v", b, c2.Hex())
+	}
+}
@@ -0,0 +1,88 @@
+package colorful
+
+// The standard illuminants of CIE 15:2018 as reference whites, for use with
+// all the *WhiteRef functions and with Adapt.
+// https://en.wikipedia.org/wiki/Standard_illuminant#White_points_of_standard_illuminants
+
+// XyToWhiteRef returns the reference white, that is XYZ with Y = 1, of the
+// given chromaticity.
+func XyToWhiteRef(x, y float64) [3]float64 {
+	return [3]float64{x / y, 1.0, (1.0 - x - y) / y}
+}
+
+// More daylight reference whites, next to D65 and D50.
+var (
+	D55 = XyToWhiteRef(0.33242, 0.34743)
+	D75 = XyToWhiteRef(0.29902, 0.31485)
+)
+
+// srgbWhite is the white of sRGB, which differs from D65 in the fifth digit
+// due to the rounding of either. Adapting to it results in exactly white.
+var srgbWhite = func() [3]float64 {
+	x, y, z := LinearRgbToXyz(1.0, 1.0, 1.0)
+	return [3]float64{x, y, z}
+}()
+
+// Illuminants holds the reference whites of the standard illuminants for the
+// CIE 1931 2° standard observer, which is the one used everywhere else in this
+// library. The names are as in CIE 15, for example "A", "D65", "F11" and
+// "LED-B3". D65 and D50 are the same as the variables of these names.
+var Illuminants = map[string][3]float64{
+	"A":        XyToWhiteRef(0.44757, 0.40745),
+	"B":        XyToWhiteRef(0.34842, 0.35161),
+	"C":        XyToWhiteRef(0.31006, 0.31616),
+	"D50":      D50,
+	"D55":      D55,
+	"D65":      D65,
+	"D75":      D75,
+	"D93":      XyToWhiteRef(0.28315, 0.29711),
+	"E":        {1.0, 1.0, 1.0},
+	"F1":       XyToWhiteRef(0.31310, 0.33727),
+	"F2":       XyToWhiteRef(0.37208, 0.37529),
+	"F3":       XyToWhiteRef(0.40910, 0.39430),
+	"F4":       XyToWhiteRef(0.44018, 0.40329),
+	"F5":       XyToWhiteRef(0.31379, 0.34531),
+	"F6":       XyToWhiteRef(0.37790, 0.38835),
+	"F7":       XyToWhiteRef(0.31292, 0.32933),
+	"F8":       XyToWhiteRef(0.34588, 0.35875),
+	"F9":       XyToWhiteRef(0.37417, 0.37281),
+	"F10":      XyToWhiteRef(0.34609, 0.35986),
+	"F11":      XyToWhiteRef(0.38052, 0.37713),
+	"F12":      XyToWhiteRef(0.43695, 0.40441),
+	"LED-B1":   XyToWhiteRef(0.4560, 0.4078),
+	"LED-B2":   XyToWhiteRef(0.4357, 0.4012),
+	"LED-B3":   XyToWhiteRef(0.3756, 0.3723),
+	"LED-B4":   XyToWhiteRef(0.3422, 0.3502),
+	"LED-B5":   XyToWhiteRef(0.3118, 0.3236),
+	"LED-BH1":  XyToWhiteRef(0.4474, 0.4066),
+	"LED-RGB1": XyToWhiteRef(0.4557, 0.4211),
+	"LED-V1":   XyToWhiteRef(0.4560, 0.4548),
+	"LED-V2":   XyToWhiteRef(0.3781, 0.3775),
+}
+
+// Illuminants10 holds the reference whites of the standard illuminants for
+// the CIE 1964 10° standard observer. CIE 15 does not tabulate the LED series
+// for this observer.
+var Illuminants10 = map[string][3]float64{
+	"A":   XyToWhiteRef(0.45117, 0.40594),
+	"B":   XyToWhiteRef(0.34980, 0.35270),
+	"C":   XyToWhiteRef(0.31039, 0.31905),
+	"D50": XyToWhiteRef(0.34773, 0.35952),
+	"D55": XyToWhiteRef(0.33411, 0.34877),
+	"D65": XyToWhiteRef(0.31382, 0.33100),
+	"D75": XyToWhiteRef(0.29968, 0.31740),
+	"D93": XyToWhiteRef(0.28327, 0.30043),
+	"E":   {1.0, 1.0, 1.0},
+	"F1":  XyToWhiteRef(0.31811, 0.33559),
+	"F2":  XyToWhiteRef(0.37925, 0.36733),
+	"F3":  XyToWhiteRef(0.41761, 0.38324),
+	"F4":  XyToWhiteRef(0.44920, 0.39074),
+	"F5":  XyToWhiteRef(0.31975, 0.34246),
+	"F6":  XyToWhiteRef(0.38660, 0.37847),
+	"F7":  XyToWhiteRef(0.31569, 0.32960),
+	"F8":  XyToWhiteRef(0.34902, 0.35939),
+	"F9":  XyToWhiteRef(0.37829, 0.37045),
+	"F10": XyToWhiteRef(0.35090, 0.35444),
+	"F11": XyToWhiteRef(0.38541, 0.37123),
+	"F12": XyToWhiteRef(0.44256, 0.39717),
+}
@@ -0,0 +1,156 @@
+package colorful
+
+import "math"
+
+/// Jzazbz ///
+//////////////
+// A perceptually uniform color space for HDR and wide-gamut content by Safdar et al.
+// https://doi.org/10.1364/OE.25.015131
+// Like the Rec. 2100 spaces, this works on absolute luminance; a Color's white
+// is taken to be HdrReferenceWhite. Jz is in [0..1] (white is about 0.22),
+// az and bz in about [-0.5..0.5].
+
+const (
+	jzB  = 1.15
+	jzG  = 0.66
+	jzD  = -0.56
+	jzD0 = 1.6295499532821566e-11
+
+	// The PQ curve of Jzazbz uses a different exponent than ST 2084.
+	jzP = 1.7 * 2523.0 / 32.0
+)
+
+func jzPqEncode(v float64) float64 {
+	vm := math.Pow(math.Max(v/PqPeak, 0.0), pqM1)
+	return math.Pow((pqC1+pqC2*vm)/(1.0+pqC3*vm), jzP)
+}
+
+func jzPqDecode(v float64) float64 {
+	vp := math.Pow(math.Max(v, 0.0), 1.0/jzP)
+	return PqPeak * math.Pow(math.Max(vp-pqC1, 0.0)/(pqC2-pqC3*vp), 1.0/pqM1)
+}
+
+// XyzToJzazbz converts from absolute CIE XYZ, with Y in cd/m², to Jzazbz.
+func XyzToJzazbz(x, y, z float64) (jz, az, bz float64) {
+	xp := jzB*x - (jzB-1.0)*z
+	yp := jzG*y - (jzG-1.0)*x
+
+	l := jzPqEncode(0.41478972*xp + 0.579999*yp + 0.0146480*z)
+	m := jzPqEncode(-0.2015100*xp + 1.120649*yp + 0.0531008*z)
+	s := jzPqEncode(-0.0166008*xp + 0.264800*yp + 0.6684799*z)
+
+	iz := 0.5*l + 0.5*m
+	az = 3.524000*l - 4.066708*m + 0.542708*s
+	bz = 0.199076*l + 1.096799*m - 1.295875*s
+	jz = (1.0+jzD)*iz/(1.0+jzD*iz) - jzD0
+	return
+}
+
+// JzazbzToXyz converts from Jzazbz to absolute CIE XYZ, with Y in cd/m².
+func JzazbzToXyz(jz, az, bz float64) (x, y, z float64) {
+	iz := (jz + jzD0) / (1.0 + jzD - jzD*(jz+jzD0))
+
+	l := jzPqDecode(iz + 0.1386050432715393*az + 0.058047316156118876*bz)
+	m := jzPqDecode(iz - 0.1386050432715393*az - 0.058047316156118876*bz)
+	s := jzPqDecode(iz - 0.09601924202631895*az - 0.811891896056039*bz)
+
+	xp := 1.9242264357876067*l - 1.0047923125953655*m + 0.03765140403061801*s
+	yp := 0.35031676209499907*l + 0.7264811939316552*m - 0.06538442294808502*s
+	z = -0.09098281098284758*l - 0.312728290523074*m + 1.5227665613052606*s
+
+	x = (xp + (jzB-1.0)*z) / jzB
+	y = (yp + (jzG-1.0)*x) / jzG
+	return
+}
+
+// Jzazbz returns the Jz, az and bz values of the color, whose white is taken
+// to have a luminance of HdrReferenceWhite.
+func (col Color) Jzazbz() (jz, az, bz float64) {
+	x, y, z := col.Xyz()
+	return XyzToJzazbz(x*HdrReferenceWhite, y*HdrReferenceWhite, z*HdrReferenceWhite)
+}
+
+// Jzazbz creates a new Color given Jz, az and bz values. Luminances above
+// HdrReferenceWhite result in values above 1.
+func Jzazbz(jz, az, bz float64) Color {
+	x, y, z := JzazbzToXyz(jz, az, bz)
+	return Xyz(x/HdrReferenceWhite, y/HdrReferenceWhite, z/HdrReferenceWhite)
+}
+
+// BlendJzazbz blends two colors in the Jzazbz color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendJzazbz(c2 Color, t float64) Color {
+	j1, a1, b1 := c1.Jzazbz()
+	j2, a2, b2 := c2.Jzazbz()
+	return Jzazbz(j1+t*(j2-j1),
+		a1+t*(a2-a1),
+		b1+t*(b2-b1))
+}
+
+/// JzCzhz ///
+//////////////
+// Jzazbz in polar coordinates. hz is in [0..360].
+
+// JzCzhz returns the Jz, Cz and hz values of the color, whose white is taken
+// to have a luminance of HdrReferenceWhite.
+func (col Color) JzCzhz() (jz, cz, hz float64) {
+	return JzazbzToJzCzhz(col.Jzazbz())
+}
+
+// JzCzhz creates a new Color given Jz, Cz and hz values. Luminances above
+// HdrReferenceWhite result in values above 1.
+func JzCzhz(jz, cz, hz float64) Color {
+	return Jzazbz(JzCzhzToJzazbz(jz, cz, hz))
+}
+
+// JzazbzToJzCzhz converts from Jzazbz to its polar coordinates JzCzhz.
+func JzazbzToJzCzhz(jz, az, bz float64) (float64, float64, float64) {
+	cz := math.Sqrt(sq(az) + sq(bz))
+	hz := math.Atan2(bz, az)
+	if hz < 0 {
+		hz += 2 * math.Pi
+	}
+	return jz, cz, hz * 180 / math.Pi
+}
+
+// JzCzhzToJzazbz converts from JzCzhz to Jzazbz.
+func JzCzhzToJzazbz(jz, cz, hz float64) (float64, float64, float64) {
+	hz *= math.Pi / 180
+	return jz, cz * math.Cos(hz), cz * math.Sin(hz)
+}
+
+// jzGrayChroma is the Cz below which a color is gray, whose hue is noise. The
+// achromatic axis of Jzazbz is not exactly D65, so sRGB grays have a Cz of up
+// to 0.0002, while a tint of 1% has about 0.002 at white.
+const jzGrayChroma = 0.0005
+
+// BlendJzCzhz blends two colors in the JzCzhz color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (col1 Color) BlendJzCzhz(col2 Color, t float64) Color {
+	j1, c1, h1 := col1.JzCzhz()
+	j2, c2, h2 := col2.JzCzhz()
+
+	// https://github.com/lucasb-eyer/go-colorful/pull/60
+	if c1 <= jzGrayChroma && c2 >= jzGrayChroma {
+		h1 = h2
+	} else if c2 <= jzGrayChroma && c1 >= jzGrayChroma {
+		h2 = h1
+	}
+
+	// We know that h are both in [0..360]
+	return JzCzhz(j1+t*(j2-j1), c1+t*(c2-c1), interp_angle(h1, h2, t))
+}
+
+// DistanceJz computes the ΔEz color difference of Safdar et al., which is the
+// Euclidean distance in JzCzhz with the hue difference weighted by chroma.
+func (c1 Color) DistanceJz(c2 Color) float64 {
+	j1, cz1, h1 := c1.JzCzhz()
+	j2, cz2, h2 := c2.JzCzhz()
+	return jzCzhzDistance(j1, cz1, h1, j2, cz2, h2)
+}
+
+// jzCzhzDistance is DistanceJz of JzCzhz values.
+func jzCzhzDistance(j1, cz1, h1, j2, cz2, h2 float64) float64 {
+	dh := 2.0 * math.Sqrt(cz1*cz2) * math.Sin((h1-h2)*math.Pi/360.0)
+	return math.Sqrt(sq(j1-j2) + sq(cz1-cz2) + sq(dh))
+}
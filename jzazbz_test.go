@@ -0,0 +1,79 @@
+package colorful
+
+import (
+	"math"
+	"testing"
+)
+
+func TestXyzToJzazbz(t *testing.T) {
+	// Reference value from the colour-science Python package.
+	jz, az, bz := XyzToJzazbz(0.20654008, 0.12197225, 0.05136952)
+	if !almosteq(jz, 0.0053504) || !almosteq(az, 0.0092430) || !almosteq(bz, 0.0052600) {
+		t.Errorf("XyzToJzazbz => (%v, %v, %v), want (0.0053504, 0.0092430, 0.0052600)", jz, az, bz)
+	}
+
+	x, y, z := JzazbzToXyz(0.0053504, 0.0092430, 0.0052600)
+	if !almosteq(x, 0.20654008) || !almosteq(y, 0.12197225) || !almosteq(z, 0.05136952) {
+		t.Errorf("JzazbzToXyz => (%v, %v, %v), want (0.20654008, 0.12197225, 0.05136952)", x, y, z)
+	}
+
+	// White at the HDR reference white.
+	jz, az, bz = Color{1, 1, 1}.Jzazbz()
+	if !almosteq(jz, 0.222065) || math.Abs(az) > 1e-3 || math.Abs(bz) > 1e-3 {
+		t.Errorf("White.Jzazbz() => (%v, %v, %v), want (0.222065, 0, 0)", jz, az, bz)
+	}
+}
+
+func TestJzazbzRoundtrip(t *testing.T) {
+	for i, tt := range vals {
+		c := Jzazbz(tt.c.Jzazbz())
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. %v -> Jzazbz -> sRGB = %v", i, tt.c, c)
+		}
+		c = JzCzhz(tt.c.JzCzhz())
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. %v -> JzCzhz -> sRGB = %v", i, tt.c, c)
+		}
+	}
+}
+
+func TestDistanceJz(t *testing.T) {
+	for i, tt := range dists {
+		// The hue term makes ΔEz equal to the Euclidean distance in Jzazbz.
+		j1, a1, b1 := tt.c1.Jzazbz()
+		j2, a2, b2 := tt.c2.Jzazbz()
+		want := math.Sqrt(sq(j1-j2) + sq(a1-a2) + sq(b1-b2))
+		if d := tt.c1.DistanceJz(tt.c2); math.Abs(d-want) > 1e-9 {
+			t.Errorf("%v. %v.DistanceJz(%v) => %v, want %v", i, tt.c1, tt.c2, d, want)
+		}
+	}
+}
+
+func TestBlendJzazbzEndpoints(t *testing.T) {
+	c1, _ := Hex("#1a1a46")
+	c2, _ := Hex("#666666")
+	if b := c1.BlendJzazbz(c2, 0).Hex(); b != c1.Hex() {
+		t.Errorf("BlendJzazbz t=0: got %v, want %v", b, c1.Hex())
+	}
+	if b := c1.BlendJzazbz(c2, 1).Hex(); b != c2.Hex() {
+		t.Errorf("BlendJzazbz t=1: got %v, want %v", b, c2.Hex())
+	}
+	if b := c1.BlendJzCzhz(c2, 0).Hex(); b != c1.Hex() {
+		t.Errorf("BlendJzCzhz t=0: got %v, want %v", b, c1.Hex())
+	}
+	if b := c1.BlendJzCzhz(c2, 1).Hex(); b != c2.Hex() {
+		t.Errorf("BlendJzCzhz t=1: got %v, want %v", b, c2.Hex())
+	}
+}
+
+func TestBlendJzCzhzGray(t *testing.T) {
+	// Grays have a tiny Cz, whose hue must not leak into the blend.
+	red := Color{0.8, 0.1, 0.1}
+	_, _, hred := red.JzCzhz()
+	for _, gray := range []Color{{1.0, 1.0, 1.0}, {0.5, 0.5, 0.5}, {0.05, 0.05, 0.05}} {
+		_, _, h := gray.BlendJzCzhz(red, 0.5).JzCzhz()
+		if math.Abs(math.Remainder(h-hred, 360.0)) > 1.0 {
+			t.Errorf("%v.BlendJzCzhz(%v, 0.5) has a hue of %v, want %v", gray, red, h, hred)
+		}
+	}
+}
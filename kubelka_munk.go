@@ -0,0 +1,77 @@
+package colorful
+
+import "math"
+
+// Subtractive mixing of paints using the single-constant Kubelka-Munk theory,
+// in which each pigment absorbs (K) and scatters (S) light at every
+// wavelength, and the K/S ratio of a mix is that of its pigments weighted by
+// their concentrations. The reflectance spectra of the pigments are found
+// with Spectrum, in the spirit of Mixbox.
+// https://doi.org/10.1145/3478513.3480549
+
+// The reflectance of pigments is mapped to [kmMinReflectance..1] for mixing,
+// as the K/S of darker ones is so large that they would blacken any mix, and
+// infinite at zero. The mix is mapped back, so that mixing is still exact
+// for a single color.
+const kmMinReflectance = 0.03
+
+func kmRatio(r float64) float64 {
+	r = kmMinReflectance + (1.0-kmMinReflectance)*clamp01(r)
+	return sq(1.0-r) / (2.0 * r)
+}
+
+func kmReflectance(ks float64) float64 {
+	r := 1.0 + ks - math.Sqrt(ks*ks+2.0*ks)
+	return (r - kmMinReflectance) / (1.0 - kmMinReflectance)
+}
+
+// MixKubelkaMunk mixes the colors like paints, so that for example blue and
+// yellow make a green rather than a gray. The weights are the amounts of each
+// color in the mix, there must be as many as colors, and they need not add up
+// to one. Mixing a single color, or a color with itself, results in that color.
+// WARNING: like real paints, mixes of saturated colors may be outside of sRGB,
+// use Clamped if needed.
+func MixKubelkaMunk(colors []Color, weights []float64) Color {
+	return MixKubelkaMunkEx(colors, weights, nil)
+}
+
+// MixKubelkaMunkEx is like MixKubelkaMunk, but also takes the tinting strength
+// of each color, which is how strongly it colors a mix compared to the others.
+// For example, a strong pigment like phthalo blue may have a strength of 10
+// against 1 for a weak earth pigment. nil means a strength of 1 for all colors.
+func MixKubelkaMunkEx(colors []Color, weights, strengths []float64) Color {
+	wavelengths := CIE1931.Y.Wavelengths
+	ks := make([]float64, len(wavelengths))
+
+	total := 0.0
+	for i, col := range colors {
+		c := weights[i]
+		if strengths != nil {
+			c *= strengths[i]
+		}
+		if c == 0.0 {
+			continue
+		}
+		total += c
+
+		s := col.Spectrum()
+		for j, w := range wavelengths {
+			ks[j] += c * kmRatio(s.At(w))
+		}
+	}
+	if total == 0.0 {
+		return Color{}
+	}
+
+	values := make([]float64, len(wavelengths))
+	for j := range values {
+		values[j] = kmReflectance(ks[j] / total)
+	}
+	return Spectrum{Wavelengths: wavelengths, Values: values}.ReflectanceColor()
+}
+
+// BlendKubelkaMunk blends two colors like paints using MixKubelkaMunk.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendKubelkaMunk(c2 Color, t float64) Color {
+	return MixKubelkaMunk([]Color{c1, c2}, []float64{1.0 - t, t})
+}
@@ -0,0 +1,50 @@
+package colorful
+
+import (
+	"testing"
+)
+
+func TestMixKubelkaMunk(t *testing.T) {
+	blue, _ := Hex("#0000ff")
+	yellow, _ := Hex("#ffff00")
+	cyan, _ := Hex("#00ffff")
+	black, _ := Hex("#000000")
+	white, _ := Hex("#ffffff")
+
+	for _, c := range []Color{blue, yellow, black, white, {0.3, 0.6, 0.2}} {
+		if got := MixKubelkaMunk([]Color{c}, []float64{1.0}); !got.AlmostEqualRgb(c) {
+			t.Errorf("MixKubelkaMunk(%v) => %v", c, got)
+		}
+		if got := c.BlendKubelkaMunk(c, 0.3); !got.AlmostEqualRgb(c) {
+			t.Errorf("%v.BlendKubelkaMunk(%v, 0.3) => %v", c, c, got)
+		}
+		if got := c.BlendKubelkaMunk(white, 0.0); !got.AlmostEqualRgb(c) {
+			t.Errorf("%v.BlendKubelkaMunk(white, 0.0) => %v", c, got)
+		}
+	}
+
+	// Unlike light, blue and yellow paint make green, not gray.
+	if c := blue.BlendKubelkaMunk(yellow, 0.5); c.G < c.R || c.G < 0.3 {
+		t.Errorf("blue.BlendKubelkaMunk(yellow, 0.5) => %v, want greenish", c)
+	}
+	if c := cyan.BlendKubelkaMunk(yellow, 0.5); c.G < 0.9 || c.R > 0.5 || c.B > 0.5 {
+		t.Errorf("cyan.BlendKubelkaMunk(yellow, 0.5) => %v, want green", c)
+	}
+
+	// Black tints white a dark gray, and less so when it is weaker.
+	gray := black.BlendKubelkaMunk(white, 0.5)
+	if !almosteq(gray.R, gray.G) || !almosteq(gray.G, gray.B) || gray.R > 0.3 {
+		t.Errorf("black.BlendKubelkaMunk(white, 0.5) => %v, want dark gray", gray)
+	}
+	weak := MixKubelkaMunkEx([]Color{black, white}, []float64{1.0, 1.0}, []float64{0.1, 1.0})
+	if weak.R < gray.R+0.2 {
+		t.Errorf("MixKubelkaMunkEx with a weak black => %v, want lighter than %v", weak, gray)
+	}
+
+	// The weights are normalized.
+	a := MixKubelkaMunk([]Color{blue, yellow, white}, []float64{1.0, 2.0, 3.0})
+	b := MixKubelkaMunk([]Color{blue, yellow, white}, []float64{2.0, 4.0, 6.0})
+	if !a.AlmostEqualRgb(b) {
+		t.Errorf("MixKubelkaMunk with scaled weights => %v, want %v", b, a)
+	}
+}
@@ -0,0 +1,194 @@
+// This file provides the tonal palettes and color schemes of Material Design,
+// built on the HCT color space.
+
+package colorful
+
+import "math"
+
+// MaterialTones are the tones that Material Design uses from a tonal palette.
+var MaterialTones = []float64{0, 10, 20, 30, 40, 50, 60, 70, 80, 90, 95, 99, 100}
+
+// A TonalPalette holds all colors of the same HCT hue and chroma, which differ
+// only in tone. Tones for which the chroma is out of sRGB have the maximum
+// chroma possible.
+type TonalPalette struct {
+	Hue, Chroma float64
+
+	// The color of the palette with the chroma closest to the requested one
+	// and a tone as close to 50 as possible.
+	KeyColor Color
+}
+
+// NewTonalPalette creates the tonal palette of the given HCT hue and chroma.
+func NewTonalPalette(hue, chroma float64) TonalPalette {
+	return TonalPalette{Hue: hue, Chroma: chroma, KeyColor: materialKeyColor(hue, chroma)}
+}
+
+// TonalPaletteFromColor creates the tonal palette with the hue and chroma of
+// the given color, which becomes its key color.
+func TonalPaletteFromColor(col Color) TonalPalette {
+	h, c, _ := col.Hct()
+	return TonalPalette{Hue: h, Chroma: c, KeyColor: col}
+}
+
+// Tone returns the color of the palette with the given tone in [0..100].
+func (p TonalPalette) Tone(t float64) Color {
+	return Hct(p.Hue, p.Chroma, t)
+}
+
+// Tones returns the colors of the palette with the given tones, for example MaterialTones.
+func (p TonalPalette) Tones(tones []float64) []Color {
+	colors := make([]Color, len(tones))
+	for i, t := range tones {
+		colors[i] = p.Tone(t)
+	}
+	return colors
+}
+
+// materialKeyColor bisects the tones for the one closest to 50 which still
+// reaches the requested chroma, or failing that, the one of maximum chroma.
+func materialKeyColor(hue, chroma float64) Color {
+	const pivotTone, epsilon = 50, 0.01
+
+	maxChroma := func(tone int) float64 {
+		_, c, _ := Hct(hue, 200.0, float64(tone)).Hct()
+		return c
+	}
+
+	lowerTone, upperTone := 0, 100
+	for lowerTone < upperTone {
+		midTone := (lowerTone + upperTone) / 2
+		midChroma := maxChroma(midTone)
+		isAscending := midChroma < maxChroma(midTone+1)
+
+		if midChroma >= chroma-epsilon {
+			// Either range may contain a better tone, prefer the one closer to the pivot.
+			if math.Abs(float64(lowerTone-pivotTone)) < math.Abs(float64(upperTone-pivotTone)) {
+				upperTone = midTone
+			} else {
+				if lowerTone == midTone {
+					break
+				}
+				lowerTone = midTone
+			}
+		} else if isAscending {
+			lowerTone = midTone + 1
+		} else {
+			upperTone = midTone
+		}
+	}
+	return Hct(hue, chroma, float64(lowerTone))
+}
+
+// A CorePalette holds the tonal palettes from which a Material color scheme
+// is built. Each of them also provides the key color of its role.
+//
+// The palettes and schemes are those of the static Scheme of Material Color
+// Utilities, with fixed tones per role. The dynamic color schemes, like
+// SchemeTonalSpot with its contrast levels and tones adjusted for contrast
+// against their backgrounds, are not implemented.
+type CorePalette struct {
+	Primary        TonalPalette
+	Secondary      TonalPalette
+	Tertiary       TonalPalette
+	Neutral        TonalPalette
+	NeutralVariant TonalPalette
+	Error          TonalPalette
+}
+
+// NewCorePalette creates the Material core palette of a seed color. The
+// primary palette keeps the hue of the seed and at least a chroma of 48, the
+// tertiary one is rotated by 60° in hue and the error one is always red.
+func NewCorePalette(seed Color) CorePalette {
+	h, c, _ := seed.Hct()
+	return CorePalette{
+		Primary:        NewTonalPalette(h, math.Max(48.0, c)),
+		Secondary:      NewTonalPalette(h, 16.0),
+		Tertiary:       NewTonalPalette(h+60.0, 24.0),
+		Neutral:        NewTonalPalette(h, 4.0),
+		NeutralVariant: NewTonalPalette(h, 8.0),
+		Error:          NewTonalPalette(25.0, 84.0),
+	}
+}
+
+// MaterialScheme holds the colors of all roles of a Material color scheme.
+type MaterialScheme struct {
+	Primary, OnPrimary, PrimaryContainer, OnPrimaryContainer         Color
+	Secondary, OnSecondary, SecondaryContainer, OnSecondaryContainer Color
+	Tertiary, OnTertiary, TertiaryContainer, OnTertiaryContainer     Color
+	Error, OnError, ErrorContainer, OnErrorContainer                 Color
+	Background, OnBackground                                         Color
+	Surface, OnSurface, SurfaceVariant, OnSurfaceVariant             Color
+	Outline, OutlineVariant, Shadow, Scrim                           Color
+	InverseSurface, InverseOnSurface, InversePrimary                 Color
+}
+
+// LightScheme returns the light Material color scheme of the palettes.
+func (p CorePalette) LightScheme() MaterialScheme {
+	return MaterialScheme{
+		Primary:              p.Primary.Tone(40),
+		OnPrimary:            p.Primary.Tone(100),
+		PrimaryContainer:     p.Primary.Tone(90),
+		OnPrimaryContainer:   p.Primary.Tone(10),
+		Secondary:            p.Secondary.Tone(40),
+		OnSecondary:          p.Secondary.Tone(100),
+		SecondaryContainer:   p.Secondary.Tone(90),
+		OnSecondaryContainer: p.Secondary.Tone(10),
+		Tertiary:             p.Tertiary.Tone(40),
+		OnTertiary:           p.Tertiary.Tone(100),
+		TertiaryContainer:    p.Tertiary.Tone(90),
+		OnTertiaryContainer:  p.Tertiary.Tone(10),
+		Error:                p.Error.Tone(40),
+		OnError:              p.Error.Tone(100),
+		ErrorContainer:       p.Error.Tone(90),
+		OnErrorContainer:     p.Error.Tone(10),
+		Background:           p.Neutral.Tone(99),
+		OnBackground:         p.Neutral.Tone(10),
+		Surface:              p.Neutral.Tone(99),
+		OnSurface:            p.Neutral.Tone(10),
+		SurfaceVariant:       p.NeutralVariant.Tone(90),
+		OnSurfaceVariant:     p.NeutralVariant.Tone(30),
+		Outline:              p.NeutralVariant.Tone(50),
+		OutlineVariant:       p.NeutralVariant.Tone(80),
+		Shadow:               p.Neutral.Tone(0),
+		Scrim:                p.Neutral.Tone(0),
+		InverseSurface:       p.Neutral.Tone(20),
+		InverseOnSurface:     p.Neutral.Tone(95),
+		InversePrimary:       p.Primary.Tone(80),
+	}
+}
+
+// DarkScheme returns the dark Material color scheme of the palettes.
+func (p CorePalette) DarkScheme() MaterialScheme {
+	return MaterialScheme{
+		Primary:              p.Primary.Tone(80),
+		OnPrimary:            p.Primary.Tone(20),
+		PrimaryContainer:     p.Primary.Tone(30),
+		OnPrimaryContainer:   p.Primary.Tone(90),
+		Secondary:            p.Secondary.Tone(80),
+		OnSecondary:          p.Secondary.Tone(20),
+		SecondaryContainer:   p.Secondary.Tone(30),
+		OnSecondaryContainer: p.Secondary.Tone(90),
+		Tertiary:             p.Tertiary.Tone(80),
+		OnTertiary:           p.Tertiary.Tone(20),
+		TertiaryContainer:    p.Tertiary.Tone(30),
+		OnTertiaryContainer:  p.Tertiary.Tone(90),
+		Error:                p.Error.Tone(80),
+		OnError:              p.Error.Tone(20),
+		ErrorContainer:       p.Error.Tone(30),
+		OnErrorContainer:     p.Error.Tone(90),
+		Background:           p.Neutral.Tone(10),
+		OnBackground:         p.Neutral.Tone(90),
+		Surface:              p.Neutral.Tone(10),
+		OnSurface:            p.Neutral.Tone(90),
+		SurfaceVariant:       p.NeutralVariant.Tone(30),
+		OnSurfaceVariant:     p.NeutralVariant.Tone(80),
+		Outline:              p.NeutralVariant.Tone(60),
+		OutlineVariant:       p.NeutralVariant.Tone(30),
+		Shadow:               p.Neutral.Tone(0),
+		Scrim:                p.Neutral.Tone(0),
+		InverseSurface:       p.Neutral.Tone(90),
+		InverseOnSurface:     p.Neutral.Tone(20),
+		InversePrimary:       p.Primary.Tone(40),
+	}
+}
@@ -0,0 +1,61 @@
+package colorful
+
+import (
+	"testing"
+)
+
+func TestTonalPalette(t *testing.T) {
+	// Reference values from Material Color Utilities.
+	blue, _ := Hex("#0000ff")
+	p := TonalPaletteFromColor(blue)
+	tones := []float64{100, 95, 90, 80, 70, 60, 50, 40, 30, 20, 10, 0}
+	want := []string{"#ffffff", "#f1efff", "#e0e0ff", "#bec2ff", "#9da3ff", "#7c84ff", "#5a64ff", "#343dff", "#0000ef", "#0001ac", "#00006e", "#000000"}
+	for i, c := range p.Tones(tones) {
+		if c.Hex() != want[i] {
+			t.Errorf("%v. Tone(%v) => (%v), want %v", i, tones[i], c.Hex(), want[i])
+		}
+	}
+	if p.KeyColor != blue {
+		t.Errorf("KeyColor => (%v), want %v", p.KeyColor, blue)
+	}
+}
+
+func TestTonalPaletteKeyColor(t *testing.T) {
+	// The requested chroma is available from tone 52 on.
+	h, c, tn := NewTonalPalette(50.0, 60.0).KeyColor.Hct()
+	if !almosteq_eps(h, 50.0, 1e-3) || !almosteq_eps(c, 60.0, 1e-3) || !almosteq_eps(tn, 52.0, 1e-3) {
+		t.Errorf("KeyColor of (50, 60) => (%v, %v, %v), want (50, 60, 52)", h, c, tn)
+	}
+
+	// The requested chroma is not available anywhere, so the maximum is used.
+	h, c, tn = NewTonalPalette(149.0, 200.0).KeyColor.Hct()
+	if !almosteq_eps(h, 149.0, 1e-2) || c < 89.0 || !almosteq_eps(tn, 88.0, 1e-2) {
+		t.Errorf("KeyColor of (149, 200) => (%v, %v, %v), want (149, >89, 88)", h, c, tn)
+	}
+}
+
+func TestMaterialScheme(t *testing.T) {
+	// Reference values from Material Color Utilities.
+	seed, _ := Hex("#6750a4")
+	p := NewCorePalette(seed)
+	tests := []struct {
+		name      string
+		got, want string
+	}{
+		{"light primary", p.LightScheme().Primary.Hex(), "#6750a4"},
+		{"light secondary", p.LightScheme().Secondary.Hex(), "#625b71"},
+		{"light tertiary", p.LightScheme().Tertiary.Hex(), "#7e5260"},
+		{"light surface", p.LightScheme().Surface.Hex(), "#fffbff"},
+		{"light on surface", p.LightScheme().OnSurface.Hex(), "#1c1b1e"},
+		{"dark primary", p.DarkScheme().Primary.Hex(), "#cfbcff"},
+		{"dark secondary", p.DarkScheme().Secondary.Hex(), "#cbc2db"},
+		{"dark tertiary", p.DarkScheme().Tertiary.Hex(), "#efb8c8"},
+		{"dark surface", p.DarkScheme().Surface.Hex(), "#1c1b1e"},
+		{"dark on surface", p.DarkScheme().OnSurface.Hex(), "#e6e1e6"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%v => %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,406 @@
+package colorful
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ./doc/munselldata real.dat munsell_data.go
+
+// The Munsell color system, in which colors are noted as hue, value and
+// chroma, for example "5R 4/14", and neutrals as "N 5/". Colors are converted
+// through the Munsell renotation data, which tabulates CIE xyY under
+// illuminant C for hues in steps of 2.5, integer values and even chromas.
+// DefaultMunsellRenotation returns the data of the real colors, "real.dat" of
+// the Munsell Color Science Laboratory, which is built into the package, and
+// LoadMunsellRenotation loads other data like their "all.dat".
+// https://www.rit.edu/science/munsell-color-science-lab-educational-resources
+//
+// Hues are numbered from 0 to 100 as in ASTM D1535, with 5R at 5, 5YR at 15
+// and so on to 10RP at 100, which is the same as 0.
+
+// The hue families in the order of their hue numbers.
+var munsellFamilies = []string{"R", "YR", "Y", "GY", "G", "BG", "B", "PB", "P", "RP"}
+
+// ParseMunsell parses a Munsell notation like "5R 4/14", "2.5PB 6.5/8.2" or
+// "N 5/" into its hue in [0..100), value in [0..10] and chroma. Neutrals have
+// a hue and chroma of 0, and may be written without a space like "N5/".
+func ParseMunsell(s string) (hue, value, chroma float64, err error) {
+	fields := strings.Fields(s)
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "N") {
+		fields = []string{"N", fields[0][1:]}
+	}
+	if len(fields) != 2 {
+		return 0, 0, 0, fmt.Errorf("color: %v is not a Munsell notation", s)
+	}
+
+	vc := strings.SplitN(fields[1], "/", 2)
+	if value, err = strconv.ParseFloat(vc[0], 64); err != nil || value < 0.0 || value > 10.0 {
+		return 0, 0, 0, fmt.Errorf("color: %v is not a Munsell notation, invalid value", s)
+	}
+
+	if fields[0] == "N" {
+		if len(vc) == 2 && vc[1] != "" && vc[1] != "0" {
+			return 0, 0, 0, fmt.Errorf("color: %v is not a Munsell notation, neutrals have no chroma", s)
+		}
+		return 0.0, value, 0.0, nil
+	}
+	if len(vc) != 2 {
+		return 0, 0, 0, fmt.Errorf("color: %v is not a Munsell notation, missing chroma", s)
+	}
+	if chroma, err = strconv.ParseFloat(vc[1], 64); err != nil || chroma < 0.0 {
+		return 0, 0, 0, fmt.Errorf("color: %v is not a Munsell notation, invalid chroma", s)
+	}
+	if hue, err = parseMunsellHue(fields[0]); err != nil {
+		return 0, 0, 0, fmt.Errorf("color: %v is not a Munsell notation, %v", s, err)
+	}
+	return hue, value, chroma, nil
+}
+
+func parseMunsellHue(s string) (float64, error) {
+	i := strings.IndexFunc(s, func(r rune) bool { return r >= 'A' && r <= 'Z' })
+	if i <= 0 {
+		return 0, fmt.Errorf("invalid hue %v", s)
+	}
+	step, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil || step <= 0.0 || step > 10.0 {
+		return 0, fmt.Errorf("invalid hue %v", s)
+	}
+	for f, family := range munsellFamilies {
+		if s[i:] == family {
+			return math.Mod(float64(f)*10.0+step, 100.0), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid hue %v", s)
+}
+
+// FormatMunsell formats a Munsell color as for example "5R 4/14", with up to
+// one decimal, or as "N 5/" if its chroma rounds to 0.
+func FormatMunsell(hue, value, chroma float64) string {
+	format := func(v float64) string {
+		return strconv.FormatFloat(math.Round(v*10.0)/10.0, 'f', -1, 64)
+	}
+	if math.Round(chroma*10.0) == 0.0 {
+		return "N " + format(value) + "/"
+	}
+
+	// Hues are noted in (0..10] of their family, so that 0R is 10RP.
+	hue = math.Round(hue*10.0) / 10.0
+	hue = math.Mod(math.Mod(hue, 100.0)+100.0, 100.0)
+	f := int(hue / 10.0)
+	step := hue - float64(f)*10.0
+	if step < 0.05 {
+		f, step = (f+9)%10, 10.0
+	}
+	return format(step) + munsellFamilies[f] + " " + format(value) + "/" + format(chroma)
+}
+
+// MunsellValueToY returns the luminance Y in [0..1] of the given Munsell
+// value in [0..10], using the polynomial of ASTM D1535.
+func MunsellValueToY(v float64) float64 {
+	return v * (1.1914 + v*(-0.22533+v*(0.23352+v*(-0.020484+v*0.00081939)))) / 100.0
+}
+
+// YToMunsellValue returns the Munsell value in [0..10] of the luminance Y in
+// [0..1], which is the inverse of MunsellValueToY.
+func YToMunsellValue(y float64) float64 {
+	// Newton's method, starting from the similar L* of CIE-L*a*b*.
+	v := 10.0 * (1.16*lab_f(y) - 0.16)
+	for i := 0; i < 20; i++ {
+		d := (1.1914 + v*(-0.45066+v*(0.70056+v*(-0.081936+v*0.00409695)))) / 100.0
+		step := (MunsellValueToY(v) - y) / d
+		v -= step
+		if math.Abs(step) < 1e-12 {
+			break
+		}
+	}
+	return v
+}
+
+// The chromaticity of illuminant C, the white of the renotation data.
+var munsellWhiteX, munsellWhiteY, _ = XyzToXyy(Illuminants["C"][0], Illuminants["C"][1], Illuminants["C"][2])
+
+type munsellKey struct {
+	hue, value, chroma float64
+}
+
+// MunsellRenotation holds the Munsell renotation data, for converting colors
+// from and to Munsell notation.
+type MunsellRenotation struct {
+	xy     map[munsellKey][2]float64
+	values []float64
+}
+
+// LoadMunsellRenotation reads renotation data in the format of the files of
+// the Munsell Color Science Laboratory, that is lines of hue, value, chroma,
+// x, y and Y like "2.5R 9 2 0.3107 0.3164 76.70", with an optional header.
+// Hues need to be in steps of 2.5, values integer or in steps of 0.2 and
+// chromas even.
+func LoadMunsellRenotation(r io.Reader) (*MunsellRenotation, error) {
+	var rows [][5]float64
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || (line == 1 && fields[0] == "h") {
+			continue
+		}
+		if len(fields) < 5 {
+			return nil, fmt.Errorf("color: invalid Munsell renotation data in line %v", line)
+		}
+		hue, err := parseMunsellHue(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("color: invalid Munsell renotation data in line %v: %v", line, err)
+		}
+		var nums [4]float64
+		for i := range nums {
+			if nums[i], err = strconv.ParseFloat(fields[i+1], 64); err != nil {
+				return nil, fmt.Errorf("color: invalid Munsell renotation data in line %v: %w", line, err)
+			}
+		}
+		rows = append(rows, [5]float64{hue, nums[0], nums[1], nums[2], nums[3]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return newMunsellRenotation(rows)
+}
+
+// newMunsellRenotation makes the renotation data of rows of hue number,
+// value, chroma, x and y.
+func newMunsellRenotation(rows [][5]float64) (*MunsellRenotation, error) {
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("color: no Munsell renotation data")
+	}
+	m := &MunsellRenotation{xy: make(map[munsellKey][2]float64, len(rows))}
+	values := map[float64]bool{}
+	for _, row := range rows {
+		m.xy[munsellKey{row[0], row[1], row[2]}] = [2]float64{row[3], row[4]}
+		values[row[1]] = true
+	}
+	for v := range values {
+		m.values = append(m.values, v)
+	}
+	sort.Float64s(m.values)
+	return m, nil
+}
+
+var (
+	munsellDefault     *MunsellRenotation
+	munsellDefaultErr  error
+	munsellDefaultOnce sync.Once
+)
+
+// DefaultMunsellRenotation returns the renotation data of the real colors,
+// which is built into the package from "real.dat" by go generate. It fails
+// if the package was built without it.
+func DefaultMunsellRenotation() (*MunsellRenotation, error) {
+	munsellDefaultOnce.Do(func() {
+		munsellDefault, munsellDefaultErr = newMunsellRenotation(munsellRealData)
+	})
+	return munsellDefault, munsellDefaultErr
+}
+
+// xyAt returns the chromaticity of a hue of the data in steps of 2.5 and a
+// value of the data, interpolating linearly between chromas.
+func (m *MunsellRenotation) xyAt(hue, value, chroma float64) (x, y float64, ok bool) {
+	c0 := 2.0 * math.Floor(chroma/2.0)
+	t := (chroma - c0) / 2.0
+	entry := func(c float64) ([2]float64, bool) {
+		if c == 0.0 {
+			return [2]float64{munsellWhiteX, munsellWhiteY}, true
+		}
+		p, ok := m.xy[munsellKey{hue, value, c}]
+		return p, ok
+	}
+
+	p0, ok := entry(c0)
+	if !ok {
+		return 0, 0, false
+	}
+	if t == 0.0 {
+		return p0[0], p0[1], true
+	}
+	p1, ok := entry(c0 + 2.0)
+	if !ok {
+		return 0, 0, false
+	}
+	return p0[0] + t*(p1[0]-p0[0]), p0[1] + t*(p1[1]-p0[1]), true
+}
+
+// xyAtValue returns the chromaticity of a color of a value of the data,
+// interpolating between hues in polar coordinates around the white.
+func (m *MunsellRenotation) xyAtValue(hue, value, chroma float64) (x, y float64, ok bool) {
+	h0 := 2.5 * math.Floor(hue/2.5)
+	t := (hue - h0) / 2.5
+	x0, y0, ok := m.xyAt(math.Mod(h0, 100.0), value, chroma)
+	if !ok || t == 0.0 {
+		return x0, y0, ok
+	}
+	x1, y1, ok := m.xyAt(math.Mod(h0+2.5, 100.0), value, chroma)
+	if !ok {
+		return 0, 0, false
+	}
+
+	a0 := math.Atan2(y0-munsellWhiteY, x0-munsellWhiteX)
+	a1 := math.Atan2(y1-munsellWhiteY, x1-munsellWhiteX)
+	r0 := math.Hypot(x0-munsellWhiteX, y0-munsellWhiteY)
+	r1 := math.Hypot(x1-munsellWhiteX, y1-munsellWhiteY)
+	a := interp_angle(a0*180.0/math.Pi, a1*180.0/math.Pi, t) * math.Pi / 180.0
+	r := r0 + t*(r1-r0)
+	return munsellWhiteX + r*math.Cos(a), munsellWhiteY + r*math.Sin(a), true
+}
+
+// MunsellToXyy converts a Munsell color to CIE xyY under illuminant C, by
+// interpolating the renotation data. Values outside of the data, like below
+// 1 in "real.dat", take the chromaticity of the closest value in the data.
+// It fails for colors outside of the data, which are not real colors.
+func (m *MunsellRenotation) MunsellToXyy(hue, value, chroma float64) (x, y, Y float64, err error) {
+	Y = MunsellValueToY(value)
+	if chroma == 0.0 {
+		return munsellWhiteX, munsellWhiteY, Y, nil
+	}
+	hue = math.Mod(math.Mod(hue, 100.0)+100.0, 100.0)
+
+	// The values of the data around the value.
+	var v0, v1 float64
+	i := sort.SearchFloat64s(m.values, value)
+	if i == len(m.values) {
+		v0, v1 = m.values[i-1], m.values[i-1]
+	} else if i > 0 && m.values[i] != value {
+		v0, v1 = m.values[i-1], m.values[i]
+	} else {
+		v0, v1 = m.values[i], m.values[i]
+	}
+
+	x0, y0, ok := m.xyAtValue(hue, v0, chroma)
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("color: %v is outside of the Munsell renotation data", FormatMunsell(hue, value, chroma))
+	}
+	if v0 == v1 {
+		return x0, y0, Y, nil
+	}
+	x1, y1, ok := m.xyAtValue(hue, v1, chroma)
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("color: %v is outside of the Munsell renotation data", FormatMunsell(hue, value, chroma))
+	}
+
+	// Interpolated linearly in luminance.
+	t := (Y - MunsellValueToY(v0)) / (MunsellValueToY(v1) - MunsellValueToY(v0))
+	return x0 + t*(x1-x0), y0 + t*(y1-y0), Y, nil
+}
+
+// XyyToMunsell converts CIE xyY under illuminant C to a Munsell color, by
+// searching the hue and chroma whose MunsellToXyy is the given chromaticity.
+func (m *MunsellRenotation) XyyToMunsell(x, y, Y float64) (hue, value, chroma float64, err error) {
+	value = YToMunsellValue(Y)
+	dx, dy := x-munsellWhiteX, y-munsellWhiteY
+	if math.Hypot(dx, dy) < 1e-6 {
+		return 0.0, value, 0.0, nil
+	}
+	residual := func(h, c float64) (float64, float64, bool) {
+		xc, yc, _, err := m.MunsellToXyy(h, value, c)
+		return xc - x, yc - y, err == nil
+	}
+
+	// Start with the hue whose chroma 2 is in the direction of the color,
+	// and the chroma of the same distance.
+	angle := math.Atan2(dy, dx)
+	best := math.Inf(1)
+	for h := 0.0; h < 100.0; h += 0.5 {
+		xc, yc, ok := residual(h, 2.0)
+		if !ok {
+			continue
+		}
+		xc, yc = xc+x-munsellWhiteX, yc+y-munsellWhiteY
+		d := math.Abs(math.Remainder(math.Atan2(yc, xc)-angle, 2.0*math.Pi))
+		if d < best {
+			best = d
+			hue, chroma = h, 2.0*math.Hypot(dx, dy)/math.Hypot(xc, yc)
+		}
+	}
+	if math.IsInf(best, 1) {
+		return 0, 0, 0, fmt.Errorf("color: no Munsell renotation data for a value of %v", value)
+	}
+
+	// Newton's method on hue and chroma, halving steps which leave the data
+	// or do not get closer.
+	rx, ry, ok := residual(hue, chroma)
+	for chroma > 2.0 && !ok {
+		chroma /= 1.5
+		rx, ry, ok = residual(hue, chroma)
+	}
+	for i := 0; i < 50 && ok && math.Hypot(rx, ry) > 1e-10; i++ {
+		const dh, dc = 1e-4, 1e-4
+		hx, hy, ok1 := residual(hue+dh, chroma)
+		cx, cy, ok2 := residual(hue, chroma+dc)
+		if !ok1 || !ok2 {
+			cx, cy, ok2 = residual(hue, chroma-dc)
+			cx, cy = 2.0*rx-cx, 2.0*ry-cy
+			if !ok1 || !ok2 {
+				break
+			}
+		}
+		j00, j01 := (hx-rx)/dh, (cx-rx)/dc
+		j10, j11 := (hy-ry)/dh, (cy-ry)/dc
+		det := j00*j11 - j01*j10
+		if det == 0.0 {
+			break
+		}
+		sh := (j11*rx - j01*ry) / det
+		sc := (j00*ry - j10*rx) / det
+
+		improved := false
+		for step := 1.0; step > 1e-4; step /= 2.0 {
+			h := math.Mod(hue-step*sh+100.0, 100.0)
+			c := math.Max(chroma-step*sc, 0.0)
+			nx, ny, ok := residual(h, c)
+			if ok && math.Hypot(nx, ny) < math.Hypot(rx, ry) {
+				hue, chroma, rx, ry = h, c, nx, ny
+				improved = true
+				break
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+	if !ok || math.Hypot(rx, ry) > 1e-6 {
+		return 0, 0, 0, fmt.Errorf("color: xyY (%v, %v, %v) is outside of the Munsell renotation data", x, y, Y)
+	}
+	return hue, value, chroma, nil
+}
+
+// Color converts the Munsell notation, like "5R 4/14", to a color, adapting
+// it from illuminant C to the D65 white of sRGB using Bradford.
+// WARNING: many Munsell colors are outside of sRGB, use Clamped if needed.
+func (m *MunsellRenotation) Color(notation string) (Color, error) {
+	hue, value, chroma, err := ParseMunsell(notation)
+	if err != nil {
+		return Color{}, err
+	}
+	x, y, Y, err := m.MunsellToXyy(hue, value, chroma)
+	if err != nil {
+		return Color{}, err
+	}
+	xc, yc, zc := XyyToXyz(x, y, Y)
+	return Xyz(Adapt(xc, yc, zc, Illuminants["C"], srgbWhite, Bradford)), nil
+}
+
+// Munsell returns the Munsell notation of the color, like "5R 4/14", adapting
+// it from the D65 white of sRGB to illuminant C using Bradford.
+func (m *MunsellRenotation) Munsell(col Color) (string, error) {
+	xc, yc, zc := col.Xyz()
+	xc, yc, zc = Adapt(xc, yc, zc, srgbWhite, Illuminants["C"], Bradford)
+	x, y, Y := XyzToXyyWhiteRef(xc, yc, zc, Illuminants["C"])
+	hue, value, chroma, err := m.XyyToMunsell(x, y, Y)
+	if err != nil {
+		return "", err
+	}
+	return FormatMunsell(hue, value, chroma), nil
+}
@@ -0,0 +1,7 @@
+package colorful
+
+// The Munsell renotation data of the real colors, "real.dat" of the Munsell
+// Color Science Laboratory, as rows of hue number, value, chroma, x and y
+// under illuminant C. It is written by doc/munselldata from a copy of
+// real.dat, see there for where to download it.
+var munsellRealData = [][5]float64{}
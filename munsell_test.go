@@ -0,0 +1,240 @@
+package colorful
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseMunsell(t *testing.T) {
+	tests := []struct {
+		notation           string
+		hue, value, chroma float64
+	}{
+		{"5R 4/14", 5.0, 4.0, 14.0},
+		{"10RP 5/2", 0.0, 5.0, 2.0},
+		{"2.5PB 6.5/8.2", 72.5, 6.5, 8.2},
+		{"7.5GY 9/1", 37.5, 9.0, 1.0},
+		{"N 5/", 0.0, 5.0, 0.0},
+		{"N 9.5/0", 0.0, 9.5, 0.0},
+		{"N5/", 0.0, 5.0, 0.0},
+		{"N8.5/", 0.0, 8.5, 0.0},
+	}
+	for _, tt := range tests {
+		hue, value, chroma, err := ParseMunsell(tt.notation)
+		if err != nil || !almosteq(hue, tt.hue) || !almosteq(value, tt.value) || !almosteq(chroma, tt.chroma) {
+			t.Errorf("ParseMunsell(%v) => (%v, %v, %v, %v), want (%v, %v, %v)", tt.notation, hue, value, chroma, err, tt.hue, tt.value, tt.chroma)
+		}
+	}
+
+	for _, notation := range []string{"", "5R", "5R 4", "R 4/2", "0R 4/2", "5X 4/2", "5R 11/2", "5R 4/-2", "N 5/2", "N/", "N5/2"} {
+		if _, _, _, err := ParseMunsell(notation); err == nil {
+			t.Errorf("ParseMunsell(%v) should fail", notation)
+		}
+	}
+}
+
+func TestFormatMunsell(t *testing.T) {
+	tests := []struct {
+		hue, value, chroma float64
+		notation           string
+	}{
+		{5.0, 4.0, 14.0, "5R 4/14"},
+		{0.0, 5.0, 2.0, "10RP 5/2"},
+		{100.0, 5.0, 2.0, "10RP 5/2"},
+		{72.5, 6.5, 8.23, "2.5PB 6.5/8.2"},
+		{19.99, 3.0, 4.0, "10YR 3/4"},
+		{-2.5, 3.0, 4.0, "7.5RP 3/4"},
+		{37.5, 5.0, 0.01, "N 5/"},
+	}
+	for _, tt := range tests {
+		if notation := FormatMunsell(tt.hue, tt.value, tt.chroma); notation != tt.notation {
+			t.Errorf("FormatMunsell(%v, %v, %v) => %v, want %v", tt.hue, tt.value, tt.chroma, notation, tt.notation)
+		}
+	}
+}
+
+func TestMunsellValue(t *testing.T) {
+	// Values from ASTM D1535.
+	tests := []struct{ value, y float64 }{
+		{0.0, 0.0},
+		{1.0, 0.011799},
+		{5.0, 0.19272},
+		{10.0, 1.0},
+	}
+	for _, tt := range tests {
+		if y := MunsellValueToY(tt.value); !almosteq_eps(y, tt.y, 1e-4) {
+			t.Errorf("MunsellValueToY(%v) => %v, want %v", tt.value, y, tt.y)
+		}
+	}
+	// The example of colour-science for ASTM D1535.
+	if y := MunsellValueToY(4.08244375); math.Abs(y-0.122363426) > 1e-9 {
+		t.Errorf("MunsellValueToY(4.08244375) => %v, want 0.122363426", y)
+	}
+	if v := YToMunsellValue(0.1223634268); math.Abs(v-4.08244375) > 1e-7 {
+		t.Errorf("YToMunsellValue(0.1223634268) => %v, want 4.08244375", v)
+	}
+	for v := 0.5; v <= 10.0; v += 0.5 {
+		if v2 := YToMunsellValue(MunsellValueToY(v)); math.Abs(v2-v) > 1e-9 {
+			t.Errorf("YToMunsellValue(MunsellValueToY(%v)) => %v", v, v2)
+		}
+	}
+}
+
+// A made-up renotation data in which the chromaticities are on circles
+// around the white, with a radius proportional to the chroma, for all values.
+// Interpolating it is exact, which the real data is not.
+func testMunsellRenotation() string {
+	lines := []string{"h V C x y Y"}
+	for hue := 2.5; hue <= 100.0; hue += 2.5 {
+		for value := 1.0; value <= 9.0; value++ {
+			for chroma := 2.0; chroma <= 20.0; chroma += 2.0 {
+				x, y := testMunsellXy(hue, chroma)
+				notation := strings.Replace(FormatMunsell(hue, value, chroma), "/", " ", 1)
+				lines = append(lines, fmt.Sprintf("%v %.6f %.6f %.4f", notation, x, y, 100.0*MunsellValueToY(value)))
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func testMunsellXy(hue, chroma float64) (x, y float64) {
+	s, c := math.Sincos(hue * 3.6 * math.Pi / 180.0)
+	return munsellWhiteX + 0.005*chroma*c, munsellWhiteY + 0.005*chroma*s
+}
+
+func TestMunsellRenotation(t *testing.T) {
+	m, err := LoadMunsellRenotation(strings.NewReader(testMunsellRenotation()))
+	if err != nil {
+		t.Fatalf("LoadMunsellRenotation() => %v", err)
+	}
+
+	for _, hvc := range [][3]float64{{5.0, 4.0, 14.0}, {1.2, 3.3, 5.5}, {98.9, 6.7, 1.1}, {51.0, 9.0, 19.9}, {33.3, 0.5, 3.0}, {70.0, 9.5, 8.0}} {
+		x, y, Y, err := m.MunsellToXyy(hvc[0], hvc[1], hvc[2])
+		wx, wy := testMunsellXy(hvc[0], hvc[2])
+		if err != nil || math.Abs(x-wx) > 1e-6 || math.Abs(y-wy) > 1e-6 || !almosteq(Y, MunsellValueToY(hvc[1])) {
+			t.Errorf("MunsellToXyy(%v) => (%v, %v, %v, %v), want (%v, %v, %v)", hvc, x, y, Y, err, wx, wy, MunsellValueToY(hvc[1]))
+		}
+
+		h, v, c, err := m.XyyToMunsell(x, y, Y)
+		if err != nil || math.Abs(math.Remainder(h-hvc[0], 100.0)) > 1e-4 || math.Abs(v-hvc[1]) > 1e-6 || math.Abs(c-hvc[2]) > 1e-4 {
+			t.Errorf("XyyToMunsell(MunsellToXyy(%v)) => (%v, %v, %v, %v)", hvc, h, v, c, err)
+		}
+	}
+
+	if _, _, _, err := m.MunsellToXyy(5.0, 5.0, 24.0); err == nil {
+		t.Errorf("MunsellToXyy(5R 5/24) should fail outside of the data")
+	}
+
+	for _, notation := range []string{"N 5/", "5R 4/6", "7.5PB 6/3"} {
+		c, err := m.Color(notation)
+		if err != nil {
+			t.Errorf("Color(%v) => %v", notation, err)
+			continue
+		}
+		if back, err := m.Munsell(c); err != nil || back != notation {
+			t.Errorf("Munsell(Color(%v)) => %v, %v", notation, back, err)
+		}
+	}
+	// Neutrals are the white of sRGB.
+	if c, _ := m.Color("N 10/"); !c.AlmostEqualRgb(Color{1.0, 1.0, 1.0}) {
+		t.Errorf("Color(N 10/) => %v, want white", c)
+	}
+}
+
+// A made-up renotation data whose hue loci curve like those of the real data,
+// turning with chroma and value, and whose chromas are not evenly spaced.
+func testMunsellCurvedRenotation() string {
+	lines := []string{"h V C x y Y"}
+	for hue := 2.5; hue <= 100.0; hue += 2.5 {
+		for value := 1.0; value <= 9.0; value++ {
+			for chroma := 2.0; chroma <= 20.0; chroma += 2.0 {
+				x, y := testMunsellCurvedXy(hue, value, chroma)
+				notation := strings.Replace(FormatMunsell(hue, value, chroma), "/", " ", 1)
+				lines = append(lines, fmt.Sprintf("%v %.6f %.6f %.4f", notation, x, y, 100.0*MunsellValueToY(value)))
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func testMunsellCurvedXy(hue, value, chroma float64) (x, y float64) {
+	s, c := math.Sincos((hue*3.6 + 1.5*chroma + 2.0*value) * math.Pi / 180.0)
+	r := 0.004*chroma + 0.0001*chroma*chroma
+	return munsellWhiteX + r*c, munsellWhiteY + r*s
+}
+
+func TestMunsellRenotationCurved(t *testing.T) {
+	m, err := LoadMunsellRenotation(strings.NewReader(testMunsellCurvedRenotation()))
+	if err != nil {
+		t.Fatalf("LoadMunsellRenotation() => %v", err)
+	}
+	polar := func(x, y float64) (float64, float64) {
+		return math.Atan2(y-munsellWhiteY, x-munsellWhiteX), math.Hypot(x-munsellWhiteX, y-munsellWhiteY)
+	}
+
+	for _, hvc := range [][3]float64{{5.0, 4.0, 14.0}, {1.2, 3.3, 5.5}, {98.9, 6.7, 1.1}, {51.0, 8.0, 17.9}, {72.6, 5.5, 8.0}, {26.3, 2.0, 3.0}} {
+		// The data itself at the corners around the color.
+		h0, c0 := 2.5*math.Floor(hvc[0]/2.5), 2.0*math.Floor(hvc[2]/2.0)
+		for _, corner := range [][2]float64{{h0, c0}, {h0 + 2.5, c0}, {h0, c0 + 2.0}, {h0 + 2.5, c0 + 2.0}} {
+			if corner[1] == 0.0 {
+				continue
+			}
+			value := math.Floor(hvc[1])
+			x, y, _, err := m.MunsellToXyy(corner[0], value, corner[1])
+			wx, wy := testMunsellCurvedXy(math.Mod(corner[0], 100.0), value, corner[1])
+			if err != nil || math.Abs(x-wx) > 1e-6 || math.Abs(y-wy) > 1e-6 {
+				t.Errorf("MunsellToXyy(%v, %v, %v) => (%v, %v, %v), want the data (%v, %v)", corner[0], value, corner[1], x, y, err, wx, wy)
+			}
+		}
+
+		// Between two hues of the data, the angle and radius are between theirs.
+		value := math.Floor(hvc[1])
+		x, y, _, err := m.MunsellToXyy(hvc[0], value, c0+2.0)
+		a, r := polar(x, y)
+		x0, y0, _, _ := m.MunsellToXyy(h0, value, c0+2.0)
+		x1, y1, _, _ := m.MunsellToXyy(h0+2.5, value, c0+2.0)
+		a0, r0 := polar(x0, y0)
+		a1, r1 := polar(x1, y1)
+		if err != nil || math.Remainder(a-a0, 2.0*math.Pi) < -1e-12 || math.Remainder(a1-a, 2.0*math.Pi) < -1e-12 || r < math.Min(r0, r1)-1e-12 || r > math.Max(r0, r1)+1e-12 {
+			t.Errorf("MunsellToXyy(%v, %v, %v) => (%v, %v, %v), not between the hues of the data", hvc[0], value, c0+2.0, x, y, err)
+		}
+
+		// The inverse finds the color on the curved loci.
+		x, y, Y, err := m.MunsellToXyy(hvc[0], hvc[1], hvc[2])
+		if err != nil {
+			t.Errorf("MunsellToXyy(%v) => %v", hvc, err)
+			continue
+		}
+		h, v, c, err := m.XyyToMunsell(x, y, Y)
+		if err != nil || math.Abs(math.Remainder(h-hvc[0], 100.0)) > 1e-4 || math.Abs(v-hvc[1]) > 1e-6 || math.Abs(c-hvc[2]) > 1e-4 {
+			t.Errorf("XyyToMunsell(MunsellToXyy(%v)) => (%v, %v, %v, %v)", hvc, h, v, c, err)
+		}
+	}
+}
+
+// TestDefaultMunsellRenotation tests the data of real.dat against the example
+// of colour-science, which interpolates like ASTM D1535 between some hues
+// linearly instead of radially, so it agrees only approximately.
+func TestDefaultMunsellRenotation(t *testing.T) {
+	m, err := DefaultMunsellRenotation()
+	if err != nil {
+		t.Skipf("DefaultMunsellRenotation() => %v", err)
+	}
+	hue, value, chroma, _ := ParseMunsell("4.2YR 8.1/5.3")
+	if x, y, Y, err := m.MunsellToXyy(hue, value, chroma); err != nil || math.Abs(x-0.38736945) > 2e-3 || math.Abs(y-0.35751656) > 2e-3 || math.Abs(Y-0.59362) > 1e-4 {
+		t.Errorf("MunsellToXyy(4.2YR 8.1/5.3) => (%v, %v, %v, %v), want (0.38737, 0.35752, 0.59362)", x, y, Y, err)
+	}
+	if h, v, c, err := m.XyyToMunsell(0.38736945, 0.35751656, 0.59362); err != nil || FormatMunsell(h, v, c) != "4.2YR 8.1/5.3" {
+		t.Errorf("XyyToMunsell(0.38737, 0.35752, 0.59362) => %v, %v", FormatMunsell(h, v, c), err)
+	}
+}
+
+func TestLoadMunsellRenotationErrors(t *testing.T) {
+	for _, data := range []string{"", "h V C x y Y", "5R 4 2", "5X 4 2 0.3 0.3 12", "5R 4 2 0.3 a 12"} {
+		if _, err := LoadMunsellRenotation(strings.NewReader(data)); err == nil {
+			t.Errorf("LoadMunsellRenotation(%q) should fail", data)
+		}
+	}
+}
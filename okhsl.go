@@ -0,0 +1,348 @@
+package colorful
+
+import "math"
+
+// Okhsv and Okhsl are color spaces for color pickers by Björn Ottosson, built
+// on OkLab so that hue and lightness are perceptually meaningful, while like
+// HSV and HSL, they fill the whole sRGB gamut for saturations in [0..1].
+// https://bottosson.github.io/posts/colorpicker/
+// This is a port of the reference implementation, which works directly on
+// linear sRGB and so uses slightly different matrices than XyzToOkLab.
+
+func linearRgbToOkLab(r, g, b float64) (l, a, bb float64) {
+	l_ := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m_ := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s_ := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+	l = 0.2104542553*l_ + 0.7936177850*m_ - 0.0040720468*s_
+	a = 1.9779984951*l_ - 2.4285922050*m_ + 0.4505937099*s_
+	bb = 0.0259040371*l_ + 0.7827717662*m_ - 0.8086757660*s_
+	return
+}
+
+func okLabToLinearRgb(l, a, b float64) (r, g, bb float64) {
+	l_ := cub(l + 0.3963377774*a + 0.2158037573*b)
+	m_ := cub(l - 0.1055613458*a - 0.0638541728*b)
+	s_ := cub(l - 0.0894841775*a - 1.2914855480*b)
+	r = 4.0767416621*l_ - 3.3077115913*m_ + 0.2309699292*s_
+	g = -1.2684380046*l_ + 2.6097574011*m_ - 0.3413193965*s_
+	bb = -0.0041960863*l_ - 0.7034186147*m_ + 1.7076147010*s_
+	return
+}
+
+// The toe maps OkLab's L to a lightness estimate closer to CIE L*, for use
+// as the lightness and value of Okhsl and Okhsv.
+const (
+	okToeK1 = 0.206
+	okToeK2 = 0.03
+	okToeK3 = (1.0 + okToeK1) / (1.0 + okToeK2)
+)
+
+func okToe(x float64) float64 {
+	return 0.5 * (okToeK3*x - okToeK1 + math.Sqrt(sq(okToeK3*x-okToeK1)+4.0*okToeK2*okToeK3*x))
+}
+
+func okToeInv(x float64) float64 {
+	return (x*x + okToeK1*x) / (okToeK3 * (x + okToeK2))
+}
+
+// okMaxSaturation finds the maximum saturation S = C/L possible for the hue
+// given by the normalized a and b, such that some sRGB component is zero.
+func okMaxSaturation(a, b float64) float64 {
+	// A polynomial fit for an initial guess, for the component that first
+	// goes below zero.
+	var k0, k1, k2, k3, k4, wl, wm, ws float64
+	if -1.88170328*a-0.80936493*b > 1.0 {
+		// Red component
+		k0, k1, k2, k3, k4 = 1.19086277, 1.76576728, 0.59662641, 0.75515197, 0.56771245
+		wl, wm, ws = 4.0767416621, -3.3077115913, 0.2309699292
+	} else if 1.81444104*a-1.19445276*b > 1.0 {
+		// Green component
+		k0, k1, k2, k3, k4 = 0.73956515, -0.45954404, 0.08285427, 0.12541070, 0.14503204
+		wl, wm, ws = -1.2684380046, 2.6097574011, -0.3413193965
+	} else {
+		// Blue component
+		k0, k1, k2, k3, k4 = 1.35733652, -0.00915799, -1.15130210, -0.50559606, 0.00692167
+		wl, wm, ws = -0.0041960863, -0.7034186147, 1.7076147010
+	}
+	s := k0 + k1*a + k2*b + k3*a*a + k4*a*b
+
+	// One step of Halley's method gets the error below 1e-6.
+	kl := 0.3963377774*a + 0.2158037573*b
+	km := -0.1055613458*a - 0.0638541728*b
+	ks := -0.0894841775*a - 1.2914855480*b
+
+	l_, m_, s_ := 1.0+s*kl, 1.0+s*km, 1.0+s*ks
+	f := wl*cub(l_) + wm*cub(m_) + ws*cub(s_)
+	f1 := wl*3.0*kl*l_*l_ + wm*3.0*km*m_*m_ + ws*3.0*ks*s_*s_
+	f2 := wl*6.0*kl*kl*l_ + wm*6.0*km*km*m_ + ws*6.0*ks*ks*s_
+	return s - f*f1/(f1*f1-0.5*f*f2)
+}
+
+// OkLabCusp returns the lightness L and chroma C of the most chromatic color
+// within sRGB of the hue given by a and b, which must be normalized so that
+// a² + b² = 1.
+func OkLabCusp(a, b float64) (l, c float64) {
+	s := okMaxSaturation(a, b)
+	r, g, bb := okLabToLinearRgb(1.0, s*a, s*b)
+	l = math.Cbrt(1.0 / math.Max(math.Max(r, g), bb))
+	return l, l * s
+}
+
+// OkLabGamutIntersection finds the intersection of the line from (l0, 0) to
+// (l1, c1) in the OkLab plane of the hue given by the normalized a and b with
+// the boundary of sRGB. It returns t, such that the intersection is at
+// (l0 + t*(l1-l0), t*c1).
+func OkLabGamutIntersection(a, b, l1, c1, l0 float64) float64 {
+	cuspL, cuspC := OkLabCusp(a, b)
+	return okGamutIntersection(a, b, l1, c1, l0, cuspL, cuspC)
+}
+
+func okGamutIntersection(a, b, l1, c1, l0, cuspL, cuspC float64) float64 {
+	if (l1-l0)*cuspC-(cuspL-l0)*c1 <= 0.0 {
+		// Lower half, where the boundary is a straight line.
+		return cuspC * l0 / (c1*cuspL + cuspC*(l0-l1))
+	}
+
+	// Upper half, first intersect with the triangle, then refine using one
+	// step of Halley's method on each component.
+	t := cuspC * (l0 - 1.0) / (c1*(cuspL-1.0) + cuspC*(l0-l1))
+
+	dl, dc := l1-l0, c1
+	kl := 0.3963377774*a + 0.2158037573*b
+	km := -0.1055613458*a - 0.0638541728*b
+	ks := -0.0894841775*a - 1.2914855480*b
+	ldt, mdt, sdt := dl+dc*kl, dl+dc*km, dl+dc*ks
+
+	l, c := l0*(1.0-t)+t*l1, t*c1
+	l_, m_, s_ := l+c*kl, l+c*km, l+c*ks
+	ll, m, s := cub(l_), cub(m_), cub(s_)
+	ll1, m1, s1 := 3.0*ldt*l_*l_, 3.0*mdt*m_*m_, 3.0*sdt*s_*s_
+	ll2, m2, s2 := 6.0*ldt*ldt*l_, 6.0*mdt*mdt*m_, 6.0*sdt*sdt*s_
+
+	step := func(wl, wm, ws float64) float64 {
+		f := wl*ll + wm*m + ws*s - 1.0
+		f1 := wl*ll1 + wm*m1 + ws*s1
+		f2 := wl*ll2 + wm*m2 + ws*s2
+		u := f1 / (f1*f1 - 0.5*f*f2)
+		if u < 0.0 {
+			return math.MaxFloat64
+		}
+		return -f * u
+	}
+	tr := step(4.0767416621, -3.3077115913, 0.2309699292)
+	tg := step(-1.2684380046, 2.6097574011, -0.3413193965)
+	tb := step(-0.0041960863, -0.7034186147, 1.7076147010)
+	return t + math.Min(tr, math.Min(tg, tb))
+}
+
+// The maximum S = C/L and T = C/(1-L) of the sRGB gamut triangle at the cusp.
+func okStMax(cuspL, cuspC float64) (s, t float64) {
+	return cuspC / cuspL, cuspC / (1.0 - cuspL)
+}
+
+// A polynomial fit of S and T for a smooth, though not exact, gamut approximation.
+func okStMid(a, b float64) (s, t float64) {
+	s = 0.11516993 + 1.0/(7.44778970+4.15901240*b+
+		a*(-2.19557347+1.75198401*b+
+			a*(-2.13704948-10.02301043*b+
+				a*(-4.24894561+5.38770819*b+4.69891013*a))))
+	t = 0.11239642 + 1.0/(1.61320320-0.68124379*b+
+		a*(0.40370612+0.90148123*b+
+			a*(-0.27087943+0.61223990*b+
+				a*(0.00299215-0.45399568*b-0.14661872*a))))
+	return
+}
+
+// okChromas returns the chroma at Okhsl saturations 0, 0.8 and 1 for the
+// given OkLab lightness and normalized hue.
+func okChromas(l, a, b float64) (c0, cMid, cMax float64) {
+	cuspL, cuspC := OkLabCusp(a, b)
+	cMax = okGamutIntersection(a, b, l, 1.0, l, cuspL, cuspC)
+	sMax, tMax := okStMax(cuspL, cuspC)
+	sMid, tMid := okStMid(a, b)
+
+	// Scale factor to compensate for the curved part of the gamut shape.
+	k := cMax / math.Min(l*sMax, (1.0-l)*tMax)
+
+	// Use a soft minimum function, instead of a sharp triangle shape, to get a smooth value for chroma.
+	ca, cb := l*sMid, (1.0-l)*tMid
+	cMid = 0.9 * k * math.Sqrt(math.Sqrt(1.0/(1.0/sq(sq(ca))+1.0/sq(sq(cb)))))
+
+	// For c0, the shape is independent of hue, so it is constant.
+	ca, cb = l*0.4, (1.0-l)*0.8
+	c0 = math.Sqrt(1.0 / (1.0/sq(ca) + 1.0/sq(cb)))
+	return
+}
+
+// okHue returns the hue in [0..360] and the normalized direction of a and b.
+func okHue(a, b float64) (h, an, bn float64) {
+	c := math.Sqrt(sq(a) + sq(b))
+	h = math.Atan2(b, a) * 180.0 / math.Pi
+	if h < 0.0 {
+		h += 360.0
+	}
+	return h, a / c, b / c
+}
+
+/// Okhsv ///
+/////////////
+// Hue in [0..360], Saturation and Value in [0..1].
+
+// Okhsv returns the hue in [0..360], saturation and value in [0..1] of the color.
+func (col Color) Okhsv() (h, s, v float64) {
+	l, a, b := linearRgbToOkLab(col.LinearRgb())
+	// Grays, including those with a tiny chroma due to rounding, have no hue.
+	if sq(a)+sq(b) < 1e-12 {
+		return 0.0, 0.0, okToe(l)
+	}
+	h, an, bn := okHue(a, b)
+	c := math.Sqrt(sq(a) + sq(b))
+
+	cuspL, cuspC := OkLabCusp(an, bn)
+	sMax, tMax := okStMax(cuspL, cuspC)
+	const s0 = 0.5
+	k := 1.0 - s0/sMax
+
+	// Find L and C of the triangle vertex along the line through the color.
+	t := tMax / (c + l*tMax)
+	lv, cv := t*l, t*c
+
+	// Undo the toe and the scaling to the gamut.
+	lvt := okToeInv(lv)
+	cvt := cv * lvt / lv
+	r, g, bb := okLabToLinearRgb(lvt, an*cvt, bn*cvt)
+	scaleL := math.Cbrt(1.0 / math.Max(math.Max(r, g), math.Max(bb, 0.0)))
+	l /= scaleL
+	c /= scaleL
+	c = c * okToe(l) / l
+	l = okToe(l)
+
+	v = l / lv
+	s = (s0 + tMax) * cv / (tMax*s0 + tMax*k*cv)
+	return
+}
+
+// Okhsv creates a new Color given a hue in [0..360], saturation and value in [0..1].
+func Okhsv(h, s, v float64) Color {
+	if v <= 0.0 {
+		return Color{0.0, 0.0, 0.0}
+	}
+	bn, an := math.Sincos(h * math.Pi / 180.0)
+
+	cuspL, cuspC := OkLabCusp(an, bn)
+	sMax, tMax := okStMax(cuspL, cuspC)
+	const s0 = 0.5
+	k := 1.0 - s0/sMax
+
+	// L and C of the triangle vertex along the line from white through the color.
+	lv := 1.0 - s*s0/(s0+tMax-tMax*k*s)
+	cv := s * tMax * s0 / (s0 + tMax - tMax*k*s)
+	l, c := v*lv, v*cv
+
+	// Compensate for both the toe and the curved top part of the triangle.
+	lvt := okToeInv(lv)
+	cvt := cv * lvt / lv
+	lNew := okToeInv(l)
+	c = c * lNew / l
+	l = lNew
+
+	r, g, b := okLabToLinearRgb(lvt, an*cvt, bn*cvt)
+	scaleL := math.Cbrt(1.0 / math.Max(math.Max(r, g), math.Max(b, 0.0)))
+	l *= scaleL
+	c *= scaleL
+
+	return LinearRgb(okLabToLinearRgb(l, c*an, c*bn))
+}
+
+// BlendOkhsv blends two colors in the Okhsv color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendOkhsv(c2 Color, t float64) Color {
+	h1, s1, v1 := c1.Okhsv()
+	h2, s2, v2 := c2.Okhsv()
+
+	// https://github.com/lucasb-eyer/go-colorful/pull/60
+	if s1 == 0 && s2 != 0 {
+		h1 = h2
+	} else if s2 == 0 && s1 != 0 {
+		h2 = h1
+	}
+
+	// We know that h are both in [0..360]
+	return Okhsv(interp_angle(h1, h2, t), s1+t*(s2-s1), v1+t*(v2-v1))
+}
+
+/// Okhsl ///
+/////////////
+// Hue in [0..360], Saturation and Lightness in [0..1].
+
+// Okhsl returns the hue in [0..360], saturation and lightness in [0..1] of the color.
+func (col Color) Okhsl() (h, s, l float64) {
+	L, a, b := linearRgbToOkLab(col.LinearRgb())
+	if sq(a)+sq(b) < 1e-12 {
+		return 0.0, 0.0, okToe(L)
+	}
+	h, an, bn := okHue(a, b)
+	c := math.Sqrt(sq(a) + sq(b))
+
+	// Saturation interpolates between c0, cMid and cMax, which are at 0, 0.8 and 1.
+	c0, cMid, cMax := okChromas(L, an, bn)
+	const mid, midInv = 0.8, 1.25
+	if c < cMid {
+		k1 := mid * c0
+		k2 := 1.0 - k1/cMid
+		s = c / (k1 + k2*c) * mid
+	} else {
+		k0 := cMid
+		k1 := (1.0 - mid) * sq(cMid) * sq(midInv) / c0
+		k2 := 1.0 - k1/(cMax-cMid)
+		t := (c - k0) / (k1 + k2*(c-k0))
+		s = mid + (1.0-mid)*t
+	}
+	return h, s, okToe(L)
+}
+
+// Okhsl creates a new Color given a hue in [0..360], saturation and lightness in [0..1].
+func Okhsl(h, s, l float64) Color {
+	if l >= 1.0 {
+		return Color{1.0, 1.0, 1.0}
+	} else if l <= 0.0 {
+		return Color{0.0, 0.0, 0.0}
+	}
+	bn, an := math.Sincos(h * math.Pi / 180.0)
+	L := okToeInv(l)
+
+	c0, cMid, cMax := okChromas(L, an, bn)
+	const mid, midInv = 0.8, 1.25
+	var c float64
+	if s < mid {
+		t := midInv * s
+		k1 := mid * c0
+		k2 := 1.0 - k1/cMid
+		c = t * k1 / (1.0 - k2*t)
+	} else {
+		t := (s - mid) / (1.0 - mid)
+		k0 := cMid
+		k1 := (1.0 - mid) * sq(cMid) * sq(midInv) / c0
+		k2 := 1.0 - k1/(cMax-cMid)
+		c = k0 + t*k1/(1.0-k2*t)
+	}
+
+	return LinearRgb(okLabToLinearRgb(L, c*an, c*bn))
+}
+
+// BlendOkhsl blends two colors in the Okhsl color-space.
+// t == 0 results in c1, t == 1 results in c2
+func (c1 Color) BlendOkhsl(c2 Color, t float64) Color {
+	h1, s1, l1 := c1.Okhsl()
+	h2, s2, l2 := c2.Okhsl()
+
+	// https://github.com/lucasb-eyer/go-colorful/pull/60
+	if s1 == 0 && s2 != 0 {
+		h1 = h2
+	} else if s2 == 0 && s1 != 0 {
+		h2 = h1
+	}
+
+	// We know that h are both in [0..360]
+	return Okhsl(interp_angle(h1, h2, t), s1+t*(s2-s1), l1+t*(l2-l1))
+}
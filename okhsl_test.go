@@ -0,0 +1,96 @@
+package colorful
+
+import (
+	"math"
+	"testing"
+)
+
+// Reference values from the implementation in color.js.
+var okhsxvals = []struct {
+	hex string
+	hsv [3]float64
+	hsl [3]float64
+}{
+	{"#ff0000", [3]float64{29.2338851923426, 0.9995219692256989, 1.0}, [3]float64{29.2338851923426, 1.0, 0.5680846525040862}},
+	{"#808080", [3]float64{0.0, 0.0, 0.5357064594}, [3]float64{0.0, 0.0, 0.5357064594}},
+	{"#ffffff", [3]float64{0.0, 0.0, 1.0}, [3]float64{0.0, 0.0, 1.0}},
+	{"#000000", [3]float64{0.0, 0.0, 0.0}, [3]float64{0.0, 0.0, 0.0}},
+}
+
+func TestOkhsvConversion(t *testing.T) {
+	for i, tt := range okhsxvals {
+		c, _ := Hex(tt.hex)
+		h, s, v := c.Okhsv()
+		if !almosteq_eps(h, tt.hsv[0], 1e-6) || !almosteq_eps(s, tt.hsv[1], 1e-6) || !almosteq_eps(v, tt.hsv[2], 1e-6) {
+			t.Errorf("%v. %v.Okhsv() => (%v, %v, %v), want %v", i, tt.hex, h, s, v, tt.hsv)
+		}
+		if c2 := Okhsv(tt.hsv[0], tt.hsv[1], tt.hsv[2]); c2.Hex() != tt.hex {
+			t.Errorf("%v. Okhsv(%v) => (%v), want %v", i, tt.hsv, c2.Hex(), tt.hex)
+		}
+	}
+}
+
+func TestOkhslConversion(t *testing.T) {
+	for i, tt := range okhsxvals {
+		c, _ := Hex(tt.hex)
+		h, s, l := c.Okhsl()
+		if !almosteq_eps(h, tt.hsl[0], 1e-6) || !almosteq_eps(s, tt.hsl[1], 1e-6) || !almosteq_eps(l, tt.hsl[2], 1e-6) {
+			t.Errorf("%v. %v.Okhsl() => (%v, %v, %v), want %v", i, tt.hex, h, s, l, tt.hsl)
+		}
+		if c2 := Okhsl(tt.hsl[0], tt.hsl[1], tt.hsl[2]); c2.Hex() != tt.hex {
+			t.Errorf("%v. Okhsl(%v) => (%v), want %v", i, tt.hsl, c2.Hex(), tt.hex)
+		}
+	}
+}
+
+func TestOkhsxRoundtrip(t *testing.T) {
+	for i, tt := range vals {
+		h, s, v := tt.c.Okhsv()
+		if c := Okhsv(h, s, v); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. Okhsv(%v, %v, %v) => (%v), want %v (delta %v)", i, h, s, v, c, tt.c, delta)
+		}
+		h, s, l := tt.c.Okhsl()
+		if c := Okhsl(h, s, l); !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. Okhsl(%v, %v, %v) => (%v), want %v (delta %v)", i, h, s, l, c, tt.c, delta)
+		}
+	}
+}
+
+func TestOkhsxFillsGamut(t *testing.T) {
+	// Full saturation and value are on the boundary of sRGB, up to the
+	// accuracy of the approximations of the reference implementation.
+	for h := 0.0; h < 360.0; h += 10.0 {
+		c := Okhsv(h, 1.0, 1.0)
+		if !c.Clamped().AlmostEqualRgb(c) {
+			t.Errorf("Okhsv(%v, 1, 1) => (%v), want a valid color", h, c)
+		}
+		if max := math.Max(c.R, math.Max(c.G, c.B)); !almosteq_eps(max, 1.0, 1e-4) {
+			t.Errorf("Okhsv(%v, 1, 1) => (%v), want a maximum component of 1", h, c)
+		}
+		if min := math.Min(c.R, math.Min(c.G, c.B)); min > 5e-3 {
+			t.Errorf("Okhsv(%v, 1, 1) => (%v), want a minimum component of 0", h, c)
+		}
+
+		for _, l := range []float64{0.2, 0.5, 0.8} {
+			if c := Okhsl(h, 1.0, l); !c.Clamped().AlmostEqualRgb(c) {
+				t.Errorf("Okhsl(%v, 1, %v) => (%v), want a valid color", h, l, c)
+			}
+		}
+	}
+}
+
+func TestOkLabCusp(t *testing.T) {
+	// The cusp of the hue of red is red itself.
+	l, a, b := linearRgbToOkLab(1.0, 0.0, 0.0)
+	c := math.Sqrt(sq(a) + sq(b))
+	lc, cc := OkLabCusp(a/c, b/c)
+	if !almosteq_eps(lc, l, 1e-3) || !almosteq_eps(cc, c, 1e-3) {
+		t.Errorf("OkLabCusp of red => (%v, %v), want (%v, %v)", lc, cc, l, c)
+	}
+
+	// Going from mid-gray towards saturated red hits the gamut at red.
+	tt := OkLabGamutIntersection(a/c, b/c, l, c, l)
+	if !almosteq_eps(tt, 1.0, 1e-4) {
+		t.Errorf("OkLabGamutIntersection towards red => %v, want 1", tt)
+	}
+}
@@ -32,6 +32,19 @@ type SoftPaletteSettings struct {
 // happens to fall outside of the color-space, which can only happen if you
 // specify a CheckColor function.
 func SoftPaletteExWithRand(colorsCount int, settings SoftPaletteSettings, rand RandInterface) ([]Color, error) {
+	return softPaletteEx(colorsCount, settings, nil, rand)
+}
+
+// SoftPaletteExWithMetricAndRand is like SoftPaletteExWithRand, but clusters
+// the colors by the given metric instead of the Euclidean distance in L*a*b*.
+func SoftPaletteExWithMetricAndRand(colorsCount int, settings SoftPaletteSettings, metric DistanceFunc, rand RandInterface) ([]Color, error) {
+	metric = metric.orDefault()
+	return softPaletteEx(colorsCount, settings, &metric, rand)
+}
+
+// softPaletteEx clusters by the metric, or directly by lab_dist if it is nil,
+// which is much faster than going through a DistanceFunc.
+func softPaletteEx(colorsCount int, settings SoftPaletteSettings, metric *DistanceFunc, rand RandInterface) ([]Color, error) {
 
 	// Checks whether it's a valid RGB and also fulfills the potentially provided constraint.
 	check := func(col lab_t) bool {
@@ -73,6 +86,16 @@ func SoftPaletteExWithRand(colorsCount int, settings SoftPaletteSettings, rand R
 		}
 	}
 
+	// The coordinates of the samples in the space of the metric.
+	var sample_coords, mean_coords [][3]float64
+	if metric != nil {
+		sample_coords = make([][3]float64, len(samples))
+		for isample, sample := range samples {
+			sample_coords[isample] = metric.convert(Lab(sample.L, sample.A, sample.B))
+		}
+		mean_coords = make([][3]float64, colorsCount)
+	}
+
 	clusters := make([]int, len(samples))
 	samples_used := make([]bool, len(samples))
 
@@ -80,11 +103,21 @@ func SoftPaletteExWithRand(colorsCount int, settings SoftPaletteSettings, rand R
 	for i := 0; i < settings.Iterations; i++ {
 		// Reassigning the samples to clusters, i.e. to their closest mean.
 		// By the way, also check if any sample is used as a medoid and if so, mark that.
+		if metric != nil {
+			for imean, mean := range means {
+				mean_coords[imean] = metric.convert(Lab(mean.L, mean.A, mean.B))
+			}
+		}
 		for isample, sample := range samples {
 			samples_used[isample] = false
 			mindist := math.Inf(+1)
 			for imean, mean := range means {
-				dist := lab_dist(sample, mean)
+				var dist float64
+				if metric == nil {
+					dist = lab_dist(sample, mean)
+				} else {
+					dist = metric.distance(sample_coords[isample], mean_coords[imean])
+				}
 				if dist < mindist {
 					mindist = dist
 					clusters[isample] = imean
@@ -132,9 +165,18 @@ func SoftPaletteExWithRand(colorsCount int, settings SoftPaletteSettings, rand R
 				// Switch to medoid mode and pick the closest (unused) sample.
 				// This should always find something thanks to len(samples) >= colorsCount
 				mindist := math.Inf(+1)
+				var newmean_coords [3]float64
+				if metric != nil {
+					newmean_coords = metric.convert(Lab(newmean.L, newmean.A, newmean.B))
+				}
 				for isample, sample := range samples {
 					if !samples_used[isample] {
-						dist := lab_dist(sample, newmean)
+						var dist float64
+						if metric == nil {
+							dist = lab_dist(sample, newmean)
+						} else {
+							dist = metric.distance(sample_coords[isample], newmean_coords)
+						}
 						if dist < mindist {
 							mindist = dist
 							newmean = sample
@@ -151,6 +193,10 @@ func SoftPaletteEx(colorsCount int, settings SoftPaletteSettings) ([]Color, erro
 	return SoftPaletteExWithRand(colorsCount, settings, getDefaultGlobalRand())
 }
 
+func SoftPaletteExWithMetric(colorsCount int, settings SoftPaletteSettings, metric DistanceFunc) ([]Color, error) {
+	return SoftPaletteExWithMetricAndRand(colorsCount, settings, metric, getDefaultGlobalRand())
+}
+
 // A wrapper which uses common parameters.
 func SoftPaletteWithRand(colorsCount int, rand RandInterface) ([]Color, error) {
 	return SoftPaletteExWithRand(colorsCount, SoftPaletteSettings{nil, 50, false}, rand)
@@ -4,6 +4,7 @@ package colorful
 
 import (
 	"math"
+	"runtime"
 	"sort"
 )
 
@@ -14,13 +15,6 @@ type element struct {
 	rank   int      // Rank (approximate depth) of the subtree with this element as root
 }
 
-// newElement creates a singleton set and returns its sole element.
-func newElement() *element {
-	s := &element{}
-	s.parent = s
-	return s
-}
-
 // find returns an arbitrary element of a set when invoked on any element of
 // the set, The important feature is that it returns the same value when
 // invoked on any element of the set.  Consequently, it can be used to test if
@@ -60,132 +54,567 @@ func union(e1, e2 *element) {
 // are indexes into a list of Color values.
 type edgeIdxs [2]int
 
-// An edgeDistance is a map from an edge (pair of indices) to a distance
-// between the two vertices.
-type edgeDistance map[edgeIdxs]float64
+// A weightedEdge is an edge together with the distance between its vertices.
+type weightedEdge struct {
+	uv   edgeIdxs
+	dist float64
+}
 
-// allToAllDistancesCIEDE2000 computes the CIEDE2000 distance between each pair of
-// colors.  It returns a map from a pair of indices (u, v) with u < v to a
-// distance.
-func allToAllDistancesCIEDE2000(cs []Color) edgeDistance {
-	nc := len(cs)
-	m := make(edgeDistance, nc*nc)
-	for u := 0; u < nc-1; u++ {
-		for v := u + 1; v < nc; v++ {
-			m[edgeIdxs{u, v}] = cs[u].DistanceCIEDE2000(cs[v])
-		}
+// exactMSTLimit is the largest number of colors whose minimum spanning tree is
+// computed exactly, in quadratic time.  Larger sets get an approximate one.
+const exactMSTLimit = 2048
+
+// mstNeighbors is the number of nearest neighbors of each color whose edges
+// are candidates for the approximate minimum spanning tree.
+const mstNeighbors = 10
+
+// minSpanTree computes a minimum spanning tree of the colors under the metric.
+// It returns the n-1 edges of the tree.
+func minSpanTree(cs []Color, metric DistanceFunc) []edgeIdxs {
+	points := make([][3]float64, len(cs))
+	parallelRows(len(cs), func(i int) {
+		points[i] = metric.convert(cs[i])
+	})
+	if len(cs) <= exactMSTLimit {
+		return primMST(points, metric)
 	}
-	return m
+	return approxMST(cs, points, metric)
 }
 
-// sortEdges sorts all edges in a distance map by increasing vertex distance.
-func sortEdges(m edgeDistance) []edgeIdxs {
-	es := make([]edgeIdxs, 0, len(m))
-	for uv := range m {
-		es = append(es, uv)
+// pairDistance returns the distance between two vertices, measured from the
+// lower index to the higher one for metrics which are not symmetric.
+func pairDistance(points [][3]float64, metric DistanceFunc, u, v int) float64 {
+	if u > v {
+		u, v = v, u
 	}
+	return metric.distance(points[u], points[v])
+}
+
+// primMST runs Prim's algorithm on the implicit distance matrix of the points,
+// which takes quadratic time but only linear memory.  Every step updates the
+// distance of all vertices outside of the tree to it in parallel.
+func primMST(points [][3]float64, metric DistanceFunc) []edgeIdxs {
+	n := len(points)
+	inTree := make([]bool, n)
+	parent := make([]int, n)
+	dist := make([]float64, n)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+
+	chunks := runtime.GOMAXPROCS(0)
+	nearest := make([]int, chunks)
+	tree := make([]edgeIdxs, 0, n-1)
+	for u := 0; len(tree) < n-1; {
+		inTree[u] = true
+		parallelRows(chunks, func(c int) {
+			nearest[c] = -1
+			for v := c * n / chunks; v < (c+1)*n/chunks; v++ {
+				if inTree[v] {
+					continue
+				}
+				if d := pairDistance(points, metric, u, v); d < dist[v] {
+					dist[v], parent[v] = d, u
+				}
+				if nearest[c] < 0 || dist[v] < dist[nearest[c]] {
+					nearest[c] = v
+				}
+			}
+		})
+
+		// The vertex closest to the tree joins it next.
+		next := -1
+		for _, v := range nearest {
+			if v >= 0 && (next < 0 || dist[v] < dist[next]) {
+				next = v
+			}
+		}
+		tree = append(tree, edgeIdxs{parent[next], next})
+		u = next
+	}
+	return tree
+}
+
+// approxMST runs Kruskal's algorithm on the edges from every color to its
+// nearest neighbors in OkLab, found with a k-d tree.  Components which are
+// not connected by those edges are joined by a tree of one color of each.
+func approxMST(cs []Color, points [][3]float64, metric DistanceFunc) []edgeIdxs {
+	n := len(cs)
+	oklab := make([][3]float64, n)
+	parallelRows(n, func(i int) {
+		l, a, b := cs[i].OkLab()
+		oklab[i] = [3]float64{l, a, b}
+	})
+	kd := newKdTree(oklab)
+
+	es := make([]weightedEdge, n*mstNeighbors)
+	parallelRows(n, func(u int) {
+		row := es[u*mstNeighbors : (u+1)*mstNeighbors]
+		for i, v := range kd.nearest(u, mstNeighbors) {
+			row[i] = weightedEdge{edgeIdxs{u, v}, pairDistance(points, metric, u, v)}
+		}
+	})
 	sort.Slice(es, func(i, j int) bool {
-		return m[es[i]] < m[es[j]]
+		return es[i].dist < es[j].dist
 	})
-	return es
-}
 
-// minSpanTree computes a minimum spanning tree from a vertex count and a
-// distance-sorted edge list.  It returns the subset of edges that belong to
-// the tree, including both (u, v) and (v, u) for each edge.
-func minSpanTree(nc int, es []edgeIdxs) map[edgeIdxs]struct{} {
 	// Start with each vertex in its own set.
-	elts := make([]*element, nc)
+	elts := make([]element, n)
 	for i := range elts {
-		elts[i] = newElement()
+		elts[i].parent = &elts[i]
 	}
 
-	// Run Kruskal's algorithm to construct a minimal spanning tree.
-	mst := make(map[edgeIdxs]struct{}, nc)
-	for _, uv := range es {
-		u, v := uv[0], uv[1]
+	tree := make([]edgeIdxs, 0, n-1)
+	for _, e := range es {
+		u, v := e.uv[0], e.uv[1]
 		if elts[u].find() == elts[v].find() {
 			continue // Same set: edge would introduce a cycle.
 		}
-		mst[uv] = struct{}{}
-		mst[edgeIdxs{v, u}] = struct{}{}
-		union(elts[u], elts[v])
+		tree = append(tree, e.uv)
+		union(&elts[u], &elts[v])
+	}
+	if len(tree) == n-1 {
+		return tree
+	}
+
+	// Join the remaining components by one color each.
+	var reps []int
+	for i := range elts {
+		if elts[i].find() == &elts[i] {
+			reps = append(reps, i)
+		}
 	}
-	return mst
+	repPoints := make([][3]float64, len(reps))
+	for i, r := range reps {
+		repPoints[i] = points[r]
+	}
+	for _, uv := range primMST(repPoints, metric) {
+		tree = append(tree, edgeIdxs{reps[uv[0]], reps[uv[1]]})
+	}
+	return tree
 }
 
-// traverseMST walks a minimum spanning tree in prefix order.
-func traverseMST(mst map[edgeIdxs]struct{}, root int) []int {
-	// Compute a list of neighbors for each vertex.
-	neighs := make(map[int][]int, len(mst))
-	for uv := range mst {
+// traverseMST walks a minimum spanning tree in prefix order, visiting the
+// neighbors of each vertex in order of their index.
+func traverseMST(nc int, mst []edgeIdxs, root int) []int {
+	// Compute a list of neighbors for each vertex, all in one slice.
+	start := make([]int, nc+1)
+	for _, uv := range mst {
+		start[uv[0]+1]++
+		start[uv[1]+1]++
+	}
+	for u := 0; u < nc; u++ {
+		start[u+1] += start[u]
+	}
+	neighs := make([]int, start[nc])
+	fill := make([]int, nc)
+	copy(fill, start)
+	for _, uv := range mst {
 		u, v := uv[0], uv[1]
-		neighs[u] = append(neighs[u], v)
+		neighs[fill[u]] = v
+		fill[u]++
+		neighs[fill[v]] = u
+		fill[v]++
 	}
-	for u, vs := range neighs {
-		sort.Ints(vs)
-		copy(neighs[u], vs)
+	for u := 0; u < nc; u++ {
+		sort.Ints(neighs[start[u]:start[u+1]])
 	}
 
-	// Walk the tree from a given vertex.
-	order := make([]int, 0, len(neighs))
-	visited := make(map[int]bool, len(neighs))
-	var walkFrom func(int)
-	walkFrom = func(r int) {
-		// Visit the starting vertex.
+	// Walk the tree from a given vertex, with a stack instead of recursion so
+	// that long branches are fine.  Children are pushed in reverse order so
+	// that they are popped in order.
+	order := make([]int, 0, nc)
+	visited := make([]bool, nc)
+	stack := []int{root}
+	for len(stack) > 0 {
+		r := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
 		order = append(order, r)
 		visited[r] = true
-
-		// Recursively visit each child in turn.
-		for _, c := range neighs[r] {
-			if !visited[c] {
-				walkFrom(c)
+		for i := start[r+1] - 1; i >= start[r]; i-- {
+			if c := neighs[i]; !visited[c] {
+				stack = append(stack, c)
 			}
 		}
 	}
-	walkFrom(root)
 	return order
 }
 
+// A kdTree finds the nearest neighbors of points.  It is stored implicitly
+// in a permutation of their indices, with the median of every range as the
+// node that splits it along the axis of its depth.
+type kdTree struct {
+	points [][3]float64
+	idxs   []int
+}
+
+// newKdTree builds a k-d tree of the points.
+func newKdTree(points [][3]float64) *kdTree {
+	kd := &kdTree{points: points, idxs: make([]int, len(points))}
+	for i := range kd.idxs {
+		kd.idxs[i] = i
+	}
+	kd.build(0, len(points), 0)
+	return kd
+}
+
+func (kd *kdTree) build(lo, hi, axis int) {
+	if hi-lo < 2 {
+		return
+	}
+	idxs := kd.idxs[lo:hi]
+	sort.Slice(idxs, func(i, j int) bool {
+		return kd.points[idxs[i]][axis] < kd.points[idxs[j]][axis]
+	})
+	mid := (lo + hi) / 2
+	kd.build(lo, mid, (axis+1)%3)
+	kd.build(mid+1, hi, (axis+1)%3)
+}
+
+// nearest returns the k points nearest to point u, other than u itself, from
+// the nearest to the farthest.
+func (kd *kdTree) nearest(u, k int) []int {
+	if k > len(kd.points)-1 {
+		k = len(kd.points) - 1
+	}
+	best := make([]int, 0, k)
+	bestDist := make([]float64, 0, k)
+	var search func(lo, hi, axis int)
+	search = func(lo, hi, axis int) {
+		if lo >= hi {
+			return
+		}
+		mid := (lo + hi) / 2
+		v := kd.idxs[mid]
+		p, q := kd.points[u], kd.points[v]
+		if v != u {
+			// Insert v in order if it is among the k nearest so far.
+			d := sq(p[0]-q[0]) + sq(p[1]-q[1]) + sq(p[2]-q[2])
+			if len(best) < k || d < bestDist[len(best)-1] {
+				if len(best) < k {
+					best, bestDist = append(best, v), append(bestDist, d)
+				}
+				i := len(best) - 1
+				for ; i > 0 && bestDist[i-1] > d; i-- {
+					best[i], bestDist[i] = best[i-1], bestDist[i-1]
+				}
+				best[i], bestDist[i] = v, d
+			}
+		}
+
+		// Search the side of u first, and the other one only if it can
+		// contain points nearer than the farthest so far.
+		diff := p[axis] - q[axis]
+		near, far := [2]int{lo, mid}, [2]int{mid + 1, hi}
+		if diff > 0 {
+			near, far = far, near
+		}
+		search(near[0], near[1], (axis+1)%3)
+		if len(best) < k || sq(diff) < bestDist[len(best)-1] {
+			search(far[0], far[1], (axis+1)%3)
+		}
+	}
+	search(0, len(kd.idxs), 0)
+	return best
+}
+
 // Sorted sorts a list of Color values.  Sorting is not a well-defined operation
 // for colors so the intention here primarily is to order colors so that the
-// transition from one to the next is fairly smooth.
+// transition from one to the next is fairly smooth.  It walks a minimum
+// spanning tree under CIEDE2000 starting from the darkest color, SortedEx
+// offers other metrics and strategies.
 func Sorted(cs []Color) []Color {
+	return SortedEx(cs, SortOptions{})
+}
+
+// A SortStrategy is a way of ordering colors in SortedEx.
+type SortStrategy int
+
+const (
+	// SortMST walks a minimum spanning tree of the colors in prefix order,
+	// like Sorted. Above 2048 colors the tree is approximated from the
+	// nearest neighbors of every color in OkLab, which is much faster and
+	// only slightly longer.
+	SortMST SortStrategy = iota
+
+	// SortTSP visits the nearest unvisited color next, and then improves this
+	// path with up to 50 passes of 2-opt, which reverses parts of it where
+	// that shortens it. It is the smoothest, but the slowest, and needs all
+	// the distances, that is memory for n² of them. Above 2048 colors it
+	// falls back to SortMST.
+	SortTSP
+
+	// SortHueLightness sorts grays first, then the colors by their OkLch hue
+	// in HueBins bins, and within each by lightness. The bins start at the
+	// hue of the start color, which is not necessarily the first one.
+	SortHueLightness
+
+	// SortHilbert orders the colors along a Hilbert curve through OkLab,
+	// which is fast and keeps nearby colors together, but jumps between
+	// distant ones now and then. The curve is walked from the end which is
+	// closer to the start color along it, which is not necessarily first.
+	SortHilbert
+)
+
+// SortOptions configures SortedEx. The zero value sorts like Sorted.
+type SortOptions struct {
+	// Metric is the distance between colors, MetricCIEDE2000 if left out.
+	Metric DistanceFunc
+
+	// Start is the color at which to start: SortMST and SortTSP start with
+	// the color closest to it, while SortHueLightness and SortHilbert only
+	// orient their order by it. It is black if left out, so that SortMST and
+	// SortTSP start with the darkest color.
+	Start Color
+
+	// Strategy is how the colors are ordered, SortMST if left out.
+	Strategy SortStrategy
+
+	// HueBins is the number of hue ranges of SortHueLightness, 12 if left out.
+	HueBins int
+}
+
+// SortedEx sorts a list of Color values like Sorted, but with the given
+// options. Only SortMST and SortTSP order by the metric, all of them use it
+// to find the color closest to Start.
+func SortedEx(cs []Color, opts SortOptions) []Color {
 	// Do nothing in trivial cases.
 	newCs := make([]Color, len(cs))
 	if len(cs) < 2 {
 		copy(newCs, cs)
 		return newCs
 	}
+	metric := opts.Metric.orDefault()
+
+	// Find the color closest to the start.
+	start := metric.convert(opts.Start)
+	var sIdx int
+	closest := math.MaxFloat64
+	for i, c := range cs {
+		if d := metric.distance(start, metric.convert(c)); d < closest {
+			sIdx = i
+			closest = d
+		}
+	}
+
+	// All the distances of SortTSP would take too much memory.
+	strategy := opts.Strategy
+	if strategy == SortTSP && len(cs) > tspLimit {
+		strategy = SortMST
+	}
+
+	var idxs []int
+	switch strategy {
+	case SortTSP:
+		idxs = tspPath(symmetricDistances(cs, metric), sIdx)
+	case SortHueLightness:
+		bins := opts.HueBins
+		if bins <= 0 {
+			bins = 12
+		}
+		idxs = hueLightnessOrder(cs, bins, cs[sIdx])
+	case SortHilbert:
+		idxs = hilbertOrder(cs, sIdx)
+	default:
+		idxs = traverseMST(len(cs), minSpanTree(cs, metric), sIdx)
+	}
+
+	for i, idx := range idxs {
+		newCs[i] = cs[idx]
+	}
+	return newCs
+}
+
+// symmetricDistances returns the DistanceMatrix, averaging both directions
+// for metrics which are not symmetric.
+func symmetricDistances(cs []Color, metric DistanceFunc) [][]float64 {
+	dists := DistanceMatrix(cs, metric)
+	if !metric.symmetric {
+		for u := range dists {
+			for v := u + 1; v < len(dists); v++ {
+				d := (dists[u][v] + dists[v][u]) / 2.0
+				dists[u][v], dists[v][u] = d, d
+			}
+		}
+	}
+	return dists
+}
+
+// tspLimit is the largest number of colors that SortTSP sorts, whose
+// distances take 32 MiB.
+const tspLimit = 2048
+
+// tspPasses is the largest number of passes of 2-opt over the whole path.
+const tspPasses = 50
+
+// tspPath finds a short path through all vertices starting at the given
+// one, by going to the nearest unvisited vertex and then applying 2-opt.
+func tspPath(dists [][]float64, start int) []int {
+	n := len(dists)
+	path := make([]int, 0, n)
+	visited := make([]bool, n)
+	for u := start; len(path) < n; {
+		path = append(path, u)
+		visited[u] = true
+		next, nextDist := -1, math.MaxFloat64
+		for v := 0; v < n; v++ {
+			if !visited[v] && dists[u][v] < nextDist {
+				next, nextDist = v, dists[u][v]
+			}
+		}
+		u = next
+	}
+
+	// Reverse path[i+1..j] while that makes it shorter. The start stays first,
+	// while the end is free, so j can be the last vertex, without a next one.
+	const eps = 1e-12
+	for pass, improved := 0, true; improved && pass < tspPasses; pass++ {
+		improved = false
+		for i := 0; i < n-2; i++ {
+			for j := i + 2; j < n; j++ {
+				a, b, c := path[i], path[i+1], path[j]
+				delta := dists[a][c] - dists[a][b]
+				if j+1 < n {
+					d := path[j+1]
+					delta += dists[b][d] - dists[c][d]
+				}
+				if delta < -eps {
+					for l, r := i+1, j; l < r; l, r = l+1, r-1 {
+						path[l], path[r] = path[r], path[l]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+	return path
+}
 
-	// Compute the distance from each color to every other color.
-	dists := allToAllDistancesCIEDE2000(cs)
+// hueLightnessOrder sorts grays by lightness, followed by the colors by the
+// bin of their hue, starting with that of the given color, and by lightness.
+func hueLightnessOrder(cs []Color, bins int, start Color) []int {
+	// Chromas below this are grays, as their hue is meaningless.
+	const grayChroma = 0.02
+
+	_, startC, startH := start.OkLch()
+	if startC < grayChroma {
+		startH = 0.0
+	}
 
-	// Produce a list of edges in increasing order of the distance between
-	// their vertices.
-	edges := sortEdges(dists)
+	idxs := make([]int, len(cs))
+	keys := make([]int, len(cs))
+	lightness := make([]float64, len(cs))
+	for i, c := range cs {
+		l, ch, h := c.OkLch()
+		idxs[i] = i
+		lightness[i] = l
+		keys[i] = -1
+		if ch >= grayChroma {
+			keys[i] = int(math.Mod(h-startH+360.0, 360.0)*float64(bins)/360.0) % bins
+		}
+	}
+	sort.SliceStable(idxs, func(i, j int) bool {
+		u, v := idxs[i], idxs[j]
+		if keys[u] != keys[v] {
+			return keys[u] < keys[v]
+		}
+		return lightness[u] < lightness[v]
+	})
+	return idxs
+}
 
-	// Construct a minimum spanning tree from the list of edges.
-	mst := minSpanTree(len(cs), edges)
+// The number of bits per dimension of the Hilbert curve of hilbertOrder.
+const hilbertBits = 16
 
-	// Find the darkest color in the list.
-	var black Color
-	var dIdx int             // Index of darkest color
-	light := math.MaxFloat64 // Lightness of darkest color (distance from black)
+// hilbertOrder sorts the colors along a Hilbert curve through the bounding
+// box of their OkLab values, in the direction which starts closer to start.
+func hilbertOrder(cs []Color, start int) []int {
+	points := make([][3]float64, len(cs))
+	lo := [3]float64{math.MaxFloat64, math.MaxFloat64, math.MaxFloat64}
+	hi := [3]float64{-math.MaxFloat64, -math.MaxFloat64, -math.MaxFloat64}
 	for i, c := range cs {
-		d := black.DistanceCIEDE2000(c)
-		if d < light {
-			dIdx = i
-			light = d
+		l, a, b := c.OkLab()
+		points[i] = [3]float64{l, a, b}
+		for k := range lo {
+			lo[k] = math.Min(lo[k], points[i][k])
+			hi[k] = math.Max(hi[k], points[i][k])
 		}
 	}
 
-	// Traverse the tree starting from the darkest color.
-	idxs := traverseMST(mst, dIdx)
+	// One scale for all axes, to keep distances in proportion.
+	extent := math.Max(hi[0]-lo[0], math.Max(hi[1]-lo[1], hi[2]-lo[2]))
+	if extent == 0.0 {
+		extent = 1.0
+	}
+	const cells = 1<<hilbertBits - 1
+	keys := make([]uint64, len(cs))
+	idxs := make([]int, len(cs))
+	for i, p := range points {
+		var x [3]uint32
+		for k := range x {
+			x[k] = uint32((p[k] - lo[k]) / extent * cells)
+		}
+		keys[i] = hilbertIndex(x, hilbertBits)
+		idxs[i] = i
+	}
+	sort.SliceStable(idxs, func(i, j int) bool {
+		return keys[idxs[i]] < keys[idxs[j]]
+	})
 
-	// Convert the index list to a list of colors, overwriting the input.
+	// Walk the curve backwards if the start is in its second half.
 	for i, idx := range idxs {
-		newCs[i] = cs[idx]
+		if idx == start {
+			if 2*i >= len(idxs) {
+				for l, r := 0, len(idxs)-1; l < r; l, r = l+1, r-1 {
+					idxs[l], idxs[r] = idxs[r], idxs[l]
+				}
+			}
+			break
+		}
 	}
-	return newCs
+	return idxs
+}
+
+// hilbertIndex returns the position of a point on the 3D Hilbert curve with
+// the given number of bits per coordinate, using the algorithm of Skilling.
+// https://doi.org/10.1063/1.1751381
+func hilbertIndex(x [3]uint32, bits uint) uint64 {
+	m := uint32(1) << (bits - 1)
+
+	// Inverse undo of the excess work.
+	for q := m; q > 1; q >>= 1 {
+		p := q - 1
+		for i := range x {
+			if x[i]&q != 0 {
+				x[0] ^= p
+			} else {
+				t := (x[0] ^ x[i]) & p
+				x[0] ^= t
+				x[i] ^= t
+			}
+		}
+	}
+
+	// Gray encode.
+	for i := 1; i < len(x); i++ {
+		x[i] ^= x[i-1]
+	}
+	t := uint32(0)
+	for q := m; q > 1; q >>= 1 {
+		if x[len(x)-1]&q != 0 {
+			t ^= q - 1
+		}
+	}
+	for i := range x {
+		x[i] ^= t
+	}
+
+	// Interleave the bits, most significant first.
+	var h uint64
+	for b := int(bits) - 1; b >= 0; b-- {
+		for i := range x {
+			h = h<<1 | uint64(x[i]>>uint(b)&1)
+		}
+	}
+	return h
 }
@@ -1,6 +1,13 @@
 package colorful
 
-import "testing"
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+)
 
 // TestSortSimple tests the sorting of a small set of colors.
 func TestSortSimple(t *testing.T) {
@@ -27,3 +34,295 @@ func TestSortSimple(t *testing.T) {
 		}
 	}
 }
+
+// randomColors returns n random colors of a fixed seed.
+func randomColors(n int) []Color {
+	rnd := rand.New(rand.NewSource(1))
+	cs := make([]Color, n)
+	for i := range cs {
+		cs[i] = Color{rnd.Float64(), rnd.Float64(), rnd.Float64()}
+	}
+	return cs
+}
+
+// pathLength returns the sum of the distances between neighbors.
+func pathLength(cs []Color) float64 {
+	l := 0.0
+	for i := 1; i < len(cs); i++ {
+		l += cs[i-1].DistanceCIEDE2000(cs[i])
+	}
+	return l
+}
+
+// TestSortedEx tests that all strategies return a permutation of the input,
+// and that those by distance start with the color closest to the start.
+func TestSortedEx(t *testing.T) {
+	in := randomColors(200)
+	if out, exp := SortedEx(in, SortOptions{}), Sorted(in); !reflect.DeepEqual(out, exp) {
+		t.Errorf("SortedEx with the zero options differs from Sorted")
+	}
+
+	// A custom metric, which is not known to be symmetric.
+	okLab := NewDistanceFunc(func(c1, c2 Color) float64 {
+		l1, a1, b1 := c1.OkLab()
+		l2, a2, b2 := c2.OkLab()
+		return math.Sqrt(sq(l1-l2) + sq(a1-a2) + sq(b1-b2))
+	})
+
+	white := Color{1.0, 1.0, 1.0}
+	for _, strategy := range []SortStrategy{SortMST, SortTSP, SortHueLightness, SortHilbert} {
+		for _, metric := range []DistanceFunc{MetricCIEDE2000, MetricCMC(2.0, 1.0), okLab} {
+			out := SortedEx(in, SortOptions{Metric: metric, Strategy: strategy, Start: white})
+			count := make(map[Color]int)
+			for _, c := range in {
+				count[c]++
+			}
+			for _, c := range out {
+				count[c]--
+			}
+			for c, n := range count {
+				if n != 0 {
+					t.Fatalf("SortedEx(%v) changed the count of %v by %v", strategy, c, -n)
+				}
+			}
+
+			if strategy == SortMST || strategy == SortTSP {
+				lightest := in[0]
+				for _, c := range in {
+					if metric.Distance(white, c) < metric.Distance(white, lightest) {
+						lightest = c
+					}
+				}
+				if out[0] != lightest {
+					t.Errorf("SortedEx(%v) starts with %v, want %v", strategy, out[0], lightest)
+				}
+			}
+		}
+	}
+}
+
+// TestSortTSP tests that the TSP path is shorter than the MST walk, and not
+// improved by any reversal.
+func TestSortTSP(t *testing.T) {
+	in := randomColors(150)
+	mst := SortedEx(in, SortOptions{Strategy: SortMST})
+	tsp := SortedEx(in, SortOptions{Strategy: SortTSP})
+	if pathLength(tsp) >= pathLength(mst) {
+		t.Errorf("TSP path of length %v is not shorter than MST path of length %v", pathLength(tsp), pathLength(mst))
+	}
+	if tsp[0] != mst[0] {
+		t.Errorf("TSP path starts with %v, MST path with %v", tsp[0], mst[0])
+	}
+
+	for i := 0; i < len(tsp)-2; i++ {
+		for j := i + 2; j < len(tsp); j++ {
+			d := tsp[i].DistanceCIEDE2000(tsp[j]) - tsp[i].DistanceCIEDE2000(tsp[i+1])
+			if j+1 < len(tsp) {
+				d += tsp[i+1].DistanceCIEDE2000(tsp[j+1]) - tsp[j].DistanceCIEDE2000(tsp[j+1])
+			}
+			if d < -1e-9 {
+				t.Fatalf("Reversing %v..%v shortens the TSP path by %v", i+1, j, -d)
+			}
+		}
+	}
+
+	// Too many colors for all their distances fall back to SortMST.
+	in = randomColors(tspLimit + 1)
+	if tsp, mst := SortedEx(in, SortOptions{Strategy: SortTSP}), SortedEx(in, SortOptions{Strategy: SortMST}); !reflect.DeepEqual(tsp, mst) {
+		t.Errorf("SortTSP of %v colors differs from SortMST", len(in))
+	}
+}
+
+// TestSortHueLightness tests that grays come first, and then the hues in
+// order, each from dark to light.
+func TestSortHueLightness(t *testing.T) {
+	in := []Color{
+		{0.9, 0.1, 0.1}, {0.5, 0.5, 0.5}, {0.1, 0.1, 0.9}, {0.4, 0.05, 0.05},
+		{0.2, 0.2, 0.2}, {0.1, 0.8, 0.1}, {0.05, 0.3, 0.05}, {0.9, 0.9, 0.9},
+	}
+	out := SortedEx(in, SortOptions{Strategy: SortHueLightness, HueBins: 6})
+	exp := []Color{
+		{0.2, 0.2, 0.2}, {0.5, 0.5, 0.5}, {0.9, 0.9, 0.9},
+		{0.4, 0.05, 0.05}, {0.9, 0.1, 0.1},
+		{0.05, 0.3, 0.05}, {0.1, 0.8, 0.1},
+		{0.1, 0.1, 0.9},
+	}
+	if !reflect.DeepEqual(out, exp) {
+		t.Errorf("SortedEx(SortHueLightness) => %v, want %v", out, exp)
+	}
+}
+
+// TestHilbertIndex tests that the Hilbert curve visits every cell once, and
+// moves to a neighboring cell at every step.
+func TestHilbertIndex(t *testing.T) {
+	for bits := uint(1); bits <= 4; bits++ {
+		n := uint32(1) << bits
+		cells := make(map[uint64][3]uint32)
+		for x := uint32(0); x < n; x++ {
+			for y := uint32(0); y < n; y++ {
+				for z := uint32(0); z < n; z++ {
+					cells[hilbertIndex([3]uint32{x, y, z}, bits)] = [3]uint32{x, y, z}
+				}
+			}
+		}
+		if uint32(len(cells)) != n*n*n {
+			t.Fatalf("hilbertIndex with %v bits has %v distinct indices, want %v", bits, len(cells), n*n*n)
+		}
+		for h := uint64(1); h < uint64(len(cells)); h++ {
+			p, q := cells[h-1], cells[h]
+			steps := 0
+			for k := range p {
+				steps += int(math.Abs(float64(p[k]) - float64(q[k])))
+			}
+			if steps != 1 {
+				t.Fatalf("hilbertIndex with %v bits jumps from %v to %v at %v", bits, p, q, h)
+			}
+		}
+	}
+}
+
+// treeWeight returns the sum of the distances of the edges of a tree, and
+// whether it spans all the points.
+func treeWeight(points [][3]float64, metric DistanceFunc, tree []edgeIdxs) (float64, bool) {
+	w := 0.0
+	for _, uv := range tree {
+		w += pairDistance(points, metric, uv[0], uv[1])
+	}
+	return w, len(tree) == len(points)-1 && len(traverseMST(len(points), tree, 0)) == len(points)
+}
+
+// TestMinSpanTree tests Prim's algorithm against Kruskal's on all edges, and
+// that the approximate tree is close to it.
+func TestMinSpanTree(t *testing.T) {
+	cs := randomColors(300)
+	metric := MetricCIEDE2000
+	points := make([][3]float64, len(cs))
+	for i, c := range cs {
+		points[i] = metric.convert(c)
+	}
+
+	var es []weightedEdge
+	dists := DistanceMatrix(cs, metric)
+	for u := range cs {
+		for v := u + 1; v < len(cs); v++ {
+			es = append(es, weightedEdge{edgeIdxs{u, v}, dists[u][v]})
+		}
+	}
+	sort.Slice(es, func(i, j int) bool { return es[i].dist < es[j].dist })
+	elts := make([]element, len(cs))
+	for i := range elts {
+		elts[i].parent = &elts[i]
+	}
+	exp := 0.0
+	for _, e := range es {
+		if elts[e.uv[0]].find() != elts[e.uv[1]].find() {
+			exp += e.dist
+			union(&elts[e.uv[0]], &elts[e.uv[1]])
+		}
+	}
+
+	if w, ok := treeWeight(points, metric, primMST(points, metric)); !ok || !almosteq_eps(w, exp, 1e-9) {
+		t.Errorf("primMST has weight %v and spans: %v, want %v", w, ok, exp)
+	}
+	if w, ok := treeWeight(points, metric, approxMST(cs, points, metric)); !ok || w > 1.01*exp {
+		t.Errorf("approxMST has weight %v and spans: %v, want about %v", w, ok, exp)
+	}
+
+	// Two clusters far apart, which the nearest neighbors do not connect.
+	cs = cs[:0]
+	for i := 0; i < 40; i++ {
+		v := float64(i) / 1000.0
+		cs = append(cs, Color{v, v, v}, Color{1.0 - v, 1.0 - v, 1.0 - v})
+	}
+	points = points[:len(cs)]
+	for i, c := range cs {
+		points[i] = metric.convert(c)
+	}
+	if _, ok := treeWeight(points, metric, approxMST(cs, points, metric)); !ok {
+		t.Errorf("approxMST of two clusters does not span them")
+	}
+}
+
+// TestKdTree tests the nearest neighbors of the k-d tree against all points.
+func TestKdTree(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	points := make([][3]float64, 500)
+	for i := range points {
+		points[i] = [3]float64{rnd.Float64(), rnd.Float64(), math.Floor(rnd.Float64() * 4.0)}
+	}
+	kd := newKdTree(points)
+	dist := func(u, v int) float64 {
+		return euclideanDistance(points[u], points[v])
+	}
+	for u := range points {
+		nearest := kd.nearest(u, 5)
+		if len(nearest) != 5 {
+			t.Fatalf("kdTree.nearest(%v) => %v, want 5 points", u, nearest)
+		}
+		farthest := dist(u, nearest[len(nearest)-1])
+		closer := 0
+		for v := range points {
+			if v != u && dist(u, v) < farthest {
+				closer++
+			}
+		}
+		if closer > len(nearest)-1 {
+			t.Errorf("kdTree.nearest(%v) => %v, but %v points are closer than the last", u, nearest, closer)
+		}
+		for i := 1; i < len(nearest); i++ {
+			if dist(u, nearest[i-1]) > dist(u, nearest[i]) || nearest[i] == u {
+				t.Errorf("kdTree.nearest(%v) => %v, not in order", u, nearest)
+			}
+		}
+	}
+}
+
+// TestTraverseMSTDeep tests that a tree deeper than any call stack is fine.
+func TestTraverseMSTDeep(t *testing.T) {
+	const n = 1000000
+	tree := make([]edgeIdxs, n-1)
+	for i := range tree {
+		tree[i] = edgeIdxs{i + 1, i}
+	}
+	order := traverseMST(n, tree, 0)
+	for i, v := range order {
+		if i != v {
+			t.Fatalf("traverseMST of a path visits %v at %v", v, i)
+		}
+	}
+}
+
+// TestSortedLarge tests that many colors are sorted via the approximate tree.
+func TestSortedLarge(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	in := randomColors(4 * exactMSTLimit)
+	out := Sorted(in)
+	count := make(map[Color]int)
+	for _, c := range in {
+		count[c]++
+	}
+	for _, c := range out {
+		count[c]--
+	}
+	for c, n := range count {
+		if n != 0 {
+			t.Fatalf("Sorted changed the count of %v by %v", c, -n)
+		}
+	}
+	if 2.0*pathLength(out) > pathLength(in) {
+		t.Errorf("Sorted path has length %v, the input %v", pathLength(out), pathLength(in))
+	}
+}
+
+func BenchmarkSorted(b *testing.B) {
+	for _, n := range []int{1000, 100000} {
+		cs := randomColors(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Sorted(cs)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package colorful
+
+import "math"
+
+// Spectral colorimetry: computing the color of emission and reflectance
+// spectra as seen by the CIE standard observers.
+// The data tables are in spectral_data.go.
+
+// A Spectrum holds the values of a spectral power distribution, or a
+// reflectance or transmittance in [0..1], at the given wavelengths in nm.
+// The wavelengths must be strictly increasing, but need not be equally spaced.
+type Spectrum struct {
+	Wavelengths []float64
+	Values      []float64
+}
+
+// NewSpectrum creates a Spectrum from values sampled at equally spaced
+// wavelengths, as most instruments and data tables provide them.
+func NewSpectrum(start, step float64, values []float64) Spectrum {
+	wavelengths := make([]float64, len(values))
+	for i := range wavelengths {
+		wavelengths[i] = start + step*float64(i)
+	}
+	return Spectrum{Wavelengths: wavelengths, Values: values}
+}
+
+// At returns the value of the spectrum at the given wavelength in nm, which is
+// linearly interpolated between the samples. Outside of the sampled range,
+// the nearest sample is used, as recommended by CIE 15.
+func (s Spectrum) At(wavelength float64) float64 {
+	n := len(s.Wavelengths)
+	if n == 0 {
+		return 0.0
+	}
+	if wavelength <= s.Wavelengths[0] {
+		return s.Values[0]
+	}
+	if wavelength >= s.Wavelengths[n-1] {
+		return s.Values[n-1]
+	}
+
+	// Binary search for the first sample above the wavelength.
+	lo, hi := 0, n-1
+	for hi-lo > 1 {
+		mid := (lo + hi) / 2
+		if s.Wavelengths[mid] <= wavelength {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	t := (wavelength - s.Wavelengths[lo]) / (s.Wavelengths[hi] - s.Wavelengths[lo])
+	return s.Values[lo] + t*(s.Values[hi]-s.Values[lo])
+}
+
+// Resample returns the spectrum sampled from start to end, inclusive, in
+// steps of step nm, using At.
+func (s Spectrum) Resample(start, end, step float64) Spectrum {
+	n := int(math.Floor((end-start)/step+1e-9)) + 1
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = s.At(start + step*float64(i))
+	}
+	return NewSpectrum(start, step, values)
+}
+
+// An Observer holds the color matching functions x̄, ȳ and z̄ of a standard observer.
+type Observer struct {
+	X, Y, Z Spectrum
+}
+
+func observerFromTable(table [][3]float64) Observer {
+	x := make([]float64, len(table))
+	y := make([]float64, len(table))
+	z := make([]float64, len(table))
+	for i, row := range table {
+		x[i], y[i], z[i] = row[0], row[1], row[2]
+	}
+	return Observer{NewSpectrum(380, 5, x), NewSpectrum(380, 5, y), NewSpectrum(380, 5, z)}
+}
+
+// The CIE standard observers, from 380 nm to 780 nm in 5 nm steps. The 1931
+// 2° observer is the one underlying XYZ, and thus all colors, in this library.
+// The 1964 10° observer is meant for larger fields of view.
+var (
+	CIE1931 = observerFromTable(cie1931Table[:])
+	CIE1964 = observerFromTable(cie1964Table[:])
+)
+
+// integrate returns the unnormalized tristimulus values of the spectrum, or of
+// the product of the spectrum and the illuminant if it is not nil.
+func (s Spectrum) integrate(illuminant *Spectrum, observer Observer) (x, y, z float64) {
+	ws := observer.Y.Wavelengths
+	for i, w := range ws {
+		// The width of the band around the sample.
+		var dw float64
+		if i > 0 {
+			dw += (w - ws[i-1]) / 2.0
+		}
+		if i < len(ws)-1 {
+			dw += (ws[i+1] - w) / 2.0
+		}
+
+		v := s.At(w) * dw
+		if illuminant != nil {
+			v *= illuminant.At(w)
+		}
+		x += v * observer.X.Values[i]
+		y += v * observer.Y.Values[i]
+		z += v * observer.Z.Values[i]
+	}
+	return
+}
+
+// Xyz returns the CIE XYZ of the emission spectrum seen by the given observer,
+// normalized so that Y is 1. Only the chromaticity of a light source matters
+// in colorimetry, so this can be used to get the reference white of any
+// illuminant, for example for use with the *WhiteRef functions.
+func (s Spectrum) Xyz(observer Observer) (x, y, z float64) {
+	x, y, z = s.integrate(nil, observer)
+	return x / y, 1.0, z / y
+}
+
+// WhiteRef returns the reference white of the emission spectrum seen by the
+// given observer, that is Xyz as an array.
+func (s Spectrum) WhiteRef(observer Observer) [3]float64 {
+	x, y, z := s.Xyz(observer)
+	return [3]float64{x, y, z}
+}
+
+// ReflectanceXyz returns the CIE XYZ of the reflectance spectrum with values
+// in [0..1] lit by the given illuminant, seen by the given observer. It is
+// relative to the white of the illuminant, so that a perfect reflector has a Y of 1.
+func (s Spectrum) ReflectanceXyz(illuminant Spectrum, observer Observer) (x, y, z float64) {
+	x, y, z = s.integrate(&illuminant, observer)
+	_, yn, _ := illuminant.integrate(nil, observer)
+	return x / yn, y / yn, z / yn
+}
+
+// ReflectanceColor returns the color of the reflectance spectrum with values
+// in [0..1] in daylight, that is lit by SpectrumD65.
+func (s Spectrum) ReflectanceColor() Color {
+	return s.ReflectanceColorUnder(SpectrumD65, Bradford)
+}
+
+// ReflectanceColorUnder returns the color of the reflectance spectrum with
+// values in [0..1] lit by the given illuminant, as it appears to an observer
+// adapted to that illuminant. The adaptation to the D65 white of sRGB is done
+// using the given method, for example Bradford, so that a perfect reflector
+// is exactly white.
+func (s Spectrum) ReflectanceColorUnder(illuminant Spectrum, method ChromaticAdaptation) Color {
+	x, y, z := s.ReflectanceXyz(illuminant, CIE1931)
+	return Xyz(Adapt(x, y, z, illuminant.WhiteRef(CIE1931), srgbWhite, method))
+}
+
+// EmissionColor returns the brightest color with the chromaticity of the
+// emission spectrum, like Kelvin does for blackbodies.
+// WARNING: the light of narrow spectra, like that of lasers and most LEDs, is
+// outside of sRGB, so this returns an invalid color for them, use Clamped if needed.
+func (s Spectrum) EmissionColor() Color {
+	x, y, z := s.Xyz(CIE1931)
+	xc, yc, _ := XyzToXyy(x, y, z)
+	return brightestWithChromaticity(xc, yc)
+}
+
+// planck returns the spectral radiance of a blackbody at the given wavelength
+// in nm and temperature in K, relative to that at 560 nm, using the given
+// second radiation constant c2 in m·K.
+func planck(wavelength, t, c2 float64) float64 {
+	l := wavelength * 1e-9
+	return math.Pow(560e-9/l, 5.0) * math.Expm1(c2/(560e-9*t)) / math.Expm1(c2/(l*t))
+}
+
+// BlackbodySpectrum returns the spectral power distribution of a blackbody of
+// the given temperature in K, from 300 nm to 830 nm in 5 nm steps and
+// normalized to 100 at 560 nm like the CIE illuminants.
+func BlackbodySpectrum(t float64) Spectrum {
+	values := make([]float64, 107)
+	for i := range values {
+		values[i] = 100.0 * planck(300.0+5.0*float64(i), t, 1.4388e-2)
+	}
+	return NewSpectrum(300, 5, values)
+}
+
+// DaylightSpectrum returns the spectral power distribution of the CIE daylight
+// illuminant of the given correlated color temperature in K, in
+// [4000..25000], from 300 nm to 830 nm in 10 nm steps.
+func DaylightSpectrum(t float64) Spectrum {
+	x, y := DaylightXy(t)
+	d := 0.0241 + 0.2562*x - 0.7341*y
+	// CIE 15 rounds these to three decimals.
+	m1 := math.Round((-1.3515-1.7703*x+5.9114*y)/d*1000.0) / 1000.0
+	m2 := math.Round((0.0300-31.4424*x+30.0717*y)/d*1000.0) / 1000.0
+
+	values := make([]float64, len(daylightTable))
+	for i, s := range daylightTable {
+		values[i] = s[0] + m1*s[1] + m2*s[2]
+	}
+	return NewSpectrum(300, 10, values)
+}
+
+// The spectra of some CIE standard illuminants.
+var (
+	// SpectrumA is incandescent light, defined as a blackbody of 2856 K.
+	SpectrumA = func() Spectrum {
+		values := make([]float64, 531)
+		for i := range values {
+			// The definition uses the old values of c2 and thus 2848 K.
+			values[i] = 100.0 * planck(300.0+float64(i), 2848.0, 1.435e-2)
+		}
+		return NewSpectrum(300, 1, values)
+	}()
+
+	// SpectrumD50 and SpectrumD65 are daylight with a correlated color
+	// temperature of 5003 K and 6504 K, which were 5000 K and 6500 K before a
+	// revision of c2.
+	SpectrumD50 = DaylightSpectrum(5000.0 * 1.4388 / 1.438)
+	SpectrumD65 = DaylightSpectrum(6500.0 * 1.4388 / 1.438)
+
+	// SpectrumE has equal energy at all wavelengths.
+	SpectrumE = NewSpectrum(300, 530, []float64{100.0, 100.0})
+)
@@ -0,0 +1,96 @@
+package colorful
+
+import (
+	"testing"
+)
+
+func TestSpectrumAt(t *testing.T) {
+	s := Spectrum{Wavelengths: []float64{400, 500, 700}, Values: []float64{1.0, 3.0, 2.0}}
+	tests := []struct {
+		wavelength, want float64
+	}{
+		{300, 1.0}, {400, 1.0}, {450, 2.0}, {500, 3.0}, {650, 2.25}, {700, 2.0}, {800, 2.0},
+	}
+	for _, tt := range tests {
+		if got := s.At(tt.wavelength); !almosteq(got, tt.want) {
+			t.Errorf("At(%v) => %v, want %v", tt.wavelength, got, tt.want)
+		}
+	}
+
+	r := s.Resample(400, 700, 50)
+	if len(r.Values) != 7 || r.Wavelengths[6] != 700 || !almosteq(r.Values[1], 2.0) || !almosteq(r.Values[5], 2.25) {
+		t.Errorf("Resample(400, 700, 50) => %v", r)
+	}
+}
+
+func TestIlluminantSpectra(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        Spectrum
+		observer Observer
+		want     [3]float64
+	}{
+		{"D65 2°", SpectrumD65, CIE1931, D65},
+		{"D50 2°", SpectrumD50, CIE1931, D50},
+		{"A 2°", SpectrumA, CIE1931, Illuminants["A"]},
+		{"E 2°", SpectrumE, CIE1931, Illuminants["E"]},
+		{"D65 10°", SpectrumD65, CIE1964, Illuminants10["D65"]},
+		{"A 10°", SpectrumA, CIE1964, Illuminants10["A"]},
+	}
+	for _, tt := range tests {
+		w := tt.s.WhiteRef(tt.observer)
+		if !almosteq_eps(w[0], tt.want[0], 5e-4) || w[1] != 1.0 || !almosteq_eps(w[2], tt.want[2], 5e-4) {
+			t.Errorf("%v WhiteRef => %v, want %v", tt.name, w, tt.want)
+		}
+	}
+}
+
+func TestSpectralLocus(t *testing.T) {
+	// Reference chromaticities of monochromatic light from CIE 15.
+	tests := []struct {
+		wavelength, x, y float64
+	}{
+		{450, 0.1566, 0.0177},
+		{520, 0.0743, 0.8338},
+		{580, 0.5125, 0.4866},
+	}
+	for _, tt := range tests {
+		s := Spectrum{Wavelengths: []float64{tt.wavelength - 1, tt.wavelength, tt.wavelength + 1}, Values: []float64{0, 1, 0}}
+		x, y, _ := XyzToXyy(s.Xyz(CIE1931))
+		if !almosteq_eps(x, tt.x, 1e-3) || !almosteq_eps(y, tt.y, 1e-3) {
+			t.Errorf("xy of %v nm => (%v, %v), want (%v, %v)", tt.wavelength, x, y, tt.x, tt.y)
+		}
+	}
+}
+
+func TestReflectanceColor(t *testing.T) {
+	white := NewSpectrum(380, 400, []float64{1.0, 1.0})
+	gray := NewSpectrum(380, 400, []float64{0.2, 0.2})
+	for _, illuminant := range []Spectrum{SpectrumD65, SpectrumA, SpectrumE} {
+		if c := white.ReflectanceColorUnder(illuminant, Bradford); !c.AlmostEqualRgb(Color{1.0, 1.0, 1.0}) {
+			t.Errorf("white.ReflectanceColorUnder() => %v, want white", c)
+		}
+		if _, y, _ := gray.ReflectanceXyz(illuminant, CIE1931); !almosteq(y, 0.2) {
+			t.Errorf("gray.ReflectanceXyz() => Y %v, want 0.2", y)
+		}
+	}
+
+	// A reflectance reflecting only long wavelengths is red.
+	red := Spectrum{Wavelengths: []float64{580, 620}, Values: []float64{0.0, 1.0}}
+	if c := red.ReflectanceColor(); c.R < 0.9 || c.G > 0.5 || c.B > 0.2 {
+		t.Errorf("red.ReflectanceColor() => %v, want red", c)
+	}
+}
+
+func TestBlackbodySpectrum(t *testing.T) {
+	for _, temp := range []float64{2000, 2856, 4000, 6500, 10000} {
+		x, y, _ := XyzToXyy(BlackbodySpectrum(temp).Xyz(CIE1931))
+		px, py := PlanckianXy(temp)
+		if !almosteq_eps(x, px, 5e-4) || !almosteq_eps(y, py, 5e-4) {
+			t.Errorf("xy of BlackbodySpectrum(%v) => (%v, %v), want (%v, %v)", temp, x, y, px, py)
+		}
+		if c := BlackbodySpectrum(temp).EmissionColor(); !c.AlmostEqualRgb(Kelvin(temp)) {
+			t.Errorf("BlackbodySpectrum(%v).EmissionColor() => %v, want %v", temp, c, Kelvin(temp))
+		}
+	}
+}
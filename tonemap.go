@@ -0,0 +1,174 @@
+package colorful
+
+import "math"
+
+// Tone mapping operators, which compress HDR colors, whose linear RGB values
+// exceed 1, into the range of a display, more gracefully than Clamped does.
+// They work on linear RGB in which 1 is diffuse white, as in ToneMapped.
+
+// A ToneMap maps linear RGB values in [0..∞) to linear RGB values in [0..1].
+// All the ToneMap* functions are ToneMaps or return one.
+type ToneMap func(r, g, b float64) (rt, gt, bt float64)
+
+// ToneMapped returns the color tone mapped with the given operator, for
+// example ToneMapped(ToneMapAgx). Negative values, which are out of gamut,
+// are clipped to 0 first.
+func (col Color) ToneMapped(tm ToneMap) Color {
+	r, g, b := col.LinearRgb()
+	return LinearRgb(tm(math.Max(r, 0.0), math.Max(g, 0.0), math.Max(b, 0.0)))
+}
+
+// ToneMapHuePreserving returns a variant of the tone map which maps the
+// largest of the values and scales the others by the same factor, which keeps
+// their ratios and thus the hue and saturation. Without it, bright colors
+// shift in hue and desaturate towards white, which is often desired, but
+// not for example for colored lights.
+func ToneMapHuePreserving(tm ToneMap) ToneMap {
+	return func(r, g, b float64) (rt, gt, bt float64) {
+		m := math.Max(r, math.Max(g, b))
+		if m <= 0.0 {
+			return 0.0, 0.0, 0.0
+		}
+		mr, mg, mb := tm(m, m, m)
+		s := math.Max(mr, math.Max(mg, mb)) / m
+		return r * s, g * s, b * s
+	}
+}
+
+// perChannel makes a ToneMap of a curve which is applied to each channel.
+func perChannel(curve func(v float64) float64) ToneMap {
+	return func(r, g, b float64) (rt, gt, bt float64) {
+		return curve(r), curve(g), curve(b)
+	}
+}
+
+/// Reinhard ///
+////////////////
+// https://doi.org/10.1145/566654.566575
+
+// ToneMapReinhard maps v to v / (1 + v), which never reaches 1.
+func ToneMapReinhard(r, g, b float64) (rt, gt, bt float64) {
+	return perChannel(func(v float64) float64 {
+		return v / (1.0 + v)
+	})(r, g, b)
+}
+
+// ToneMapReinhardExtended returns the extended Reinhard operator, which maps
+// the given white, the smallest value to become 1, to 1.
+func ToneMapReinhardExtended(white float64) ToneMap {
+	return perChannel(func(v float64) float64 {
+		return math.Min(v*(1.0+v/(white*white))/(1.0+v), 1.0)
+	})
+}
+
+/// Hable ///
+/////////////
+// The filmic curve by John Hable used in Uncharted 2, with its original
+// parameters and an exposure bias of 2.
+// http://filmicworlds.com/blog/filmic-tonemapping-operators/
+
+func hable(v float64) float64 {
+	const a, b, c, d, e, f = 0.15, 0.50, 0.10, 0.20, 0.02, 0.30
+	return (v*(a*v+c*b)+d*e)/(v*(a*v+b)+d*f) - e/f
+}
+
+// ToneMapHable is the filmic curve of Uncharted 2, which maps 5.6 to 1.
+func ToneMapHable(r, g, b float64) (rt, gt, bt float64) {
+	const white = 11.2
+	return perChannel(func(v float64) float64 {
+		return math.Min(hable(2.0*v)/hable(white), 1.0)
+	})(r, g, b)
+}
+
+/// ACES fitted ///
+///////////////////
+// Stephen Hill's fit of the ACES reference rendering transform and sRGB
+// output transform, including their conversions from and to sRGB.
+// https://github.com/TheRealMJP/BakingLab/blob/master/BakingLab/ACES.hlsl
+
+// ToneMapAcesFitted is the filmic look of ACES, which approaches 1 at about 16.
+func ToneMapAcesFitted(r, g, b float64) (rt, gt, bt float64) {
+	input := [3][3]float64{
+		{0.59719, 0.35458, 0.04823},
+		{0.07600, 0.90834, 0.01566},
+		{0.02840, 0.13383, 0.83777},
+	}
+	output := [3][3]float64{
+		{1.60475, -0.53108, -0.07367},
+		{-0.10208, 1.10813, -0.00605},
+		{-0.00327, -0.07276, 1.07602},
+	}
+	fit := func(v float64) float64 {
+		return (v*(v+0.0245786) - 0.000090537) / (v*(0.983729*v+0.4329510) + 0.238081)
+	}
+
+	r, g, b = mulMat3Vec(input, r, g, b)
+	rt, gt, bt = mulMat3Vec(output, fit(r), fit(g), fit(b))
+	return clamp01(rt), clamp01(gt), clamp01(bt)
+}
+
+/// AgX ///
+///////////
+// Troy Sobotka's AgX, as in Blender, with the polynomial approximation of its
+// sigmoid by Benjamin Wrensch, as used by three.js. It works in Rec. 2020 and
+// desaturates bright colors smoothly towards white.
+// https://iolite-engine.com/blog_posts/minimal_agx_implementation
+
+// ToneMapAgx is the AgX base look, which approaches 1 at about 16.
+func ToneMapAgx(r, g, b float64) (rt, gt, bt float64) {
+	inset := [3][3]float64{
+		{0.856627153315983, 0.0951212405381588, 0.0482516061458583},
+		{0.137318972929847, 0.761241990602591, 0.101439036467562},
+		{0.11189821299995, 0.0767994186031903, 0.811302368396859},
+	}
+	outset := [3][3]float64{
+		{1.1271005818144368, -0.11060664309660323, -0.016493938717834573},
+		{-0.1413297634984383, 1.157823702216272, -0.016493938717834257},
+		{-0.14132976349843826, -0.11060664309660294, 1.2519364065950405},
+	}
+	const minEv, maxEv = -12.47393, 4.026069
+	sigmoid := func(v float64) float64 {
+		v = clamp01((math.Log2(math.Max(v, 1e-10)) - minEv) / (maxEv - minEv))
+		v2 := v * v
+		v4 := v2 * v2
+		return 15.5*v4*v2 - 40.14*v4*v + 31.96*v4 - 6.868*v2*v + 0.4298*v2 + 0.1191*v - 0.00232
+	}
+	display := func(v float64) float64 {
+		return math.Pow(math.Max(v, 0.0), 2.2)
+	}
+
+	r, g, b = XyzToLinearRec2020(LinearRgbToXyz(r, g, b))
+	r, g, b = mulMat3Vec(inset, r, g, b)
+	r, g, b = mulMat3Vec(outset, sigmoid(r), sigmoid(g), sigmoid(b))
+	rt, gt, bt = XyzToLinearRgb(LinearRec2020ToXyz(display(r), display(g), display(b)))
+	return clamp01(rt), clamp01(gt), clamp01(bt)
+}
+
+/// BT.2390 ///
+///////////////
+// The EETF of ITU-R BT.2390, which maps the luminances of HDR content mastered
+// up to one peak luminance to a display of a lower one. It works on PQ
+// signals, leaving darker parts untouched and rolling off the highlights.
+// https://www.itu.int/pub/R-REP-BT.2390
+
+// ToneMapBt2390 returns the EETF for content of the given peak luminance in
+// cd/m² shown on a display of the given peak luminance, for example 1000 and
+// 203 cd/m² for HDR content on an SDR display. The input is relative to
+// HdrReferenceWhite as everywhere in this library, but the output is relative
+// to the peak of the display.
+func ToneMapBt2390(sourcePeak, targetPeak float64) ToneMap {
+	sourceMax := PqEncode(sourcePeak)
+	maxLum := PqEncode(targetPeak) / sourceMax
+	ks := 1.5*maxLum - 0.5
+
+	return perChannel(func(v float64) float64 {
+		e := math.Min(PqEncode(v*HdrReferenceWhite)/sourceMax, 1.0)
+		if e > ks {
+			// The Hermite spline of the roll-off.
+			t := (e - ks) / (1.0 - ks)
+			t2, t3 := t*t, t*t*t
+			e = (2.0*t3-3.0*t2+1.0)*ks + (t3-2.0*t2+t)*(1.0-ks) + (-2.0*t3+3.0*t2)*maxLum
+		}
+		return math.Min(PqDecode(e*sourceMax)/targetPeak, 1.0)
+	})
+}
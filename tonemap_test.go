@@ -0,0 +1,57 @@
+package colorful
+
+import (
+	"testing"
+)
+
+func TestToneMaps(t *testing.T) {
+	tests := []struct {
+		name string
+		tm   ToneMap
+		// The mapping of 18% gray and of 1.
+		gray, white float64
+	}{
+		{"Reinhard", ToneMapReinhard, 0.15254, 0.5},
+		{"ReinhardExtended(4)", ToneMapReinhardExtended(4.0), 0.15426, 0.53125},
+		{"Hable", ToneMapHable, 0.12834, 0.49291},
+		{"AcesFitted", ToneMapAcesFitted, 0.10559, 0.61906},
+		{"Agx", ToneMapAgx, 0.21450, 0.59016},
+		{"Bt2390(1000, 203)", ToneMapBt2390(1000.0, 203.0), 0.18, 0.78353},
+	}
+	for _, tt := range tests {
+		if r, g, b := tt.tm(0.18, 0.18, 0.18); !almosteq_eps(r, tt.gray, 1e-4) || !almosteq(r, g) || !almosteq(g, b) {
+			t.Errorf("ToneMap%v(0.18) => (%v, %v, %v), want %v", tt.name, r, g, b, tt.gray)
+		}
+		if r, _, _ := tt.tm(1.0, 1.0, 1.0); !almosteq_eps(r, tt.white, 1e-4) {
+			t.Errorf("ToneMap%v(1) => %v, want %v", tt.name, r, tt.white)
+		}
+
+		// All values end up in [0..1] and are increasing.
+		prev := -1.0
+		for v := 0.0; v < 1000.0; v = v*1.5 + 0.01 {
+			r, _, _ := tt.tm(v, v, v)
+			if r < 0.0 || r > 1.0 || r < prev {
+				t.Errorf("ToneMap%v(%v) => %v, after %v", tt.name, v, r, prev)
+			}
+			prev = r
+		}
+
+		// The hue preserving variant keeps the ratios of the values.
+		r, g, b := ToneMapHuePreserving(tt.tm)(4.0, 1.0, 0.5)
+		if !almosteq(r/g, 4.0) || !almosteq(g/b, 2.0) || r > 1.0 {
+			t.Errorf("ToneMapHuePreserving(ToneMap%v)(4, 1, 0.5) => (%v, %v, %v)", tt.name, r, g, b)
+		}
+	}
+}
+
+func TestToneMapped(t *testing.T) {
+	bright := LinearRgb(8.0, 4.0, -0.1)
+	if c := bright.ToneMapped(ToneMapReinhard); !c.IsValid() || !c.AlmostEqualRgb(LinearRgb(8.0/9.0, 0.8, 0.0)) {
+		t.Errorf("%v.ToneMapped(ToneMapReinhard) => %v", bright, c)
+	}
+	for _, tm := range []ToneMap{ToneMapHable, ToneMapAcesFitted, ToneMapAgx, ToneMapHuePreserving(ToneMapAgx)} {
+		if c := bright.ToneMapped(tm); !c.IsValid() {
+			t.Errorf("%v.ToneMapped() => %v, want a valid color", bright, c)
+		}
+	}
+}
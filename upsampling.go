@@ -0,0 +1,245 @@
+package colorful
+
+import (
+	"math"
+)
+
+// Spectral upsampling: finding a plausible reflectance spectrum of a color,
+// for example for physically based rendering or for mixing paints. Both
+// methods return spectra whose ReflectanceColor is the original color.
+
+// The reflectance spectra of Smits (1999), in ten bins from 380 nm to 720 nm.
+// https://doi.org/10.1080/10867651.1999.10487511
+var (
+	smitsWhite   = [10]float64{1.0000, 1.0000, 0.9999, 0.9993, 0.9992, 0.9998, 1.0000, 1.0000, 1.0000, 1.0000}
+	smitsCyan    = [10]float64{0.9710, 0.9426, 1.0007, 1.0007, 1.0007, 1.0007, 0.1564, 0.0000, 0.0000, 0.0000}
+	smitsMagenta = [10]float64{1.0000, 1.0000, 0.9685, 0.2229, 0.0000, 0.0458, 0.8369, 1.0000, 1.0000, 0.9959}
+	smitsYellow  = [10]float64{0.0001, 0.0000, 0.1088, 0.6651, 1.0000, 1.0000, 0.9996, 0.9586, 0.9685, 0.9840}
+	smitsRed     = [10]float64{0.1012, 0.0515, 0.0000, 0.0000, 0.0000, 0.0000, 0.8325, 1.0149, 1.0149, 1.0149}
+	smitsGreen   = [10]float64{0.0000, 0.0000, 0.0273, 0.7937, 1.0000, 0.9418, 0.1719, 0.0000, 0.0000, 0.0025}
+	smitsBlue    = [10]float64{1.0000, 1.0000, 0.8916, 0.3323, 0.0000, 0.0000, 0.0003, 0.0369, 0.0483, 0.0496}
+)
+
+func smitsSpectrum(values [10]float64) Spectrum {
+	// The samples are at the centers of the bins.
+	return NewSpectrum(397, 34, values[:])
+}
+
+// reflectanceLinearRgb returns the linear RGB of the reflectance spectrum, as
+// used by ReflectanceColor.
+func reflectanceLinearRgb(s Spectrum) (r, g, b float64) {
+	return s.ReflectanceColor().LinearRgb()
+}
+
+// SmitsSpectrum returns a reflectance spectrum of the color using the method
+// of Smits (1999), which mixes white with one secondary and one primary
+// spectrum, all of them piecewise linear in ten bins from 380 nm to 720 nm.
+// The weights of the mix are solved for, so that the spectrum is exactly the
+// color again, rather than Smits' original approximation.
+// For saturated colors, the spectrum slightly exceeds [0..1], use Spectrum
+// if that matters.
+func (col Color) SmitsSpectrum() Spectrum {
+	r, g, b := col.LinearRgb()
+
+	var secondary, primary [10]float64
+	switch {
+	case r <= g && r <= b:
+		secondary = smitsCyan
+		if g <= b {
+			primary = smitsBlue
+		} else {
+			primary = smitsGreen
+		}
+	case g <= r && g <= b:
+		secondary = smitsMagenta
+		if r <= b {
+			primary = smitsBlue
+		} else {
+			primary = smitsRed
+		}
+	default:
+		secondary = smitsYellow
+		if r <= g {
+			primary = smitsGreen
+		} else {
+			primary = smitsRed
+		}
+	}
+
+	// The weights of the three spectra that make up the color.
+	var m [3][3]float64
+	for j, basis := range [3][10]float64{smitsWhite, secondary, primary} {
+		m[0][j], m[1][j], m[2][j] = reflectanceLinearRgb(smitsSpectrum(basis))
+	}
+	ww, ws, wp := mulMat3Vec(invMat3(m), r, g, b)
+
+	var values [10]float64
+	for i := range values {
+		values[i] = ww*smitsWhite[i] + ws*secondary[i] + wp*primary[i]
+	}
+	return smitsSpectrum(values)
+}
+
+// The method of Jakob and Hanika (2019) represents a reflectance spectrum as
+// a sigmoid of a quadratic polynomial of the wavelength, which is smooth and
+// always in [0..1]. The polynomial is in t, the wavelength mapped from
+// [380..780] nm to [0..1], sampled like the standard observer.
+// https://doi.org/10.1111/cgf.13642
+//
+// The table of coefficients jhTable in upsampling_data.go is generated by
+// TestJhTable, which checks that it is up to date.
+
+//go:generate go test -run TestJhTable -update-jh-table
+
+const jhResolution = 16
+
+// jhWeights holds the contribution of each sample of a reflectance spectrum
+// to its linear RGB.
+var jhWeights = func() [][3]float64 {
+	n := len(CIE1931.Y.Wavelengths)
+	weights := make([][3]float64, n)
+	for i := range weights {
+		impulse := make([]float64, n)
+		impulse[i] = 1.0
+		s := Spectrum{Wavelengths: CIE1931.Y.Wavelengths, Values: impulse}
+		weights[i][0], weights[i][1], weights[i][2] = reflectanceLinearRgb(s)
+	}
+	return weights
+}()
+
+func jhSigmoid(x float64) float64 {
+	return 0.5 + x/(2.0*math.Sqrt(1.0+x*x))
+}
+
+// jhResidual returns the linear RGB of the spectrum of the coefficients minus
+// the target, and its Jacobian with respect to the coefficients.
+func jhResidual(coeffs [3]float64, target [3]float64) (res [3]float64, jac [3][3]float64) {
+	n := float64(len(jhWeights) - 1)
+	for i, w := range jhWeights {
+		t := float64(i) / n
+		x := (coeffs[0]*t+coeffs[1])*t + coeffs[2]
+		sqrt := math.Sqrt(1.0 + x*x)
+		s := 0.5 + x/(2.0*sqrt)
+		ds := 0.5 / (sqrt * sqrt * sqrt)
+		for k := 0; k < 3; k++ {
+			res[k] += s * w[k]
+			jac[k][0] += ds * t * t * w[k]
+			jac[k][1] += ds * t * w[k]
+			jac[k][2] += ds * w[k]
+		}
+	}
+	for k := range res {
+		res[k] -= target[k]
+	}
+	return
+}
+
+// jhSolve refines the coefficients of a spectrum of the linear RGB color using
+// the Gauss-Newton method, halving steps which do not improve the fit.
+func jhSolve(target, coeffs [3]float64, iterations int) [3]float64 {
+	norm := func(v [3]float64) float64 {
+		return sq(v[0]) + sq(v[1]) + sq(v[2])
+	}
+
+	res, jac := jhResidual(coeffs, target)
+	for it := 0; it < iterations && norm(res) > 1e-28; it++ {
+		inv := invMat3(jac)
+		d0, d1, d2 := mulMat3Vec(inv, res[0], res[1], res[2])
+		if math.IsNaN(d0+d1+d2) || math.IsInf(d0+d1+d2, 0) {
+			break
+		}
+
+		improved := false
+		for step := 1.0; step > 1e-3; step /= 2.0 {
+			next := [3]float64{coeffs[0] - step*d0, coeffs[1] - step*d1, coeffs[2] - step*d2}
+			nres, njac := jhResidual(next, target)
+			if norm(nres) < norm(res) {
+				coeffs, res, jac = next, nres, njac
+				improved = true
+				break
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+	return coeffs
+}
+
+// jhScale returns the brightness of the k-th slice of the table, which are
+// denser towards black and white.
+func jhScale(k int) float64 {
+	smoothstep := func(x float64) float64 {
+		return x * x * (3.0 - 2.0*x)
+	}
+	return smoothstep(smoothstep(float64(k) / (jhResolution - 1)))
+}
+
+// jhLookup interpolates the coefficients of the linear RGB color in the table.
+func jhLookup(r, g, b float64) [3]float64 {
+	rgb := [3]float64{clamp01(r), clamp01(g), clamp01(b)}
+	l := 0
+	if rgb[1] > rgb[l] {
+		l = 1
+	}
+	if rgb[2] > rgb[l] {
+		l = 2
+	}
+	z := rgb[l]
+	if z == 0.0 {
+		return jhTable[l][0][0][0]
+	}
+	x := rgb[(l+1)%3] / z * (jhResolution - 1)
+	y := rgb[(l+2)%3] / z * (jhResolution - 1)
+
+	xi := int(math.Min(math.Floor(x), jhResolution-2))
+	yi := int(math.Min(math.Floor(y), jhResolution-2))
+	zi := 0
+	for zi < jhResolution-2 && jhScale(zi+1) <= z {
+		zi++
+	}
+	dx, dy := x-float64(xi), y-float64(yi)
+	dz := (z - jhScale(zi)) / (jhScale(zi+1) - jhScale(zi))
+
+	var coeffs [3]float64
+	for c := 0; c < 8; c++ {
+		wx, wy, wz := 1.0-dx, 1.0-dy, 1.0-dz
+		ix, iy, iz := xi, yi, zi
+		if c&1 != 0 {
+			wx, ix = dx, xi+1
+		}
+		if c&2 != 0 {
+			wy, iy = dy, yi+1
+		}
+		if c&4 != 0 {
+			wz, iz = dz, zi+1
+		}
+		for k := range coeffs {
+			coeffs[k] += wx * wy * wz * jhTable[l][iz][iy][ix][k]
+		}
+	}
+	return coeffs
+}
+
+// Spectrum returns a smooth reflectance spectrum of the color, using the
+// sigmoid-polynomial method of Jakob and Hanika (2019). The coefficients
+// interpolated from a precomputed table are refined for the color, so that
+// the spectrum is the color again. The spectrum is sampled from 380 nm to
+// 780 nm in 5 nm steps and always in [0..1]. Colors outside of sRGB can not
+// be reached and are only approximated.
+func (col Color) Spectrum() Spectrum {
+	r, g, b := col.LinearRgb()
+	if r == g && g == b {
+		r = clamp01(r)
+		return NewSpectrum(380, 400, []float64{r, r})
+	}
+
+	coeffs := jhSolve([3]float64{r, g, b}, jhLookup(r, g, b), 10)
+	n := float64(len(jhWeights) - 1)
+	values := make([]float64, len(jhWeights))
+	for i := range values {
+		t := float64(i) / n
+		values[i] = jhSigmoid((coeffs[0]*t+coeffs[1])*t + coeffs[2])
+	}
+	return NewSpectrum(380, 5, values)
+}
@@ -0,0 +1,13935 @@
+// Code generated by go test -run TestJhTable -update-jh-table. DO NOT EDIT.
+
+package colorful
+
+// The coefficients of the spectra of Jakob and Hanika (2019), indexed by the
+// largest component of the linear RGB color, the slice of its value and the
+// ratios of the other two components to it.
+var jhTable = [3][jhResolution][jhResolution][jhResolution][3]float64{
+	{
+		{
+			{
+				{-1.0812288417853035e+06, 2.0019607320701163e+06, -928996.4769990131},
+				{-1.1298360802923318e+06, 1.8871732049763906e+06, -815475.8136726702},
+				{-1.2853451220709726e+06, 1.8924325734993846e+06, -753990.5643930542},
+				{-1.4342495789969354e+06, 1.9182116699360874e+06, -713445.7893828445},
+				{-1.5598035356605845e+06, 1.9434840347584488e+06, -683532.6251942586},
+				{-1.6628007294302667e+06, 1.9639156698192498e+06, -659904.8177469419},
+				{-1.7467260071588713e+06, 1.9790054777120403e+06, -640356.8124779707},
+				{-1.815046808625511e+06, 1.9892727004454518e+06, -623643.2969826282},
+				{-1.8706850423049857e+06, 1.995453523737497e+06, -609004.3100160871},
+				{-1.915970754199628e+06, 1.9982355522184586e+06, -595942.1806976255},
+				{-1.9527688960024933e+06, 1.9982289810153828e+06, -584120.7136946653},
+				{-1.982557510558494e+06, 1.995937709436194e+06, -573300.4002746722},
+				{-2.0065252034165235e+06, 1.9917826188255008e+06, -563307.3627868048},
+				{-2.025624033694894e+06, 1.9861028666338418e+06, -554009.4718706005},
+				{-2.0406399618153456e+06, 1.9791884566553065e+06, -545307.7714755125},
+				{-2.0522016475450792e+06, 1.9712637662213598e+06, -537121.3683259925},
+			},
+			{
+				{343787.12093370804, -79567.76174401914, -273058.8007621232},
+				{45218.79518950675, 268000.16355995054, -316406.5432175773},
+				{-96435.48704879844, 427404.1022351742, -331797.76701703557},
+				{-180071.4406479099, 516458.17360201565, -337009.50856133265},
+				{-305016.3922498713, 618861.2473920957, -344243.95811357425},
+				{-500577.95735710044, 762395.3505680051, -357811.2022220524},
+				{-671058.4711125416, 883974.5322671703, -368848.4651480517},
+				{-816791.5363447357, 985496.6898193904, -377548.379188986},
+				{-941128.0483830259, 1.0702958879694203e+06, -384314.14443116845},
+				{-1.047438858013778e+06, 1.1413516269398495e+06, -389510.34964098956},
+				{-1.138639191748222e+06, 1.201107213519935e+06, -393435.8279576544},
+				{-1.2171444345080967e+06, 1.2515211731249555e+06, -396328.8338420011},
+				{-1.2849418543377477e+06, 1.2941674619282447e+06, -398380.0946376033},
+				{-1.3436544995675539e+06, 1.3303066946394169e+06, -399740.5833132075},
+				{-1.3946227145679896e+06, 1.360964660165429e+06, -400532.6237509523},
+				{-1.438949466718162e+06, 1.3869734022028567e+06, -400853.99517885124},
+			},
+			{
+				{484859.2176218962, -429244.717192972, -145676.42566878936},
+				{45463.659900592844, 21840.077505041678, -206939.52234440367},
+				{93897.97397431207, 84495.30588866962, -216234.09394721466},
+				{69386.27414340354, 153161.1947559811, -224173.0027603233},
+				{-5184.516478748934, 236895.6853500639, -233429.5088268155},
+				{-62480.510459748606, 298231.9002037474, -239354.855144486},
+				{-225348.4089871193, 424133.65990300814, -254094.70477377684},
+				{-374596.14191367273, 536717.3382642095, -267142.0639154281},
+				{-506161.85447588767, 634399.5699430783, -278264.06234235736},
+				{-621623.7662353346, 719007.1542280505, -287693.8058115078},
+				{-723007.7564973342, 792436.7231193944, -295677.6242837194},
+				{-812219.3206142207, 856354.4411423433, -302433.59917674656},
+				{-890923.4120391619, 912163.3991896509, -308146.07699307415},
+				{-960544.3913207442, 961035.694858647, -312969.8403548115},
+				{-1.0222854704268781e+06, 1.0039433534777141e+06, -317033.0804975721},
+				{-1.0771678127354844e+06, 1.0417005539115462e+06, -320443.6144897272},
+			},
+			{
+				{725577.1784603256, -690317.426967046, -75255.0103836119},
+				{486250.50056337065, -377114.80402736843, -118118.39141658621},
+				{307506.20352000376, -171601.14580216413, -146016.59185994294},
+				{169795.149931709, -22968.028948258136, -165859.1582365278},
+				{100676.03222379484, 63012.51058460545, -176990.0017451087},
+				{62424.170870647635, 117391.28658704902, -183530.36985097214},
+				{17205.779327921733, 168627.484130062, -189361.30498724597},
+				{-121697.46202969775, 277214.4289889503, -202833.2468282989},
+				{-249789.39193324905, 375436.6294498413, -214962.539294881},
+				{-364300.8849468386, 462219.8335609258, -225592.22631227746},
+				{-466314.54788816377, 538810.1607066036, -234878.4682546981},
+				{-557238.1759661536, 606525.9669109209, -242990.48668812154},
+				{-638422.6762763908, 666551.4257977731, -250082.22576984178},
+				{-711073.813352498, 719907.137483176, -256287.6429506844},
+				{-776242.5882844254, 767463.0354785341, -261721.9289448073},
+				{-834833.4157627386, 809955.4080637048, -266483.0137267762},
+			},
+			{
+				{814586.9352011342, -802910.0525061042, -39277.58125565729},
+				{332259.70984748134, -358683.68216843077, -95538.80320577192},
+				{407473.85144740256, -307168.887858273, -105573.2214584622},
+				{286162.8630264709, -169637.29834396899, -124223.61118311324},
+				{210460.06444472016, -77825.65239804704, -136613.71375197833},
+				{136943.89958623773, 1852.6265956422108, -147091.69675251556},
+				{104244.93596366834, 47542.81733525185, -152875.90862359182},
+				{40805.07314548874, 108040.17718199338, -160422.73697195173},
+				{-80516.72931945762, 202888.2384944967, -172485.8587662173},
+				{-191265.88334498886, 288352.2908141995, -183331.99037321995},
+				{-291046.74260698416, 364683.9834892368, -192981.75004899828},
+				{-380788.6186223384, 432863.3964801029, -201555.33593599976},
+				{-461571.4336997175, 493878.37788491545, -209177.28184499033},
+				{-534417.4322971101, 548615.0111252281, -215961.0263927664},
+				{-600245.7544192945, 597846.5578673419, -222006.98475955328},
+				{-659859.8882846241, 642235.4488163637, -227401.76224381788},
+			},
+			{
+				{885716.0260139864, -879906.0644541257, -14993.771830129206},
+				{151839.6053167015, -301597.6262346152, -84472.96126491526},
+				{459909.0672686619, -389170.8014975289, -79168.93855374664},
+				{342981.3387547058, -255757.7939032116, -97238.70384591591},
+				{251711.73176991148, -154006.44268855394, -111031.24678587896},
+				{210688.1927313702, -94049.81925125876, -119275.92101222614},
+				{156308.90240240062, -33360.672627252054, -127313.74131753496},
+				{122234.85427857198, 9150.642707005401, -132827.70381145354},
+				{38909.31689657051, 79825.87614718074, -141904.08758306724},
+				{-67121.40924023488, 162640.67618279817, -152572.61613554397},
+				{-163716.19125922275, 237407.19948594243, -162202.2468492784},
+				{-251229.60195812758, 304710.7341601254, -170857.99081632943},
+				{-330490.1598438222, 365356.8119811083, -178637.0812028146},
+				{-402364.5515007011, 420115.84269064944, -185635.0383872879},
+				{-467660.13991712284, 469676.8364393858, -191938.92449665302},
+				{-527097.9510019685, 514640.2984991061, -197625.57994384124},
+			},
+			{
+				{873933.2004945548, -882565.2903261804, -6511.373298728363},
+				{628670.4909879301, -609828.5716382272, -38562.112614613216},
+				{295222.0002050589, -326336.62806943554, -73862.32024157367},
+				{406248.6207884168, -331196.7958615209, -75881.54137651592},
+				{134348.69925397847, -119635.49421419537, -102931.96841213282},
+				{257120.4208965941, -160732.5795690746, -98976.91562278572},
+				{199298.37053267792, -97293.9198739515, -107511.00153455746},
+				{168226.18315472623, -56087.3444405849, -113046.33611572991},
+				{126015.10964701226, -11429.937478574284, -118892.15435062266},
+				{25854.551297734026, 67661.47436949976, -129140.93514176554},
+				{-67249.81603723142, 140325.0184502913, -138576.75457865174},
+				{-152162.43236877903, 206174.88789538364, -147137.0348473723},
+				{-229454.59185312485, 265834.2494005844, -154892.64362256724},
+				{-299853.25268269586, 319969.6958295056, -161923.1844675489},
+				{-364070.220104081, 369197.4138158395, -168304.12377539155},
+				{-422756.60299873515, 414064.418547139, -174103.73796554824},
+			},
+			{
+				{839697.9822153932, -858619.0568915947, -5313.893343601214},
+				{456904.5227890479, -539808.0043779949, -35702.632180830966},
+				{434709.21963109804, -435828.98848851974, -51766.76228344629},
+				{217591.90893656056, -246530.13699684187, -75971.56834236669},
+				{277501.5074909341, -237637.09791062732, -78919.89630825716},
+				{296097.9767373693, -214286.85502024158, -82898.76887646376},
+				{242428.1165552892, -153614.31287730185, -91120.19286351302},
+				{192854.6513839861, -100368.48564431825, -98270.50448696295},
+				{169321.94414175831, -67310.81635484807, -102746.74431139887},
+				{97573.89343317007, -6353.240418468067, -110698.2397307365},
+				{8057.57624616793, 63963.90522362458, -119854.58859559215},
+				{-74156.90381636066, 128112.99394091453, -128232.37446115726},
+				{-149323.26024408935, 186499.70235500805, -135872.31819447773},
+				{-218035.92576108224, 239693.06051690126, -142839.33514851227},
+				{-280923.4316200019, 288245.9856176997, -149199.0276649447},
+				{-338575.23199889995, 332657.65227218485, -155011.93029611424},
+			},
+			{
+				{745156.9627613814, -804520.2242984816, -6122.930377241391},
+				{524793.2041178604, -595368.4986155246, -22392.794268178743},
+				{564918.3778746999, -532599.4410562089, -33460.928573767276},
+				{420059.952425107, -390740.86339351116, -51479.102206799536},
+				{346521.789932846, -302513.172771767, -63400.10039231072},
+				{320755.9876982879, -252899.98676627057, -70489.45221790849},
+				{273335.64657155634, -196379.88249483364, -78195.15414533747},
+				{233415.08354292042, -149511.2858556974, -84560.01940277377},
+				{198330.07214283492, -108954.27753534181, -90041.57340270717},
+				{153861.83323104665, -65225.56678963163, -95837.47299555456},
+				{68165.41787284389, 2501.8426902320157, -104654.39141523397},
+				{-11367.143844608225, 64854.85796827024, -112806.40980811449},
+				{-84381.29794974308, 121843.37328861191, -120282.64837718602},
+				{-151338.66451917167, 173940.5935522959, -127134.03582506593},
+				{-212790.1681854268, 221640.43255067492, -133417.02789848426},
+				{-269272.7850754453, 265401.21986151184, -139185.6476116446},
+			},
+			{
+				{526259.0212917216, -682042.8026695182, -14522.336109618704},
+				{502774.17761560535, -609605.3513347501, -10572.064077104107},
+				{469403.07073189586, -494039.2235910401, -31235.853824382182},
+				{455874.6124930287, -429331.4113001713, -40728.164290897876},
+				{310611.43729899795, -300382.2345990593, -57090.87141263298},
+				{328706.00633918983, -277143.2533385685, -61122.278165132},
+				{208798.37103675006, -176481.51347505476, -74135.66260790147},
+				{236800.6066685558, -171222.78301310923, -75518.58276646538},
+				{221301.10029724566, -142899.4628718052, -79522.52444357597},
+				{191953.986265413, -108649.8942496659, -84156.94252573262},
+				{116948.4228132905, -47852.07242172658, -92077.27419622277},
+				{40040.7880404844, 12680.150531725734, -99982.71485766748},
+				{-30856.85109037579, 68227.70316804072, -107270.88410202289},
+				{-96058.35107346962, 119160.45830856677, -113978.25176548965},
+				{-156043.4005381528, 165918.4871950561, -120153.07140243833},
+				{-211300.15883781033, 208922.15963398994, -125843.37439772137},
+			},
+			{
+				{783780.4026386933, -806925.1353219226, -2568.048786857983},
+				{645937.5446466126, -671763.4374938098, -6880.050426347263},
+				{467797.0319040889, -506461.53460241127, -24309.214944860687},
+				{497087.36447981873, -467126.9465140011, -31220.942416986363},
+				{420931.78789933486, -381224.23381441325, -42368.88946790876},
+				{361213.4830520635, -312772.4475639368, -51466.234315271126},
+				{292635.38034862257, -244513.88721228138, -60468.06467892072},
+				{275137.98380978196, -211396.94413738864, -65196.821958286055},
+				{242632.96452051477, -172785.76460595027, -70451.58733458357},
+				{211332.71485841463, -137380.4337441476, -75242.94572986693},
+				{157025.09064002248, -89669.45750066386, -81512.63219370958},
+				{82695.23245435476, -30963.159755206507, -89160.76393180243},
+				{13850.820297630678, 23143.691477418484, -96249.60961498329},
+				{-49629.01934666999, 72890.88874771276, -102798.13306379388},
+				{-108156.19224391592, 118667.94698863325, -108847.06081805754},
+				{-162174.35724849658, 160860.5401538689, -114439.03277476606},
+			},
+			{
+				{774227.9431051561, -763349.2229022998, -13780.045476349227},
+				{666502.4587042436, -678598.8611107232, -4822.709540345019},
+				{579748.6045192153, -578048.9859456184, -12816.991221896309},
+				{449358.5503004749, -451505.9146740525, -28228.798367715386},
+				{433955.10878498957, -401575.7230785523, -35428.95611682831},
+				{359227.3236014983, -325221.94423136703, -45301.620685089765},
+				{282066.6209915948, -251902.60820264329, -54865.353014727836},
+				{293775.96472227375, -237192.65991953504, -57382.684832396175},
+				{254924.77469194782, -194722.32357605177, -63098.41363032415},
+				{226963.26212247772, -161440.20935664728, -67633.98489640793},
+				{190157.80657812115, -124715.51058708884, -72535.41297964632},
+				{118461.92864038431, -67887.79817232318, -79914.42235377798},
+				{51597.50972898692, -15199.565385150952, -86800.02298394684},
+				{-10212.703636413704, 33368.072518254776, -93182.97156024154},
+				{-67311.34253123697, 78153.68448156805, -99096.57067562472},
+				{-120101.46033989376, 119511.24879324628, -104578.55415571781},
+			},
+			{
+				{746254.0170005456, -752022.2713438157, -10100.29981094677},
+				{682526.5328232085, -679589.545050516, -4542.447365979485},
+				{529748.1167249142, -559446.4115439816, -10267.732415383009},
+				{458235.53199401253, -466000.73211804696, -22804.008405679862},
+				{442747.1055249418, -417293.23927200766, -29668.32215536803},
+				{364859.0162750794, -340131.6006872766, -39489.78819168844},
+				{331554.5787504612, -294125.32217780955, -45775.506910761476},
+				{273022.2329486862, -236975.12492681373, -53269.97068741993},
+				{223074.35791148522, -188026.49493184706, -59762.222205615406},
+				{231666.33833471691, -176940.260787434, -61651.485696685355},
+				{216617.8343399604, -153656.6510870299, -64911.494892260365},
+				{148705.0178529158, -99420.37917986527, -71932.06079695828},
+				{83745.82660987128, -48117.5560706119, -78614.81238344603},
+				{23546.54891355129, -709.4213790779039, -84830.12095272951},
+				{-32163.659518897148, 43091.12703617474, -90604.02781982993},
+				{-83750.672561231, 83609.11468294643, -95969.8492764688},
+			},
+			{
+				{415675.6906527716, -623748.3760940589, -3651.8081597227006},
+				{527389.9871110264, -611748.3878095391, -3754.939835180323},
+				{499735.47134633217, -548066.8603892247, -8340.455215320217},
+				{446256.05591776146, -467384.2085147385, -19102.98359246458},
+				{458571.66506543756, -435238.73343026894, -24224.4764467485},
+				{380285.2874672855, -359012.5324474714, -33767.89696005582},
+				{324345.94598927605, -300453.835476691, -41404.892313921126},
+				{301577.1153089657, -264733.75630659517, -46374.526886790576},
+				{270071.7193007743, -227267.05043092044, -51446.94515270075},
+				{258249.021311488, -204137.94048001152, -54748.847848758116},
+				{232513.7021567999, -174506.53456068266, -58763.356268759584},
+				{174444.11097011506, -126554.05557114736, -64980.37263096918},
+				{111321.35014139523, -76600.80257299465, -71462.82613515388},
+				{52671.447661798535, -30323.511508569827, -77511.44920482159},
+				{-1695.8824693214547, 12508.59483565547, -83144.54968773603},
+				{-52110.481678883225, 52192.64197421851, -88391.23651353487},
+			},
+			{
+				{734852.1699405643, -751384.4418949122, -1858.9586803689517},
+				{525703.383124863, -604840.843275732, -4002.35254159096},
+				{597726.1903449618, -594168.8106211766, -4472.18682744895},
+				{499449.7780233612, -503012.0407829272, -12608.328669901435},
+				{441532.73832101695, -433330.71657950035, -21312.491804777535},
+				{372704.97163441544, -363131.95119102474, -30137.51892772907},
+				{358287.9822251478, -329310.50349932344, -34912.22150242806},
+				{331594.53427424876, -292082.871186823, -39965.1403895376},
+				{298199.802578326, -253859.50586888773, -45067.379127509506},
+				{266092.7772930033, -218673.70072475143, -49771.78360888389},
+				{244402.41747988667, -191602.254932215, -53469.982715758015},
+				{196454.41905116872, -150046.32814501505, -58881.20679706096},
+				{135110.85256237612, -101409.58056274951, -65166.76418325603},
+				{77949.66289194173, -56230.758318585, -71051.33523979408},
+				{24878.273842324175, -14345.063581039687, -76544.47981685045},
+				{-24399.004779609444, 24518.256789972285, -81671.39162807658},
+			},
+			{
+				{555116.486525061, -661755.1557027453, -4964.558729173279},
+				{625663.0560071521, -634602.9569584844, -6071.861427284829},
+				{580417.5849474496, -583602.8686573381, -4287.9799461428265},
+				{350353.4359697406, -431291.96965345857, -15350.145533494268},
+				{424068.8335805144, -430008.44565220457, -18843.951057286507},
+				{210598.6849250832, -277100.7685397979, -36512.27912517461},
+				{354885.14401114883, -334977.17630842875, -31402.469299829932},
+				{295752.71444826334, -279239.0921737106, -38505.02126599328},
+				{301040.34250707785, -264083.4385742815, -40961.429979196735},
+				{273886.60228140664, -232053.4061361443, -45272.77218754652},
+				{250657.5045966983, -204191.79589311808, -49051.46332917326},
+				{215328.05005381972, -170480.78130522574, -53496.55343439804},
+				{155729.58930100925, -123138.62705169056, -59588.079540780316},
+				{99998.47243817935, -79024.12095119717, -65312.27874680149},
+				{48176.339943330124, -38059.87726959419, -70667.55915655471},
+				{-0.014527250662671009, 0.008048178952171175, -75675.47335582343},
+			},
+		},
+		{
+			{
+				{-323.142837056537, 600.5848001135565, -279.15691253996295},
+				{-339.6048984092135, 567.4302879123973, -245.22121507194436},
+				{-386.4581762204705, 569.0794132701958, -226.74055566941328},
+				{-431.27777687719583, 576.8633841310029, -214.55199622943778},
+				{-469.06739351690146, 584.4895469427211, -205.56015418092383},
+				{-500.06631671832713, 590.6535116292711, -198.4572456025379},
+				{-525.3283604918138, 595.210154557874, -192.5812579666194},
+				{-545.8973630004846, 598.3163971249993, -187.55777218054084},
+				{-562.649471442243, 600.1910644283777, -183.15760621151856},
+				{-576.2885049534339, 601.044000745056, -179.23182614061096},
+				{-587.3739897468248, 601.0573526333267, -175.67897479407497},
+				{-596.3513536172665, 600.3835607131208, -172.42723615541732},
+				{-603.577263645882, 599.1484213513011, -169.4241233554478},
+				{-609.3394271990991, 597.455266298551, -166.63021693290992},
+				{-613.8717179746959, 595.3889510903605, -164.01520519269363},
+				{-617.3656781118501, 593.0192808896137, -161.55529337187863},
+			},
+			{
+				{188.93087805364573, -78.82391658954809, -75.08449029611137},
+				{63.20021624577973, 47.01586566400455, -90.6072563688699},
+				{-2.1784800465621585, 110.16774580391836, -97.24122553897875},
+				{-43.66919301292173, 148.06998620624574, -100.32815687131765},
+				{-91.66817230014965, 186.092109873497, -103.51653273920408},
+				{-150.5131559182128, 229.28327627409897, -107.60029431709572},
+				{-201.80570884369317, 265.86477864461847, -110.92218058020043},
+				{-245.65369464966332, 296.4129183651999, -113.54097256559879},
+				{-283.06548468233524, 321.9307251607475, -115.57794888737017},
+				{-315.05559267977003, 343.31477625607397, -117.14281506373369},
+				{-342.50059037369937, 361.2997761909537, -118.32543172731356},
+				{-366.12781212445464, 376.4755423031894, -119.19761472557033},
+				{-386.5339441540348, 389.3145143847345, -119.8165110563046},
+				{-404.2080583812938, 400.1967032088216, -120.22772559029866},
+				{-419.55238370444783, 409.42979263135027, -120.46785698746389},
+				{-432.89944430248147, 417.26474354497714, -120.56646960113811},
+			},
+			{
+				{297.55405202588605, -221.42620457672234, -32.858780192614155},
+				{171.18815052142145, -93.37792921597398, -49.61930298063569},
+				{98.21641644050159, -20.487720435927063, -59.01578449882471},
+				{50.69006560220021, 26.297970586300753, -64.77355772172862},
+				{15.36225995522838, 59.91009931188461, -68.65732120802627},
+				{-18.651302230620377, 89.61334169677674, -71.96380004426786},
+				{-67.74440135062818, 127.55496824120777, -76.40733593826371},
+				{-112.6495363243227, 161.42933986136555, -80.33338140367263},
+				{-152.23507064416268, 190.82103268641765, -83.680282757895},
+				{-186.97651024820706, 216.27992665852867, -86.51818146428711},
+				{-217.48353273594807, 238.3766602051663, -88.92117563486353},
+				{-244.32920623960877, 257.61229121527117, -90.95483259508683},
+				{-268.0147384352389, 274.40923327484313, -92.67469122304453},
+				{-288.96807651841647, 289.1195369837019, -94.1271815056567},
+				{-307.5517499992358, 302.03620196667015, -95.35101835720383},
+				{-324.0725109216026, 313.40373659270074, -96.37853995396496},
+			},
+			{
+				{339.58960732483996, -278.6413979568902, -14.779177503877575},
+				{221.5706862822759, -159.6507102556732, -29.968543752756425},
+				{149.9667147427906, -87.7099977059621, -39.39996585236817},
+				{101.59273637778436, -39.41439375677802, -45.693885357410075},
+				{65.5122673133513, -4.057667429964497, -50.20256855139367},
+				{35.87625554498035, 24.004847769178202, -53.68066041849889},
+				{5.296222247288894, 50.64734501269106, -56.92922523880607},
+				{-36.57802480661044, 83.37248076788383, -60.98998986222417},
+				{-75.11914064804269, 112.9264784432713, -64.63974050148619},
+				{-109.57446478753909, 139.03928754435293, -67.83840906503976},
+				{-140.27023475994056, 162.08597325874612, -70.63295326376918},
+				{-167.6301441782111, 182.463226661102, -73.07429454759101},
+				{-192.06079127586622, 200.5274652269867, -75.20878678909115},
+				{-213.92508404698182, 216.58586551462503, -77.07675999678757},
+				{-233.53879451585738, 230.89973259546306, -78.71277854768582},
+				{-251.17411856640842, 243.6906898503304, -80.14633579826351},
+			},
+			{
+				{356.37658438728124, -303.9918900249266, -5.5354503416413054},
+				{249.2572482404276, -196.8319124881086, -18.542644010934445},
+				{181.278325232027, -128.44471710678022, -27.39542958608004},
+				{133.39702192107927, -80.6449878581419, -33.66731771492459},
+				{97.49435896582392, -45.14279599775793, -38.31315186699019},
+				{68.70830066889813, -17.197663926704866, -41.92669881025148},
+				{42.528651410736785, 7.040041578105051, -45.01317246257851},
+				{12.310611782726909, 32.47985492150789, -48.23230325154813},
+				{-24.199690551005602, 61.02235880594461, -51.8625408675916},
+				{-57.52345269759552, 86.7381863426778, -55.12621316816305},
+				{-87.54746863379503, 109.70679673075303, -58.03001313689255},
+				{-114.55175094538572, 130.2232593759383, -60.610119492872975},
+				{-138.86106459829236, 148.58461128791575, -62.90396911766896},
+				{-160.7833837453004, 165.05778031743924, -64.94575719800477},
+				{-180.59473971604945, 179.87494263341992, -66.7655949036589},
+				{-198.53736888419903, 193.23587475094965, -68.38965174072545},
+			},
+			{
+				{357.960213781454, -310.26835341280025, -1.5033506124983962},
+				{265.3919213253766, -219.26903240735885, -11.241709602494623},
+				{201.38907620346998, -155.02301032788685, -19.36239636455931},
+				{154.73576597381577, -108.60594867622598, -25.39946167058873},
+				{119.63136140322173, -73.75739378693373, -29.976044522363473},
+				{91.43891776027547, -46.125358439257425, -33.60006601424642},
+				{66.94747205977717, -22.811779564773037, -36.63456127964487},
+				{42.5277524289517, -0.9485749032958026, -39.453814782396705},
+				{11.728145030944622, 24.001648716432825, -42.66287861439101},
+				{-20.177537944351332, 48.921223342597145, -45.873171396887},
+				{-49.24318809920251, 71.41888173943092, -48.77085624608852},
+				{-75.57686246419891, 91.67152084187106, -51.375580862615216},
+				{-99.42804533118739, 109.92161916013318, -53.71662230922463},
+				{-121.0576967710081, 126.40111332247139, -55.82274965382887},
+				{-140.7085232996741, 141.3171002604479, -57.72011304798911},
+				{-158.59766975892484, 154.85042312213014, -59.43186672113242},
+			},
+			{
+				{351.0081910221806, -305.6760135209818, -0.4706492572411529},
+				{274.70593751052905, -233.02327352943013, -6.356960053242865},
+				{214.78246152289313, -173.15712047301275, -13.676509940465538},
+				{169.8069183155035, -128.57569684901978, -19.384653720943653},
+				{135.88859907313008, -94.80737672124874, -23.79450915225609},
+				{108.32384261474657, -67.69060556656635, -27.356790357273777},
+				{84.58367284840618, -44.85853905143403, -30.35280753099508},
+				{62.23605085797564, -24.27024183705191, -33.039736441218324},
+				{37.972507554336126, -3.4765103900464127, -35.736913150813166},
+				{7.7936970388258535, 20.347810604622943, -38.82411337059191},
+				{-20.222299318034022, 42.212926879080285, -41.66346509658651},
+				{-45.77362026248674, 62.02819527973502, -44.239440384397504},
+				{-69.03256396691371, 79.98130806794494, -46.57337219055807},
+				{-90.21804933147692, 96.27293159607937, -48.689226121180056},
+				{-109.54418696790582, 111.088421747982, -50.60970884643751},
+				{-127.20684642003626, 124.5923031627922, -52.35534722950394},
+			},
+			{
+				{343.2763017386551, -299.7305139645909, 0.03502653711219654},
+				{279.31621562884015, -240.80842297874364, -3.1233930239003977},
+				{223.82933149819806, -185.8267226717675, -9.503961403161332},
+				{180.74605995764077, -143.29056243609315, -14.841297785715854},
+				{148.06115969774183, -110.72007366521674, -19.05005469443697},
+				{121.08296233306315, -84.18844296595825, -22.51869254924908},
+				{98.10903095158851, -61.934820333248176, -25.441184473028677},
+				{77.0318033033247, -42.18274955277402, -28.031750609323378},
+				{55.5786919813664, -23.182078564999905, -30.51242208564812},
+				{29.372182401774086, -1.9205046570198367, -33.27553390571263},
+				{2.43432955703186, 19.239514256733433, -36.030905212954075},
+				{-22.305407009465988, 38.54310322534476, -38.551949197069206},
+				{-44.92476217199852, 56.11321865782268, -40.851040848086704},
+				{-65.60299282018492, 72.12134256219501, -42.94775893338992},
+				{-84.52897440443374, 86.73359727685167, -44.861790051047514},
+				{-101.8801847055628, 100.10027460770938, -46.61137336821983},
+			},
+			{
+				{336.24911550974275, -294.3363800097575, 0.5281674661924805},
+				{279.7420912928289, -243.32939967090007, -1.3224139900871679},
+				{229.8575125774653, -194.70570707525386, -6.37833187656742},
+				{188.78897294788007, -154.33934363399874, -11.318180142254672},
+				{157.31008570133042, -122.99478554227315, -15.312848197378361},
+				{130.97121122801275, -97.13356771191563, -18.665392680636426},
+				{108.64379730971235, -75.43424256786992, -21.50483088141208},
+				{88.53387472011092, -56.37009161859991, -24.00617493744964},
+				{68.45633509381973, -38.28355062117827, -26.373252834890756},
+				{46.31711755454919, -19.639258544072586, -28.80369302685846},
+				{20.519357557781344, 0.7473243970680387, -31.45765125350911},
+				{-3.4139157800201847, 19.510804720002746, -33.91080109515212},
+				{-25.385926636069936, 36.66032516404222, -36.16063659499971},
+				{-45.53594830994717, 52.338521944706336, -38.22252837468976},
+				{-64.02995287314293, 66.69410598840385, -40.11346802166334},
+				{-81.02921948672157, 79.86477069111292, -41.849684334476315},
+			},
+			{
+				{330.4949724330057, -290.25181879370723, 1.2229228884215682},
+				{277.2174473834289, -242.1429740373516, -0.5318628501692254},
+				{233.62031719094185, -200.75217300829368, -4.03173159502995},
+				{194.71226872979807, -162.71573088217158, -8.535491937780272},
+				{164.42890014263497, -132.6157720597905, -12.30849383994214},
+				{138.7953599477191, -107.49457775992268, -15.530243315034351},
+				{117.11575129902793, -86.38037113034623, -18.27580508284381},
+				{97.66070873911143, -67.83749437250964, -20.701447561723906},
+				{78.78253237064037, -50.574743769837454, -22.96053428093459},
+				{59.19882729022362, -33.59171885870412, -25.1784788361558},
+				{35.19812723341798, -14.40372223405905, -27.67338511242205},
+				{12.054069194654426, 3.81222811709979, -30.052366600750727},
+				{-9.28131639129498, 20.528290051554993, -32.245622238231064},
+				{-28.903079246820663, 35.856108871553246, -34.26416703014665},
+				{-46.95580297295659, 49.928247029340156, -36.12253738818698},
+				{-63.586260301469984, 62.87108663177069, -37.83517498801473},
+			},
+			{
+				{325.373668202227, -286.73482651669906, 1.9456680763248733},
+				{273.8422646330107, -239.8426261522757, -0.07746943071868569},
+				{235.46195665364044, -204.42092120820834, -2.332264045886639},
+				{199.0283604431162, -169.07027930096584, -6.311562362300508},
+				{169.9467366798263, -140.23784993669025, -9.855562113692695},
+				{145.05877482519907, -115.89755406620608, -12.93821032084689},
+				{124.03424979177785, -95.39042656432072, -15.583324711495537},
+				{105.13310605937711, -77.3248626653213, -17.934227496684187},
+				{87.16494570093238, -60.7195564110508, -20.10230910801372},
+				{68.71794539786201, -44.515022814150406, -22.21638954422479},
+				{47.25895633890762, -26.98762218391154, -24.494368278941483},
+				{24.889029710859635, -9.32012480422209, -26.79605095265126},
+				{4.171222729920264, 6.9625625293896105, -28.929340119956578},
+				{-14.932713045797263, 21.93377886226726, -30.900100236946056},
+				{-32.54676027544631, 35.71070633860399, -32.720577369334876},
+				{-48.80450754332991, 48.40943871287152, -34.40360596352055},
+			},
+			{
+				{319.8266452810869, -282.52258132300494, 2.388859466518408},
+				{270.46385580114605, -237.47993878738166, 0.3152238290508224},
+				{235.44884248123552, -205.83065438594366, -1.2369254315070501},
+				{202.07942563442813, -173.83441863256607, -4.526521679013872},
+				{174.2240248857463, -146.3098144453965, -7.829792509050836},
+				{150.10086272581995, -122.77221804147797, -10.76855102324336},
+				{129.71022404837237, -102.86953194893007, -13.31132875511522},
+				{111.34125406260532, -85.27928216608645, -15.585143752954233},
+				{94.00125921852131, -69.1671431011967, -17.679892148623107},
+				{76.4286392961685, -53.58501152063205, -19.707634610507228},
+				{57.23717399507125, -37.53853004947228, -21.792365755748012},
+				{35.65231050194654, -20.431643975685116, -24.01364714769127},
+				{15.52988208783462, -4.575540302176846, -26.085818950387893},
+				{-3.071766698251952, 10.040791905314563, -28.006757413376015},
+				{-20.256045845836248, 23.51942025215791, -29.78651035183347},
+				{-36.144400706049694, 35.96696907944034, -31.436450566931423},
+			},
+			{
+				{314.09564868774123, -277.9383397096897, 2.6470498132910327},
+				{267.39611230899413, -235.451681233947, 0.7519464467707854},
+				{233.98642468163567, -205.49314065147553, -0.6073012083185003},
+				{204.08039146987892, -177.27872901746127, -3.104841715972975},
+				{177.5125326856933, -151.1472658191589, -6.143757607052402},
+				{154.16461897257253, -128.42717378150664, -8.934770393232533},
+				{134.37896348032976, -109.11770682624328, -11.375212708457713},
+				{116.50125533246312, -91.98480542860037, -13.572568877747804},
+				{99.71320946422526, -76.32529071481821, -15.597406796865112},
+				{82.87039811375094, -61.28043153321762, -17.548084812235103},
+				{65.40269229037146, -46.37252575384836, -19.48284322521078},
+				{44.75415813723096, -29.92128020945998, -21.611437582357077},
+				{25.20459971257839, -14.481780894354824, -23.622607753792966},
+				{7.0874540019292, -0.2141773949931356, -25.49312849411398},
+				{-9.679196955714394, 12.968143750184716, -27.230859428188484},
+				{-25.205474702951037, 25.162994901393986, -28.84582989003538},
+			},
+			{
+				{308.43254158411753, -273.3138593181013, 2.814773332164316},
+				{264.58891771611553, -233.71125307148378, 1.225067019971217},
+				{231.884229289548, -204.36101989436577, -0.2018705072958066},
+				{205.1341369370728, -179.53514436065822, -2.0081386828083847},
+				{179.98955640483598, -154.97430174903894, -4.735201031557211},
+				{157.43091047587833, -133.09100585122698, -7.3731847136471425},
+				{138.22746859448168, -114.36534022675681, -9.711431503933175},
+				{120.80666744793027, -97.67332067558927, -11.833364456032959},
+				{104.53924841731207, -82.45094156851557, -13.78931953362683},
+				{88.3433894496782, -67.89532728147445, -15.668072097877053},
+				{71.88455399488383, -53.683554076464866, -17.507367021598647},
+				{52.50142731466676, -38.087873269841324, -19.519235386268694},
+				{33.503758663313135, -23.054000999750514, -21.470197497633052},
+				{15.852645333482819, -9.126553813780973, -23.290577441882434},
+				{-0.510020319209406, 3.76442079461549, -24.985946307802717},
+				{-15.683674836282966, 15.708449089829516, -26.56508585998562},
+			},
+			{
+				{302.93331252661625, -268.7886509223967, 2.9363741938014973},
+				{261.6369378621696, -231.78818658124655, 1.620411117145066},
+				{229.68233518853657, -203.04004344980115, 0.12844354992907114},
+				{205.27943183014233, -180.65716044505447, -1.2189355516122948},
+				{181.77648214841156, -157.9466616252776, -3.5604527632189593},
+				{160.03742369350118, -136.9363575656767, -6.036019545483531},
+				{141.40112909335537, -118.78931666456232, -8.27168035101655},
+				{124.41730014171334, -102.52890643329677, -10.318698414798266},
+				{108.61239090287883, -87.71068323212226, -12.209201702558353},
+				{92.97946273403602, -73.59460698771643, -14.02166799557631},
+				{77.17093547633526, -59.85919025966511, -15.792138212148311},
+				{59.127672725754664, -45.1595164882987, -17.683419166725887},
+				{40.664137934336935, -30.52098648737705, -19.57524232051747},
+				{23.460748978903393, -16.923897525436317, -21.34628550926066},
+				{7.4879094359435525, -4.31761270365939, -22.999554614005646},
+				{-7.3436189572118415, 7.379523957521705, -24.54266126191301},
+			},
+			{
+				{297.6228044081099, -264.415749691067, 3.033477802126235},
+				{258.385258122302, -229.51824449706007, 1.9018582405363602},
+				{227.62832682487684, -201.80672761651883, 0.45184792328953893},
+				{204.66459309082268, -180.83064675096426, -0.6868371670584973},
+				{182.9475075833017, -160.1631369727472, -2.5910289545738485},
+				{162.08987303852206, -140.09473280775822, -4.8870905950955414},
+				{144.0116775915628, -122.52582127160125, -7.018824496030691},
+				{127.45450989931457, -106.69271864216817, -8.990990224617592},
+				{112.06216517204993, -92.24996758838455, -10.819122020164578},
+				{96.93167023529605, -78.53733640585911, -12.569477338860006},
+				{81.6537706715634, -65.20585544516975, -14.279762247938468},
+				{64.81214723780926, -51.31243173360445, -16.062397312524762},
+				{46.87076889810472, -37.06160581266836, -17.896069175743794},
+				{30.0974137888242, -23.784563775378164, -19.618891366792752},
+				{14.500289420160918, -11.455397706219992, -21.23070057954622},
+				{-3.9406137620057594e-12, 2.3219589235637016e-12, -22.73800019837411},
+			},
+		},
+		{
+			{
+				{-80.6371402427388, 155.65607412482615, -73.52814142168518},
+				{-89.63114492213364, 150.3119168318475, -65.037200688343},
+				{-102.32817146025289, 150.95289418270775, -60.16195070098753},
+				{-114.34336914205367, 153.1139336764538, -56.940551309676295},
+				{-124.4620718558354, 155.20927768891886, -54.564136939385044},
+				{-132.76575557582044, 156.90649068161022, -52.68765011571735},
+				{-139.5398709922669, 158.17151784999322, -51.13603544104713},
+				{-145.06391989365304, 159.04793686523698, -49.810192588343135},
+				{-149.57169602154124, 159.59482526929563, -48.649432936208115},
+				{-153.25072868038362, 159.86846495885567, -47.614307481427076},
+				{-156.24998533925435, 159.91757716356523, -46.67793860036519},
+				{-158.68800256891058, 159.78288079065618, -45.8212994594323},
+				{-160.65966943957014, 159.49795109201338, -45.03048363334669},
+				{-162.24151493607974, 159.09035438646725, -44.29504672048093},
+				{-163.49574139899448, 158.58272165761554, -43.60695747984252},
+				{-164.47328901550668, 157.9936672110144, -42.959911471246},
+			},
+			{
+				{78.35841723589655, -39.1480977994619, -17.591128628217103},
+				{33.1629866535487, 1.5786252152045364, -22.578512564777487},
+				{8.439699301147463, 23.162441325021707, -24.96193229705211},
+				{-8.437415269233213, 37.192208352913354, -26.320121141840634},
+				{-24.15723611203719, 49.341434905899504, -27.453030193711314},
+				{-39.874155412501985, 60.8809892491011, -28.54745447491032},
+				{-53.55968903284736, 70.64716788352752, -29.437087699785298},
+				{-65.26120578693497, 78.80600436320478, -30.13933810761057},
+				{-75.25025681733932, 85.62649825678359, -30.686767994700457},
+				{-83.79762614707967, 91.34771249768517, -31.108637156437393},
+				{-91.13670370204018, 96.16519178596431, -31.428863529033737},
+				{-97.46094277201465, 100.23579104347192, -31.666544846973075},
+				{-102.9289628598442, 103.68509663917462, -31.83687086002481},
+				{-107.6707366215839, 106.61410057758982, -31.951960185388984},
+				{-111.79313845812175, 109.10456157840181, -32.0215367163231},
+				{-115.38451202844588, 111.22316027805942, -32.05345472730009},
+			},
+			{
+				{109.64210097568369, -77.57701276525292, -6.503330977360115},
+				{65.68632532729765, -37.664613133410825, -11.5388294369259},
+				{39.751274903332316, -14.31431344800704, -14.493502664226254},
+				{21.97386483319169, 1.3935665297377635, -16.430978706637664},
+				{8.095144003256415, 13.269345233827924, -17.847431615854227},
+				{-4.705328135997638, 23.676990685345285, -19.065006064781272},
+				{-17.90763044652363, 33.87068060882578, -20.261166249126187},
+				{-29.892024313329436, 42.91310392043888, -21.310276611555505},
+				{-40.45688972525135, 50.76036606155196, -22.20500762642633},
+				{-49.73243148159122, 57.561143990893015, -22.96433315674568},
+				{-57.881858640909066, 63.467858840623805, -23.60804107997518},
+				{-65.05792158714212, 68.61395723081856, -24.153588579880505},
+				{-71.39399945733733, 73.1118066847385, -24.61574479411452},
+				{-77.00388517865048, 77.05500058407726, -25.006848970757694},
+				{-81.98393400983245, 80.52140657824488, -25.337186379247207},
+				{-86.41565443720873, 83.57599162181096, -25.61534636259405},
+			},
+			{
+				{120.4717862573587, -91.78868600809663, -1.9930670411651399},
+				{80.41628788280626, -55.70389714929769, -6.3468995536799016},
+				{55.39582283253982, -33.12531571537186, -9.210448959625543},
+				{37.83832148124233, -17.426472490726674, -11.213779548220558},
+				{24.27955185136968, -5.525198339040609, -12.72015943196736},
+				{12.742051832852203, 4.300115685057443, -13.94852392422789},
+				{1.6074825832079083, 13.358303139829722, -15.073390499009475},
+				{-9.649438547956647, 22.14577812035107, -16.16479216819206},
+				{-19.938280171490042, 30.03631505471096, -17.13978385150767},
+				{-29.13685288729494, 37.009454812308846, -17.994569588975942},
+				{-37.334726657798484, 43.16674205076961, -18.741868312016695},
+				{-44.64541408804958, 48.61418374203366, -19.395283365104675},
+				{-51.17733794190738, 53.4467276713622, -19.967158151672344},
+				{-57.0270977206829, 57.746098795731015, -20.46821793483988},
+				{-62.278671888226846, 61.581771721159505, -20.907648497291536},
+				{-67.00441370227999, 65.01264794351057, -21.29328489217855},
+			},
+			{
+				{123.21383281220834, -96.5253111269738, -0.012631054527053827},
+				{88.09221594728633, -65.43694473975191, -3.4011856508122476},
+				{64.489791130541, -44.214973800623426, -6.0312425291198695},
+				{47.53680518375721, -29.02966567331643, -7.963919607219877},
+				{34.380742157823065, -17.38393567944724, -9.45613010189964},
+				{23.36168503175228, -7.836055602047182, -10.677432477782148},
+				{13.3766028039882, 0.5490709085022812, -11.745535047105312},
+				{3.3810588283498526, 8.589682159152812, -12.76831259941612},
+				{-6.381710493394852, 16.219686112557408, -13.73905580533202},
+				{-15.280068378200562, 23.087111067778096, -14.61094342618869},
+				{-23.298616999835566, 29.222609229206217, -15.387000143251301},
+				{-30.51353102259042, 34.70572652849323, -16.07698238292032},
+				{-37.01173722684895, 39.615792437082995, -16.69088518740297},
+				{-42.87534426965401, 44.023905541468714, -17.23781282706168},
+				{-48.17782506567339, 47.99183535571578, -17.72577252195394},
+				{-52.98360297971695, 51.57269804748869, -18.16171880588667},
+			},
+			{
+				{122.78744052478787, -97.51820974035964, 0.9517670409139446},
+				{92.15156617001834, -70.93814518453719, -1.5883967474714218},
+				{70.145332014867, -51.280684178419214, -3.935180008976268},
+				{53.93342668495281, -36.784265841413415, -5.752184477672552},
+				{41.23401528930956, -25.516160074056994, -7.190366224453232},
+				{30.636992755682346, -16.2622999755663, -8.379224590795856},
+				{21.228836325745025, -8.24185332167244, -9.410866062767777},
+				{12.231257068549088, -0.8276636079569083, -10.364206839128888},
+				{3.1920966551112624, 6.357784270962977, -11.289006150562773},
+				{-5.332448893679111, 13.01517675727477, -12.146814379005905},
+				{-13.096176722423154, 19.02506077839268, -12.92108958494169},
+				{-20.132097159373007, 24.437200408106918, -13.617404933185554},
+				{-26.507514934665306, 29.316672555871705, -14.24361922939675},
+				{-32.292176339420124, 33.72536230845473, -14.807406295960819},
+				{-37.5507594526258, 37.71841464692703, -15.315726364155454},
+				{-42.341053128873554, 41.34393652517505, -15.774735898252},
+			},
+			{
+				{121.39906621193667, -97.30463832270671, 1.555267259458903},
+				{94.0011406740537, -73.86588074476833, -0.45988578734504676},
+				{73.74749581658426, -55.954578670769415, -2.4780721387934928},
+				{58.32806425563129, -42.21517050242614, -4.162143111718868},
+				{46.10935197269101, -31.377457402401724, -5.529875370603038},
+				{35.90057593817555, -22.432695143766704, -6.6741565301832635},
+				{26.902851567988744, -14.70272540763551, -7.669186134390593},
+				{18.52944815458281, -7.699246025351745, -8.573090692130725},
+				{10.228110252820386, -0.9906285657695518, -9.440344623480822},
+				{2.1231456635827555, 5.400507408557366, -10.268563844989854},
+				{-5.362270519732787, 11.242425419986985, -11.027285881485453},
+				{-12.189862068637714, 16.53779406330353, -11.715821771574761},
+				{-18.407150859758485, 21.33759504165617, -12.339979103468544},
+				{-24.07288357818073, 25.69551496364133, -12.906170144991782},
+				{-29.244208023485974, 29.66096675291373, -13.420446478072696},
+				{-33.973294362609664, 33.2777445290446, -13.888269892541814},
+			},
+			{
+				{119.68514739597671, -96.62277147825903, 1.9915178245379452},
+				{94.5152394643177, -75.2373104249522, 0.2534517450921095},
+				{76.00596480276096, -59.06857762555289, -1.4356687135326518},
+				{61.40746986298896, -46.12466835355766, -2.9767634147135915},
+				{49.67723841083239, -35.73931954384114, -4.267083568826492},
+				{39.836684088198304, -27.108910511045565, -5.361382754534989},
+				{31.184890358963493, -19.6455925115113, -6.317969839793654},
+				{23.237658933522244, -12.940789551756094, -7.182423463797656},
+				{15.518151400786593, -6.620299970503257, -8.000010552778713},
+				{7.8805511613198185, -0.5387342932053639, -8.78910978606773},
+				{0.679239273300672, 5.117131607725987, -9.525635819356356},
+				{-5.932580624750964, 10.276259221649315, -10.199489984183618},
+				{-11.979382461250237, 14.97374147522576, -10.814266769376953},
+				{-17.509624445543512, 19.255670314030883, -11.375233908765667},
+				{-22.573829257578065, 23.166400828498816, -11.887647949191232},
+				{-27.21931695172168, 26.745970337196216, -12.356366591661274},
+			},
+			{
+				{117.786674328713, -95.64677272330711, 2.311051819212036},
+				{94.36613264785277, -75.82188975310312, 0.7498203787430764},
+				{77.31870011467446, -61.08785806978777, -0.6833082696147275},
+				{63.57077123430992, -48.97732673005496, -2.071339040718345},
+				{52.330325459555375, -39.053770402772734, -3.2809686707699863},
+				{42.843473873438015, -30.73751178658843, -4.323058652029282},
+				{34.5019938470945, -23.527508403406447, -5.24040777714315},
+				{26.88589466656929, -17.069524522268285, -6.069557091537271},
+				{19.608198953036258, -11.054556337120186, -6.846038630668738},
+				{12.418011307068092, -5.275867544496438, -7.594981689771999},
+				{5.5051137998562485, 0.18346546734311447, -8.305662321655392},
+				{-0.8926798294925811, 5.1990945023348445, -8.961443114715657},
+				{-6.7671190321085986, 9.7844363772323, -9.563044542380414},
+				{-12.156459788414935, 13.97818532569387, -10.114650728624994},
+				{-17.105238403262064, 17.82013501982906, -10.620811199574797},
+				{-21.656510931269356, 21.3470026995397, -11.085853658910706},
+			},
+			{
+				{115.83476259067238, -94.5359416397985, 2.558905659651039},
+				{93.86679738497354, -75.97750109730666, 1.1213757429014175},
+				{77.96002364697804, -62.33060345862219, -0.13682919613669167},
+				{65.06538598780571, -51.06033837902284, -1.3692271487339944},
+				{54.31554287290147, -41.60484508277936, -2.495864886223589},
+				{45.17097974976922, -33.600065949666984, -3.484980812877562},
+				{37.115906160666555, -26.633185272043026, -4.363114316410943},
+				{29.78032984323422, -20.394915899197162, -5.159037715631206},
+				{22.846715347621497, -14.627583394457234, -5.9005807088338695},
+				{16.0424692287351, -9.115949402408106, -6.61295386210973},
+				{9.42555879683834, -3.8619265166877854, -7.295469756229028},
+				{3.2365443951392283, 1.0086909755556672, -7.931579958657159},
+				{-2.4685497779311536, 5.478623568118609, -8.518090534972215},
+				{-7.717105043525431, 9.578879440313399, -9.058097851019475},
+				{-12.548105188927675, 13.345046168322094, -9.5555107104325},
+				{-17.000796657375865, 16.81086622577057, -10.01418665560626},
+			},
+			{
+				{113.87909793721239, -93.35578989403571, 2.755857396378686},
+				{93.1733888545918, -75.88326034670779, 1.4145925744550085},
+				{78.16866721969615, -63.06963081871813, 0.27426629930642993},
+				{66.05365494116467, -52.561743161393025, -0.820481249577532},
+				{55.796424397024715, -43.58042500641563, -1.8619480331185718},
+				{46.9851450019743, -35.8838710547132, -2.7978944711562423},
+				{39.19884636209111, -29.15152155121505, -3.6372682134898446},
+				{32.11260502119394, -23.116022799303956, -4.401381549431645},
+				{25.45916366718584, -17.55845524117563, -5.112075720970537},
+				{18.979045959614197, -12.27665551587305, -5.792052137301526},
+				{12.651418082796416, -7.225611447917316, -6.4461114129463395},
+				{6.665363534601621, -2.4990911406810405, -7.061873423388888},
+				{1.1243779525343727, 1.855600609617432, -7.632421539754092},
+				{-3.986274434906381, 5.860812716538874, -8.159672976738173},
+				{-8.700288108510414, 9.548164391264821, -8.646943890784772},
+				{-13.05345791352098, 12.948699669241448, -9.097670140872715},
+			},
+			{
+				{111.9343095845178, -92.13078607330559, 2.9118117275679176},
+				{92.35392799711032, -75.61861504063461, 1.6507744247215332},
+				{78.10409077647725, -63.48621828647516, 0.596449716514871},
+				{66.65427235501892, -53.61836136287855, -0.3890635208781074},
+				{56.88571775886186, -45.1099010828883, -1.345036955752402},
+				{48.40087128824423, -37.718777216593054, -2.2277350980414616},
+				{40.86973743841569, -31.213599622926473, -3.028976423504104},
+				{34.0113433512948, -25.368750862691545, -3.762446556112191},
+				{27.596661496354223, -19.99630304092493, -4.445009143457618},
+				{21.392293340034232, -14.914908387031693, -5.09600372561051},
+				{15.331094910367284, -10.053352396949597, -5.723033775632174},
+				{9.543147541905855, -5.469349734985673, -6.318222709982396},
+				{4.160159203233947, -1.2279303224092268, -6.87253168531819},
+				{-0.8168191493887883, 2.6827864751786383, -7.3865054516649735},
+				{-5.416299451122316, 6.290583384123585, -7.862899634851514},
+				{-9.670910881788835, 9.624029814761606, -8.304767993968422},
+			},
+			{
+				{110.01668057525931, -90.88557153063225, 3.036032585213381},
+				{91.45433139000068, -75.23761670492364, 1.8446168054838656},
+				{77.86087966198858, -63.68793669562557, 0.8573360528530961},
+				{66.96796394106494, -54.3450970030725, -0.04518520221233882},
+				{57.66435590600018, -46.28604221969678, -0.9207149934019258},
+				{49.50044077474477, -39.197835681748046, -1.7501508890389632},
+				{42.21372543371688, -32.9135770183904, -2.5139059782344435},
+				{35.56723427713027, -27.249961264108165, -3.2177648392955085},
+				{29.363299049115692, -22.04575770778254, -3.874126538780159},
+				{23.39634836241253, -17.14123789170118, -4.498946939304183},
+				{17.573522811698098, -12.451950945829111, -5.100996793475779},
+				{11.979143422719126, -8.008277191604298, -5.675693015721691},
+				{6.747863261238026, -3.877259534104683, -6.213828439474352},
+				{1.8997394990315857, -0.05931732485961441, -6.714383872749084},
+				{-2.588509290055767, 3.4695256832575865, -7.179571851666042},
+				{-6.746577520710954, 6.735547560136468, -7.612096645539656},
+			},
+			{
+				{108.141932514805, -89.64269639472892, 3.136698488667224},
+				{90.50627233475406, -74.77798930028673, 2.0065643673671367},
+				{77.49728653482912, -63.74118673037023, 1.074058360300972},
+				{67.0769775770617, -54.835002551493794, 0.23498378917180723},
+				{58.19409582074362, -47.17943819469235, -0.570507291080861},
+				{50.34449483583949, -40.38965244000001, -1.3472288766820033},
+				{43.293552545036334, -34.321000194415674, -2.0741006143299705},
+				{36.84655692365007, -28.830951764186604, -2.7492745854616976},
+				{30.833381912893834, -23.782712414358848, -3.3809098491095986},
+				{25.07394387528418, -19.03654414714109, -3.9818209467456387},
+				{19.46317644253831, -14.503154430156922, -4.560913950064709},
+				{14.055232047897666, -10.195521745602262, -5.115596978881423},
+				{8.969552023074742, -6.171636156352528, -5.637819960378938},
+				{4.245281697054572, -2.4441084388637813, -6.1250473595291135},
+				{-0.13542985512417285, 1.0071636748212527, -6.578952646797465},
+				{-4.199530685210498, 4.206318644450198, -7.001917723896568},
+			},
+			{
+				{106.31996356495041, -88.41711797747278, 3.219692164214751},
+				{89.52850693945138, -74.26282625458482, 2.143320682153841},
+				{77.04770687762972, -63.68549028346712, 1.2569648756590561},
+				{67.03885646984386, -55.153053520478075, 0.46803712178664497},
+				{58.52610473978147, -47.84820998536518, -0.2793507067396884},
+				{50.979056234998396, -41.3462956919978, -1.005408071324497},
+				{44.156579003476715, -35.488460952391, -1.6959963915018337},
+				{37.89911214431392, -30.165563123450756, -2.343317483048347},
+				{32.06172880943508, -25.263860527922322, -2.9514176704834783},
+				{26.486812399955845, -20.661647822479498, -3.530224678719168},
+				{21.06618041000005, -16.270368854690712, -4.088109426731985},
+				{15.834103282455375, -12.09209943193197, -4.623630633036479},
+				{10.88824548048314, -8.171944432062862, -5.130312605266737},
+				{6.282866677980319, -4.5321446986929645, -5.604441952118982},
+				{2.005865655303074, -1.1566145181572647, -6.047147038304595},
+				{-1.9671151485876088, 1.9767722739540947, -6.460510217571563},
+			},
+			{
+				{104.55486299101864, -87.21662020715009, 3.2888240626975094},
+				{88.53288444113096, -73.70732847646607, 2.2595360913313214},
+				{76.53508607515384, -63.54740301104379, 1.4131045232738648},
+				{66.8929499893072, -55.34370955464318, 0.6653436483501299},
+				{58.70413822488195, -48.34168640238051, -0.034599934500825075},
+				{51.44037734998345, -42.10873555329499, -0.7140565818501403},
+				{44.8393345470343, -36.45658152683593, -1.369129202643066},
+				{38.76316925887987, -31.295322013622307, -1.9893532782616279},
+				{33.08991228193922, -26.532605338092456, -2.5749197514648414},
+				{27.68176039737736, -22.063077815779906, -3.1330754803040834},
+				{22.433202628824823, -17.802425513192592, -3.671243322820667},
+				{17.364712529542576, -13.745654277095815, -4.188639389829837},
+				{12.553203159483422, -9.925793816290822, -4.6802168563499},
+				{8.061908991485456, -6.370894886835966, -5.141561159852375},
+				{3.8848076908017695, -3.0690379424057936, -5.5732481001972465},
+				{-8.126432394075006e-14, 4.862587858656952e-14, -5.977080004642534},
+			},
+		},
+		{
+			{
+				{-33.43251790818679, 70.55229814114568, -34.4846923019686},
+				{-41.766409624620316, 71.06325088746392, -30.895708863522575},
+				{-48.30800697879041, 71.74997475096546, -28.628956390842376},
+				{-54.24834702736188, 72.9511589603278, -27.118834200180306},
+				{-59.227720987275625, 74.07663174142127, -26.004807912728324},
+				{-63.31920211122794, 74.9941555596755, -25.126369834395668},
+				{-66.66956404636792, 75.6958275883172, -24.40133640421408},
+				{-69.41651448766925, 76.20596619519247, -23.78300354569366},
+				{-71.67374033325997, 76.55454297572507, -23.24271364372451},
+				{-73.53190983944168, 76.76943619106116, -22.761818248577683},
+				{-75.06283111059022, 76.87453752877049, -22.327603667001846},
+				{-76.32354317994466, 76.88971069546382, -21.93106646665122},
+				{-77.3596558917989, 76.8312852992425, -21.565624782751602},
+				{-78.20792781786174, 76.71264398554042, -21.22633478404538},
+				{-78.89822232243301, 76.54476132896396, -20.90939478030348},
+				{-79.45498887405392, 76.3366585670779, -20.611820566089712},
+			},
+			{
+				{49.65448822330441, -26.80071104379763, -7.315772744794892},
+				{22.729665370350997, -3.8759608476264007, -10.113189304568504},
+				{7.602064404967142, 8.671856808124675, -11.543371635734433},
+				{-2.7247647282737373, 16.93410980254236, -12.404826246140784},
+				{-11.193347088721184, 23.411683254489386, -13.040185516983438},
+				{-18.859199566119624, 29.0462337633894, -13.58057032948205},
+				{-25.50759001294338, 33.800764719272905, -14.018640267293922},
+				{-31.195880659137075, 37.77860641742386, -14.366034685417088},
+				{-36.060795001691396, 41.11312017201194, -14.638966290254086},
+				{-40.234173298540114, 43.92024209762002, -14.851640549420102},
+				{-43.82857372185103, 46.294138209321595, -15.015578115718714},
+				{-46.936916825168105, 48.310105052373444, -15.139945598849204},
+				{-49.63523313596147, 50.02832893845026, -15.23202042445541},
+				{-51.985747971324386, 51.49716341968868, -15.297602541752736},
+				{-54.03959133320416, 52.75573117375037, -15.341342907264256},
+				{-55.83900894472815, 53.83592902732925, -15.366996888449929},
+			},
+			{
+				{64.86921475454967, -44.917297940232125, -2.140931988865163},
+				{39.41150450607367, -23.179801410921563, -4.807922855107108},
+				{24.080603948754042, -10.155025160299466, -6.437534983683526},
+				{13.4106466473533, -1.2225556922607819, -7.542451499764827},
+				{5.16189553056936, 5.534514300647253, -8.361137964265556},
+				{-1.903914476064681, 11.154034928689791, -9.029972187906896},
+				{-8.397307525927115, 16.1608692192471, -9.620233938821881},
+				{-14.224940397979891, 20.561304510410572, -10.132724812615276},
+				{-19.36235261683369, 24.38255240828745, -10.570458747101531},
+				{-23.87901604813092, 27.7005150360757, -10.9431390603041},
+				{-27.855279217281836, 30.589601302634385, -11.26041883766105},
+				{-31.36512393348134, 33.1142370505325, -11.530716942758561},
+				{-34.4727469279436, 35.328423188102626, -11.761122514937895},
+				{-37.232749463595965, 37.27703282214057, -11.957544184342762},
+				{-39.69128566977252, 38.99734912706916, -12.124900273435447},
+				{-41.88735694267581, 40.520452721625205, -12.267294665680753},
+			},
+			{
+				{69.4740330283509, -51.02288273853885, -0.14529301149660293},
+				{46.69896291478904, -31.811971025964212, -2.3564083325370686},
+				{32.13702449435273, -19.441656682282368, -3.8903624192003603},
+				{21.756650019026218, -10.680455734286257, -4.995110142420392},
+				{13.715245047844032, -3.979205531680854, -5.84084100866609},
+				{7.018477949010991, 1.4981766990157745, -6.528640134089845},
+				{1.0407517262821282, 6.269541315733699, -7.124660092206544},
+				{-4.488431395505051, 10.57894881298244, -7.661053313286696},
+				{-9.496671902081768, 14.421290981596533, -8.136822748159798},
+				{-13.974928999901248, 17.81928035244546, -8.554459718957304},
+				{-17.97136617210233, 20.82496502454079, -8.920493969424657},
+				{-21.542026424617184, 23.490179875398486, -9.241569949683655},
+				{-24.739503345343127, 25.860806177935153, -9.523643638189144},
+				{-27.610335637683157, 27.976141036017506, -9.771864228752149},
+				{-30.194843755451046, 29.869505505726497, -9.990630353048948},
+				{-32.5276990353615, 31.56910735801175, -10.183688148115067},
+			},
+			{
+				{70.51258315792568, -53.10293815888639, 0.7886821932733606},
+				{50.30621609490957, -36.30763379710153, -0.9927375367307298},
+				{36.712229534375766, -24.823495188015695, -2.373164731430981},
+				{26.788530963299255, -16.447779171204296, -3.419346783506732},
+				{19.02993162345948, -9.950484684098337, -4.242735749590348},
+				{12.600522033111732, -4.6351195868188, -4.9196481778574634},
+				{6.97058447724983, -0.0635857987960066, -5.502293432716179},
+				{1.810127809845393, 4.039013991017953, -6.025075417501311},
+				{-2.963856580088922, 7.766997573093619, -6.499895702894714},
+				{-7.299402585644868, 11.114051613975143, -6.925414179844581},
+				{-11.208617426233461, 14.107471121051875, -7.304719438116838},
+				{-14.731195813620898, 16.78740627386216, -7.64274209886189},
+				{-17.909901261004332, 19.192558374857228, -7.944352952473603},
+				{-20.784533910517506, 21.357265816983336, -8.213942364121225},
+				{-23.390487584093318, 23.31122747434929, -8.455353785150985},
+				{-25.758696480798918, 25.079919641257046, -8.671917038815787},
+			},
+			{
+				{70.33707575003106, -53.77611697649488, 1.3352493715256142},
+				{52.071539610417474, -38.743047432896276, -0.16706839876071672},
+				{39.4774229375673, -28.187762444283948, -1.3827977508571137},
+				{30.055000814625657, -20.258815455908636, -2.353702450427929},
+				{22.60672869485297, -14.018350535979044, -3.137841374474809},
+				{16.422936436247596, -8.884854105215549, -3.7911052926179867},
+				{11.048060166818564, -4.483602385267661, -4.354744069559816},
+				{6.1810036310856695, -0.5665345855788726, -4.85804836488271},
+				{1.664430008406811, 3.004910131480178, -5.317937060530861},
+				{-2.497197937169044, 6.253954683138774, -5.736866567829101},
+				{-6.284253054275635, 9.186454271998253, -6.1150406854066865},
+				{-9.719790879400383, 11.830875806930399, -6.455719442675573},
+				{-12.837873611215624, 14.219541527321855, -6.76280898083214},
+				{-15.672623956943612, 16.382535235351824, -7.040035948223581},
+				{-18.25533799807567, 18.34645850884253, -7.2907529544335015},
+				{-20.61383575735213, 20.134430834433577, -7.5179137462163075},
+			},
+			{
+				{69.65492305610817, -53.830419010721755, 1.697623703453981},
+				{52.855087124916636, -40.07807513844017, 0.3699519841260366},
+				{41.1750015367149, -30.366530664275285, -0.700244515892044},
+				{32.258572002745375, -22.896657486528756, -1.591904033942669},
+				{25.125386712477788, -16.92927334791396, -2.3311051085939343},
+				{19.176390772783773, -11.985177932213196, -2.955586988578588},
+				{14.016425212044098, -7.742526893012285, -3.497495737539425},
+				{9.376912284337983, -3.9816253621233497, -3.9811358935415977},
+				{5.09174094745218, -0.5624167755002772, -4.422828141296596},
+				{1.110976347570763, 2.571382358309685, -4.829033771732958},
+				{-2.5439895584549577, 5.423709674407518, -5.199668843087082},
+				{-5.879093651614501, 8.01128689337852, -5.536374670108149},
+				{-8.920177946801353, 10.360483755619954, -5.842177970828403},
+				{-11.69641188924647, 12.4976609533868, -6.120228485289056},
+				{-14.235612986707483, 14.446759419160124, -6.373455622696553},
+				{-16.562976212751796, 16.22887775448868, -6.604487087161622},
+			},
+			{
+				{68.73393393748518, -53.56763391219434, 1.955207460539317},
+				{53.11422853473129, -40.81814743326971, 0.747629900315532},
+				{42.198962644172504, -31.79833834453336, -0.21139060126314324},
+				{33.770269551511994, -24.772987049739942, -1.026465804808437},
+				{26.946973525223246, -19.07961730817302, -1.7194991465127378},
+				{21.22337608163387, -14.325049826447044, -2.3134869748217457},
+				{16.25595809824878, -10.233438054788325, -2.832685184121188},
+				{11.805260896269195, -6.6105462152865755, -3.297019777026211},
+				{7.714275259678598, -3.326054584224288, -3.7208713207382336},
+				{3.90682349875381, -0.3091047946364591, -4.112205405929987},
+				{0.38433691397898756, 2.4560278807409883, -4.472367200061112},
+				{-2.84734648138367, 4.9779357481648505, -4.801896003366156},
+				{-5.805924189174285, 7.277204156175613, -5.102990710664664},
+				{-8.516084482557163, 9.376842285194169, -5.378290688316048},
+				{-11.002620588567096, 11.298466887213594, -5.630358449408265},
+				{-13.288474337541096, 13.061424962829943, -5.8615395849413146},
+			},
+			{
+				{67.69932151193748, -53.13147154848921, 2.1469816897935026},
+				{53.073208908532365, -41.210628010975306, 1.029090265379313},
+				{42.79023824946073, -32.749216351745744, 0.1521221981790823},
+				{34.80807755900126, -26.128598380910542, -0.595022622344035},
+				{28.283789616732044, -20.701981746542696, -1.2429102672171082},
+				{22.77618172234628, -16.13308443735603, -1.8063248348214385},
+				{17.986214077919207, -12.185964893744082, -2.3027858924627855},
+				{13.700415774540847, -8.689217414895975, -2.7483520544682065},
+				{9.774126730937919, -5.524014792686306, -3.155345958554137},
+				{6.123493969528081, -2.6167969401632543, -3.5317146058177857},
+				{2.7295322867133125, 0.05998500403454669, -3.880168177457141},
+				{-0.4002065814882281, 2.513254426950429, -4.200988794505476},
+				{-3.275714153405188, 4.758200812715099, -4.495624312847685},
+				{-5.9174987634721745, 6.81473787028292, -4.766253183846924},
+				{-8.347667554047868, 8.702416563015545, -5.01511594902712},
+				{-10.587195539345176, 10.439045537990209, -5.244312252942828},
+			},
+			{
+				{66.61495894318668, -52.59735858796747, 2.2943332993946224},
+				{52.84833237562965, -41.38419197357255, 1.2473453407304576},
+				{43.1008254402332, -33.38593939201371, 0.4327353488077598},
+				{35.51192753922931, -27.117300288984833, -0.2580683137409782},
+				{29.270245932483952, -21.943259488849172, -0.8635908853849791},
+				{23.96887274919165, -17.55379738114738, -1.3972342540001166},
+				{19.34474827857957, -13.744904605485837, -1.8714501442498974},
+				{15.207487197905634, -10.365502471157175, -2.29891561568373},
+				{11.42472268166898, -7.307912013520434, -2.6900295758179187},
+				{7.913196597376921, -4.501011355240348, -3.052102065412137},
+				{4.6407690916431665, -1.9101501259914637, -3.388572428107886},
+				{1.6095489929717544, 0.4744103497014315, -3.700025322431487},
+				{-1.1846337736176138, 2.663738701190093, -3.9873405019011825},
+				{-3.7583331682936967, 4.674853701616079, -4.252275472441796},
+				{-6.131228721846184, 6.525442682104274, -4.496784737964047},
+				{-8.322554255914143, 8.2319469206397, -4.722751538878289},
+			},
+			{
+				{65.51573313211843, -52.00815699755902, 2.410122176109877},
+				{52.506086225009334, -41.412805364316014, 1.4215553816281425},
+				{43.223784142844, -33.81036778748915, 0.6562789677392247},
+				{35.9775533182191, -27.843747572074825, 0.011048318156891713},
+				{29.996696066866072, -22.901902330156307, -0.5563874634763394},
+				{24.89102877382761, -18.683717383260156, -1.0616748828954052},
+				{20.422964909514555, -15.00682109439372, -1.5144392474792623},
+				{16.422046085788345, -11.737600328308913, -1.924554374673736},
+				{12.767436835104137, -8.778724072927664, -2.30068127459902},
+				{9.379720569948715, -6.063413319584385, -2.649294902132284},
+				{6.219946439782283, -3.5539326936768814, -2.974050431234195},
+				{3.282914036410567, -1.236616908252264, -3.275957760508042},
+				{0.567249783966407, 0.8974294762213665, -3.555585937274867},
+				{-1.939945369690299, 2.862569453603406, -3.814314099129578},
+				{-4.256130080499752, 4.67479092922914, -4.053840176958062},
+				{-6.398979528272059, 6.349307727621528, -4.27585537539185},
+			},
+			{
+				{64.42136056773741, -51.38946607719581, 2.502588020017884},
+				{52.0871365028943, -41.342073668971025, 1.563697399632968},
+				{43.21683652880291, -34.08570807670409, 0.8387969954023989},
+				{36.27152063931071, -28.380385015661524, 0.23065159024013798},
+				{30.52698516940896, -23.6472337637817, -0.30369623275272467},
+				{25.605275918555485, -19.59025127385387, -0.7825751580040988},
+				{21.284415578318324, -16.038980293628192, -1.2148888998784786},
+				{17.410117515235733, -12.873725967928365, -1.608453726141351},
+				{13.872043937178404, -10.006484856499497, -1.9704148585760068},
+				{10.595709905821263, -7.375345460103365, -2.306389767798388},
+				{7.539388108051755, -4.941981216359755, -2.619946007808243},
+				{4.691693595247982, -2.6895435421209806, -2.9124133721725105},
+				{2.051291344416853, -0.6095710666045497, -3.1842714459190984},
+				{-0.39160370815542117, 1.3100241444964866, -3.436578891711904},
+				{-2.6524094484671106, 3.083673455552288, -3.670802451488474},
+				{-4.747394421735114, 4.725480528480024, -3.8884617402866746},
+			},
+			{
+				{63.343035961231834, -50.7570242876433, 2.577257577323393},
+				{51.61751414273232, -41.201511355103015, 1.6816644138507664},
+				{43.117309165731314, -34.25291942068587, 0.9907259182219137},
+				{36.43976234497253, -28.777080800426134, 0.41331032133673085},
+				{30.907779918399868, -24.22973472571368, -0.09277533046531625},
+				{26.157088015715335, -20.322257537799025, -0.5476121766831878},
+				{21.974932017282853, -16.889929278151506, -0.9606510116700914},
+				{18.219060790941334, -13.822987997960741, -1.338500862223468},
+				{14.788334454391002, -11.0414845737974, -1.687065592091195},
+				{11.613362612511152, -8.48829795048225, -2.011160941881882},
+				{8.651981597520829, -6.125905948005727, -2.314109783406018},
+				{5.88847314520082, -3.935439676828444, -2.5974126923626972},
+				{3.319924768752377, -1.9078672351437083, -2.8615837376992834},
+				{0.9388551172902858, -0.0328389627793058, -3.107435622554287},
+				{-1.2682917354582444, 1.7026579931331638, -3.336227505510198},
+				{-3.3165084076314373, 3.3117181971817247, -3.549324938017523},
+			},
+			{
+				{62.28710857424978, -50.120816542548035, 2.6380107647357316},
+				{51.114473961929214, -41.010960875574355, 1.7808838200637398},
+				{42.950355953797846, -34.339697846918284, 1.1191652926195859},
+				{36.514093736686675, -29.068354070905425, 0.5677182190443257},
+				{31.173637684366614, -24.686666201451352, 0.08573362590589217},
+				{26.580514821031343, -20.916237219537646, -0.347620000630456},
+				{22.528592003552337, -17.595762344787158, -0.7427152697327178},
+				{18.883900810380183, -14.621790992364529, -1.1057188143244845},
+				{15.552948742380353, -11.920910246923714, -1.4416199867858903},
+				{12.47116967862098, -9.440413882960344, -1.7545315639348316},
+				{9.59724782809829, -7.144298478016411, -2.0474644874752213},
+				{6.91273338140885, -5.012654971288503, -2.321970877838977},
+				{4.4126207098345755, -3.0355641288169064, -2.5786366402699272},
+				{2.090820147612447, -1.2038266124798718, -2.8181065069965925},
+				{-0.06456302502442346, 0.4942998531232079, -3.0414558706986177},
+				{-2.067362036931465, 2.0709905506403827, -3.249911618884698},
+			},
+			{
+				{61.25710290494019, -49.487351702499616, 2.6876814491422696},
+				{50.58978289075044, -40.784192808730445, 1.8652242444543763},
+				{42.73349858573886, -34.365426612401905, 1.2291243537410979},
+				{36.516950779560325, -29.27856298119077, 0.7000179275874419},
+				{31.35018647186138, -25.04560168618749, 0.23873373844537524},
+				{26.901601563491, -21.40004120577262, -0.17563480308359908},
+				{22.97139353902694, -18.183996301560203, -0.554231625106421},
+				{19.43121229704022, -15.297795880373615, -0.9032928136856251},
+				{16.193558827222567, -12.672947682193046, -1.227238933121223},
+				{13.19818129932231, -10.260603359776509, -1.5296085091337686},
+				{10.405248562748518, -8.026572814412996, -1.8130891158410196},
+				{7.794745014891177, -5.9505969770312, -2.0792060821502463},
+				{5.3597082902049715, -4.021950547174245, -2.3286066362972515},
+				{3.0946397375703127, -2.2320748056675863, -2.5618335941663424},
+				{0.9890704768742532, -0.5703322782167048, -2.7798036800635217},
+				{-0.9697813106608266, 0.9746155125235157, -2.9836190165983205},
+			},
+			{
+				{60.2548212142003, -48.86092615256157, 2.7284002492973425},
+				{50.051645591476735, -40.53101506844313, 1.9375273559670867},
+				{42.47926861185766, -34.34405272786351, 1.3242446173111138},
+				{36.46452362222291, -29.425312379513517, 0.8146617838867145},
+				{31.45648862386312, -25.327029717878556, 0.37134708036237796},
+				{27.14049742854094, -21.7951665776092, -0.026301257608620864},
+				{23.32358677746039, -18.676038811998296, -0.389886804038632},
+				{19.881602694279927, -15.872467233217728, -0.725941922891338},
+				{16.731528934032518, -13.319414447527413, -1.038626692997162},
+				{13.816778977392286, -10.971222656016169, -1.331053220064563},
+				{11.09924156097929, -8.795545493654874, -1.6056103183477648},
+				{8.558128307349405, -6.7722193160681075, -1.8637519304199905},
+				{6.184908667962734, -4.889941016836446, -2.1061601592092},
+				{3.974106745794635, -3.1404314417854735, -2.3333223607568767},
+				{1.9164152053754844, -1.5139776877190456, -2.5460226237366888},
+				{-7.648200162022673e-14, 5.298123391095859e-14, -2.74525117920247},
+			},
+		},
+		{
+			{
+				{-15.43888100845025, 39.447147159546645, -20.461441342727063},
+				{-23.980689759815363, 42.38720514337144, -18.66655893590739},
+				{-28.74215356941815, 43.40406068526638, -17.374757339207896},
+				{-32.675482958646164, 44.386145772749835, -16.491709108097773},
+				{-35.93169673827092, 45.25169313450072, -15.839481232369442},
+				{-38.612387759397095, 45.96338024244817, -15.326784030021281},
+				{-40.82414295645266, 46.52956786953922, -14.90552174096338},
+				{-42.65788271409585, 46.97054479014703, -14.548054989066186},
+				{-44.18633672885271, 47.3073167426351, -14.237329488897316},
+				{-45.466694450587994, 47.558437818366954, -13.962213065452826},
+				{-46.543911600918335, 47.73944960978688, -13.715102497211834},
+				{-47.45354109428493, 47.863117928897054, -13.49060754050844},
+				{-48.22393368061532, 47.9398633268413, -13.284785154771969},
+				{-48.8778974305054, 47.97818896133545, -13.0946725580138},
+				{-49.43394066928963, 47.98504968944508, -12.91799112685392},
+				{-49.907205691797635, 47.96615418687559, -12.752952280308723},
+			},
+			{
+				{38.62167213746125, -21.9039826914944, -3.711174617883983},
+				{18.42857812533372, -5.396604879371688, -5.717701517573922},
+				{7.093800384832746, 3.7087482498953537, -6.778192212081358},
+				{-0.5146356886882071, 9.66700819760188, -7.427901261427089},
+				{-6.340619084450903, 14.09269382297977, -7.881157264763576},
+				{-11.264771329921242, 17.719797829891718, -8.237474869555161},
+				{-15.494218229928455, 20.75832340186823, -8.524380082000192},
+				{-19.1167549542933, 23.307825881225913, -8.754007126905387},
+				{-22.22738235176486, 25.457756207640347, -8.937339806831734},
+				{-24.910839558547693, 27.281885719864828, -9.083444599982618},
+				{-27.23778577911117, 28.839089963427302, -9.199531541409138},
+				{-29.26595853971304, 30.176072579961495, -9.291286801806038},
+				{-31.042350507386217, 31.330007337769747, -9.363203594011688},
+				{-32.60530472034345, 32.330686768434525, -9.418853316342464},
+				{-33.986268973971214, 33.202180596291534, -9.461095309140786},
+				{-35.21119671103002, 33.96409571593967, -9.492236186689293},
+			},
+			{
+				{47.74775461529914, -32.61033927765443, -0.6531640195279649},
+				{29.119237026765465, -17.424345239245497, -2.4664404550336414},
+				{17.864000098520055, -8.23231638738546, -3.6057324641628137},
+				{10.069730082737019, -1.9184596294999037, -4.38791440903636},
+				{4.147094765306816, 2.8148697698901883, -4.967011056101373},
+				{-0.6995917686298044, 6.620309026889583, -5.425491837001565},
+				{-4.904548911492784, 9.856405353750997, -5.810303187364634},
+				{-8.620089237678764, 12.666452580357163, -6.140308700579716},
+				{-11.894672260969417, 15.109530031971696, -6.423037017119214},
+				{-14.782185586415146, 17.23970207663726, -6.665425494995981},
+				{-17.335617286530535, 19.105061254131943, -6.873705493169099},
+				{-19.601899428983874, 20.746145422609843, -7.053162870500886},
+				{-21.62113267090283, 22.19654908930907, -7.20819701697734},
+				{-23.427161917555434, 23.484024914956166, -7.342452799006259},
+				{-25.048471519629427, 24.631553536495915, -7.458953747527129},
+				{-26.5090675058618, 25.658252742750765, -7.560217399840867},
+			},
+			{
+				{50.215469612396014, -36.01988671421698, 0.5101598370871352},
+				{33.63295221575861, -22.672741402599772, -0.9844083586034037},
+				{23.052233694480176, -14.055314563753917, -2.0335553145078555},
+				{15.526131676034405, -7.930668136687289, -2.7989899213495737},
+				{9.746245295003792, -3.257806783864987, -3.3874186263876473},
+				{5.037505379686944, 0.5094460396794712, -3.861690610623496},
+				{1.0083024200000925, 3.689593900195503, -4.260730355205496},
+				{-2.564518547498519, 6.46793403707046, -4.607973279698582},
+				{-5.764550289880214, 8.924950804775238, -4.913603804885025},
+				{-8.62660674750694, 11.101046444910718, -5.182620491416218},
+				{-11.188346849210811, 13.033454350465473, -5.419709951964158},
+				{-13.48694501340597, 14.755788473003065, -5.62917238209041},
+				{-15.555885659522154, 16.29698088372642, -5.814752558725978},
+				{-17.42430930531292, 17.6815146786525, -5.979648319767222},
+				{-19.117250707909925, 18.930018653665655, -6.126573843253656},
+				{-20.656133053669013, 20.059891293435726, -6.257831999095192},
+			},
+			{
+				{50.74105625726275, -37.28366981958783, 1.1087468386935704},
+				{35.78023055367264, -25.348977485003722, -0.1643692660939528},
+				{25.950327488683282, -17.394284410051096, -1.101074783050198},
+				{18.791665938465997, -11.578421831853511, -1.8179000963345866},
+				{13.227830231640825, -7.069509334977351, -2.3850732623950153},
+				{8.679513393710108, -3.407033468557193, -2.850135594080173},
+				{4.804174768393919, -0.3153797136410238, -3.2442840725049327},
+				{1.3885507156151864, 2.378712352830979, -3.588203480499168},
+				{-1.6847914536111028, 4.775130681040216, -3.8941249561509927},
+				{-4.460798683575404, 6.919598084941243, -4.167572362335801},
+				{-6.967117910455234, 8.84194682729449, -4.412126051750359},
+				{-9.233048010770826, 10.570002459273658, -4.631212913654038},
+				{-11.286712614236766, 12.128721073072125, -4.82796779054146},
+				{-13.15340468121778, 13.539727281937612, -5.005147252948305},
+				{-14.8552823396796, 14.821521172193382, -5.165137413291252},
+				{-16.411558602987608, 15.989878178763446, -5.30999286052493},
+			},
+			{
+				{50.55501783825157, -37.73181524903059, 1.4807397582353843},
+				{36.82156028909209, -26.824006537165985, 0.3465963935866049},
+				{27.674401508954208, -19.467241828975094, -0.49190461973303307},
+				{20.895707322390106, -13.979807311303516, -1.1543215059940493},
+				{15.565922597408576, -9.663942065653277, -1.6913367388014053},
+				{11.185088147030667, -6.12957193923252, -2.1384882933590608},
+				{7.451368157016493, -3.136811188188754, -2.5207503837221084},
+				{4.171674202596622, -0.5305059108541519, -2.8555187626637863},
+				{1.2250727856938584, 1.7886740036768844, -3.1544103068891984},
+				{-1.4500228913954196, 3.8757327180715166, -3.423918233886854},
+				{-3.8811167274987652, 5.759570122742261, -3.6673847357280556},
+				{-6.091677910534252, 7.463630091414798, -3.887568134491815},
+				{-8.105465817466788, 9.009576495908462, -4.087092042481612},
+				{-9.94463698423312, 10.41664683742955, -4.268335616323099},
+				{-11.62899650678847, 11.701545774638994, -4.4333983233894125},
+				{-13.175934067507587, 12.878660237699988, -4.584112237945134},
+			},
+			{
+				{50.05397237603939, -37.79529100335566, 1.7366723488984726},
+				{37.30247627160527, -27.684908669587685, 0.6972656570529524},
+				{28.727664129896713, -20.81820711284144, -0.06669929565916904},
+				{22.307285345729383, -15.64093851631093, -0.6782621156642094},
+				{17.209098546412225, -11.520926449597127, -1.183505323226304},
+				{12.993773770073105, -8.120330820762822, -1.6099767601338868},
+				{9.39341562763195, -5.2289125794878455, -1.977708178677331},
+				{6.233796136239468, -2.708039578805985, -2.301249785314001},
+				{3.40039998544392, -0.46509608894624177, -2.5908961578778817},
+				{0.8247129430694807, 1.5578506353084132, -2.853259806730699},
+				{-1.526696915181539, 3.3926506049889857, -3.0919434874205978},
+				{-3.6745784743971575, 5.06044274405768, -3.3093284538606595},
+				{-5.639205719236697, 6.580225920492167, -3.507625406065852},
+				{-7.440137600826371, 7.96923666658399, -3.68889724402542},
+				{-9.09522595220009, 9.242665725211582, -3.8550003618546604},
+				{-10.620341876799296, 10.413721680114985, -4.007575849072335},
+			},
+			{
+				{49.40122944678411, -37.6542331300999, 1.9238603326694952},
+				{37.47290837332967, -28.199611647310633, 0.9551631927538815},
+				{29.376222688462537, -21.732518497780465, 0.24674903609464965},
+				{23.276194628103582, -16.829684743181645, -0.32159176120849103},
+				{18.397452823301084, -12.89638445770077, -0.7965962585822464},
+				{14.341152526564523, -9.627306287691857, -1.2021250274133086},
+				{10.866668885938457, -6.835577535737535, -1.5546135241294232},
+				{7.8161509031964, -4.396748811879089, -1.8662816012822612},
+				{5.083289044212166, -2.225731175829384, -2.1461019381578823},
+				{2.599400546549516, -0.26590770167338273, -2.400286646191289},
+				{0.3259595828804151, 1.5169621646109646, -2.632619082716172},
+				{-1.7581956041626978, 3.143751146794072, -2.8453771410961908},
+				{-3.6708681934100142, 4.631496452459572, -3.040464427670838},
+				{-5.42946016290098, 5.995756314961667, -3.219680530623814},
+				{-7.050177040601715, 7.250432630712848, -3.3846759819586385},
+				{-8.547605625127279, 8.407725651677776, -3.5369287262719165},
+			},
+			{
+				{48.67488562750158, -37.396907217226655, 2.0663510645634813},
+				{37.45786509311527, -28.502258822305937, 1.154164724205136},
+				{29.769725472236576, -22.369747674840735, 0.48849564195637873},
+				{23.949942068262388, -17.70328874327888, -0.044675516821618286},
+				{19.27312344365405, -13.941223823373226, -0.4925576223222546},
+				{15.366855363859937, -10.797326220556448, -0.8781034892478141},
+				{12.010858671703506, -8.101449203891681, -1.215579255474859},
+				{9.060964581390826, -5.740798335427755, -1.5154153009392377},
+				{6.4187919460207254, -3.637281664078089, -1.785431227105768},
+				{4.018187363661551, -1.7371647489746318, -2.031283288106935},
+				{1.81832389525496, -0.0055661002270343445, -2.2567181138455537},
+				{-0.20378100052713, 1.5790291972735444, -2.464030225341632},
+				{-2.0646362688148643, 3.032484496190952, -2.6549281530123365},
+				{-3.7798998313109284, 4.368978497591958, -2.8309963146905397},
+				{-5.364378152392517, 5.601308526849974, -2.9937104992927477},
+				{-6.831557616947637, 6.740803466402479, -3.144408755979913},
+			},
+			{
+				{47.91627778296325, -37.07162165399133, 2.177935289678267},
+				{37.326063238037165, -28.666913344016326, 1.31311173868742},
+				{29.995121455514077, -22.822382005000097, 0.6816773824887004},
+				{24.420920011373735, -18.35974450728373, 0.17700338252914807},
+				{19.92655440347377, -14.751073814580277, -0.24743717891696598},
+				{16.159811613582285, -11.723709389082911, -0.6146159712178831},
+				{12.914859234930958, -9.118603363065322, -0.9378096839900444},
+				{10.058430129916381, -6.831987303488009, -1.2262175889360516},
+				{7.49919120962011, -4.7918354661521665, -1.4867249264304314},
+				{5.174330075455592, -2.9476363128893794, -1.7244375643248346},
+				{3.0427490514178497, -1.26508650458882, -1.9429279802481254},
+				{1.0798023966371206, 0.277888893571169, -2.144500811703257},
+				{-0.7307321080521468, 1.6966433283647187, -2.3307613258963165},
+				{-2.403196574790187, 3.004277138120153, -2.503125236562612},
+				{-3.951210887329148, 4.2126415667197055, -2.6629202813714072},
+				{-5.3873102229530865, 5.33230681942604, -2.8113640295388254},
+			},
+			{
+				{47.14876453145229, -36.706890728680285, 2.267218758241951},
+				{37.118171518015316, -28.737735084987555, 1.4433737166345382},
+				{30.105281511120996, -23.146653533700913, 0.8403640492895315},
+				{24.74872100358827, -18.862039402682015, 0.3591247721603449},
+				{20.418194586579173, -15.389598019721259, -0.045386018191027264},
+				{16.77979377336808, -12.469017710586876, -0.396120483658123},
+				{13.63837325982377, -9.948867019112582, -0.7060429412236182},
+				{10.86900160557973, -7.731979559042753, -0.9836282558936773},
+				{8.386300090668945, -5.751318897933975, -1.2350636270804063},
+				{6.130839503787307, -3.9594380348913534, -1.4649740813977021},
+				{4.062317679139844, -2.3231978669924582, -1.6767066202450693},
+				{2.1551382458944395, -0.8203810978885407, -1.8725304971562333},
+				{0.39280828167836107, 0.5642273691418287, -2.0539999682794376},
+				{-1.2381300071095798, 1.842945838466718, -2.2224060512363057},
+				{-2.7503046894368506, 3.0268232202873597, -2.378952204205516},
+				{-4.155425314739174, 4.125766505043212, -2.5247520156011114},
+			},
+			{
+				{46.386032960506775, -36.3204010886796, 2.339897911421963},
+				{36.85969658627286, -28.74270511261091, 1.5522708830261043},
+				{30.13392830783064, -23.37848886230396, 0.9735717086669363},
+				{24.972715999616884, -19.251711859811817, 0.5120031848035073},
+				{20.789681479342484, -15.900504498548731, 0.12442330899736567},
+				{17.268613069663882, -13.076893693404227, -0.21182614051351562},
+				{14.223239391230313, -10.635516924065056, -0.5096317616657774},
+				{11.53498496137334, -8.483965665403547, -0.7771144739044542},
+				{9.123312029732539, -6.559122609748484, -1.0199906699149426},
+				{6.931891104656158, -4.816237206700674, -1.2424999265546848},
+				{4.9216731078609355, -3.2235264433221142, -1.447764452464765},
+				{3.0667665999978255, -1.758999216836195, -1.6379821401666665},
+				{1.3502704852353307, -0.40747171422453593, -1.8146746641298177},
+				{-0.2407645358899203, 0.8428483428419551, -1.9790457999505089},
+				{-1.718160236946962, 2.0023423661173725, -2.1321964827523905},
+				{-3.092910074602465, 3.0803374844197737, -2.2751506654324705},
+			},
+			{
+				{45.6362122174893, -35.92348676896073, 2.399910054945376},
+				{36.56738425361007, -28.70043183962048, 1.6447673403928096},
+				{30.103497026599864, -23.54186650709325, 1.0873505203160276},
+				{25.119639908243407, -19.55695558311353, 0.6426365153755381},
+				{21.070273266712075, -16.314434571381234, 0.26955531791055787},
+				{17.65634973130485, -13.578653316007742, -0.05402251579892905},
+				{14.699756516460834, -11.209843624863966, -0.34090433903598033},
+				{12.08701950594214, -9.119226423802193, -0.599063277405371},
+				{9.74146878446269, -7.24668411474373, -0.8339336527932433},
+				{7.609463674793999, -5.5497209986631, -1.0494709968057696},
+				{5.6533330530353965, -3.997889093949486, -1.2486005732600614},
+				{3.8473272761408386, -2.5696466582938062, -1.433433242078921},
+				{2.1742298649531184, -1.2498750652343789, -1.6054594515018217},
+				{0.6213315617023993, -0.027122401759392484, -1.7658234303087832},
+				{-0.8225585042740862, 1.108451173754214, -1.9155435020692893},
+				{-2.167816134113217, 2.1656676502759473, -2.0555667345094735},
+			},
+			{
+				{44.904090024527285, -35.52356423720891, 2.4500690280898247},
+				{36.25262616492258, -28.623772057250257, 1.7243715484941646},
+				{30.02945860568513, -23.653398259327183, 1.1859236219268414},
+				{25.20816849336308, -19.797477952414724, 0.755922639909515},
+				{21.280899862672136, -16.65327729224481, 0.39540336511886953},
+				{17.965069877348, -13.997218851930219, 0.0829148563114043},
+				{15.090421239688917, -11.695039310550248, -0.19419331297495016},
+				{12.547914250164716, -9.661035830895557, -0.4438235147275019},
+				{10.264016077294404, -7.837415609713408, -0.6712591043745606},
+				{8.187349897623236, -6.183508873137254, -0.8802643641725679},
+				{6.281575609580177, -4.670075839832605, -1.0736076000370642},
+				{4.521327637525804, -3.276121665541673, -1.2533135570587588},
+				{2.889233946665302, -1.9866649902868478, -1.4208388669395666},
+				{1.3726724148170177, -0.7904818160003307, -1.577286342492246},
+				{-0.039074499331106204, 0.32183896199262096, -1.7236094064122585},
+				{-1.3558550628069659, 1.3586823547327103, -1.860689761347463},
+			},
+			{
+				{44.192382749063064, -35.125544373542745, 2.492440636127395},
+				{35.92338536376348, -28.52187939467829, 1.7936465003701296},
+				{29.922810443502012, -23.724962537726082, 1.2723395058213787},
+				{25.25169818381846, -19.987437811230254, 0.8553902886882945},
+				{21.436813424030888, -16.932975177331183, 0.5058931950591529},
+				{18.21120575201631, -14.349632252628366, 0.2031572434112097},
+				{15.412250671312636, -12.108617617169918, -0.06523166388039364},
+				{12.935025030656833, -10.127087886150457, -0.3071093102089125},
+				{10.708658062881298, -8.349153276043348, -0.5276813119105812},
+				{8.683668907424966, -6.735611319662132, -0.7305943894994755},
+				{6.8249242842637905, -5.258263397977143, -0.9185021696723531},
+				{5.107551063957163, -3.896675187148578, -1.0933545593657816},
+				{3.514164698411414, -2.6360616863128215, -1.256573971725709},
+				{2.032166038190093, -1.465369460251505, -1.4092329938050088},
+				{0.6511796322344914, -0.3755210686093096, -1.5522352387913472},
+				{-0.6381980523992924, 0.641494024546126, -1.686407532312948},
+			},
+			{
+				{43.50249641569058, -34.73269068687983, 2.5285757733808425},
+				{35.58534420789664, -28.401427654671195, 1.8545153043054834},
+				{29.791572404630152, -23.765283738256226, 1.34886242370586},
+				{25.26007016712728, -20.137261744388972, 0.943648757586422},
+				{21.549338537926477, -17.165367572256883, 0.6039417217396599},
+				{18.407162450573445, -14.648745442084385, 0.30984557620401454},
+				{15.678301315969826, -12.46399646126456, 0.04924178123062902},
+				{13.26178764564112, -10.531065929856409, -0.18561399959538114},
+				{11.089139210088154, -8.795742281780798, -0.39987893145394743},
+				{9.112494291842197, -7.220028742058517, -0.597132269946675},
+				{7.297783100285082, -5.776602780710658, -0.7799507814693797},
+				{5.620652234432695, -4.445556593896645, -0.9502257474544695},
+				{4.06380497513462, -3.212333207972662, -1.1093485154023845},
+				{2.6146532598288044, -2.0660243524805777, -1.2583687104329797},
+				{1.2630629410732868, -0.997810942057867, -1.3981524983630877},
+				{-3.8483529761584486e-14, 2.809527348461678e-14, -1.5294813607531654},
+			},
+		},
+		{
+			{
+				{-5.205104082161576, 23.637515071810142, -13.713562740158094},
+				{-14.680425088935552, 28.338049722710934, -12.846271738615533},
+				{-19.14505905309561, 29.911946527969764, -12.069828944422238},
+				{-22.3399742815737, 30.94664795807935, -11.502948431882647},
+				{-24.91150063417562, 31.787299139296703, -11.080997179399748},
+				{-27.02861182780536, 32.480800427076254, -10.750966266963628},
+				{-28.793722016031403, 33.05394675920919, -10.482304791426163},
+				{-30.28143175551369, 33.52929237642096, -10.25690126450477},
+				{-31.547929278468935, 33.925618520498425, -10.063416776034783},
+				{-32.63616992244931, 34.258202095652905, -9.894392552519784},
+				{-33.57941797569173, 34.5393467969503, -9.744712574380237},
+				{-34.40375536968568, 34.77894836827251, -9.610741831519743},
+				{-35.12987603132367, 34.98498995464025, -9.489819020643745},
+				{-35.77438146796625, 35.163944758969535, -9.379944891063477},
+				{-36.350726824964696, 35.321093388910136, -9.279583623608037},
+				{-36.869920227611225, 35.46077110128203, -9.187532163999379},
+			},
+			{
+				{34.539039217403605, -20.315976879484456, -1.9314277164636227},
+				{17.064246185295183, -6.50227930214412, -3.597790989316965},
+				{7.352511771595796, 1.10532312360537, -4.497589225326921},
+				{0.9515179473393687, 6.037476360592815, -5.054056499796829},
+				{-3.7621437873473553, 9.596684399425046, -5.434559043224859},
+				{-7.548606865773507, 12.393413505986484, -5.71988674400306},
+				{-10.744814007554096, 14.706167875049982, -5.946977825967876},
+				{-13.483624374393099, 16.6537645834817, -6.13104926959016},
+				{-15.850237981619665, 18.311788949987253, -6.281544908146721},
+				{-17.911017754180136, 19.736821851026026, -6.4054679297879575},
+				{-19.71874899582203, 20.972439630469225, -6.508172908244962},
+				{-21.315658835122658, 22.052644050425023, -6.593806912585483},
+				{-22.735740848707497, 23.004266233570306, -6.665616422031355},
+				{-24.00656314327222, 23.848708199009092, -6.726169054184109},
+				{-25.15065931910141, 24.603217671729972, -6.777515519580755},
+				{-26.186593717457864, 25.28182960451306, -6.821308942390475},
+			},
+			{
+				{40.571036105293246, -27.404532774093703, 0.12006138749973806},
+				{24.81858287212803, -15.048487847068309, -1.3148345694334649},
+				{15.355899692365773, -7.56200992103572, -2.2329249867166356},
+				{8.859884202223274, -2.4327213988552727, -2.868138432228518},
+				{4.000399981257244, 1.3789306856043015, -3.337795420779072},
+				{0.1275965027672207, 4.38720127238955, -3.7044494914367996},
+				{-3.119631827548269, 6.879514676329115, -4.0045712648878515},
+				{-5.937946828307759, 9.015803415212718, -4.258902499026682},
+				{-8.418523257412408, 10.875641128123407, -4.477754359942002},
+				{-10.616329864689108, 12.508457624934692, -4.6675027463252885},
+				{-12.574783277119293, 13.952204354969233, -4.833061024314755},
+				{-14.329694162567568, 15.237285779670227, -4.978383912395613},
+				{-15.9107397457253, 16.388335228771066, -5.106687591303905},
+				{-17.342582770081187, 17.425466407748, -5.220605369321717},
+				{-18.64580268322842, 18.36522737743331, -5.322307707811636},
+				{-19.837659051202667, 19.221335600398717, -5.41359570262814},
+			},
+			{
+				{42.06584820054838, -29.63897285928511, 0.9275880827754196},
+				{27.983093617036864, -18.699672351644466, -0.2822096901765389},
+				{19.16223165780192, -11.75266331600812, -1.1140422160747123},
+				{12.929145236937218, -6.822684540844163, -1.7247865919714847},
+				{8.18985000282025, -3.0780898409548647, -2.1949867098665137},
+				{4.388710354558771, -0.08744474857193879, -2.5720956814153837},
+				{1.2091159787047676, 2.3978126182765513, -2.8853634001639774},
+				{-1.542075485149067, 4.530633167475495, -3.1535597462570584},
+				{-3.9773510491256046, 6.402450832300642, -3.388161263666246},
+				{-6.155646457285511, 8.064219692036714, -3.5955877218237426},
+				{-8.115179287437439, 9.54983326642859, -3.7801241386573508},
+				{-9.88664476426859, 10.885937111888444, -3.9451729484942186},
+				{-11.495769650852008, 12.094327309470428, -4.093545394387205},
+				{-12.964240317040248, 13.193023785492754, -4.22758575525962},
+				{-14.310383300251432, 14.1970253055199, -4.349259419628821},
+				{-15.549737358655344, 15.118895907647543, -4.460222739995261},
+			},
+			{
+				{42.31552926131299, -30.511280770421802, 1.3744721465185876},
+				{29.467614369512553, -20.5713778141636, 0.2999398796006608},
+				{21.267638119601912, -14.148100089610733, -0.44811759754126745},
+				{15.357780146602996, -9.485846846439765, -1.0166036820298563},
+				{10.802422426999247, -5.884568827149684, -1.4666345958895706},
+				{7.120601479450957, -2.977235593792719, -1.8347433237074904},
+				{4.032946206521397, -0.5474612315573936, -2.14452158712134},
+				{1.3648494310524146, 1.541353554031991, -2.4117420773867515},
+				{-0.9956503516021813, 3.3779036781691816, -2.64703266040167},
+				{-3.115592279928481, 5.017103998433483, -2.8570952591436},
+				{-5.033884479214361, 6.492529212000427, -3.046046841424173},
+				{-6.778320346190959, 7.828425749160313, -3.216887826739388},
+				{-8.371766998742922, 9.044369204433561, -3.372073988365028},
+				{-9.833595965888605, 10.156615843668288, -3.513671023178446},
+				{-11.18030578896286, 11.178789875099941, -3.6434294938964213},
+				{-12.425987872205909, 12.122380515529219, -3.7628398016502747},
+			},
+			{
+				{42.09143084173664, -30.83716798566862, 1.6662587032718095},
+				{30.206061824587064, -21.64861275910525, 0.679270403387191},
+				{22.5239447729484, -15.65109887935223, -0.0061670660999433545},
+				{16.92435906217264, -11.247144502683287, -0.5338192797011871},
+				{12.562854172458781, -7.803880955877614, -0.9592535855177625},
+				{9.012534867940772, -4.9988733820470745, -1.3124827388194769},
+				{6.023894830226774, -2.641055336598042, -1.6129564573006347},
+				{3.4390520300204925, -0.6081427479934346, -1.8739977906407246},
+				{1.1536524687499514, 1.1815347933883014, -2.104933128173676},
+				{-0.9006148176662014, 2.782429916574536, -2.3121685655289754},
+				{-2.7656503811880087, 4.229278376832489, -2.499844054466902},
+				{-4.4685759477429325, 5.545397518456282, -2.6707587279984697},
+				{-6.030390146293855, 6.748805516781808, -2.827105569063762},
+				{-7.4687221328100435, 7.85437374644873, -2.9707232241093484},
+				{-8.79863114820161, 8.874601276548015, -3.1031791164409177},
+				{-10.033040453126592, 9.82007404937751, -3.225817242016924},
+			},
+			{
+				{41.654818411079084, -30.896152956058245, 1.8746462723998603},
+				{30.565589050706656, -22.315999721265385, 0.9515822856698752},
+				{23.31113899715211, -16.660296121089743, 0.3115122014860035},
+				{17.98581978049816, -12.481481253277446, -0.1822608118548081},
+				{13.808681882259311, -9.189422778413322, -0.5841182524689893},
+				{10.388438978703372, -6.488736919569454, -0.9213128217127569},
+				{7.498262339259313, -4.206886905374688, -1.2106315541957642},
+				{4.994070863248115, -2.233144628993331, -1.4635619596455938},
+				{2.7794050513544586, -0.49264319427469094, -1.688283541724789},
+				{0.7885918908718755, 1.0662516339418613, -1.8906496270809616},
+				{-1.02162109860691, 2.478418901499881, -2.0747006492826072},
+				{-2.679034515734758, 3.767145885654976, -2.243160733329795},
+				{-4.203683381783693, 4.949510667479966, -2.398050884952224},
+				{-5.611925400640823, 6.039330102876254, -2.541033633801789},
+				{-6.9176860287742965, 7.048193131203258, -2.6735266109474254},
+				{-8.132941816734895, 7.985936348478485, -2.7967508362473477},
+			},
+			{
+				{41.118290045816565, -30.811334819217933, 2.0322188998042705},
+				{30.71140938877267, -22.74653156897476, 1.1599550050659178},
+				{23.81976858348714, -17.37427588265563, 0.5541391358464196},
+				{18.731539510112285, -13.38629284738558, 0.08715715427187527},
+				{14.721743941516733, -10.230442975655626, -0.2941298776128107},
+				{11.424161140150284, -7.628803659639959, -0.6160783803675746},
+				{8.628251006015303, -5.421366252916331, -0.8940850747476126},
+				{6.200812830535915, -3.5062209761934127, -1.1383926513485134},
+				{4.052272562360092, -1.8142485330745761, -1.3562875684281939},
+				{2.1205475695890783, -0.297039930084984, -1.553077534836429},
+				{0.36288795713076744, 1.0793254935008025, -1.7325845496293608},
+				{-1.249208099100578, 2.3381547566781866, -1.8974737907645138},
+				{-2.7355105416007532, 3.496064206668307, -2.0496653945285495},
+				{-4.111511805757939, 4.566104082126019, -2.1906911045336463},
+				{-5.390248713012579, 5.559131529745414, -2.3218480160620745},
+				{-6.582924237432061, 6.484360241156295, -2.4442550936642315},
+			},
+			{
+				{40.537676715678394, -30.645669595425176, 2.156357507659409},
+				{30.72797205878489, -23.028965437659846, 1.3266249223667412},
+				{24.152384552814613, -17.899587512141018, 0.7480151513450329},
+				{19.270448126890628, -14.07449960858, 0.3022893009188353},
+				{15.409654520986875, -11.03832476672228, -0.061785157559294056},
+				{12.22472432985528, -8.527323455997298, -0.3700900911138398},
+				{9.517077062341667, -6.390059822053966, -0.6374182241426872},
+				{7.161787315257795, -4.531017946978764, -0.873276715248014},
+				{5.0749388042988315, -2.8856486679680575, -1.0843173342257468},
+				{3.1979171132372537, -1.408483571133878, -1.2753967184227655},
+				{1.4893658067416415, -0.06702162647573542, -1.4500885138271888},
+				{-0.07934446395321844, 1.1617470507629832, -1.6109708341114033},
+				{-1.5280455118862581, 2.294214332529363, -1.7599014942125975},
+				{-2.8717066208018256, 3.3429067427606505, -1.898319801975905},
+				{-4.122681336999185, 4.318100742179613, -2.0274275043604226},
+				{-5.29153827806387, 5.228491359761955, -2.1482593628086066},
+			},
+			{
+				{39.94298343866051, -30.43443652715002, 2.2573247256189757},
+				{30.662911667318006, -23.213719453418385, 1.4643607894500743},
+				{24.368134865280773, -18.29765942539248, 0.9083006442271202},
+				{19.668378658822334, -14.614097255315686, 0.47982585204739947},
+				{15.9400189516986, -11.682715158860931, 0.13000166422359144},
+				{12.85716620121696, -9.253116046869799, -0.16648246884700313},
+				{10.230983732425535, -7.180551861901474, -0.42412690111608065},
+				{7.942883536289823, -5.374133154685606, -0.6520567538024301},
+				{5.913451006361529, -3.7727996809687623, -0.8565168631192283},
+				{4.0870954356662414, -2.3335441252553086, -1.0420270147698338},
+				{2.4240902371565576, -1.025324722238985, -1.2119373183832312},
+				{0.8961836481608445, 0.17430893766912048, -1.3687203763072364},
+				{-0.5165214547704368, 1.2815572485095383, -1.5141839234689785},
+				{-1.8287189224222309, 2.308602673102756, -1.6497040165306214},
+				{-3.052254505778709, 3.2652707278350883, -1.7764087679106695},
+				{-4.197178333028454, 4.159825749985738, -1.8952640320197536},
+			},
+			{
+				{39.35109223330342, -30.198625244627173, 2.3416215277015775},
+				{30.545135573214385, -23.331694921636082, 1.5811052947263664},
+				{24.503355242918637, -18.606288194367192, 1.0443495528051416},
+				{19.9668576710842, -15.047896786252466, 0.6302503906660794},
+				{16.35700340187809, -12.208954445009422, 0.29229986234488603},
+				{13.366295735908224, -9.852012258887521, 0.005916301954157026},
+				{10.814671154246799, -7.838320196533621, -0.24315651321658005},
+				{8.588702140793206, -6.080589523126697, -0.4638450585525937},
+				{6.612552192819088, -4.52040378437201, -0.6621573403295024},
+				{4.8331525854981825, -3.1167254598278187, -0.8423833975965364},
+				{3.2123424355116748, -1.8398258811831194, -1.0076961742899988},
+				{1.7225152297831348, -0.6679220284632368, -1.1604648880142643},
+				{0.34384388390684945, 0.4149432501740014, -1.3024491362148793},
+				{-0.9382263051602749, 1.4207160150862663, -1.434981106474951},
+				{-2.135179171984499, 2.3588798353889486, -1.5591337043134983},
+				{-3.256645377747917, 3.2373442323580646, -1.675817166253706},
+			},
+			{
+				{38.77170845682167, -29.951245300122952, 2.413586051699621},
+				{30.393158685182055, -23.40293595729905, 1.6821057734281188},
+				{24.581585029073864, -18.850038642301254, 1.162272855568207},
+				{20.193177990510485, -15.404022925220573, 0.7604482155949863},
+				{16.6903694477954, -12.647524189275734, 0.43252801384275413},
+				{13.783047105424522, -10.35555458347221, 0.15476173944642307},
+				{11.299433386983006, -8.395115018942297, -0.08682244128352655},
+				{9.130649284629593, -6.6820347076404305, -0.301014773383891},
+				{7.203807751338622, -5.159985182377032, -0.4936895420797173},
+				{5.467920847973103, -3.7894842253863734, -0.6689923618681292},
+				{3.8862192204340236, -2.541912691252222, -0.8299685570910986},
+				{2.431826759262265, -1.396149247836861, -0.9788998066381188},
+				{1.0851213273700524, -0.33652965719083683, -1.117500077451197},
+				{-0.1683583105611455, 0.6487060441207999, -1.2470695223405417},
+				{-1.339846508750941, 1.5687859521881589, -1.3686409192338407},
+				{-2.4386399268123777, 2.431330876766109, -1.483080539405545},
+			},
+			{
+				{38.210405389858835, -29.700587613067697, 2.4762320153504285},
+				{30.219327262601464, -23.441016576683893, 1.7709933297911655},
+				{24.61875231682368, -19.04561011670796, 1.2662551259500991},
+				{20.3659655476097, -15.701682998062546, 0.8751282790907932},
+				{16.960755152207046, -13.019534284586854, 0.5558156251154325},
+				{14.129314919224496, -10.786016038035932, 0.28544220294687955},
+				{11.707783089576546, -8.87371975718785, 0.05036908665263681},
+				{9.591518619587012, -7.201392749615497, -0.1580600573124534},
+				{7.710210811341655, -5.714485373341476, -0.34563343855459483},
+				{6.014622351437575, -4.374771439679079, -0.516409609983421},
+				{4.469185833505885, -3.1545463996429515, -0.6733490409254357},
+				{3.0477408210878596, -2.03328796231103, -0.8186670016653129},
+				{1.7309543443804896, -0.9956431386933338, -0.9540380114756226},
+				{0.5044630897145643, -0.0300192832589877, -1.0807378718159832},
+				{-0.642795733793214, 0.8726200467098395, -1.199771087071056},
+				{-1.7198577743754557, 1.719664212023233, -1.311970169714507},
+			},
+			{
+				{37.670289187942814, -29.45203024808584, 2.5317214436336624},
+				{30.03213068609567, -23.455443445941064, 1.8503745547251653},
+				{24.62602383016085, -19.20477965051252, 1.3592744333738827},
+				{20.498360570904538, -15.95445005819793, 0.9776277981271768},
+				{17.18286580786001, -13.340019614580287, 0.6658148737032669},
+				{14.420938199872138, -11.159483337928835, 0.40184110428705216},
+				{12.056261669438186, -9.29083961324237, 0.17243399859164704},
+				{9.988242208261642, -7.655659781726446, -0.03091151821472803},
+				{8.148939807709462, -6.201022791360034, -0.21391063236428895},
+				{6.490650861554907, -4.889771491471328, -0.3805662909091217},
+				{4.97884997574833, -3.6949612915103716, -0.5337853837236081},
+				{3.5880370510792634, -2.596590019613616, -0.6757371551612015},
+				{2.299203561070417, -1.5796064524900664, -0.8080654543351761},
+				{1.098104624996942, -0.6325771797483944, -0.9320277788093843},
+				{-0.02619899877591263, 0.2533869114556192, -1.0486094583373191},
+				{-1.0825425206911634, 1.0854923692868674, -1.1586180848174505},
+			},
+			{
+				{37.15295636943037, -29.20909305629918, 2.5816468679025193},
+				{29.837546564715783, -23.453056072633938, 1.9221772145908804},
+				{24.611458377438495, -19.336107326687078, 1.4435187273576193},
+				{20.599903608681913, -16.1722035417724, 1.0703858725253703},
+				{17.367446354478144, -13.61996898261823, 0.7651971384091873},
+				{14.669612504331837, -11.487823313324634, 0.5068197796464331},
+				{12.35724296299835, -9.658950515848657, 0.28236465891477436},
+				{10.33363149002749, -8.05767328150023, 0.083496250110662},
+				{8.533089682348757, -6.632628762019318, -0.09542430208161419},
+				{6.90931762914438, -5.347626145375331, -0.25835697134925767},
+				{5.42871650599333, -4.176374862485655, -0.40817425215753866},
+				{4.066385365390602, -3.099321333478915, -0.5470155721513924},
+				{2.8036443679520593, -2.1016919555010922, -0.6765033160891165},
+				{1.6263856649070607, -1.172204893072272, -0.797882145305748},
+				{0.523767248459606, -0.30208846697907316, -0.9121251869576229},
+				{-0.5128939259414044, 0.5157212176974857, -1.0200226232888556},
+			},
+			{
+				{36.65906643325092, -28.974080348391478, 2.6272077349912557},
+				{29.63986268339773, -23.438884454144585, 1.9878628009223425},
+				{24.58101280470359, -19.44597312833286, 1.5206387769375567},
+				{20.67769522422397, -16.362319967679817, 1.1552331887105023},
+				{17.522529325447284, -13.867604449915122, 0.8559645968545191},
+				{14.884143056629561, -11.779966266295366, 0.6025307741347541},
+				{12.62013601396507, -9.98752790922767, 0.38243019243033444},
+				{10.637530671515222, -8.417282859016032, 0.18751001851575494},
+				{8.872806112448691, -7.019386047325472, 0.012216139181719191},
+				{7.280989829397864, -5.758561381291713, -0.1473701069896079},
+				{5.829335135312945, -4.609108653051367, -0.29409607167942337},
+				{4.49348986893473, -3.5518691843698877, -0.43008217327295784},
+				{3.255093251993186, -2.572320078263307, -0.5569371815533971},
+				{2.1001860954349465, -1.6593216477365693, -0.6758978410655929},
+				{1.0180108765480094, -0.804199408025285, -0.7879304490279061},
+				{-3.378253662222741e-14, 2.1264716173306896e-14, -0.893814009603434},
+			},
+		},
+		{
+			{
+				{2.8912359262995877, 13.278015588201155, -9.78902356255298},
+				{-8.339779476166637, 19.849836666767334, -9.553252214121516},
+				{-13.27593806849572, 22.193920918008427, -9.124902399358264},
+				{-16.361476792944057, 23.47750305278209, -8.762383562294952},
+				{-18.71375568325162, 24.424369084426395, -8.483440600030644},
+				{-20.635519896884524, 25.1976500173024, -8.266217733644082},
+				{-22.253620941482264, 25.85353155854793, -8.092551720560675},
+				{-23.64350476863269, 26.42287834649685, -7.950553143194677},
+				{-24.85682688616032, 26.92653864707312, -7.832474118668137},
+				{-25.931250875193506, 27.379827454270796, -7.73312365398249},
+				{-26.895241275481887, 27.794463874584892, -7.648915043412469},
+				{-27.770855535991945, 28.17964728706067, -7.577299912305704},
+				{-28.575529752091708, 28.542742272330187, -7.516424110338052},
+				{-29.323285372350785, 28.88974715533641, -7.464912188265528},
+				{-30.025575149276282, 29.22562830308076, -7.421728428248613},
+				{-30.691892741343683, 29.55456552099542, -7.386084838670698},
+			},
+			{
+				{34.24439120727547, -20.686152165394283, -0.8142750198725085},
+				{17.483195237394625, -7.833885127373935, -2.341810132799697},
+				{8.315047318314335, -0.8087441107057498, -3.180335732597423},
+				{2.3578645302846657, 3.7156135946016344, -3.7046535250683923},
+				{-1.9349736500949746, 6.936893404757135, -4.063254212323657},
+				{-5.273244564828687, 9.407948812975176, -4.327396718312678},
+				{-8.028463311245343, 11.419090219090888, -4.535174236158166},
+				{-10.383491532872128, 13.116665008946903, -4.705692834885348},
+				{-12.433861948652227, 14.579344979855103, -4.848999031500958},
+				{-14.24233348752187, 15.85873904665118, -4.971571309363172},
+				{-15.855094283954548, 16.992275870586074, -5.078054983927},
+				{-17.307754662920843, 18.00834224756475, -5.171941946883546},
+				{-18.62847006391141, 18.929043303522334, -5.255930450439782},
+				{-19.83989745921374, 19.77192925819784, -5.332148011273407},
+				{-20.960534168174863, 20.551160818247695, -5.402300860710188},
+				{-22.005677558885036, 21.27833043622971, -5.467778743292283},
+			},
+			{
+				{38.37669332197299, -25.67658473823753, 0.6800713177999916},
+				{23.53555843172474, -14.413085093294232, -0.5954549527359827},
+				{14.778839221127743, -7.674480361816836, -1.410854555781537},
+				{8.819265008127942, -3.0720347558944763, -1.9791440606565882},
+				{4.405234369211499, 0.3333431291966137, -2.4007502485074257},
+				{0.9362644554280471, 3.0001044056144885, -2.7293088732346416},
+				{-1.918639793618235, 5.18315514369057, -2.9961669000122755},
+				{-4.358175731981943, 7.036413225481307, -3.22086283834653},
+				{-6.498880130894596, 8.651758643222568, -3.4152529299801366},
+				{-8.406994806233401, 10.083149923680356, -3.586323193539706},
+				{-10.126077093231704, 11.366596542991395, -3.7387431529357356},
+				{-11.6886448519276, 12.528867908413261, -3.875996134375108},
+				{-13.120367813233381, 13.59088391597305, -4.000814928783572},
+				{-14.442073241113356, 14.569437077272768, -4.115401321358362},
+				{-15.670955858630597, 15.47825468327069, -4.221561445006991},
+				{-16.82140362877528, 16.328723535049434, -4.320798510270915},
+			},
+			{
+				{39.29288927926033, -27.27811771098347, 1.3101103888210446},
+				{25.934449163288832, -17.190989679055864, 0.19813152781775478},
+				{17.815797184131206, -10.973925658274025, -0.5358184159433231},
+				{12.135864772119847, -6.589058557259798, -1.0738794576989692},
+				{7.847524752568308, -3.2670764761161672, -1.4893076636990663},
+				{4.436695228948282, -0.6231545723697814, -1.8228624444896793},
+				{1.6142884487727753, 1.5619623563735365, -2.0994901139751696},
+				{-0.7974153045795449, 3.4241707022920376, -2.335401129675704},
+				{-2.9134098178306505, 5.05205255518152, -2.541512907666795},
+				{-4.806390198523652, 6.502593866397843, -2.724986566824043},
+				{-6.521353767378499, 7.812091699167977, -2.8904253876563293},
+				{-8.089266173910495, 9.005916340225095, -3.0410722325500523},
+				{-9.533783728392743, 10.103440034265542, -3.1794257392094485},
+				{-10.87393126760637, 11.120140172495569, -3.3075002086124545},
+				{-12.125417940764, 12.068696931140295, -3.4269599023677673},
+				{-13.301436250467626, 12.959679470109373, -3.5392030359312043},
+			},
+			{
+				{39.33902464699631, -27.922174469537595, 1.6814782939943815},
+				{27.074170448599283, -18.66052003376874, 0.6641027233637581},
+				{19.49712607810894, -12.875560697359836, -0.007286647878130775},
+				{14.118764905663923, -8.73372339968259, -0.5081468965560857},
+				{10.004425609786347, -5.548048430602641, -0.903707276417371},
+				{6.700345983165969, -2.982467170961144, -1.2274492207215828},
+				{3.94958957626198, -0.8444247083615467, -1.4999094230165593},
+				{1.5926609313549958, 0.9867640161576292, -1.7346847801537364},
+				{-0.4757573229009533, 2.5913696627278453, -1.941196280887443},
+				{-2.3270591334638926, 4.02429204954452, -2.126080546560534},
+				{-4.008351202702273, 5.322444581164624, -2.2938821076698868},
+				{-5.550826292610205, 6.510874370563408, -2.4477190574529923},
+				{-6.9771224876245865, 7.607978953424766, -2.5899070101529946},
+				{-8.305030044931547, 8.62821540381389, -2.7222872609059214},
+				{-9.549124128138422, 9.583371304191187, -2.8463785825293155},
+				{-10.72162654942243, 10.483272310086756, -2.9634608958260014},
+			},
+			{
+				{39.0548828855934, -28.17155402826126, 1.9356560041231916},
+				{27.667218450995186, -19.554323282313632, 0.9831661270242038},
+				{20.525534883795316, -14.104457255951726, 0.3545406828968434},
+				{15.413677831442147, -10.174047221258373, -0.11557844914629858},
+				{11.471564179073772, -7.125319541892311, -0.49051957599657164},
+				{8.283270587490954, -4.649937585198315, -0.8009957768292986},
+				{5.614927964320648, -2.5735646177703426, -1.0649893572585112},
+				{3.3211591901809707, -0.7869748863049406, -1.294303222884232},
+				{1.3053856767624024, 0.7829149856775823, -1.497171888889399},
+				{-0.49932107693738925, 2.1870972435272784, -1.679538094392515},
+				{-2.1396451618428034, 3.4615298180374934, -1.8456980079851448},
+				{-3.647431541826211, 4.631231870500123, -1.9986890424453374},
+				{-5.045062750238101, 5.7141337933913565, -2.1407146615683947},
+				{-6.349581233945042, 6.723995252614825, -2.2734877105161417},
+				{-7.574747006157274, 7.671918803554116, -2.3984088321098747},
+				{-8.732035427306062, 8.56712774412228, -2.5166567706071716},
+			},
+			{
+				{38.63190577635195, -28.230022317559264, 2.125378300309435},
+				{27.981877198326252, -20.147488225549804, 1.2229381386567935},
+				{21.20163811506063, -14.968001508744802, 0.6249912211416526},
+				{16.31547071690828, -11.212062332202892, 0.17808657168597153},
+				{12.528310413946409, -8.285174051716947, -0.17922143430900206},
+				{9.450678555038253, -5.896683619061767, -0.4767853679032848},
+				{6.864440851147783, -3.883723647463857, -0.7314518024324079},
+				{4.634644242851548, -2.145135211286832, -0.9539555322411262},
+				{2.671651375816642, -0.6133601459356837, -1.1517083542916542},
+				{0.9129421972506818, 0.7589898190319405, -1.330078398021066},
+				{-0.6862098264312504, 2.0060628433348304, -1.4930398364246762},
+				{-2.1575885311175855, 3.1524487244938, -1.6435122053687716},
+				{-3.5236480340191125, 4.215852875144132, -1.7836275326765652},
+				{-4.801048512866287, 5.209596273675938, -1.9150109307953125},
+				{-6.002986024402674, 6.144275184980583, -2.0389732423647304},
+				{-7.140368231660815, 7.0286372328073785, -2.15661196378804},
+			},
+			{
+				{38.15436998803382, -28.190247593827905, 2.275677571900731},
+				{28.1402935305684, -20.565626376436988, 1.4144380442306632},
+				{21.670339137380807, -15.61372620572751, 0.8400215735204993},
+				{16.97686764628353, -12.003085620591554, 0.41068314757938745},
+				{13.324932616156241, -9.180458146262616, 0.06747786749428253},
+				{10.347663467989658, -6.87009472653092, -0.21885554597175777},
+				{7.838463284196918, -4.916908115993046, -0.4647310215738428},
+				{5.669899960649773, -3.22517427189035, -0.6803582189075394},
+				{3.757611074347464, -1.7313440857204658, -0.8726461793558614},
+				{2.0427355727045677, -0.3909001831566431, -1.0465554411781657},
+				{0.48276500637443653, 0.8284738441953851, -1.205776532434895},
+				{-0.9533198034062148, 1.9505655381850113, -1.3530836014767171},
+				{-2.287950837856248, 2.9928356290303233, -1.4905455396850684},
+				{-3.5376291077456328, 3.968335726068912, -1.61972926381226},
+				{-4.715181296466656, 4.8873003513587605, -1.7418803424349334},
+				{-5.831088490881592, 5.758106819777383, -1.8580330359385289},
+			},
+			{
+				{37.663877466775034, -28.10012401033417, 2.400226596975475},
+				{28.206328851008458, -20.87486760640811, 1.574164918317215},
+				{22.0089527648139, -16.120274581057874, 1.018782186880988},
+				{17.4831962736229, -12.633509502557546, 0.6031376022590664},
+				{13.949136363901324, -9.899859570692414, 0.27105258542568944},
+				{11.060961910751127, -7.657774066815989, -0.0059927163150022625},
+				{8.621848545702814, -5.758583185787812, -0.24415927850639893},
+				{6.510091051612903, -4.110432721968321, -0.45342842199750266},
+				{4.645290182002773, -2.6526076485418004, -0.6404451205883968},
+				{2.97145957989893, -1.3427420448400424, -0.8099144880817628},
+				{1.4480681663773651, -0.15006201297439162, -0.9653183173721795},
+				{0.045143253620532155, 0.9483201380682151, -1.109295048643729},
+				{-1.2594830164552253, 1.9695126471023199, -1.2438487299472754},
+				{-2.4822272307495923, 2.9263733195948047, -1.370505440786542},
+				{-3.6356894879345503, 3.828885967885216, -1.490465439368951},
+				{-4.730037666431167, 4.685145117182574, -1.6047153710533835},
+			},
+			{
+				{37.18231961913891, -27.986487622167882, 2.507219594092473},
+				{28.216738091068233, -21.11366559376801, 1.711855102129821},
+				{22.262464450052725, -16.53346895652582, 1.1724586359744824},
+				{17.885246050289783, -13.154641525349266, 0.7678443970433535},
+				{14.454948750287693, -10.49778195898413, 0.4446054153475989},
+				{11.645537935298085, -8.314965186884761, 0.17509631377434276},
+				{9.269237624447754, -6.463536415414294, -0.05656784223188329},
+				{7.209191669039145, -4.854815657561978, -0.26024224923761097},
+				{5.388107820683666, -3.4301811521694123, -0.44244723048924417},
+				{3.75223392050752, -2.1488466771638426, -0.6077477718148762},
+				{2.2626646016567755, -0.9812415170922127, -0.7594913495907278},
+				{0.8904651374537206, 0.09470442904072422, -0.9002118583248103},
+				{-0.38609496474070687, 1.0956850729464154, -1.0318531510387567},
+				{-1.5833223586899927, 2.034377442841481, -1.1559098093698412},
+				{-2.7136816876671843, 2.920592230749701, -1.273552118036175},
+				{-3.787118970021893, 3.762213954565482, -1.3857325509773841},
+			},
+			{
+				{36.721587738230625, -27.865222171564383, 2.601906785880152},
+				{28.1941741951289, -21.30603811552459, 1.8337033018627464},
+				{22.45893181930172, -16.881979998837675, 1.3080846162964244},
+				{18.215034388499017, -13.59880459428656, 0.912608198282243},
+				{14.877158958486282, -11.009161840353428, 0.596522344210909},
+				{12.137683180986906, -8.878005205631537, 0.3331125910356152},
+				{9.81741090138225, -7.068498015646475, 0.10682472353889771},
+				{7.803941349215189, -5.4948868723008255, -0.09207666711448065},
+				{6.022624451505998, -4.100271130503116, -0.2700445932133396},
+				{4.421502953634384, -2.845065789672331, -0.43157475384594873},
+				{2.9629809860496037, -1.7006158814973102, -0.5799389090532573},
+				{1.619036545437631, -0.6455243263757955, -0.7176018653441066},
+				{0.36843563318102934, 0.3364962937973097, -0.8464599883945327},
+				{-0.80496327007694, 1.2579366565149162, -0.9679831731342396},
+				{-1.9135346205023496, 2.128482416892489, -1.0833229972867215},
+				{-2.9670742029553385, 2.955867601859704, -1.1934092092633743},
+			},
+			{
+				{36.28817790655606, -27.746105610629673, 2.6878296330527043},
+				{28.153331634688602, -21.46781882445275, 1.9438827199321458},
+				{22.616846364367216, -17.184790711056884, 1.4303499073350487},
+				{18.49378531032944, -13.987436657941789, 1.0426117481619943},
+				{15.239099368216923, -11.457393530420571, 0.7324088549682729},
+				{12.562180649167223, -9.37163818375208, 0.47396022522293085},
+				{10.291952504182103, -7.59893028290999, 0.25207904628348093},
+				{8.32024618480394, -6.056342564881519, 0.05717090292944766},
+				{6.574825898880944, -4.6885422473419975, -0.11716019243292619},
+				{5.005271981841988, -3.4569384453436967, -0.27537332423596783},
+				{3.575067326980591, -2.333591464380471, -0.4207022241823301},
+				{2.256960720467398, -1.297638368631468, -0.5555724116359406},
+				{1.0301981589917026, -0.33314926661964617, -0.6818487214985507},
+				{-0.12115653182106009, 0.5721822827274179, -0.8009835356434702},
+				{-1.2094014134213202, 1.4279443721251845, -0.9141180163867068},
+				{-2.2442349334161316, 2.2417674420696296, -1.022168720710601},
+			},
+			{
+				{35.88555974516543, -27.635340589426562, 2.767479237408083},
+				{28.10413466604152, -21.609903774707696, 2.045335353972338},
+				{22.748950052933544, -17.45505757199427, 1.5425328979853066},
+				{18.73618821727605, -14.335396292744623, 1.1614562543420333},
+				{15.557016754454741, -11.85875510466209, 0.856163096067678},
+				{12.936484315164211, -9.813255735597508, 0.6017790067582979},
+				{10.71114709980399, -8.072980798110006, 0.3834995749091924},
+				{8.776876733638655, -6.557745593895792, 0.19189147196297518},
+				{7.063727507274832, -5.213723619404072, 0.020623613572201927},
+				{5.5226815493436865, -4.00322334058118, -0.1347348939784917},
+				{4.11815648857633, -2.8989032553264242, -0.2773985314756377},
+				{2.8235572756650726, -1.8803307004727854, -0.4097736339311146},
+				{1.6185640319078556, -0.9318751369580438, -0.5337078911729792},
+				{0.48746098304502783, -0.04140030883019543, -0.6506436918955596},
+				{-0.5819720119403788, 0.8006006216969411, -0.7617181254318776},
+				{-1.599371816992249, 1.6016896495771902, -0.8678422459838191},
+			},
+			{
+				{35.515469469763026, -27.536962179701554, 2.842671942218726},
+				{28.053515849216012, -21.740065502312348, 2.1402147779177367},
+				{22.86435559074569, -17.7022525997424, 1.6470170100450345},
+				{18.952794353285324, -14.653378360087032, 1.2717428217752291},
+				{15.84261643918152, -12.224973068438612, 0.9705941592938538},
+				{13.273250633278714, -10.215451584755508, 0.719562092080653},
+				{11.088389680566477, -8.503915158531225, 0.5042243228050622},
+				{9.18774627772204, -7.012819779684196, 0.31532050206575124},
+				{7.503570600855335, -5.689805971030137, 0.14659513367032442},
+				{5.9881723091858605, -4.498041829241414, -0.006351703143513264},
+				{4.606823178572381, -3.4107263852584206, -0.14672101516240088},
+				{3.3335078612743874, -2.407793119938332, -0.27691048606682356},
+				{2.148294913751703, -1.4738629599041462, -0.39876079224022576},
+				{1.0356870957713145, -0.5969506253036749, -0.513710914925017},
+				{-0.01645184209705637, 0.23238044455554183, -0.6228987584187204},
+				{-1.017718525539198, 1.0216485329632026, -0.7272357959845515},
+			},
+			{
+				{35.17864763211283, -27.45366010205814, 2.914775323345424},
+				{28.006466344232717, -21.864027198729993, 2.230149408910282},
+				{22.969794158584783, -17.933422986047862, 1.745594112777603},
+				{19.15143316116744, -14.949338608754386, 1.3754143612151224},
+				{16.104596547088214, -12.5647637961883, 1.077792045582068},
+				{13.581914376872305, -10.587604745394772, 0.8295366159935877},
+				{11.433728867323275, -8.901667603382869, 0.6165984252754678},
+				{9.563384389932096, -7.431928430711313, 0.42989436982683404},
+				{7.905233911359541, -6.127451376265731, 0.2632524196594565},
+				{6.41286280658321, -4.952242667573044, 0.1123094196890425},
+				{5.052352145094105, -3.8800167247346167, -0.026121159068782002},
+				{3.7982214878980782, -2.8910401569617874, -0.15443179996644393},
+				{2.6308945974513196, -1.9701544283907195, -0.2744615484037667},
+				{1.5350852118195344, -1.105508508345049, -0.3876505231065804},
+				{0.4987471696887315, -0.2877286565374705, -0.4951408994870788},
+				{-0.4876860784074713, 0.49067557527357386, -0.597848802067476},
+			},
+			{
+				{34.87527427282651, -27.387277859613384, 2.9848501653562893},
+				{27.966685388615335, -21.98612937591529, 2.3164067993854203},
+				{23.070385878344783, -18.15396873611994, 1.8396518136372362},
+				{19.338087074583438, -15.229372129851432, 1.4739659723480325},
+				{16.349606160757567, -12.884793657111906, 1.1793573150820111},
+				{13.869694994737886, -10.936886996188871, 0.9334047789813736},
+				{11.754879260491995, -9.273853938947301, 0.7224168270679382},
+				{9.911921952744516, -7.823058805449017, 0.5374864433671492},
+				{8.277179994169009, -6.534936173992676, 0.3725291611158811},
+				{6.80546629006524, -5.374309708117826, 0.22322362961787176},
+				{5.4636413278514135, -4.315396835079906, 0.08640113786838859},
+				{4.226734317670288, -3.3387828324731235, -0.04032501609834014},
+				{3.0755043216210454, -2.4295138905613674, -0.15879383465682298},
+				{1.9948699419597562, -1.5758629425042303, -0.2704487480974433},
+				{0.9728812922687032, -0.7685153320475153, -0.3764381291574149},
+				{-4.016108112089492e-14, 2.7776354757144402e-14, -0.4776853802928018},
+			},
+		},
+		{
+			{
+				{11.221875844134171, 4.678111580003677, -7.112920180558231},
+				{-2.8559614354879552, 13.656022883131849, -7.427317649750042},
+				{-8.80190216159827, 16.9860242726264, -7.280861002975004},
+				{-12.245005291880661, 18.728428752312993, -7.085303076830817},
+				{-14.683523786911703, 19.903268610769565, -6.915922653208283},
+				{-16.628485288678142, 20.83477197531202, -6.782595663071169},
+				{-18.271500739818425, 21.632454419903645, -6.6797555577871846},
+				{-19.70700302699351, 22.34537527219689, -6.601161540213703},
+				{-20.992381185099017, 23.00173409759077, -6.542113302139106},
+				{-22.16691667014834, 23.620407759307763, -6.499307265410267},
+				{-23.259503573452797, 24.215277772646886, -6.470458952538753},
+				{-24.29248950524044, 24.797239072210896, -6.454005351302284},
+				{-25.283871534152063, 25.375297204203704, -6.448902381958204},
+				{-26.24868125791408, 25.957246070725706, -6.454492364494259},
+				{-27.19992648083918, 26.550129836836042, -6.470418941304823},
+				{-28.149274298238634, 27.160586372988384, -6.496574127216849},
+			},
+			{
+				{36.75904994114783, -22.60151874998474, 0.03948119958368474},
+				{19.32289190968116, -9.628446729467345, -1.4628467680707162},
+				{10.002451713283333, -2.6352989289159425, -2.29897154547039},
+				{4.018594466745996, 1.8454127861129024, -2.828213782184877},
+				{-0.24650620742188017, 5.022999630522197, -3.1942331652179607},
+				{-3.5126483864567546, 7.441623897299555, -3.4650923018711506},
+				{-6.1600909403606074, 9.389780606011254, -3.6778586670975435},
+				{-8.40744457835883, 11.033782938807981, -3.854186693919085},
+				{-10.376577946234697, 12.467689536511138, -4.006286052191064},
+				{-12.139405300702672, 13.747863556969419, -4.141384288423381},
+				{-13.744290608798368, 14.912352414796427, -4.264311631898358},
+				{-15.226831159610263, 15.989056442201903, -4.378591167361488},
+				{-16.61474046322541, 16.999562964966945, -4.486946751553785},
+				{-17.93047704918693, 17.961260690035576, -4.5915811863830855},
+				{-19.192859568353708, 18.888653477354588, -4.694348473908854},
+				{-20.41815798382825, 19.7942501496594, -4.796870688248708},
+			},
+			{
+				{39.53409693168138, -26.22469041894112, 1.202402123657166},
+				{24.239570744549596, -14.944856051365036, -0.04969271259152086},
+				{15.51638560895366, -8.401299527868646, -0.8284541659447018},
+				{9.638378179020355, -3.9541876400415754, -1.3740570777930812},
+				{5.309323836239326, -0.6663057830586908, -1.7818056128639856},
+				{1.9249269305984242, 1.908214241990161, -2.101753440812494},
+				{-0.8419987042246271, 4.013725282061244, -2.3629718011394543},
+				{-3.188398521537671, 5.798302290880837, -2.5837650176752596},
+				{-5.241522725633695, 7.358031614654975, -2.7763394916537},
+				{-7.083093619723209, 8.755313216861314, -2.948756917154466},
+				{-8.765106146299328, 10.030612983464241, -3.1062918729581095},
+				{-10.323408665741477, 11.212205603385803, -3.252657896649493},
+				{-11.784970410369873, 12.321463757711529, -3.3906872682762965},
+				{-13.171340011165176, 13.37546571553152, -3.522667325809705},
+				{-14.500497315074734, 14.388439008781361, -3.650527389795776},
+				{-15.787988327625088, 15.37266600761916, -3.7759570758602115},
+			},
+			{
+				{39.98660272824025, -27.40423494453604, 1.7359166527234968},
+				{26.15501388469991, -17.203216990390093, 0.6088227934529504},
+				{18.079410314824305, -11.166045504130565, -0.09634337936749295},
+				{12.513165616392993, -6.960763536821588, -0.6071096216270917},
+				{8.333482718722365, -3.7805971603286013, -1.0025094629465463},
+				{5.0183347966927885, -1.2464169346788545, -1.3218509485736536},
+				{2.281291554057074, 0.8524713622368756, -1.5884251081314338},
+				{-0.05165721060344394, 2.645306694678932, -1.817237863210213},
+				{-2.094573207996542, 4.217339514629374, -2.0185647047537607},
+				{-3.9261762523844737, 5.627696388383819, -2.1997436954210037},
+				{-5.600663155557099, 6.917513957143032, -2.3659890581212246},
+				{-7.154986541892782, 8.115337996779886, -2.5209741081436956},
+				{-8.615825359639636, 9.242077350820345, -2.667427682540655},
+				{-10.003919428963359, 10.314107126285382, -2.807519467867921},
+				{-11.336333504728366, 11.344940347833766, -2.9430691631164954},
+				{-12.627732097677509, 12.34620074815806, -3.0756692056054984},
+			},
+			{
+				{39.81952747899133, -27.87653516170287, 2.068986934663709},
+				{27.09382250261492, -18.458643025032902, 1.017088842455556},
+				{19.521298488139937, -12.797380903617523, 0.3588605471048884},
+				{14.245800420558007, -8.816688464394536, -0.11993541686608884},
+				{10.242008028036311, -5.772393751241715, -0.4956596641674987},
+				{7.0358355703866815, -3.320119146909898, -0.8038559277850019},
+				{4.36876214522262, -1.2709299668533247, -1.0646605503103608},
+				{2.0834985469845146, 0.49104943969484416, -1.2909482176093885},
+				{0.0767373545555783, 2.0424573208830523, -1.491566380623878},
+				{-1.7235190745284854, 3.4369792906231784, -1.67292019215407},
+				{-3.369299241658568, 4.713615441964712, -1.839792876519089},
+				{-4.897996443747634, 5.900706987606205, -1.9957361330721681},
+				{-6.336507766059996, 7.018975005721359, -2.143393309382032},
+				{-7.7052190011165, 8.08433446978317, -2.284830925664035},
+				{-9.020595261007575, 9.109738810729459, -2.4217626964861316},
+				{-10.296641688057623, 10.106250592773183, -2.555680982386093},
+			},
+			{
+				{39.43992944038668, -28.06464210258921, 2.308906062689923},
+				{27.614339733460547, -19.273272668760413, 1.3116538775154905},
+				{20.443191533300347, -13.900385421014352, 0.6840607339149982},
+				{15.407247503448227, -10.098568749737783, 0.22801326507848718},
+				{11.562725442583034, -7.175930492826061, -0.13069967277073258},
+				{8.46629540415687, -4.807769674913912, -0.42679371707357927},
+				{5.876967027936459, -2.81745207078646, -0.67925152342831},
+				{3.648934841078236, -1.0977238658165156, -0.8998295297293595},
+				{1.6866960397487114, 0.42202029517834994, -1.0964867215936172},
+				{-0.07624019433227168, 1.7911709043917976, -1.2749566531260987},
+				{-1.6884259410166276, 3.0459391583959956, -1.439561827748153},
+				{-3.186136399271774, 4.213540144351608, -1.593632287024086},
+				{-4.596306699151464, 5.3144246178652885, -1.7397231660747836},
+				{-5.939236563951463, 6.364237808173007, -1.8798272892651733},
+				{-7.2310499355265705, 7.3755510169609515, -2.015578254251872},
+				{-8.48529693646316, 8.35900402004232, -2.1483878177403826},
+			},
+			{
+				{38.994853911375486, -28.12712214029996, 2.4978275060566903},
+				{27.926687659978704, -19.85895770783614, 1.5441082802888404},
+				{21.09076629592448, -14.72068802318726, 0.9383953933525957},
+				{16.252635972862254, -11.061527090473087, 0.49817255337731464},
+				{12.543155630709146, -8.239162095072087, 0.15221406795213852},
+				{9.545031229860196, -5.945327164181621, -0.13364109557902215},
+				{7.0295025976615815, -4.01112813122717, -0.37808298005970464},
+				{4.858407131613949, -2.3345078773090804, -0.5924529755728998},
+				{2.9416662717327413, -0.8487855039595181, -0.7842592697074345},
+				{1.2168191486409068, 0.49241792151053054, -0.9588285457838496},
+				{-0.36169337522440564, 1.7230359325892428, -1.120148387859711},
+				{-1.8283689803111882, 2.8688531825680337, -1.2713205001341927},
+				{-3.209636652768054, 3.9497642203389876, -1.4147909224300392},
+				{-4.525730307251556, 4.981192965398358, -1.552494613064802},
+				{-5.7925996907928115, 5.975459940056923, -1.6860088807886817},
+				{-7.023496149740076, 6.9429007487342504, -1.816685380001821},
+			},
+			{
+				{38.548058622750496, -28.136642710570243, 2.656339435974739},
+				{28.128712944866507, -20.315032235876423, 1.7388824287184},
+				{21.58105469643015, -15.37506589428804, 1.149920086031905},
+				{16.910334741427146, -11.8331691265249, 0.7210483762082389},
+				{13.314663143485443, -9.092664612319261, 0.38429894291010397},
+				{10.401022718052017, -6.861085329670855, 0.10630567050258262},
+				{7.951125854944047, -4.9760447689485705, -0.13144927492160816},
+				{5.8324412398860686, -3.3389877989996477, -0.34020163675984555},
+				{3.958655043757271, -1.8857111816003496, -0.52729694549831},
+				{2.27014850077232, -0.5718165414412517, -0.6978634766824834},
+				{0.7236061644288553, 0.6350086770498798, -0.8556895546219958},
+				{-0.7138095621563223, 1.759310240723564, -1.0037063296463191},
+				{-2.0676728449656614, 2.8202770568019595, -1.1442509736491184},
+				{-3.3580049589322924, 3.833061270320822, -1.2792054268226605},
+				{-4.600658154482842, 4.8098097628695395, -1.4101083807117685},
+				{-5.808699982114328, 5.760648397339223, -1.5382694178674008},
+			},
+			{
+				{38.12997122503229, -28.13068978272422, 2.7959233708496356},
+				{28.272713837921852, -20.695170160845613, 1.9093770622550612},
+				{21.97722805772356, -15.926609537613558, 1.3338189708766206},
+				{17.45120652632805, -12.483717128202585, 0.9133673850814196},
+				{13.952575565958513, -9.810910183546143, 0.5832431227968333},
+				{11.110875628677618, -7.6307476680333926, 0.3110219008534789},
+				{8.717645273486871, -5.787106204761816, 0.07843808652478415},
+				{6.64522726930157, -4.184402436733264, -0.12569173579543907},
+				{4.810184273848679, -2.7601799720777787, -0.308678940948677},
+				{3.154930668241143, -1.4713322606574757, -0.47558630493288767},
+				{1.6377638521885471, -0.2866281284464261, -0.6301097931813566},
+				{0.22715164651577985, 0.8175652870589812, -0.7750798387181781},
+				{-1.1015908476330944, 1.859779761083575, -0.9127501199507356},
+				{-2.36812067686686, 2.8548454773735075, -1.0449551896747806},
+				{-3.5881955913745194, 3.814767512529866, -1.173207169881746},
+				{-4.774779230186681, 4.749534301823048, -1.2987887357387904},
+			},
+			{
+				{37.75593837207495, -28.130276392573673, 2.923613368906735},
+				{28.389464507418555, -21.031396697469162, 2.0637380037492186},
+				{22.31685839232065, -16.412945887883772, 1.4991867952810773},
+				{17.917717657034732, -13.055197404559525, 1.0851273901449814},
+				{14.502932132169297, -10.439251917502737, 0.7597728897809625},
+				{11.72271183776059, -8.301631694510943, 0.4916752497658428},
+				{9.377873194800511, -6.49226980039708, 0.2628903893800642},
+				{7.345344170963668, -4.918440111963246, 0.062318785113927445},
+				{5.544199716949629, -3.5191822207118437, -0.11734113925243907},
+				{3.918446412532996, -2.2523283143985484, -0.28114611991459704},
+				{2.4275636372981033, -1.087370127843244, -0.4327667984808248},
+				{1.040987314476233, -0.0012987130644657333, -0.5749898669957256},
+				{-0.26521106668318234, 1.0239043728861543, -0.7100199628718094},
+				{-1.5102631287152206, 2.002752498147149, -0.8396564114436588},
+				{-2.709798922368235, 2.9471180916143385, -0.9653937855633913},
+				{-3.8767270052057725, 3.8669044970321904, -1.08850025466138},
+			},
+			{
+				{37.43400563278299, -28.148038110001725, 3.0440533153292857},
+				{28.498482133415166, -21.34434276237354, 2.2073205310473702},
+				{22.62408246416593, -16.85833885463492, 1.6519222057036027},
+				{18.337199780380594, -13.57464985399184, 1.2427619681665123},
+				{14.995594267366895, -11.007034339321963, 0.9208093634886786},
+				{12.268254511679878, -8.904811152085909, 0.6555663086623276},
+				{9.964649107005314, -7.123648257632103, 0.42943900092431037},
+				{7.9660725191101145, -5.573606443431927, 0.2314459707292173},
+				{6.1939662312142945, -4.195162687273397, 0.054310203448292696},
+				{4.593737067503785, -2.9469594502929337, -0.1070304256121392},
+				{3.1258068886099513, -1.7990253142571078, -0.25625104012601413},
+				{1.760340039528984, -0.7287790984556696, -0.3961303203641453},
+				{0.474000693710345, 0.2814310210505433, -0.5288537468082729},
+				{-0.7520393194350115, 1.2458522525211986, -0.6562013911609487},
+				{-1.9332512158619795, 2.176232836191058, -0.7796585363124157},
+				{-3.082511751665866, 3.082426311467308, -0.9004886927832483},
+			},
+			{
+				{37.16858917685098, -28.19213262205636, 3.1605146378618802},
+				{28.612985055123122, -21.6482001893762, 2.343881629315702},
+				{22.915452192272216, -17.279494378990126, 1.7961112968534074},
+				{18.72838029864294, -14.060603476538168, 1.3906830168214523},
+				{15.451043373496445, -11.534346755830033, 1.0710815236156532},
+				{12.769418109589214, -9.461685597630813, 0.8077047181761657},
+				{10.50095553083746, -7.703626950367714, 0.5833092855308645},
+				{8.531064714451222, -6.172900197166224, 0.38704949075167666},
+				{6.783450485574951, -4.811390535232943, 0.2116909075450276},
+				{5.2048391488968795, -3.5785187856425447, 0.05217403466197217},
+				{3.7564857779497434, -2.4447801114505894, -0.09518612349694515},
+				{2.409145888863332, -1.3879176817059116, -0.23317588540939818},
+				{1.139948417895038, -0.3905410576070461, -0.3639805856615648},
+				{-0.06958866531143469, 0.5613929610355387, -0.48937537601674513},
+				{-1.2347654579151055, 1.4795239274306171, -0.6108439912632644},
+				{-2.3684323667079776, 2.373677548191591, -0.7296527454162587},
+			},
+			{
+				{36.9623392631817, -28.26827211798687, 3.275444350202721},
+				{28.74251682853884, -21.95334884326909, 2.4762138356887125},
+				{23.203015221628334, -17.688611677591833, 1.9347532344107596},
+				{19.104845720134094, -14.526499126148554, 1.5320926688106569},
+				{15.884091976330634, -12.035692243216454, 1.2139972621411783},
+				{13.24206501998833, -9.98770160462604, 0.9516950291839393},
+				{11.00352832087418, -8.24845007302171, 0.7282774598016079},
+				{9.057721042791137, -6.733173502237504, 0.5330378526362396},
+				{7.330492489963016, -5.385113443467637, 0.35879473351111063},
+				{5.769832512472639, -4.164454490111784, 0.20050137134967116},
+				{4.337780784726015, -3.042139897697458, 0.0544677769502882},
+				{3.005618522063046, -1.9961971581560505, -0.08210238062854057},
+				{1.7508623804891263, -1.0094405334719332, -0.21140231945128063},
+				{0.5553226649010525, -0.06798294680051853, -0.33521131342443955},
+				{-0.596131864929931, 0.8397239379368969, -0.45501748970792333},
+				{-1.716319904937842, 1.7234895043956355, -0.5720949946937606},
+			},
+			{
+				{36.8171446931221, -28.380854689212104, 3.390781723831671},
+				{28.894440753145634, -22.267856719052485, 2.6065085928969167},
+				{23.496063589571435, -18.095112832284535, 2.070170896242097},
+				{19.477006934363096, -14.982626347967118, 1.6694411278199883},
+				{16.30601897779053, -12.522090445685441, 1.3521401949722447},
+				{13.698230576962692, -10.494545554330688, 1.0902554543820624},
+				{11.485071357760837, -8.770407024938455, 0.8671891240020629},
+				{9.559311498628265, -7.267230663105765, 0.6723675829844106},
+				{7.848804452067961, -5.9295361998881715, 0.4986647453008102},
+				{6.302738941968833, -4.718244824026219, 0.34105265681753966},
+				{4.883904867887743, -3.604738962943043, 0.1958423354982867},
+				{3.564077925877942, -2.567319190721502, 0.060230688244369945},
+				{2.3211239006320112, -1.5889824353614186, -0.06798402748209688},
+				{1.1371107185040434, -0.6559721478953079, -0.19058847207386184},
+				{-0.002929558864705787, 0.24318038369216435, -0.30907810598480107},
+				{-1.1117717823241346, 1.118270460311393, -0.42473730499049306},
+			},
+			{
+				{36.73470905097133, -28.533636518159465, 3.5081536712120753},
+				{29.074848119052255, -22.598394093392322, 2.7365786251312185},
+				{23.80219403935969, -18.506690035316257, 2.2042591079539355},
+				{19.853286005206616, -15.437289847781344, 1.804701475741233},
+				{16.725864948259762, -13.00234873230478, 1.4875686823938299},
+				{14.147495412703641, -10.991490228287912, 1.225534727131375},
+				{11.955659543844307, -9.27921014468737, 1.0022839093339324},
+				{10.046358242632962, -7.785187533163356, 0.8073642285903695},
+				{8.349294971300814, -6.455124204887301, 0.6337017341924663},
+				{6.8147782100574705, -5.250633904766885, 0.47628845833600136},
+				{5.406307812839352, -4.143521059832036, 0.3314398263354365},
+				{4.096130389304688, -3.1123527521333116, 0.19634964698017393},
+				{2.8624406651172336, -2.1403020271776056, 0.0688100403441032},
+				{1.6875461464346797, -1.2137340681622741, -0.052972388299383596},
+				{0.5566400493643412, -0.3212570002490828, -0.1705003807659329},
+				{-0.5429918471365238, 0.5469043343541189, -0.2850683556047546},
+			},
+			{
+				{36.7169087206234, -28.73016222788575, 3.6290037196885936},
+				{29.289147395809582, -22.95082761669605, 2.8680029066830652},
+				{24.12799306374661, -18.929980810567685, 2.3386443175836455},
+				{20.24087756566722, -15.897555526068665, 1.939544749853591},
+				{17.15126173754426, -13.483873402279311, 1.622005789989574},
+				{14.597870837138153, -11.486259964825758, 1.3593146323548242},
+				{12.423659490102455, -9.782894468953794, 1.1354062490792047},
+				{10.527564543492028, -8.29537993911285, 0.9399354454195232},
+				{8.840978940301698, -6.9704940167566996, 0.7658734614872286},
+				{7.3152407560107235, -5.77048598831731, 0.6082297944932512},
+				{5.91450923100965, -4.667553443154354, 0.4633244975462467},
+				{4.611473510398179, -3.6405170717465167, 0.3283500674414747},
+				{3.3846399966984153, -2.6727207083061706, 0.20109477720348806},
+				{2.216544841349297, -1.7506490170073814, 0.07976053271560489},
+				{1.0925431210866479, -0.8629898914629596, -0.03716082768016789},
+				{-3.572492351305297e-14, 2.4045450227834422e-14, -0.15097163922833132},
+			},
+		},
+		{
+			{
+				{21.328539166772035, -4.063867622051959, -4.984885780822057},
+				{2.8467543217006996, 8.257549258577257, -5.8807296722146285},
+				{-4.613761807679529, 12.829033407271202, -5.996436650113087},
+				{-8.791218690268497, 15.225827240614487, -5.957960440875034},
+				{-11.596754491172653, 16.774220566500492, -5.891152061480726},
+				{-13.745656533252669, 17.95089078357154, -5.832438695787705},
+				{-15.54247027891299, 18.949714408517508, -5.7914281377318515},
+				{-17.127465891163105, 19.8552042344121, -5.7689305932393316},
+				{-18.577784560276, 20.71185172953112, -5.76390240049629},
+				{-19.943246942681785, 21.547913159191967, -5.775329275635953},
+				{-21.260179472527426, 22.384245766933716, -5.802647167454168},
+				{-22.557758031094025, 23.238223110814506, -5.845809342380486},
+				{-23.861468530453134, 24.125839975683462, -5.905288478574513},
+				{-25.195353588965173, 25.063108685960227, -5.982091542798996},
+				{-26.583766050082165, 26.067216071059537, -6.077812139589125},
+				{-28.053020220314114, 27.1577012313272, -6.1947349040191595},
+			},
+			{
+				{41.93337603440604, -26.047828291537652, 0.8107263234820564},
+				{22.502844795884798, -12.020970237425242, -0.7556734583409018},
+				{12.489705552262375, -4.665547147014661, -1.6286664739844168},
+				{6.143206600969428, 0.0179036504236001, -2.1875166225699596},
+				{1.646583902537835, 3.339820606327952, -2.579738110492651},
+				{-1.7824756592544884, 5.874220399260142, -2.874659314662239},
+				{-4.545765852858791, 7.918426724994514, -3.1094987968487704},
+				{-6.878384497571217, 9.646954983707586, -3.306602358033719},
+				{-8.929029610088284, 11.170409739668843, -3.4803517301606286},
+				{-10.791111939792325, 12.558972338043043, -3.6399340426294677},
+				{-12.525711383632027, 13.859190784517052, -3.7914703077177134},
+				{-14.176963386185813, 15.105093849813729, -3.9394831702131787},
+				{-15.780012711558577, 16.32403482166554, -4.087679671823096},
+				{-17.365318340489495, 17.539939791322883, -4.23939306945497},
+				{-18.96138702251672, 18.775429232630195, -4.397879823069778},
+				{-20.596884482242565, 20.053500115619308, -4.566565640930687},
+			},
+			{
+				{43.5515306640601, -28.665586684979253, 1.7723170388128697},
+				{26.552345979465127, -16.434125860376493, 0.43329345040807915},
+				{17.34783502296742, -9.690982294334681, -0.35461595308722677},
+				{11.238244670669907, -5.158169148185894, -0.9049690211953438},
+				{6.758350994954258, -1.8068157724513743, -1.3196301570123032},
+				{3.257122442936243, 0.8285043626963217, -1.6487521924935271},
+				{0.39000572851068027, 2.9978270843481947, -1.921085085606065},
+				{-2.046832617181231, 4.85063893572893, -2.1546023556214102},
+				{-4.185375426093558, 6.484408400948136, -2.361430770680635},
+				{-6.117883873632225, 7.967567991609185, -2.5503355571471866},
+				{-7.910276691976712, 9.349434766494568, -2.727789759865605},
+				{-9.60979075139327, 10.665943395502053, -2.898615814406519},
+				{-11.252516440285136, 11.945045102773907, -3.0666573117451295},
+				{-12.868664512769346, 13.210494611936497, -3.2352715210434297},
+				{-14.485832843095867, 14.484267137676527, -3.4076552721807505},
+				{-16.131304065894238, 15.788322971693592, -3.587094489301472},
+			},
+			{
+				{43.49308206051834, -29.472074232927977, 2.249070819103607},
+				{28.125699993769633, -18.36297965061978, 1.015234425667084},
+				{19.592472905248496, -12.113679875939665, 0.290170614420856},
+				{13.834140251166192, -7.846241685400343, -0.2229797492242439},
+				{9.539828093917178, -4.6320413852202895, -0.6196262483656818},
+				{6.134569160151876, -2.062474583271024, -0.9423572571471682},
+				{3.3141822511394947, 0.08063204738220701, -1.2148757330377988},
+				{0.8974542471984219, 1.9286297409756263, -1.452113999151346},
+				{-1.233096572351789, 3.5674733758398625, -1.6642399021422083},
+				{-3.159449562703383, 5.057586806072975, -1.8586577132293192},
+				{-4.942815132204957, 6.444340051109564, -2.041112542585383},
+				{-6.630308285589288, 7.762975776863559, -2.216204440414527},
+				{-8.25852306466207, 9.041333838327784, -2.3876752487820045},
+				{-9.85749881973905, 10.302712740007157, -2.558753568784722},
+				{-11.454095442584702, 11.568298435208153, -2.7324675129175655},
+				{-13.074463668653557, 12.858998294190224, -2.9118936867416774},
+			},
+			{
+				{43.03669691600811, -29.7652287646544, 2.563265555340035},
+				{28.92756547957457, -19.500005396213727, 1.397997510569582},
+				{20.897119715183056, -13.600323168899248, 0.7084842381420999},
+				{15.425591621019521, -9.541768978965798, 0.22141923937109131},
+				{11.313981567783864, -6.464041550216168, -0.156510651027377},
+				{8.02797602108923, -3.9838169458779102, -0.46681824026460866},
+				{5.286577451344617, -1.8991136077846282, -0.7315348946241264},
+				{2.9233353821440913, -0.089636291568152, -0.9640759372006926},
+				{0.8305615323793033, 1.5230262939133916, -1.1734566996521063},
+				{-1.0665498867752403, 2.9937715156953733, -1.366199049979152},
+				{-2.823577410596901, 4.363671895308037, -1.5473477267722315},
+				{-4.484481952123453, 5.665419804115887, -1.7210546014005685},
+				{-6.085123413137362, 6.925967499680424, -1.8908556825956622},
+				{-7.65536594981532, 8.168158940930736, -2.0598467347302893},
+				{-9.221633593416518, 9.412595379809137, -2.2309117577719797},
+				{-10.809325415797765, 10.679400970296976, -2.40695498845753},
+			},
+			{
+				{42.49771697959557, -29.87935096305455, 2.8025203536071945},
+				{29.410420233283048, -20.29330212237838, 1.6892771682014265},
+				{21.782224131282415, -14.663102892398008, 1.0223340586542966},
+				{16.536297728738788, -10.761009683030117, 0.5513649068371798},
+				{12.575049082672278, -7.791554493118004, 0.18661523768435348},
+				{9.396467668604908, -5.3907352675766935, -0.11303652421358631},
+				{6.733842177872281, -3.3650851933543113, -0.36944817897276977},
+				{4.429493874727114, -1.60003774695317, -0.5956249264211999},
+				{2.3819494054252406, -0.02157508662474778, -0.8000858384479572},
+				{0.5210987181607732, 1.4217442416377972, -0.9888829251447774},
+				{-1.204746734004524, 2.768102400659408, -1.1666327736358781},
+				{-2.8363031496621978, 4.047769747355722, -1.3371098105006294},
+				{-4.40758100295048, 5.286208535095107, -1.5035933328982252},
+				{-5.947858469359227, 6.505611814643794, -1.669035978861032},
+				{-7.483232861363153, 7.72611755569499, -1.836205349929023},
+				{-9.038614457759461, 8.967286635127492, -2.007873698789944},
+			},
+			{
+				{41.98669157832876, -29.940521980434664, 3.00263421454467},
+				{29.748661348034197, -20.916719503605375, 1.931243570135177},
+				{22.455658570241333, -15.505780511756337, 1.280037266230658},
+				{17.39267965928563, -11.725249911242377, 0.8190990494365537},
+				{13.552067985936025, -8.83864333638152, 0.462675485584657},
+				{10.46191077038626, -6.500736668771451, 0.1704510124604696},
+				{7.867513217373568, -4.525065175432744, -0.07938626346516622},
+				{5.617151641553628, -2.8004445187090465, -0.2998614874135689},
+				{3.6131806801002764, -1.2551830867492144, -0.4994108975801308},
+				{1.7884146429450487, 0.16022324610400784, -0.6839221463994014},
+				{0.09364571925893449, 1.4822215443157174, -0.8578075111942399},
+				{-1.5095450034079345, 2.7394389238617647, -1.024616354861799},
+				{-3.0532404634384473, 3.9559221455010545, -1.1874153014476148},
+				{-4.565698245772818, 5.153020896726681, -1.3490101067845768},
+				{-6.072624262849628, 6.350428777195722, -1.5120691682135248},
+				{-7.598596287385685, 7.5672953311651305, -1.6792651427492031},
+			},
+			{
+				{41.54888317768346, -30.00624165530587, 3.181625900111264},
+				{30.025902921937018, -21.454591334461618, 2.1446771388022565},
+				{23.017958472366807, -16.226904749008558, 1.5049768250771114},
+				{18.107248976138223, -12.543947274745465, 1.0503260753141923},
+				{14.364475218428817, -9.721299974636448, 0.6988339397951813},
+				{11.34535291932257, -7.431447089610884, 0.4111931555828972},
+				{8.80656410191792, -5.4948318590392216, 0.16579374040296369},
+				{6.601599516798146, -3.8032074275033616, -0.05042428526749709},
+				{4.635593413733408, -2.2863718952415977, -0.2459552840799732},
+				{2.8432109263528074, -0.8958575319926905, -0.42669467214191376},
+				{1.1767612211540415, 0.40384834107914896, -0.5970051307810811},
+				{-0.4007271459211024, 1.6404132845743302, -0.7603399039529027},
+				{-1.919887806342744, 2.836882335202067, -0.9196374902141383},
+				{-3.4078569957035283, 4.013782409684292, -1.07758170583043},
+				{-4.889819140869409, 5.190342401277323, -1.2367546092358233},
+				{-6.39009479535909, 6.385398264455615, -1.399754122292736},
+			},
+			{
+				{41.20484209479098, -30.106606545066537, 3.3499594581655447},
+				{30.288553667140263, -21.95439428046163, 2.341494310292537},
+				{23.52531439042392, -16.881656478077584, 1.7102855900375296},
+				{18.743156198208037, -13.278383161594398, 1.259398318990849},
+				{15.080677385203265, -10.505626549946058, 0.9104814961302593},
+				{12.118398421386122, -8.251980317530425, 0.6252511376468076},
+				{9.62363253867416, -6.34452343020124, 0.38238897408792866},
+				{7.454867081590046, -4.677948416931046, 0.16887247993058},
+				{5.519748099225185, -3.183410129672027, -0.02384100437633102},
+				{3.754351637738182, -1.8131466291523273, -0.20170348353220435},
+				{2.111963317868738, -0.5321442554708272, -0.3691063120405752},
+				{0.5564846003579392, 0.6867653885181048, -0.5294914456258675},
+				{-0.9417835980064617, 1.8660369413968492, -0.6857457167466873},
+				{-2.4090485344841572, 3.0255695038187724, -0.8404757651718361},
+				{-3.8699062027676114, 4.184144543727506, -0.996194574399857},
+				{-5.348441774815215, 5.360345764680757, -1.1554448383500884},
+			},
+			{
+				{40.96518893752804, -30.259654286918014, 3.514466148199394},
+				{30.56565968865583, -22.446229619234142, 2.5293763983955446},
+				{24.013024510115013, -17.504763986908838, 1.9042549867132905},
+				{19.33987656850673, -13.966789663106736, 1.4552528304143484},
+				{15.743559010825997, -11.232899124649753, 1.1071854386107136},
+				{12.82659573219993, -9.006064543465598, 0.8227237997023262},
+				{10.36598236943088, -7.11948223675057, 0.5808567674169152},
+				{8.22491710365747, -5.470694081522303, 0.3686417960089687},
+				{6.313475902056865, -3.9922325706365602, 0.17752649156657777},
+				{4.5690433780925, -2.6370091904462294, 0.0015159859899363044},
+				{2.945676840405633, -1.3703689707876667, -0.16382585239516226},
+				{1.4078460473808296, -0.16541049119118514, -0.3219679757660267},
+				{-0.07357826336775534, 1.0000124472957201, -0.4757949054213403},
+				{-1.5241544510885043, 2.1454016931175155, -0.6278798619619985},
+				{-2.967932839271799, 3.2891691643226273, -0.780689608145268},
+				{-4.428763685038804, 4.449690365995315, -0.9367278539961681},
+			},
+			{
+				{40.836805923691216, -30.47799767036115, 3.680106107597419},
+				{30.877632138107177, -22.95139529477411, 2.713808088349503},
+				{24.50573507932795, -18.120475017056304, 2.092678967452315},
+				{19.924663523136655, -14.63549286863675, 1.6440268324514602},
+				{16.382521359385777, -11.931303911308593, 1.2954494582597111},
+				{13.501207317851579, -9.723565760109183, 1.0104694490025343},
+				{11.066377958705289, -7.850961731117151, 0.7683578045188709},
+				{8.945537490372672, -6.213662177296289, 0.55625858641426},
+				{7.051056718198093, -4.74553624798765, 0.3656306314780509},
+				{5.321593516244157, -3.4001881736735897, 0.19045858615183647},
+				{3.711935248310523, -2.1433214559845326, 0.026273962157254402},
+				{2.187012412014955, -0.9482379123165486, -0.1304229926891912},
+				{0.7180747737649162, 0.20703591187128195, -0.28253425291349743},
+				{-0.7200117191288757, 1.3417745419966711, -0.4326281146593806},
+				{-2.150859695933609, 2.4741239171880185, -0.5831470574542659},
+				{-3.5980877464190817, 3.622289770952532, -0.7365692955813368},
+			},
+			{
+				{40.82576067869683, -30.77207621063591, 3.8508684961198854},
+				{31.24062531779875, -23.486689295151326, 2.8991023820115163},
+				{25.022533987067828, -18.747500919505836, 2.280011635329179},
+				{20.51825188948218, -15.304435892351695, 1.8303467337732773},
+				{17.0196309146996, -12.621894463011678, 1.4801043829268963},
+				{14.165512533239214, -10.426609253739509, 1.1935383435532958},
+				{11.749195221885621, -8.562086201161007, 0.9501565135662595},
+				{9.642020045863948, -6.930827902354353, 0.7371740843929224},
+				{7.758440328793213, -5.467919173505626, 0.5460616077580348},
+				{6.038307329238347, -4.127628644594783, 0.3707932280606827},
+				{4.437117067675078, -2.8760299790595125, 0.20688148516695648},
+				{2.920239329998655, -1.6866352709921792, 0.050805769836599506},
+				{1.4592362088456836, -0.5376114212320392, -0.10035254780286385},
+				{0.02927398130313135, 0.5901704031141702, -0.2491667380543945},
+				{-1.3929264423731498, 1.7146714950627193, -0.39806848773691594},
+				{-2.830760706318329, 2.853957348006563, -0.5495194340823568},
+			},
+			{
+				{40.938912248458905, -31.151992674057094, 4.030295179406021},
+				{31.669023858227916, -24.066852026896147, 3.0889819695423357},
+				{25.57980756962373, -19.401777542154164, 2.4700102440143334},
+				{21.13802651003952, -15.990231350195403, 2.0180360007854077},
+				{17.673053714001657, -13.321899351638061, 1.6650748681541148},
+				{14.838415952490045, -11.133059269430701, 1.375980222966055},
+				{12.434061192946107, -9.271369854061355, 1.130439893167025},
+				{10.334672963703325, -7.641333550694084, 0.9157122951034143},
+				{8.456525473182673, -6.179077916749642, 0.7232665713161148},
+				{6.7405282824102075, -4.839452567836966, 0.547062936823684},
+				{5.14282597976199, -3.588873386731864, 0.38259858184284645},
+				{3.6292061897398855, -2.401064587276637, 0.22633967952629253},
+				{2.1715243644602813, -1.2543375658379483, 0.07535826570140594},
+				{0.7451990176032856, -0.12969030708545637, -0.072921398251238},
+				{-0.6727781816999334, 0.9906909923342326, -0.22092285498917205},
+				{-2.10558175227742, 2.124741071904457, -0.371093118254878},
+			},
+			{
+				{41.18500831860237, -31.628759802712374, 4.2218344156669865},
+				{32.17707781215327, -24.706161947903716, 3.28695623870449},
+				{26.193069759110564, -20.098220133031873, 2.6661402099117466},
+				{21.8000114963008, -16.708063790243404, 2.210552384985266},
+				{18.359192266326243, -14.04676539959682, 1.8538488738445298},
+				{15.536705383162078, -11.858680031216315, 1.5613413586582305},
+				{13.13818165225453, -9.994948507502937, 1.3128317893048065},
+				{11.041142919145647, -8.361721132957497, 1.0955859459467612},
+				{9.16339576143135, -6.895965420916322, 0.9010501513811779},
+				{7.446734770323022, -5.552990490397772, 0.7231579640241426},
+				{5.8478502440658575, -4.2994847904912925, 0.5573850272683931},
+				{4.332891470083288, -3.1093516183559164, 0.40018359697012185},
+				{2.873978238204655, -1.9610372285420967, 0.2486197404878396},
+				{1.4467567583207404, -0.8356632635237974, 0.10012004452238879},
+				{0.028459633743646583, 0.2844439431042219, -0.04772710794212322},
+				{-1.4038558604332079, 1.417076402486494, -0.19734948563951366},
+			},
+			{
+				{41.575662965782755, -32.21535718208187, 4.429125602235299},
+				{32.78019241671897, -25.419676379363736, 3.496609103198091},
+				{26.87834020724012, -20.85203042974397, 2.871872335215013},
+				{22.52032228978407, -17.473064083106824, 2.411299701500448},
+				{19.094212636252358, -14.811603866288493, 2.049806078604566},
+				{16.27664999243017, -12.618651174677625, 1.7530093200783678},
+				{13.87799510133764, -10.748150701137297, 1.5007510836404736},
+				{11.77809531898602, -9.107532026162824, 1.2802620888399525},
+				{9.895983737050887, -7.634379826549325, 1.0829386900919027},
+				{8.174142697395054, -6.284317032676379, 0.902669062542277},
+				{6.56967453752082, -5.024205225742907, 0.7348941471463556},
+				{5.048998590838411, -3.828059567562937, 0.5760436979487038},
+				{3.584437506943748, -2.6744191352638054, 0.4231735440804712},
+				{2.151823275156575, -1.5445060412168339, 0.27371221597584766},
+				{0.7285992212861009, -0.42077632714042934, 0.12526261948136144},
+				{-0.7079377350777563, 0.7144041755523068, -0.024577370644910678},
+			},
+			{
+				{42.12645537460288, -32.927841964811556, 4.65627572763432},
+				{33.49617122663612, -26.224403889450237, 3.7218636400031757},
+				{27.653398694209315, -21.679869326060448, 3.0909443367623597},
+				{23.316435918360547, -18.301496676464822, 2.6238927943918924},
+				{19.895341334336443, -15.632404595200551, 2.2564896719023144},
+				{17.07533009051655, -13.428815243363648, 1.9544931407341302},
+				{14.670535310269813, -11.54677869060277, 1.6977002639747463},
+				{12.562601485984924, -9.894614125049989, 1.4732574293526934},
+				{10.671465309787079, -8.410281823889742, 1.2724787753956155},
+				{8.940081515037551, -7.049554710890603, 1.0891839271643904},
+				{7.325809766831097, -5.779347851702763, 0.918761281827405},
+				{5.795221768107586, -4.573696276415443, 0.757603871495524},
+				{4.3207503694820435, -3.4111598424391953, 0.6027461601147046},
+				{2.878339210558534, -2.2730058748624518, 0.4516107407342212},
+				{1.4455842555072054, -1.1417813157448986, 0.3018109142011328},
+				{-4.2356815924310047e-14, 2.9055699502159875e-14, 0.15097163922832518},
+			},
+		},
+		{
+			{
+				{34.52793797380067, -14.201073936575023, -3.0438520446860715},
+				{9.344616026748259, 2.906138961109155, -4.630951940664228},
+				{-0.16550230820496067, 9.01775226803988, -5.012507203262362},
+				{-5.354581783914036, 12.213621862866477, -5.127088727844891},
+				{-8.761705439331168, 14.264611024470337, -5.163834419433932},
+				{-11.291062410560729, 15.784292125300288, -5.180545633335388},
+				{-13.359083200028309, 17.048065134852134, -5.200102220975027},
+				{-15.178561990656425, 18.19354245707191, -5.232184062747581},
+				{-16.866002315902268, 19.29495622294755, -5.280969565026733},
+				{-18.495226212044923, 20.399697532903538, -5.348965636165717},
+				{-20.121537872268462, 21.5445986868478, -5.438600962796611},
+				{-21.793442392034972, 22.763866895125545, -5.552955787525489},
+				{-23.559815929478383, 24.094146654158, -5.696273902218768},
+				{-25.475982759137764, 25.579146022915488, -5.874546652620798},
+				{-27.610801535575142, 27.27541330850093, -6.096382903608936},
+				{-30.057029004195584, 29.261131318801663, -6.37446096143306},
+			},
+			{
+				{50.050267606004354, -31.249752573795003, 1.611613449868098},
+				{26.951644585300752, -15.042399145168032, -0.13034422987824298},
+				{15.787052348117006, -7.016847716507465, -1.0665203936922996},
+				{8.833441842637178, -1.9620197287285142, -1.6701284122417177},
+				{3.9372868536004115, 1.621024184542002, -2.099124123034569},
+				{0.2048506125897258, 4.369099962832499, -2.4273478044624244},
+				{-2.8115555990849437, 6.605560485865902, -2.694162280033599},
+				{-5.3671178589735025, 8.51650206620247, -2.922938409492257},
+				{-7.624819229500087, 10.221652672997713, -3.129111915338552},
+				{-9.699705750078008, 11.806129050526717, -3.323959411385543},
+				{-11.676178200531451, 13.333378033990138, -3.5161337274579934},
+				{-13.619999897030018, 14.85413375851379, -3.7128004406625346},
+				{-15.589044015622692, 16.414347203602173, -3.9207230930213828},
+				{-17.641650715885167, 18.06157161798685, -4.147195340118604},
+				{-19.844349941541196, 19.851184484237436, -4.401012203383484},
+				{-22.28138843335365, 21.854378868350768, -4.693779482687673},
+			},
+			{
+				{50.38559792230074, -32.93622851535113, 2.4422107690190824},
+				{30.268778945843604, -18.76424306935529, 0.9041026852365539},
+				{20.12872297016195, -11.490985092726843, 0.06865646665150511},
+				{13.563586645404087, -6.70828246205124, -0.5041016290356672},
+				{8.784721893994409, -3.183762926062049, -0.937287483937452},
+				{5.049333261947961, -0.4002065059974362, -1.2849956043178097},
+				{1.9756639510584657, 1.9124186471008178, -1.5773175409717435},
+				{-0.6588857524343432, 3.9138890990982, -1.8330099781130045},
+				{-2.9969132397399414, 5.707995632583743, -2.0647928349592917},
+				{-5.138301414526388, 7.368469589936588, -2.282072250892064},
+				{-7.160599186559114, 8.953328932995491, -2.4925604066084994},
+				{-9.12921043283939, 10.512326204406058, -2.7031521529028666},
+				{-11.10234768336706, 12.090902445092519, -2.9204143382346714},
+				{-13.136954793276677, 13.734809552940824, -3.151227463268445},
+				{-15.295899332315997, 15.495788283810858, -3.4036341855946124},
+				{-17.65689546031982, 17.438889979679086, -3.6879944006924466},
+			},
+			{
+				{49.607343013510366, -33.290301870823406, 2.877978073366119},
+				{31.55647538876309, -20.463203716227284, 1.4443846234986657},
+				{22.142066389990713, -13.686984606652217, 0.6607286561092747},
+				{15.96702287963896, -9.185463106457638, 0.12395222326817482},
+				{11.415555447474897, -5.827041502354338, -0.2870195230617621},
+				{7.813010776425496, -3.1388111232950857, -0.6228193932128027},
+				{4.816388281614088, -0.8791065416755777, -0.9097653779847421},
+				{2.2257286856466356, 1.0944063834254845, -1.1639104939573734},
+				{-0.08774161997609897, 2.8746287166252653, -1.396177584488085},
+				{-2.2141698757761366, 4.527639475465016, -1.614680180522285},
+				{-4.222379324321442, 6.1048049789767775, -1.8260166099182584},
+				{-6.170799289574493, 7.65051562553409, -2.036159207124755},
+				{-8.114235794769003, 9.20718111468714, -2.251071300954488},
+				{-10.107724263548986, 10.818425780919497, -2.4771390046841515},
+				{-12.211617380732275, 12.533290860321985, -2.721789518961492},
+				{-14.499544850896648, 14.412754248193242, -2.994493111556832},
+			},
+			{
+				{48.695279311750625, -33.32836950483135, 3.1799240321791724},
+				{32.23669487426154, -21.526675617242017, 1.8203990043380527},
+				{23.36600449033478, -15.1030169564097, 1.0644919765007825},
+				{17.4820916722912, -10.798654263210889, 0.5476645052448239},
+				{13.120384528086625, -7.574580026978689, 0.15306454394420466},
+				{9.649922964674372, -4.982567665590324, -0.16985257770946033},
+				{6.747182165856781, -2.7923684017083596, -0.4471145985808605},
+				{4.224475438365663, -0.8698091039754083, -0.6940407936255173},
+				{1.961494753097561, 0.8718836891481867, -0.9207661522194479},
+				{-0.1258469823440727, 2.4941901409131217, -1.1347174364226935},
+				{-2.101448415794058, 4.044677316481343, -1.3418816269320728},
+				{-4.0186879197654415, 5.5639079915677065, -1.5475998804582822},
+				{-5.927604408815141, 7.090636741702066, -1.757207333282203},
+				{-7.880206740790996, 8.665868270243385, -1.9765743932200044},
+				{-9.934674221886194, 10.336428787688472, -2.2126287505604436},
+				{-12.161939166467869, 12.160498720751823, -2.4741973693441808},
+			},
+			{
+				{47.878367421405684, -33.314312095020085, 3.4237120353904076},
+				{32.690705773384295, -22.328415047745086, 2.1216140201761693},
+				{24.25524186724494, -16.177965159931396, 1.3828454227247884},
+				{18.594809249622983, -12.016121305648715, 0.8764605296754717},
+				{14.377246004107121, -8.887865433014216, 0.4909193296133613},
+				{11.011969255053653, -6.3690634669260655, 0.17646913261363872},
+				{8.190374027916365, -4.237600025643391, -0.09305028155012685},
+				{5.731821954851494, -2.363036814277868, -0.33307850738421063},
+				{3.520512327423798, -0.6612657881538572, -0.5536712923856675},
+				{1.4757676939580113, 0.9269071735356084, -0.7620445943209061},
+				{-0.46350461165185697, 2.4470001778386963, -0.9639157254050316},
+				{-2.347950832113225, 3.937505795339505, -1.1643056955026472},
+				{-4.224318721076146, 5.434700312206456, -1.3681415387964142},
+				{-6.141346308346478, 6.977039267642581, -1.5808408470971904},
+				{-8.154931987606613, 8.609295398147568, -1.8089021301291002},
+				{-10.334037870247851, 10.38759768322233, -2.06066520031398},
+			},
+			{
+				{47.22636181778113, -33.34461015355579, 3.6413421657812766},
+				{33.07056531171194, -23.019492577797042, 2.38482742595068},
+				{24.993261035018257, -17.08869657771876, 1.6572145015009554},
+				{19.510564551299108, -13.034384449171064, 1.1558991747712708},
+				{15.403002857968918, -9.974115203968939, 0.7744550855924052},
+				{12.116695068908331, -7.506423206990774, 0.46427656480340296},
+				{9.35727260441867, -5.417443298276555, 0.19935025927668432},
+				{6.950157716299953, -3.5799061192175157, -0.03589361352135041},
+				{4.78247664418949, -1.9110949740356045, -0.25165385093118536},
+				{2.775351831132025, -0.35272854551674115, -0.45520475533447663},
+				{0.8691099354011594, 1.1398450889195242, -0.6522289032458761},
+				{-0.9855299972051527, 2.604149906706711, -0.8476342485845595},
+				{-2.8336004309639646, 4.0751742744234125, -1.0461473940417083},
+				{-4.721464815071503, 5.589577115458317, -1.2528786059490533},
+				{-6.702793952644585, 7.190304412159911, -1.4739700205884785},
+				{-8.844956747197752, 8.931890111471896, -1.7173785922538936},
+			},
+			{
+				{46.76361101329247, -33.463325646891256, 3.8497639529437646},
+				{33.4527066445704, -23.677081692521956, 2.629222928463828},
+				{25.672719916974515, -17.924628185503856, 1.908586269496795},
+				{20.333446337299772, -13.952651406995288, 1.4088815177903269},
+				{16.310534460675584, -10.940457651120147, 1.0282496383171698},
+				{13.082302391722745, -8.506723119027445, 0.7192286388323244},
+				{10.367522454716752, -6.445447634242848, 0.45608042220784595},
+				{7.9975311348502105, -4.632678201247318, 0.22323475415714533},
+				{5.86220712430835, -2.987076654702777, 0.010397853315784413},
+				{3.8839888757033982, -1.450918998265189, -0.18981096453137192},
+				{2.003929712256165, 0.020151492405412006, -0.3831441540879735},
+				{0.17331704059376424, 1.4633476206417138, -0.5745205918517953},
+				{-1.652168387784737, 2.9131487815212194, -0.7686052071031216},
+				{-3.517627716625036, 4.405261562965116, -0.970346900685781},
+				{-5.474990922312991, 5.9812681630262166, -1.185627511750308},
+				{-7.590298021431151, 7.694449883710649, -1.4221014453102954},
+			},
+			{
+				{46.50131839353847, -33.696002597049336, 4.059715760996828},
+				{33.883436877131416, -24.348463961006246, 2.8667201581964354},
+				{26.349455708058553, -18.739649241681935, 2.149620476955659},
+				{21.125438094714504, -14.829785806647815, 1.6489424440188956},
+				{17.16700736199769, -11.85028118872215, 1.2667534836827317},
+				{13.980214459230105, -9.437203221951162, 0.9565953411981356},
+				{11.295433192823442, -7.391539735075729, 0.6929965849505509},
+				{8.949460708457009, -5.592483086446683, 0.4604340214722899},
+				{6.834899521312738, -3.960171842726472, 0.24858460814998057},
+				{4.87553128858287, -2.43753514013293, 0.05000803953160767},
+				{3.0130183191178954, -0.9804517023914759, -0.1411137549809386},
+				{1.1989034518276425, 0.44822182237525354, -0.3297487173440743},
+				{-0.6109492496348707, 1.882869622499768, -0.520571551747944},
+				{-2.4611928193796526, 3.358856130788393, -0.7184719280279852},
+				{-4.402734253083913, 4.916968705062224, -0.9291716555494364},
+				{-6.500598583260569, 6.60957597058347, -1.1600950679071913},
+			},
+			{
+				{46.44883273938679, -34.06222749948444, 4.279206654732822},
+				{34.396470623056786, -25.067987862287882, 3.105965369376757},
+				{27.063067551449386, -19.571819043650738, 2.389218643339567},
+				{21.929513559175252, -15.706378719858108, 1.8853578199870786},
+				{18.018278579038796, -12.74659810827728, 1.4997025928820729},
+				{14.858961109455185, -10.343241913707555, 1.1866022187235652},
+				{12.191869333732738, -8.303226210105393, 0.9207807168542728},
+				{9.858544200719118, -6.508452112570353, 0.6867424609310543},
+				{7.754052896201131, -4.8804107898315605, 0.4741480589000726},
+				{5.803503117598742, -3.362731115580773, 0.2755309178370716},
+				{3.949231586353042, -1.9116253034524968, 0.0850437685653333},
+				{2.1430443833673913, -0.49005696175199404, -0.10231041700479751},
+				{0.34083163429666374, 0.9363665655379663, -0.29122572896222293},
+				{-1.5020414465491674, 2.4029780034089225, -0.48657908089068636},
+				{-3.4361011480206414, 3.950245260041739, -0.6940078539724814},
+				{-5.5257506392779865, 5.6299782942539105, -0.9207718055051705},
+			},
+			{
+				{46.618633611285944, -34.581463688171425, 4.515215315110233},
+				{35.021228254059025, -25.86508344230133, 3.35421519924775},
+				{27.84649437480205, -20.452481117574973, 2.634623837066803},
+				{22.780317128899025, -16.614885935081464, 2.125471215444101},
+				{18.900448139311965, -13.663013720351207, 1.7346354643268933},
+				{15.756118587340007, -11.25972007793982, 1.4170429154572486},
+				{13.095896378314139, -9.216729634439664, 1.1475107501035013},
+				{10.765234426298708, -7.418091176914125, 0.910517344068844},
+				{8.661228331428873, -5.786355674629607, 0.6956802024972835},
+				{6.710110223481397, -4.2657273008083, 0.49550113479330615},
+				{4.85485093315919, -2.812750494037374, 0.3041174440936847},
+				{3.0475706634518076, -1.390566554657163, 0.11652884432111241},
+				{1.2442255076385842, 0.03516387121221263, -0.07195454953390121},
+				{-0.5999148128932754, 1.4997971368439054, -0.2661941165051323},
+				{-2.5353840459823607, 3.0437422738540145, -0.47177490942828065},
+				{-4.626344105369208, 4.71855014575696, -0.6958380651446447},
+			},
+			{
+				{47.02950801929325, -35.27671615505634, 4.774744718778806},
+				{35.78778598350594, -26.76900015421727, 3.6184382528643155},
+				{28.731515894754875, -21.411446458445756, 2.892600419776305},
+				{23.710143321514938, -17.585248895851883, 2.375969183726401},
+				{19.846282923146244, -14.62976708300642, 1.9782637455892114},
+				{16.705070761370646, -12.217389097362764, 1.6547259065445477},
+				{14.041662567834683, -10.163489015473408, 1.3801410932913316},
+				{11.704538588235659, -8.353638445573427, 1.1388888338527048},
+				{9.592294262620708, -6.711065895113363, 0.9204951505710695},
+				{7.631950728872148, -5.180303693273734, 0.7173974702765231},
+				{5.766912386004839, -3.718082058110591, 0.5236996373111421},
+				{3.949530085334731, -2.2876680441501547, 0.3343970104044286},
+				{2.1358352081095466, -0.8548043960016083, 0.14481932082394475},
+				{0.28102256799023667, 0.6158393806191809, -0.049856938534847184},
+				{-1.6655806291422601, 2.1646656148700405, -0.2551559074909139},
+				{-3.768142134898231, 3.8431241386457904, -0.4781115314387077},
+			},
+			{
+				{47.70967904015203, -36.177832664062755, 5.065691018076922},
+				{36.73084213695334, -27.81252538906191, 3.906156881356775},
+				{29.752872284709333, -22.480813836471256, 3.170286080283249},
+				{24.753218310155404, -18.648863190623967, 2.643766153982383},
+				{20.889686043569107, -15.677879584652588, 2.2373991870913654},
+				{17.739663905558317, -13.247200182765704, 1.90644412965547},
+				{15.063191085114068, -11.174628133845578, 1.625505987424954},
+				{12.71084155926899, -9.346576762368153, 1.3787764617830072},
+				{10.582124669570995, -7.686513820918012, 1.1556280536327073},
+				{8.604437777871194, -6.138982141674203, 0.9483852640021226},
+				{6.721305303804245, -4.660650317786451, 0.7510785935623826},
+				{4.885072281313248, -3.21472250009207, 0.5586685493047473},
+				{3.0516934050520472, -1.7669062729031262, 0.3664882706684566},
+				{1.1762243728827062, -0.28186278481840343, 0.1697552137768315},
+				{-0.7921934845483063, 1.2807979572629655, -0.036975962521197336},
+				{-2.9178290520202808, 2.972442068052735, -0.26061190094932934},
+			},
+			{
+				{48.700981061565095, -37.325529900110844, 5.397793328245441},
+				{37.89402254336611, -29.035922300980634, 4.226308610244722},
+				{30.95242191941574, -23.69875721114098, 3.4760166787413658},
+				{25.949806685152943, -19.84232614688165, 2.9368236885741945},
+				{22.069823929669358, -16.842931370582228, 2.5197829871619835},
+				{18.898394783702003, -14.384147487661476, 2.1798225054637963},
+				{16.198642644978424, -12.284878311851212, 1.891187837929848},
+				{13.822225363940095, -10.431621166265428, 1.63777496514755},
+				{11.668919864375509, -8.747588108040222, 1.408727859290134},
+				{9.666030054114671, -7.176963933440135, 1.1961984862810098},
+				{7.7568159855918575, -5.676046209592201, 0.9940909645737485},
+				{5.893273255893148, -4.207693580303638, 0.7972805699183756},
+				{4.030965397876707, -2.737317035333849, 0.601056933449513},
+				{2.1245416853444894, -1.2293509336112798, 0.40064177402273776},
+				{0.12275874153030804, 0.3567247006678, 0.19065297445686524},
+				{-2.038874947952313, 2.072238116297635, -0.03567483417608543},
+			},
+			{
+				{50.065339066330914, -38.77731563103019, 5.783927148997388},
+				{39.33574583886998, -30.492197220458017, 4.5903679606651595},
+				{32.3845286102543, -25.114368918520622, 3.8203619771708577},
+				{27.351340110066534, -21.212062888990218, 3.2651466034082475},
+				{23.436130349579642, -18.16959080564449, 2.835067385472015},
+				{20.229371574938728, -15.671773679447421, 2.4842998198050594},
+				{17.495283110618317, -13.537104773586234, 2.186508463047911},
+				{15.08546905247038, -11.651289603156936, 1.9251596953408567},
+				{12.899239302489523, -9.936710565887712, 1.6890771977666859},
+				{10.863268355415117, -8.336768282542668, 1.4701689081432032},
+				{8.920107472448281, -6.8070289214949184, 1.2621491608693594},
+				{7.020985901180697, -5.309660894269644, 1.0597449065474662},
+				{5.120645901021978, -3.8094172946115625, 0.8581345237922627},
+				{3.1728685190556605, -2.270115086852986, 0.6524707206432955},
+				{1.125544940218007, -0.6507045604294288, 0.4373632133263595},
+				{-1.0864708530356586, 1.1004365844564228, 0.20614012469768758},
+			},
+			{
+				{51.89567283464315, -40.61720143736469, 6.242140270283313},
+				{41.13843610412636, -32.25529023211384, 5.014064299400009},
+				{34.124302629429515, -26.795015503794897, 4.217682895337198},
+				{29.028116690574286, -22.821227856856403, 3.6422569675975494},
+				{25.055706678797396, -19.71827392917622, 3.19624869342541},
+				{21.797562266469885, -17.168716479995453, 2.832546546143889},
+				{19.016675348333795, -14.988824754259987, 2.5239487408750185},
+				{16.56324737416204, -13.06244979155384, 2.253319820853644},
+				{14.335246384009086, -11.310448523939217, 2.009046874866015},
+				{12.25808225571268, -9.674926799495312, 1.7827055968816077},
+				{10.273075560687431, -8.110294671213547, 1.567744581227389},
+				{8.330200113439528, -6.577626740362481, 1.3586679074667067},
+				{6.382857884013259, -5.040583812127687, 1.1504604142935437},
+				{4.383354969972497, -3.461851539629107, 0.9381070938926787},
+				{2.2779699009237526, -1.7992147653196329, 0.7160879154868487},
+				{-8.18573033040247e-14, 5.436873077672195e-14, 0.4776853802927917},
+			},
+		},
+		{
+			{
+				{51.63225224771741, -26.365942845904236, -1.1443965669451739},
+				{16.642297109231066, -2.5789267911614324, -3.5618417891510403},
+				{4.60435626049249, 5.335418451738143, -4.215075041805977},
+				{-1.7592514290926016, 9.402842974031213, -4.47488113132036},
+				{-5.870716147928226, 11.999480741281053, -4.608715507895474},
+				{-8.885089842062944, 13.913914464286057, -4.698933338070459},
+				{-11.311704004961518, 15.4882651308066, -4.778439405129937},
+				{-13.424278668353114, 16.904856900851165, -4.863961673727605},
+				{-15.38910443625132, 18.27520241397293, -4.9657280388346186},
+				{-17.317925583641617, 19.676614885333333, -5.091296258840975},
+				{-19.299977539413334, 21.1745463074116, -5.248093611286846},
+				{-21.422632538665784, 22.837612339068894, -5.4453118097839335},
+				{-23.7886415576128, 24.75125026617309, -5.695903714489667},
+				{-26.537807294703917, 27.036245445226626, -6.019627795171579},
+				{-29.88433285008868, 29.881873020393403, -6.448800790057541},
+				{-34.195467173512675, 33.6166448404041, -7.040834982250851},
+			},
+			{
+				{61.258100672263055, -38.32950905968124, 2.5011540117906503},
+				{32.33160702476692, -18.516693046878483, 0.43679474179372063},
+				{19.654204209184144, -9.592105749984544, -0.5793228458316366},
+				{11.958598338702167, -4.079692855023043, -1.2325228195252755},
+				{6.5946776424604705, -0.19143849912047595, -1.699890198315578},
+				{2.516262147494444, 2.7979726532530433, -2.061828656996219},
+				{-0.7896952470447294, 5.25103299886735, -2.3612308247367717},
+				{-3.6122919175291752, 7.37531348992128, -2.623758795737503},
+				{-6.133856700798866, 9.304150898066176, -2.8665519312435688},
+				{-8.483872993778936, 11.13447676286825, -3.102624147810008},
+				{-10.768849974684805, 12.94822118013942, -3.3435468126866477},
+				{-13.088174396429471, 14.824489281227587, -3.601098778908614},
+				{-15.54667915811799, 16.85009159563059, -3.8888577254568784},
+				{-18.27319205472653, 19.13532331981622, -4.22474765853478},
+				{-21.451592969326615, 21.841064835712693, -4.635581332652861},
+				{-25.382576411144147, 25.233621203158226, -5.166421244571359},
+			},
+			{
+				{59.82312268474039, -38.88309278514669, 3.2253351413309432},
+				{35.0004551149625, -21.70048992656533, 1.3697934473964535},
+				{23.552686250744976, -13.632182600711289, 0.4552368128738251},
+				{16.397486461222204, -8.50104442309272, -0.15045598775359098},
+				{11.261146101833882, -4.759085205060975, -0.6058155768255206},
+				{7.262067166273335, -1.8033310118113157, -0.9734838561825615},
+				{3.9641881817354623, 0.6685340232158514, -1.2864181839506938},
+				{1.116174790986361, 2.8339770707167857, -1.5651050840650715},
+				{-1.4440302973979497, 4.809962288987844, -1.8238168836091766},
+				{-3.8323430769362976, 6.682509316293637, -2.073657867347568},
+				{-6.141808884064386, 8.523116730485294, -2.3244169489990596},
+				{-8.458992449304935, 10.400799460905008, -2.5860991112548826},
+				{-10.87880661559624, 12.393610065308224, -2.870569818498723},
+				{-13.519538087344417, 14.60153419514676, -3.193582080604896},
+				{-16.547759681737144, 17.168519108398602, -3.578381912845851},
+				{-20.23108750733633, 20.32906581059994, -4.063419407786536},
+			},
+			{
+				{57.942604802415715, -38.58006161105634, 3.6163332668613783},
+				{36.00458141281508, -23.212701434558298, 1.8886994929998915},
+				{25.38614472721643, -15.675974849271931, 1.0178287047887071},
+				{18.657564091229244, -10.834311718807575, 0.44384005441076785},
+				{13.786309451569824, -7.27938297180513, 0.01215844150314357},
+				{9.959075997487245, -4.4464238952224795, -0.33941333906029025},
+				{6.7752498068376354, -2.056059417608941, -0.6418737427846146},
+				{4.005824346935524, 0.05306229321064184, -0.9135657569633677},
+				{1.5029532006029336, 1.9870192815593104, -1.1670707822835538},
+				{-0.8399669934482744, 3.8243441357119012, -1.412220978064906},
+				{-3.1091731986770976, 5.630794392682011, -1.6577578608995083},
+				{-5.3841117655727535, 7.4694045015939725, -1.9125709943997702},
+				{-7.750050141930721, 9.41034519936113, -2.1870631974785906},
+				{-10.31471469501084, 11.544454068623445, -2.4951807593472366},
+				{-13.232724737216426, 14.004297845357605, -2.857744374925278},
+				{-16.75303325996722, 17.00600902963402, -3.3092002600302073},
+			},
+			{
+				{56.308987825793906, -38.21371635251571, 3.900090319257396},
+				{36.54599794206455, -24.21367067177164, 2.267708177854406},
+				{26.556076418943213, -17.062302306790222, 1.420328416698539},
+				{20.134219572139195, -12.413301804901202, 0.8608280840221563},
+				{15.458858463685257, -8.987845988980306, 0.44207149949774777},
+				{11.774005373762927, -6.253693385508621, 0.10245535569705412},
+				{8.699278766262163, -3.941952704013697, -0.18934919216413246},
+				{6.016033025106894, -1.8969845742287883, -0.45166681911479545},
+				{3.583607686591227, -0.017360623954683022, -0.6966972453701241},
+				{1.3008216279212046, 1.771478323014314, -0.933746067956744},
+				{-0.9143937108869369, 3.531901008879564, -1.1709926325066617},
+				{-3.13801172295714, 5.3238179832429084, -1.4167234278082539},
+				{-5.451107198049822, 7.213567221566132, -1.6805582629398461},
+				{-7.954427357136207, 9.28594135135237, -1.9752285143120036},
+				{-10.793512826197947, 11.665259185177161, -2.3198006091710686},
+				{-14.205783840822399, 14.556136563644726, -2.7460617789510686},
+			},
+			{
+				{55.0340023634385, -37.96940007577521, 4.144246500742061},
+				{36.95808249361938, -25.029953491994146, 2.585434873104721},
+				{27.46833302775984, -18.177045492248343, 1.7521684008260987},
+				{21.279316119173856, -13.664853658345605, 1.1985330612953735},
+				{16.745229800596245, -10.32479184187301, 0.7848292587518737},
+				{13.162329456497583, -7.655853368479868, 0.45085994803541524},
+				{10.169235763235301, -5.399690949427525, 0.1653663790222535},
+				{7.555011543996442, -3.404473670665156, -0.09020854175303329},
+				{5.18275541408602, -1.5705457958766689, -0.32823269729751475},
+				{2.9537827302986672, 0.1752359131190591, -0.5580041049678831},
+				{0.7880607915157708, 1.8937651309554373, -0.7875360121004079},
+				{-1.3886387962232432, 3.643381562063628, -1.0248277412199693},
+				{-3.6557471312864864, 5.4886377814552665, -1.2790855096566573},
+				{-6.111301396969268, 7.511510234577044, -1.5623685312856173},
+				{-8.895432458617792, 9.831219407978857, -1.8925689148797724},
+				{-12.238520669034504, 12.645148833078458, -2.299638185197706},
+			},
+			{
+				{54.12764863084927, -37.90710461160778, 4.378462713212435},
+				{37.37905722041609, -25.799952035020073, 2.876098289374177},
+				{28.292786698919155, -19.181883693484448, 2.0508930408478094},
+				{22.284776753200536, -14.769271638078099, 1.4981123457364993},
+				{17.853723787310702, -11.485038411784146, 1.0846146875876215},
+				{14.340921225342564, -8.855539363973135, 0.751644390620159},
+				{11.402499956755777, -6.6325832061175545, 0.46824859804049707},
+				{8.83515336402966, -4.668489674045016, 0.21585220054668927},
+				{6.505411825468581, -2.865280127537469, -0.018031170685993844},
+				{4.316155391452154, -1.1504892485740388, -0.24281228885378395},
+				{2.188165642955579, 0.5363571832855105, -0.46654239626341204},
+				{0.04774258714195733, 2.2531172455873025, -0.6971514663501159},
+				{-2.1842123745244857, 4.063719371941534, -0.9436576907074457},
+				{-4.605426094696881, 6.049215252261121, -1.21777363340457},
+				{-7.35441344170571, 8.326661718567724, -1.5367053178366528},
+				{-10.65839454878504, 11.089621594212748, -1.929176215580733},
+			},
+			{
+				{53.584416057730365, -38.055001951727924, 4.619708018514938},
+				{37.88412581519381, -26.600204422778468, 3.158990943960668},
+				{29.12158843965321, -20.1645586046939, 2.336902948282313},
+				{23.25330589252944, -15.822621739144395, 1.7813906309455487},
+				{18.89609442644054, -12.572388647524164, 1.3647878842929209},
+				{15.429048016941543, -9.963201075467635, 1.0295504608280581},
+				{12.523364111895148, -7.755636443373917, 0.7450029962460383},
+				{9.982663609624078, -5.805892148172392, 0.4926155773601547},
+				{7.676919166176183, -4.0178507856225005, 0.2598854269517603},
+				{5.5106858705364985, -2.3199147790921377, 0.037365737456404605},
+				{3.405397997362325, -0.6519331096793208, -0.18302974207440734},
+				{1.287377530588006, 1.043901955955092, -0.4092356213659867},
+				{-0.9229218939293159, 2.8315865016622337, -0.6502108417872842},
+				{-3.3242004150081783, 4.792327601696645, -0.9175120172198553},
+				{-6.056132524361628, 7.0431449005995255, -1.2280000419349444},
+				{-9.346512370489462, 9.77680419042724, -1.6096616139562852},
+			},
+			{
+				{53.404479467450976, -38.43566283819421, 4.88023284166066},
+				{38.52631007379409, -27.484435314637505, 3.4476024776706606},
+				{30.017472071281986, -21.18447095720154, 2.623925679211517},
+				{24.252817035692093, -16.8880022614205, 2.0625929600576196},
+				{19.94480145744967, -13.65364755126335, 1.6402552148149185},
+				{16.503577271158463, -11.049445920911841, 1.300250580577438},
+				{13.612733163185958, -8.843038780685152, 1.0120477229520068},
+				{11.081598203439539, -6.893729272613473, 0.7571045274710405},
+				{8.783078361418468, -5.106969523605983, 0.5228965680569617},
+				{6.623326104719554, -3.4120492217434353, 0.2999792057925259},
+				{4.524556855415892, -1.7493056574456944, 0.0802896225254505},
+				{2.413184253142207, -0.06107376430544476, -0.14407261769348645},
+				{0.20900642719939658, 1.7168755885702982, -0.3820221087234335},
+				{-2.188304200463269, 3.666455102802023, -0.645045312066446},
+				{-4.921320504855844, 5.905996479450494, -0.949863451330624},
+				{-8.221743825800118, 8.630043117337753, -1.3241099614871896},
+			},
+			{
+				{53.60117649004061, -39.076513825427874, 5.171041203689469},
+				{39.353529164610094, -28.499864591269723, 3.7534257925311096},
+				{31.03353269425116, -22.29121762496999, 2.923236820877341},
+				{25.338539453712862, -18.01619215494391, 2.3530235648036006},
+				{21.0570113660872, -14.781034944426562, 1.922542146778024},
+				{17.623839391226372, -12.168302855594542, 1.5756189593370966},
+				{14.73231237974094, -9.950896684931685, 1.281683554469973},
+				{12.196060787527893, -7.990256507915728, 1.0220716198945274},
+				{9.89008194072434, -6.192807699210164, 0.7841628685185216},
+				{7.721620076886926, -4.488367982383417, 0.5584712625998194},
+				{5.613449512556543, -2.817622998357306, 0.33695137733196645},
+				{3.4920951711280694, -1.123107641268033, 0.11176967689258084},
+				{1.276803029962477, 0.659515838391664, -0.12589524329592403},
+				{-1.1344998993342297, 2.612930457611988, -0.3874412957032244},
+				{-3.8881906712250625, 4.857456375451172, -0.6895256348485618},
+				{-7.222526946807829, 7.591392319243526, -1.0596862752402798},
+			},
+			{
+				{54.2064122560506, -40.01674270068411, 5.504048808748351},
+				{40.418678400108135, -29.696694314242645, 4.088133304578732},
+				{32.22425356976625, -23.53478598138778, 3.2459430263530784},
+				{26.56489614198945, -19.256604167920052, 2.663510505783534},
+				{22.28732266457099, -16.0039211408748, 2.222411902404149},
+				{18.845054770782486, -13.369620052473207, 1.8664999461215985},
+				{15.938352418236958, -11.12995806322938, 1.5649400293276774},
+				{13.383648656227756, -9.14743853070921, 1.2988055202051312},
+				{11.057041894045136, -7.328725427559707, 1.0552736241238414},
+				{8.866108878015764, -5.603582174013524, 0.8247251646265914},
+				{6.733566832594025, -3.9125616293606202, 0.5990543625566211},
+				{4.585610443185137, -2.198059765684962, 0.3704453111939283},
+				{2.3407487884857288, -0.39551533963083596, 0.13017120937620305},
+				{-0.10481122614430101, 1.578444915044294, -0.1330240206445514},
+				{-2.901492956258896, 3.846229477507227, -0.435687320597324},
+				{-6.295854389529915, 6.611098307079439, -0.8053559866556171},
+			},
+			{
+				{55.27800582148035, -41.31496538731527, 5.89408389244742},
+				{41.78875449305568, -31.13644806514382, 4.465417666054029},
+				{33.65460478494437, -24.973790956860572, 3.604778046588181},
+				{27.994731561944377, -20.66564099824188, 3.0062298667687055},
+				{23.697258632817785, -17.377438021923115, 2.551750384594413},
+				{20.228153019982567, -14.707990031407924, 2.1846666389292935},
+				{17.291764928200557, -12.434827920880418, 1.8736026319714452},
+				{14.705707988429344, -10.420320128430511, 1.5991979333231148},
+				{12.346084788541479, -8.570536264624812, 1.3482979996270543},
+				{10.119912017535011, -6.81448730884109, 1.111033874350444},
+				{7.949000567090958, -5.091912968872801, 0.8791200790379865},
+				{5.758345721540869, -3.344365834353037, 0.6446334963087047},
+				{3.464893317576681, -1.5063978183543267, 0.39883103055032015},
+				{0.9625643064828939, 0.506473021651487, 0.13055268669448108},
+				{-1.9033163790942582, 2.8187941260957032, -0.1765740612777103},
+				{-5.388412581265667, 5.639173508762025, -0.5499991888401514},
+			},
+			{
+				{56.91229451961633, -43.06090841214497, 6.361520561191987},
+				{43.55682454383064, -32.9026185721578, 4.903254747299893},
+				{35.41110676958072, -26.685306658425784, 4.016193933935915},
+				{29.709953585084495, -22.31617796931449, 3.396734314264802},
+				{25.36578053370326, -18.97187549805322, 2.925587261259409},
+				{21.850330345894097, -16.25221287629808, 2.544865672574457},
+				{18.868821401837756, -13.933585228601565, 2.2222988575642186},
+				{16.23820214315513, -11.876823932099986, 1.9378763579429275},
+				{13.833329310941672, -9.986436973777664, 1.6779542381456678},
+				{11.559642749722356, -8.189879886119764, 1.4322755283976676},
+				{9.337049461924668, -6.425276216271064, 1.1922307453164775},
+				{7.08820552366483, -4.632404738284817, 0.9496185827974674},
+				{4.727094261383188, -2.743781342418366, 0.6954692945641588},
+				{2.1437381996701212, -0.6725020743073642, 0.4184909061678591},
+				{-0.8221668064284106, 1.709171421722536, 0.10235067659581831},
+				{-4.436187970380349, 4.615557154309648, -0.2802019965563156},
+			},
+			{
+				{59.267692257569955, -45.396207022766966, 6.936636731744349},
+				{45.86172259285098, -35.11793278792959, 5.427493688843213},
+				{37.61934860522752, -28.78027753903337, 4.503593914832802},
+				{31.827940601919725, -24.312055821057452, 3.857019289726528},
+				{27.40516160135303, -20.886755283433594, 3.365094580619982},
+				{23.82073224981683, -18.099246983264884, 2.967807031857357},
+				{20.776862706097656, -15.721801764718986, 2.631517604987735},
+				{18.087588823951595, -13.611963655987546, 2.335278909085606},
+				{15.625005889487989, -11.671486407067988, 2.0647549946838506},
+				{13.291764781164147, -9.82530580396448, 1.809130907964268},
+				{11.004697061855994, -8.008986598952381, 1.55930159975789},
+				{8.682761296736444, -6.15942897108338, 1.306582251185942},
+				{6.2351656236934785, -4.205624711753019, 1.0414979631386432},
+				{3.5454426618747323, -2.05610792926733, 0.7522284235896013},
+				{0.4441794310429368, 0.4229093281265222, 0.42197834397725714},
+				{-3.3474543896492532, 3.4540353377901245, 0.02337103207572984},
+			},
+			{
+				{62.61184609116663, -48.55528427192647, 7.66792848065307},
+				{48.92528591332329, -37.976784343882954, 6.078536528223718},
+				{40.476574027666025, -31.432065015854114, 5.103287002466391},
+				{34.53180140405552, -26.814717602405132, 4.421135082661919},
+				{29.9901177238877, -23.276578164630376, 3.9030546128528085},
+				{26.30916046752032, -20.399673453549617, 3.4856072651348664},
+				{23.183042225774948, -17.948125170069684, 3.133108491932818},
+				{20.419920380924207, -15.773832384301633, 2.823268824851782},
+				{17.887142625002774, -13.774210368144857, 2.5407820672717967},
+				{15.48301203629005, -11.870428832581043, 2.2740513730755176},
+				{13.11981524066386, -9.994315556935598, 2.0132599104268882},
+				{10.711140652593745, -8.078544297262859, 1.748987985312552},
+				{8.159173247045267, -6.04675657896685, 1.470911963667472},
+				{5.337518667578975, -3.8001028530442915, 1.1661516905084526},
+				{2.061988437853286, -1.194044504139702, 0.8165732866686853},
+				{-1.9683001706433068, 2.009600059883354, 0.39339100144294303},
+			},
+			{
+				{67.42469574173293, -52.954100869543545, 8.63996089629125},
+				{53.131488118569365, -41.813988097119, 6.925447550695831},
+				{44.32048905456809, -34.93663402937649, 5.87701969419558},
+				{38.134270186576416, -30.09938851300956, 5.147010952591976},
+				{33.41952069057852, -26.405317706762272, 4.595426193238028},
+				{29.607225779429523, -23.411898583815926, 4.153374108740583},
+				{26.376003096401067, -20.869139741373747, 3.782077462179649},
+				{23.52383847177254, -18.619832055701796, 3.4572895231991247},
+				{20.910491488993486, -16.55476768444008, 3.162323693217643},
+				{18.42772988630726, -14.58960173829403, 2.8844839723599667},
+				{15.981277640735488, -12.650822885026699, 2.6129478311274696},
+				{13.477099232538281, -10.665093147415039, 2.3372297287784676},
+				{10.807361910932796, -8.548320677080879, 2.045711690591244},
+				{7.831147246489127, -6.190572005142383, 1.7237577002668918},
+				{4.34149141591116, -3.430010484642092, 1.3506605093022614},
+				{-1.9943012997945226e-13, 1.3907044065222248e-13, 0.8938140096034137},
+			},
+		},
+		{
+			{
+				{71.37676974379387, -39.57371596307885, 0.5573428402643068},
+				{23.88387825829211, -7.729674834858039, -2.6830779381867265},
+				{9.16947339724043, 2.02690165968758, -3.588728302474325},
+				{1.6621525877563772, 6.910796501244198, -3.972597132755481},
+				{-3.1006904674522335, 9.992436523969838, -4.185498811271438},
+				{-6.556446382229619, 12.252341980151607, -4.3349644146169535},
+				{-9.320549519794717, 14.106672416519826, -4.4642842834885},
+				{-11.712372710182573, 15.771635769204185, -4.595302370064513},
+				{-13.931147454377319, 17.38500518749937, -4.74249769972435},
+				{-16.124122770854306, 19.05370476782738, -4.918411847371658},
+				{-18.420535517083156, 20.87879394082293, -5.13669296736272},
+				{-20.961072799274188, 22.97897214488267, -5.415507970388096},
+				{-23.93471164656799, 25.52270801099286, -5.782858675302342},
+				{-27.644203711626854, 28.788561951044432, -6.287212276608029},
+				{-32.660119972602985, 33.31001105629687, -7.0236061075998},
+				{-40.28758430865613, 40.317174364202174, -8.213917666415666},
+			},
+			{
+				{74.52367933732455, -46.65883972823949, 3.441924197799155},
+				{37.78165484041161, -21.943925210062236, 0.9195113647372583},
+				{23.450892046243588, -12.027509112593686, -0.18380518266314472},
+				{15.032361040528794, -6.073375602042035, -0.8807278645678107},
+				{9.2461923028672, -1.9127764964621465, -1.3793537079804792},
+				{4.878412483058367, 1.2773506417691063, -1.7671970543003819},
+				{1.3464907317452706, 3.901431595089622, -2.0908563355259964},
+				{-1.6756854304818194, 6.191022545077413, -2.378588642833671},
+				{-4.3955148794233265, 8.297640701856595, -2.6499098261847513},
+				{-6.963364596262913, 10.33568626929967, -2.9204799917976443},
+				{-9.50681619565237, 12.40753266377413, -3.2052930756299816},
+				{-12.157960373349098, 14.625048713949141, -3.5217949257736456},
+				{-15.083762716122973, 17.135855526512923, -3.894111787232036},
+				{-18.53453710847816, 20.168310078937527, -4.3607320709084085},
+				{-22.956207708955702, 24.13713888362627, -4.992844383763119},
+				{-29.31902248280884, 29.954230788615458, -5.9486395131695256},
+			},
+			{
+				{70.61642295650093, -45.70634447880645, 4.0517198540396375},
+				{39.8815525546727, -24.714151611105354, 1.7910074994655385},
+				{26.984330968964645, -15.742465752481781, 0.7828630247232228},
+				{19.228884276801434, -10.24612721460956, 0.14159002321003888},
+				{13.768880080056775, -6.304120264427295, -0.33321038455257124},
+				{9.559022776444118, -3.2086092416668515, -0.7157649269723412},
+				{6.102315564593247, -0.6192766732214156, -1.0428604127857974},
+				{3.1171585695294155, 1.660843405809612, -1.337010460091321},
+				{0.4210189388244026, 3.7634280752043296, -1.6142957301276408},
+				{-2.120886352139501, 5.789935887103958, -1.8880339909857455},
+				{-4.624088051182388, 7.832149078081426, -2.1711962799807707},
+				{-7.206317097671004, 9.98916647972979, -2.4787410416760687},
+				{-10.012273417021454, 12.388973508537909, -2.8309592267862085},
+				{-13.25734027286521, 15.228163446352921, -3.2599758352056933},
+				{-17.324477546073904, 18.862244465029097, -3.824872472839015},
+				{-23.03728989481659, 24.06362986300697, -4.655316164503339},
+			},
+			{
+				{67.20270377954961, -44.49302730409788, 4.380784228532892},
+				{40.59489454344076, -26.058366261962224, 2.2989050522887027},
+				{28.674354449697443, -17.68428218372887, 1.3309583562135292},
+				{21.38248640723476, -12.484891527103366, 0.716587666559272},
+				{16.21446765967515, -8.740029920204522, 0.26434790530623087},
+				{12.207468711128367, -5.785933522075468, -0.10015548917741118},
+				{8.8984280120272, -3.301368515494956, -0.4132456508697756},
+				{6.027236483158109, -1.103543730179668, -0.6960155048878571},
+				{3.4265595675230673, 0.9281149983710331, -0.9629745160235015},
+				{0.9728893100471081, 2.8862316102749093, -1.2259821520605718},
+				{-1.4400993200407486, 4.854857637212221, -1.4965887389564745},
+				{-3.921450042575255, 6.925193426411124, -1.7881637160432502},
+				{-6.605563436040126, 9.215231305785824, -2.1188610157260666},
+				{-9.690245974805388, 11.904724955139942, -2.5172054232165935},
+				{-13.52355463294167, 15.3162955224712, -3.035295679243532},
+				{-18.854104488675958, 20.150526058788188, -3.7873455315590867},
+			},
+			{
+				{64.5791403720148, -43.556925073244315, 4.631868186989641},
+				{40.975849468159446, -26.991763095030443, 2.683586725731466},
+				{29.80019310621914, -19.058774474733152, 1.7382607743143368},
+				{22.840307151861204, -14.055667231230851, 1.134444151257897},
+				{17.87466727963375, -10.435725867981645, 0.6917208063565697},
+				{14.015876083063066, -7.578710159684192, 0.33725282607440576},
+				{10.826369571432384, -5.176767120313497, 0.03453768666737177},
+				{8.056872531582941, -3.0524366583965215, -0.23778460757389944},
+				{5.546808064106584, -1.0889617526612159, -0.49412986522681673},
+				{3.178357017437919, 0.8023775794234554, -0.7459161284405967},
+				{0.8506258600547145, 2.701351357827261, -1.0039898122234265},
+				{-1.539757379313163, 4.6941948574197205, -1.2807455613980583},
+				{-4.120659817117162, 6.89260955975897, -1.592952217009343},
+				{-7.081388092920271, 9.467384271669248, -1.966983707547878},
+				{-10.754964058098809, 12.72510131995054, -2.4509793947482414},
+				{-15.856664154447975, 17.33172085845593, -3.1505028371090242},
+			},
+			{
+				{62.67427722717596, -42.972430542784245, 4.865290324571112},
+				{41.32480788531708, -27.811690670779058, 3.0178143632166687},
+				{30.736073899358182, -20.21837229943699, 2.0859254827734133},
+				{24.0268645560966, -15.353581706760306, 1.4847586440611318},
+				{19.203511330619065, -11.812234435631687, 1.043842025420796},
+				{15.443765696001591, -9.012831032803085, 0.6922863366582747},
+				{12.334094182881342, -6.661096464820569, 0.3939405609239529},
+				{9.63535260861996, -4.584900451448809, 0.12738335784374352},
+				{7.1916822880121085, -2.6697867793915826, -0.12193038671690701},
+				{4.888056870990601, -0.8285205015655477, -0.3654348559323853},
+				{2.6260383626381234, 1.0169535879632503, -0.6137767224052882},
+				{0.30503756189080233, 2.950458412094077, -0.8788777731216523},
+				{-2.199425003146111, 5.080279399350071, -1.1766815651221816},
+				{-5.072668524492429, 7.572441793169432, -1.5322089055899082},
+				{-8.64380908409263, 10.727045039360359, -1.9913198014466142},
+				{-13.624867347341493, 15.200593903843792, -2.655190152879037},
+			},
+			{
+				{61.405590303438366, -42.746229213991256, 5.108326103532477},
+				{41.77131377583348, -28.650390278272816, 3.335089805634882},
+				{31.646089090349758, -21.31803251889656, 2.4097791233772834},
+				{25.127582144424913, -16.550428980205155, 1.8062961087718044},
+				{20.40430685508152, -13.056495150977616, 1.3624698772041712},
+				{16.70799743311459, -10.287000302162042, 1.0090990941891684},
+				{13.645766330143017, -7.9594864456574514, 0.7104124067084481},
+				{10.987812656342307, -5.906993863046594, 0.44507109788598265},
+				{8.582981801313426, -4.017621955893387, 0.19854692755132014},
+				{6.318731890175586, -2.2055025919364946, -0.0405784433533405},
+				{4.098005562025222, -0.39345677627843934, -0.28288383019267327},
+				{1.8211364563777876, 1.5014668584889508, -0.5400942963841675},
+				{-0.6356458426471032, 3.58639227726632, -0.8277340028535227},
+				{-3.457690575427062, 6.026004258829621, -1.1700719417856564},
+				{-6.977842096214209, 9.120398146788595, -1.6117773179821835},
+				{-11.927385185964447, 13.534808278733223, -2.25249528656133},
+			},
+			{
+				{60.72176487459393, -42.882562091344575, 5.378002605887324},
+				{42.39337562500994, -29.58818516721401, 3.655968968719212},
+				{32.62715185614217, -22.44905723536866, 2.7308880113376532},
+				{26.249126162637, -17.744455773766727, 2.1210820095016367},
+				{21.592266317640718, -14.273638495270712, 1.6708878000218843},
+				{17.93197353812154, -11.513266961359998, 1.31234059077196},
+				{14.892085051427305, -9.190272824539436, 1.0098722095960788},
+				{12.250373255883737, -7.14177997363847, 0.7421515206584415},
+				{9.859624440515343, -5.25802411515807, 0.4946629019998648},
+				{7.609561550288438, -3.454467713101451, 0.25605131632167905},
+				{5.404373687183395, -1.654811044665402, 0.015852131999426092},
+				{3.1447025521104983, 0.22343780613171807, -0.23749833421304373},
+				{0.7058339438829694, 2.28751056480796, -0.5192945918662195},
+				{-2.10099450570721, 4.70341200470134, -0.8534701685000714},
+				{-5.619265903484984, 7.776699634846473, -1.2843340328917618},
+				{-10.618474234144195, 12.196103988858539, -1.9121131968567233},
+			},
+			{
+				{60.606953788309944, -43.399632378585935, 5.688661242693634},
+				{43.256166997666604, -30.690297479079923, 3.9966561862145134},
+				{33.754663886734946, -23.681561387867905, 3.065185281081734},
+				{27.471002222090462, -19.008123589325933, 2.4451608270806124},
+				{22.850551984783305, -15.538817924772053, 1.985554672281286},
+				{19.202873448345144, -12.770053426322052, 1.6190820308319287},
+				{16.164612153326328, -10.435579548174102, 1.3101232411769956},
+				{13.519034559744227, -8.375192965463844, 1.0371935342942238},
+				{11.121527118609709, -6.480325411809248, 0.7856690519139193},
+				{8.863136043363617, -4.667107208262591, 0.5441723838007252},
+				{6.648669146561234, -2.85970749636614, 0.30231458587635257},
+				{4.378620180408254, -0.9758478926062142, 0.04869685819701793},
+				{1.9265165828135613, 1.0923030371275422, -0.23176614798106332},
+				{-0.9021488485471032, 3.5137080291776006, -0.5628589722022801},
+				{-4.467679008457723, 6.603933403455649, -0.9891182261070249},
+				{-9.595706099719886, 11.088599325177873, -1.6130505308729541},
+			},
+			{
+				{61.08357092811742, -44.3384219881603, 6.055882210460655},
+				{44.43086698365769, -32.0246049329074, 4.373106076073814},
+				{35.103708510431105, -25.08393556443412, 3.427817892974316},
+				{28.868734085390177, -20.40929884988493, 2.793300652150276},
+				{24.255387334091854, -16.9201386883758, 2.321185181426702},
+				{20.59830614361321, -14.126494634337895, 1.9442052400804632},
+				{17.543009986679834, -11.766236186699693, 1.6263738049233347},
+				{14.876073649152943, -9.680287268224667, 1.3458495408720563},
+				{12.453937248147085, -7.760140188033351, 1.087732615047433},
+				{10.167617485165662, -5.92157095990782, 0.8404600198639993},
+				{7.92120790981362, -4.088206102374952, 0.5935553560189675},
+				{5.613751122394893, -2.177027645805061, 0.33564593506247575},
+				{3.1157862831574152, -0.07880071408761288, 0.05178088104722357},
+				{0.22530971991533222, 2.3794470543431516, -0.28172008504651436},
+				{-3.43980868517393, 5.526622389167852, -0.7099048498327376},
+				{-8.778743611591926, 10.137678709635026, -1.3388761755912373},
+			},
+			{
+				{62.2209473693277, -45.77355902839164, 6.49983119106609},
+				{46.010135561068836, -33.67574854153631, 4.804148935670368},
+				{36.76471056468543, -26.73690256200586, 3.836198043004693},
+				{30.52991008098352, -22.02563690180436, 3.1821124847149984},
+				{25.89273202174649, -18.493614088819292, 2.6939969085065067},
+				{22.203726042525854, -15.658072915802105, 2.3038006374558457},
+				{19.113133447831903, -13.258039178268367, 1.9747746092103682},
+				{16.4084865766552, -11.133846228057255, 1.6844732336688992},
+				{13.945601120554848, -9.175785647168775, 1.4175226082394083},
+				{11.613933637845227, -7.298099535120347, 1.1619758047540814},
+				{9.31514439464616, -5.422530553091544, 0.9070364123366148},
+				{6.944665825868464, -3.463646427238266, 0.6410718036196666},
+				{4.367375503937919, -1.3088052369850922, 0.34895331089703235},
+				{1.3707586062648607, 1.2210193886836962, 0.006883688935042259},
+				{-2.4537155720798314, 4.470834032886487, -0.43079181911027353},
+				{-8.094983239853686, 9.275866377615841, -1.0745396501961937},
+			},
+			{
+				{64.155438889404, -47.832413425284614, 7.049736671219788},
+				{48.12857570444794, -35.76315841434442, 5.315300608580035},
+				{38.862290676614, -28.750072663885142, 4.313494394541458},
+				{32.57234298788769, -23.960558417717042, 3.6334353766750027},
+				{27.876303913563635, -20.359057421490824, 3.1250929107754395},
+				{24.130647166503838, -17.46271271689173, 2.7186052377335668},
+				{20.985630913313315, -15.008219060009894, 2.3759459761010846},
+				{18.227046879305817, -12.833286298321095, 2.0737484716693833},
+				{15.708181720769817, -10.825544266533033, 1.7959237218722328},
+				{13.315223566603386, -8.896369087423087, 1.5299099095487307},
+				{10.945447938801058, -6.9640826948363515, 1.2643165571098747},
+				{8.487983274219426, -4.938715083343999, 0.9868722520196982},
+				{5.79793965974562, -2.7010139165377947, 0.6817165308160772},
+				{2.646208060011112, -0.06131126881583972, 0.3242189545067895},
+				{-1.4099091556092271, 3.346757184425625, -0.13239389755412706},
+				{-7.464540557887676, 8.428383382721671, -0.8031301218620767},
+			},
+			{
+				{67.13386483362899, -50.733153433841785, 7.751847693237069},
+				{50.99854907012285, -38.47204065936058, 5.945140986781749},
+				{41.586806973186896, -31.289321430317404, 4.894315959832108},
+				{35.17357508134355, -26.368955763851847, 4.179717391188919},
+				{30.376221404121, -22.665116607687985, 3.6457363552263806},
+				{26.545109592358873, -19.68572888756685, 3.2192860413779947},
+				{23.324679334636766, -17.16068461103505, 2.8603457945698167},
+				{20.495610253669042, -14.922460280849224, 2.5441947166129895},
+				{17.906314459161667, -12.854191203083605, 2.253713066473738},
+				{15.437671007240079, -10.862780557746568, 1.9754377531152933},
+				{12.980296723117727, -8.861330454476219, 1.6970566513767325},
+				{10.413844699537734, -6.752821092826085, 1.4052008000896792},
+				{7.578416547756246, -4.407247817740185, 1.082532940905374},
+				{4.218759868619599, -1.616822077412729, 0.7022976657069961},
+				{-0.15844176999431286, 2.0186261502355434, 0.2147227178197872},
+				{-6.775107788158644, 7.489301935915047, -0.5005346275187914},
+			},
+			{
+				{71.61065840278066, -54.86779155928547, 8.686123758610977},
+				{54.984079105714095, -42.116865963164045, 6.7582458912302465},
+				{45.258315714044315, -34.63206608192735, 5.636122369506576},
+				{38.630274225580024, -29.508763483538566, 4.874767392954027},
+				{33.67649414523626, -25.659373160789578, 4.307874476561934},
+				{29.72481716540107, -22.56976978953775, 3.8569934514432953},
+				{26.405795655488454, -19.956691760599547, 3.479028060786233},
+				{23.49034197814038, -17.64370135540445, 3.1472628767020288},
+				{20.81881051435637, -15.506916799717787, 2.8430983400507657},
+				{18.264083934585805, -13.446639116314007, 2.551782165141954},
+				{15.707217610210934, -11.368453763895007, 2.2596552082041175},
+				{13.014411124645076, -9.165145487762159, 1.9516661086782259},
+				{10.004113147805146, -6.6906992715267934, 1.60803180107536},
+				{6.381846648332828, -3.7088393385909324, 1.198001656330893},
+				{1.5747454270119743, 0.23727328457117391, 0.6648747549815549},
+				{-5.821158821905418, 6.264118347715376, -0.12255911373299952},
+			},
+			{
+				{78.48815694812421, -61.006029397089456, 10.006683488648754},
+				{60.7771916144513, -47.29248827157914, 7.87586083242746},
+				{50.47826605255303, -39.298180054802295, 6.646206411588683},
+				{43.49944560672012, -33.86344200276017, 5.819273712030044},
+				{38.312631774361776, -29.808166117782132, 5.209305368916809},
+				{34.197231122076005, -26.57545272919617, 4.728860051438154},
+				{30.757237006065274, -23.858724429932877, 4.329965151633736},
+				{27.7466022248208, -21.4668654332649, 3.9828781859230973},
+				{24.99283883200271, -19.265163480675938, 3.6668288750099816},
+				{22.356922023353864, -17.144230542692586, 3.365209245375491},
+				{19.70621779762657, -14.998907568550456, 3.0624135413895415},
+				{16.887856788433822, -12.707407211287173, 2.7408895691413377},
+				{13.689234240589542, -10.100352817670856, 2.377035604264163},
+				{9.757891357966207, -6.898194977923311, 1.9333709751912245},
+				{4.3961666260837795, -2.5513311121056708, 1.339725045882697},
+				{-4.108746314506724, 4.285205754933132, 0.436659203355676},
+			},
+			{
+				{89.8265408572449, -70.89611279841878, 12.06056549461456},
+				{69.90479457367626, -55.30816364493745, 9.563912365603443},
+				{58.564591042080714, -46.42531169763907, 8.159042802551665},
+				{51.006728261416406, -40.49602627398213, 7.234596124238461},
+				{45.4751259688111, -36.147875187804544, 6.567514053837884},
+				{41.15190827340876, -32.741561923775215, 6.054218357324612},
+				{37.59142453337381, -29.92849823276834, 5.638478929204875},
+				{34.51825185831906, -27.492928106725138, 5.2857328492538},
+				{31.7394273372781, -25.28332238207225, 4.97197699452362},
+				{29.09827306897237, -23.176265885370956, 4.6779938833429275},
+				{26.442568412662954, -21.051552396684954, 4.385489017247708},
+				{23.591949064103673, -18.76670159376236, 4.073334314828944},
+				{20.28751595999188, -16.11769475569181, 3.7120780159778404},
+				{16.08655413502759, -12.75721847666839, 3.2530250628470956},
+				{10.086115032011294, -7.980184887822554, 2.6009909895071734},
+				{-4.9991156525728e-13, 3.36434260177292e-13, 1.5294813607531168},
+			},
+		},
+		{
+			{
+				{89.5714546631701, -51.17028649894517, 1.8019570673580636},
+				{29.528528000447157, -11.618087713491336, -2.074328136778481},
+				{12.624139017531625, -0.39064479296821025, -3.168406635439509},
+				{4.2504443392607305, 5.0968063051869485, -3.6386162713821837},
+				{-0.9762448653810963, 8.516058959772339, -3.903592806681519},
+				{-4.727426207942266, 11.003957287526037, -4.090423639689286},
+				{-7.705998639965329, 13.03669264646776, -4.250480277564135},
+				{-10.271930001920774, 14.860032800431856, -4.409913234528794},
+				{-12.645092236510555, 16.629169856923042, -4.586029685869613},
+				{-14.990616641200731, 18.468628068986565, -4.794492902710478},
+				{-17.46295523435714, 20.505694378474033, -5.05375788827651},
+				{-20.242897951295753, 22.90276190479542, -5.390178238177895},
+				{-23.594650842283254, 25.912143288630286, -5.847644778822855},
+				{-27.990842619680652, 30.0018945683268, -6.510671736858872},
+				{-34.47741322721862, 36.23167654071707, -7.5745047640796805},
+				{-46.205257757628175, 47.86243962508607, -9.648557332672114},
+			},
+			{
+				{86.66375522593493, -54.26230967001022, 4.255911441491808},
+				{42.04402635053125, -24.588472643503096, 1.262438259938265},
+				{26.32750303290068, -13.834554713595624, 0.08427609371347304},
+				{17.36728232500365, -7.5526152010804495, -0.6429858464843027},
+				{11.29154782781372, -3.207465756931233, -1.1611252719983807},
+				{6.746276892026176, 0.1059777924113378, -1.563739234098378},
+				{3.0955426305580125, 2.8242398635682364, -1.9001087703455763},
+				{-0.013009088282844564, 5.196089624347253, -2.200271813498563},
+				{-2.8028330188127906, 7.385457975749678, -2.485356902989632},
+				{-5.437307232699927, 9.51925369749917, -2.772983195713656},
+				{-8.058220433341276, 11.716441044001801, -3.081049961809199},
+				{-10.816450637108145, 14.114742885322567, -3.431827595036059},
+				{-13.912110539535982, 16.910487724642408, -3.858762529361202},
+				{-17.672371787331812, 20.4426633011106, -4.421565370141015},
+				{-22.748822570281884, 25.4222430862309, -5.248553561635579},
+				{-30.753747260677397, 33.774596555548385, -6.697700838387937},
+			},
+			{
+				{80.02636372514037, -51.66415197457449, 4.744838203180913},
+				{43.70882928464159, -27.0710320561581, 2.100061400319702},
+				{29.60952543930831, -17.34317977015048, 1.0121839627134221},
+				{21.390586529043112, -11.56046360071225, 0.34237808696578},
+				{15.707365733715122, -7.478920625671106, -0.14556287330790843},
+				{11.376385608078914, -4.301285300126026, -0.5360260882117794},
+				{7.85129743839601, -1.6555425594298603, -0.8692242545094652},
+				{4.829451255060642, 0.669399018078169, -1.1691625160199268},
+				{2.1174468674009743, 2.8140587482342356, -1.4530060874325994},
+				{-0.4263901756024773, 4.888002598517281, -1.7353131718173593},
+				{-2.9235031482532468, 6.993488885180327, -2.0309186233379197},
+				{-5.499151755080572, 9.246807464430058, -2.358057718299186},
+				{-8.310574807586537, 11.808548569277905, -2.7434315346324487},
+				{-11.594506514312927, 14.943893607348715, -3.233030048843434},
+				{-15.761282099876523, 19.173206984420606, -3.9206707580537685},
+				{-21.4168060583174, 25.676252695540466, -5.036546307401222},
+			},
+			{
+				{75.00413226204033, -49.48820654198564, 5.0035838745312535},
+				{44.17545400139943, -28.28005569670194, 2.601791872125992},
+				{31.197070013961408, -19.22147416620085, 1.554142705806139},
+				{23.4744335374716, -13.744415942325416, 0.9079510969512476},
+				{18.099325657617147, -9.863434356153167, 0.4407117251187897},
+				{13.989258972618178, -6.835856934624114, 0.06849695398667333},
+				{10.633740472537418, -4.308396716894666, -0.24906214771160035},
+				{7.751243840502218, -2.0827801337718768, -0.5350960438255962},
+				{5.164549688367693, -0.029726282584347986, -0.805335716208988},
+				{2.74594800680271, 1.949868200785525, -1.0726875124370163},
+				{0.38821623892841833, 3.947150701087192, -1.3500458486103284},
+				{-2.0158090011238654, 6.064038468937246, -1.6530140023649837},
+				{-4.594329016656966, 8.43820830582599, -2.0040482618801105},
+				{-7.525416334100987, 11.289791308084277, -2.440916054819744},
+				{-11.061545410194453, 15.024044437401876, -3.0372853744842745},
+				{-15.112433487648884, 20.35787981106167, -3.948988797510521},
+			},
+			{
+				{71.37681292444391, -47.961493159689795, 5.214432437978083},
+				{44.4109179033535, -29.150312819111317, 2.9905726695890777},
+				{32.287657949417536, -20.58979287899179, 1.9667324751658442},
+				{24.92237594708283, -15.316495643061597, 1.3289023054565468},
+				{19.75602626424703, -11.558179968971238, 0.8688158420607864},
+				{15.796958338877054, -8.624871616139313, 0.504849488294461},
+				{12.565773884300958, -6.180055978065193, 0.19677369711402945},
+				{9.793816504647301, -4.031859530798137, -0.0787595865567327},
+				{7.311315134759505, -2.0549431904637356, -0.3374678545629211},
+				{4.997218564550416, -0.15447821946450555, -0.5918301011623038},
+				{2.752101985232312, 1.7549969737986795, -0.8538546173127807},
+				{0.4796014165309921, 3.7671253055150116, -1.1376580232231446},
+				{-1.9307539186338283, 6.005998314442477, -1.4631480081179897},
+				{-4.621414935555237, 8.665413420242805, -1.8631849902137632},
+				{-7.750253828574153, 12.085595258298458, -2.399907309627196},
+				{-10.80705015485037, 16.7293940368191, -3.1893141616539027},
+			},
+			{
+				{68.84349777901704, -47.02262532855805, 5.429520061001834},
+				{44.69371246940066, -29.962161112599922, 3.3364286831978287},
+				{33.238071651227884, -21.782631755604964, 2.3268067912272294},
+				{26.141869700609472, -16.652559900322604, 1.6898257726709043},
+				{21.120972293002783, -12.969738162365948, 1.2294895232728769},
+				{17.259568315860072, -10.089030404577693, 0.8665204972169478},
+				{14.106144919557288, -7.689695643754407, 0.5611990908525679},
+				{11.404325638772235, -5.586515729168226, 0.29026244286097824},
+				{8.990561485457361, -3.6574199976109107, 0.03799447232789703},
+				{6.747753716641838, -1.8097673652580757, -0.20800550984240415},
+				{4.58026962119489, 0.03942366399620482, -0.45944015554790424},
+				{2.3970063899871104, 1.9799123581137352, -0.729722969568299},
+				{0.09636078387266767, 4.1290660830494526, -1.0373895692134998},
+				{-2.4465596039240984, 6.667958635259863, -1.412632728796929},
+				{-5.3444961769675725, 9.907948955783718, -1.9117866830038754},
+				{-7.877272430161746, 14.205526794692604, -2.633468113568512},
+			},
+			{
+				{67.21027972528806, -46.61383372621004, 5.672807115656748},
+				{45.147775512095954, -30.8451111664179, 3.6730071697807944},
+				{34.21091720218407, -22.95299237062482, 2.669697995669893},
+				{27.31636007655029, -17.921110943293645, 2.0284782318596886},
+				{22.39554793401451, -14.28125217274112, 1.5632474332306032},
+				{18.59407867766917, -11.424596319373709, 1.1966093262148179},
+				{15.483387111211163, -9.043339240795605, 0.8892323041856459},
+				{12.817497908890891, -6.957947157067563, 0.6179483602160174},
+				{10.438418585839186, -5.0494093891096075, 0.36711752785884494},
+				{8.23247766279421, -3.227084720355454, 0.12446276193390152},
+				{6.106465634546261, -1.409566447622357, -0.12151644024105658},
+				{3.9713849183012195, 0.4913246629475977, -0.38388647772007717},
+				{1.7280680711575247, 2.590944410534798, -0.6805557610923448},
+				{-0.7449677448774614, 5.067785703136878, -1.0406021771193772},
+				{-3.555031160963699, 8.231960236746502, -1.5185996083342173},
+				{-5.944597338560579, 12.449639632247479, -2.212027804775278},
+			},
+			{
+				{66.37161610764028, -46.70987921988666, 5.960655305627418},
+				{45.85471813044956, -31.884128003542695, 4.022324230858928},
+				{35.309159889708575, -24.19720655753707, 3.0175190000159744},
+				{28.558053919122155, -19.22444111994666, 2.367587300989884},
+				{23.699945374318524, -15.601097610987363, 1.8938349023697387},
+				{19.928846454092625, -12.74657872428425, 1.5201104800390344},
+				{16.834086802246112, -10.362832128985616, 1.2071971184101253},
+				{14.177502678698158, -8.274330404322043, 0.931853920167379},
+				{11.805039722454243, -6.364058631833816, 0.6784012278631587},
+				{9.605307495253635, -4.542648624222997, 0.43461482482615604},
+				{7.486476184862724, -2.7296518941791996, 0.18915150734941708},
+				{5.359930205979577, -0.8374027440726671, -0.07081140299773848},
+				{3.1247679217424227, 1.2502216327853783, -0.36291027505253726},
+				{0.6519488443942231, 3.7165764657341946, -0.716122953404037},
+				{-2.1940792965301443, 6.893060653604995, -1.1862776866016576},
+				{-4.776415534782386, 11.258648537937889, -1.882979274104771},
+			},
+			{
+				{66.29247343739301, -47.32225497568995, 6.309086350877581},
+				{46.892034916454094, -33.155968411030464, 4.403272038858689},
+				{36.6212018465851, -25.596430241378346, 3.388485949880723},
+				{29.958564816992634, -20.644955017163394, 2.7251716436505777},
+				{25.128677382821515, -17.013534482334517, 2.2394711837664283},
+				{21.361963652875996, -14.141974093680927, 1.855713008636711},
+				{18.26070276610325, -11.738696219866979, 1.534444842725928},
+				{15.591828771957232, -9.63031978791748, 1.252124125440845},
+				{13.203260746449743, -7.700427403354565, 0.9928348015341607},
+				{10.984093833335699, -5.859623358983736, 0.7442097964922133},
+				{8.841983031856486, -4.027072111337454, 0.4948663369781255},
+				{6.686454530730572, -2.1141293044141825, 0.2320372426137582},
+				{4.411364897680225, -0.0017120646531484877, -0.061871505599338614},
+				{1.871487060662628, 2.5038403994782668, -0.4163333384700855},
+				{-1.1267453223070742, 5.774362321676652, -0.8906803611774813},
+				{-4.2266629014233565, 10.50401491002362, -1.6187150723717354},
+			},
+			{
+				{67.00519023407884, -48.50592866218584, 6.73825213223812},
+				{48.35497701470596, -34.74955689198012, 4.836276085420354},
+				{38.244704757982475, -27.23818243425097, 3.8016546770171398},
+				{31.614369458590524, -22.268072187869695, 3.1195465720249556},
+				{26.777831256586943, -18.60318792778932, 2.6181888914379985},
+				{22.990263414683124, -15.695799916243324, 2.2214706203371346},
+				{19.861852981320002, -13.257312501886425, 1.8892691071890733},
+				{17.161813381863727, -11.114485019733445, 1.5974645718902143},
+				{14.737967622471587, -9.149978117119318, 1.329673454318501},
+				{12.477884069825361, -7.272859667245749, 1.0731401322485594},
+				{10.286256960198536, -5.400075424315771, 0.8161401121702918},
+				{8.067386815856889, -3.439551071863431, 0.5455769699475582},
+				{5.704923762397484, -1.265854996206782, 0.24343960912459064},
+				{3.0285034389478285, 1.3305819972699733, -0.12082176757917543},
+				{-0.2413742616085589, 4.779269798096274, -0.6117216835195881},
+				{-4.202606940870843, 10.100200715208718, -1.3991329130322012},
+			},
+			{
+				{68.62317449152306, -50.3758142334392, 7.277338696749825},
+				{50.37958724304353, -36.78651943395332, 5.34772944697333},
+				{40.30873774994672, -29.23584089911028, 4.281064502999982},
+				{33.64875946365213, -24.201538427848984, 3.573431680861704},
+				{28.767380060547136, -20.47469018018869, 3.0520204630144683},
+				{24.932327007107457, -17.51136204281041, 2.6391273623084825},
+				{21.756185057639446, -15.021957924476693, 2.293398573209724},
+				{19.007333844984473, -12.831082101806839, 1.98979185326561},
+				{16.531256769430716, -10.81878283037965, 1.7111836215055345},
+				{14.21185230609944, -8.890951290639123, 1.4441509746224355},
+				{11.948168285230865, -6.960255796515967, 1.1762718688530962},
+				{9.635167408981578, -4.92808606917613, 0.8935957461347613},
+				{7.139224337550977, -2.6575035888439182, 0.5768821154779672},
+				{4.251825764807925, 0.08582434848342561, 0.19333541360593515},
+				{0.575848240950036, 3.808744776885833, -0.3285780717718948},
+				{-4.64299999276128, 9.984209832373613, -1.2070007669457727},
+			},
+			{
+				{71.38135799738494, -53.1435439093915, 7.97272977084451},
+				{53.17984611862118, -39.45329337228103, 5.97663197166431},
+				{43.00685544915798, -31.757224614594396, 4.861664979081331},
+				{36.24307060898077, -26.602477733167856, 4.119595424265999},
+				{31.27178990818025, -22.779253225375953, 3.572571078367538},
+				{27.359193985094574, -19.736962260446155, 3.1397396675443625},
+				{24.11366505657566, -17.18005062782214, 2.7777469136813533},
+				{21.29899587285789, -14.928108865980018, 2.460164557734803},
+				{18.75568904609521, -12.856532886356018, 2.1687822451299374},
+				{16.361551243386042, -10.86614807207691, 1.8891758435396728},
+				{14.007018389205179, -8.863031313205392, 1.607810595148137},
+				{11.573049171625076, -6.738433218971576, 1.3092450570941323},
+				{8.899879728323828, -4.337208280862979, 0.9718770548031177},
+				{5.721616019513697, -1.3862853600657232, 0.5585168436800004},
+				{1.476450103989059, 2.729958745617478, -0.013115552098652712},
+				{-5.49666970261752, 10.095440009053954, -1.0231418648356638},
+			},
+			{
+				{75.7339210710736, -57.201105639775776, 8.904964929271188},
+				{57.122673644958205, -43.0651390061434, 6.7875778606007735},
+				{46.661873747655875, -35.08014459497849, 5.60071826599455},
+				{39.69689773989728, -29.72923825006237, 4.811594029919078},
+				{34.578473435099696, -25.76514835597058, 4.231544489575603},
+				{30.552685379777685, -22.616378870629195, 3.774254415158817},
+				{27.21485883668538, -19.97455025694252, 3.393223300894163},
+				{24.319177198775684, -17.650309872622884, 3.0599619101391657},
+				{21.697720691360303, -15.511686928520213, 2.754691050317069},
+				{19.21918625053104, -13.45204826086263, 2.461546702297786},
+				{16.761844609893537, -11.368064662828065, 2.1653573593257436},
+				{14.18711552146826, -9.136498782590472, 1.8483889836078986},
+				{11.298223889895405, -6.575579704596365, 1.4851777476459367},
+				{7.745592770691271, -3.3536080948499896, 1.0308111554712411},
+				{2.7253953233356176, 1.3095062732669005, 0.38418711347083456},
+				{-6.683999314437734, 10.339370007205986, -0.8177179866324472},
+			},
+			{
+				{82.60145968006778, -63.329000699403224, 10.229838298891659},
+				{62.90582818822447, -48.21731117067804, 7.901232986255633},
+				{51.87787267627436, -39.72244295195154, 6.604412426102797},
+				{44.56940200894451, -34.06284464384256, 5.7488860180120955},
+				{39.22564150879344, -29.896585609649975, 5.125508946635047},
+				{35.04407031891785, -26.608752474571187, 4.638623379677124},
+				{31.59337416170827, -23.867358112088468, 4.2366932166346185},
+				{28.610566736337688, -21.46808081752303, 3.8880928512036825},
+				{25.91432068253491, -19.267599705181386, 3.5707462172557736},
+				{23.36006599032219, -17.148500070472753, 3.2667245872880586},
+				{20.8091946368971, -14.994288291209521, 2.9584984607031086},
+				{18.096314820505626, -12.661471605006602, 2.624935398900239},
+				{14.97316356919402, -9.930269721413756, 2.2345779013227296},
+				{10.969914895592899, -6.38197118177996, 1.7297134579261737},
+				{4.9162787504079795, -0.9777099785970477, 0.9749617260379341},
+				{-7.980926038351569, 10.478622621018218, -0.5249816933250949},
+			},
+			{
+				{94.1040633790532, -73.31089032119176, 12.298856127329993},
+				{72.06703360903052, -56.21710414971025, 9.58128636219461},
+				{59.97369484824592, -46.812589869004526, 8.10367451143672},
+				{52.08539303290431, -40.65587007146649, 7.1489886702584045},
+				{46.403937888357795, -36.19998348915133, 6.468082149068292},
+				{42.02541109547882, -32.744183841180934, 5.948373954026455},
+				{38.467540515031764, -29.913311225934244, 5.529770673821498},
+				{35.437419382916445, -27.47784384546963, 5.175659639156703},
+				{32.73268298659202, -25.27704530083953, 4.860563241298609},
+				{30.189803271711035, -23.178267500910763, 4.563662778951337},
+				{27.64711389036935, -21.047077279297184, 4.2641592448253105},
+				{24.90232450611264, -18.711921254169727, 3.9360044801235894},
+				{21.633838453767204, -15.89799747422181, 3.5384546435210087},
+				{17.192822548607925, -12.05368345105276, 2.9923307295850017},
+				{9.828688717565264, -5.714728322378438, 2.0981360684915145},
+				{-8.458745644719226, 9.606305745452094, 0.07537238266586259},
+			},
+			{
+				{116.50807801494913, -92.40066879678889, 16.141531452720272},
+				{88.93591838911621, -70.73920032733797, 12.567399440347767},
+				{74.63982506802769, -59.50557434633764, 10.743856429529558},
+				{65.71112966495535, -52.485882421806174, 9.627583970634502},
+				{59.556024924312275, -47.64287277392745, 8.877686969501436},
+				{55.04448050153993, -44.08894412692491, 8.346318321353863},
+				{51.593287513548056, -41.3656687681292, 7.957683995358915},
+				{48.86141479121877, -39.204419484338445, 7.667949542796698},
+				{46.62403826828338, -37.42727305064485, 7.448732820776247},
+				{44.707955216801466, -35.8959793840528, 7.278722215534873},
+				{42.94499976440107, -34.47479196632215, 7.137752242878788},
+				{41.11475631796147, -32.98434632080926, 6.999704840306723},
+				{38.8273954131968, -31.10739338671919, 6.818297646390174},
+				{35.18332060336351, -28.117134708702743, 6.486771217086425},
+				{27.377986036260452, -21.776985305636924, 5.679294163414301},
+				{-2.302051363865016e-12, 1.5952026554731197e-12, 2.7452511792022456},
+			},
+		},
+		{
+			{
+				{100.99629856225143, -58.2923842601004, 2.4912574361762805},
+				{32.54658231574483, -13.662427796304147, -1.7690926114555903},
+				{14.435130830155952, -1.6362410907714562, -2.961295723584769},
+				{5.607647808156507, 4.163080582554782, -3.4746106462814104},
+				{0.1488799297730152, 7.749281207467616, -3.764542732553408},
+				{-3.741670960987371, 10.344419517855398, -3.968531559780467},
+				{-6.813423488116656, 12.456356235880925, -4.142103151221938},
+				{-9.447739096217894, 14.346413644595607, -4.31363199210078},
+				{-11.875657099550645, 16.179316654720488, -4.501959803023895},
+				{-14.26845798993362, 18.087168737204614, -4.724173809664177},
+				{-16.78719108790538, 20.207794099987353, -5.000865625739369},
+				{-19.623480642493337, 22.72262265863624, -5.362350938949203},
+				{-23.061945243189616, 25.923174901893955, -5.860844192812804},
+				{-27.619702565840292, 30.37279330208861, -6.601492328676499},
+				{-34.43843435651173, 37.415466774427905, -7.843654021485428},
+				{-45.9215906844376, 51.11541610935352, -10.442081789248084},
+			},
+			{
+				{93.9727848874386, -58.83560312829079, 4.733601786890389},
+				{44.32232206272284, -25.993392775693106, 1.4374819069522253},
+				{27.83012868644823, -14.76867211627905, 0.21661635839658172},
+				{18.587937604130722, -8.316916148101368, -0.5258339698752889},
+				{12.371739567130676, -3.882952300859437, -1.052817127771337},
+				{7.749140382520662, -0.5154149229876619, -1.4615098149930106},
+				{4.056716734327844, 2.238688481400482, -1.8024996793134747},
+				{0.9302603856798274, 4.636015321792658, -2.106584728069403},
+				{-1.858748994143331, 6.845088717904586, -2.395474171178309},
+				{-4.474732248380293, 8.996175527096389, -2.687388888095198},
+				{-7.056684212009528, 11.211485193765567, -3.0010494699248214},
+				{-9.746028858254505, 13.632569875857678, -3.3600581867485664},
+				{-12.714515491776021, 16.45911614837878, -3.800116811344119},
+				{-16.188832421438946, 20.021040555863408, -4.38425517871868},
+				{-20.287475164670983, 24.876590609839745, -5.234597840633808},
+				{-19.105155134076945, 29.444789362148224, -6.3099674454438865},
+			},
+			{
+				{85.45968423874034, -55.10679212433417, 5.138194928734458},
+				{45.749970474924176, -28.32646520613884, 2.2599365962437226},
+				{30.986415585384577, -18.17941161903818, 1.1275274743757684},
+				{22.52275682304276, -12.24435181925137, 0.4423467762142242},
+				{16.730418103453648, -8.09397554487466, -0.05198950924828994},
+				{12.349944905304053, -4.881786314104338, -0.4454722113251343},
+				{8.808807796505542, -2.218676849130103, -0.7802166168745908},
+				{5.795059178375391, 0.1129864481663417, -1.0809174718106118},
+				{3.113331769549791, 2.2563054365275383, -1.3650471999784917},
+				{0.6251866373096101, 4.3213342967351345, -1.6473314684779456},
+				{-1.7807541258641284, 6.408708537742278, -1.942677647235072},
+				{-4.20575866906213, 8.628919693433872, -2.2691796689456587},
+				{-6.745244890829155, 11.124086488999696, -2.6524939044190172},
+				{-9.437141526633981, 14.087717132609647, -3.132685361310034},
+				{-11.730613502840793, 17.62618055268776, -3.760371103245845},
+				{-2.830357208176839, 17.278084519673314, -4.093788321283629},
+			},
+			{
+				{79.38741085985285, -52.298261071190026, 5.348344928716963},
+				{46.075588352266614, -29.459401682516003, 2.7585812361988666},
+				{32.52035462652593, -20.026822898266385, 1.6673092651975243},
+				{24.572297608504424, -14.403488487024314, 1.0042375542912954},
+				{19.095725415640572, -10.454680819226226, 0.5294439675941306},
+				{14.943794981051203, -7.395368616932165, 0.15393456008527198},
+				{11.582070653425701, -4.855993183389089, -0.1646896853597344},
+				{8.71987068374198, -2.6312712870799166, -0.4504915597801187},
+				{6.178617683177229, -0.5893990556428206, -0.7195978747638433},
+				{3.8355927536114427, 1.368256015646848, -0.9849887619074551},
+				{1.5972864431587483, 3.328991189429351, -1.259328889502456},
+				{-0.6117326472149411, 5.384154808412119, -1.5574059752757605},
+				{-2.8391138129209375, 7.641607375273961, -1.8990456266508988},
+				{-5.008146904215128, 10.217018030062174, -2.311661872800026},
+				{-6.17181561130017, 12.969984025797903, -2.809077836531994},
+				{7.467231687224846, 9.872602984095339, -2.758148575835318},
+			},
+			{
+				{75.12500959464086, -50.39317637320958, 5.531103630970237},
+				{46.22547562422455, -30.291367299565614, 3.1491586965015568},
+				{33.590884350213464, -21.3917622259628, 2.082928439623297},
+				{26.0155182122554, -15.977622054176463, 1.427316224530499},
+				{20.75171402110482, -12.150859864939243, 0.9585471401199405},
+				{16.752006940223936, -9.184151692452147, 0.5903135437702068},
+				{13.516202403689867, -6.726601119746619, 0.28053058971571826},
+				{10.767956588910286, -4.5801854948526515, 0.004998319667808369},
+				{8.336889289533662, -2.6173837928436763, -0.25238987499609644},
+				{6.107751806163967, -0.7441802047228112, -0.5041797740031632},
+				{3.9965499395882036, 1.1201445913069368, -0.7620584614864977},
+				{1.9426209314458756, 3.056464251936863, -1.0390519350901444},
+				{-0.0740173014730922, 5.15359397394524, -1.3517452938011536},
+				{-1.91077252743162, 7.484976114142405, -1.720736947547195},
+				{-2.390356940053076, 9.779328166795908, -2.141284639924718},
+				{14.274918571537123, 5.101848632409337, -1.8863908353910848},
+			},
+			{
+				{72.20084348110738, -49.2291225496123, 5.732278550015766},
+				{46.46676379694674, -31.09470378156204, 3.500648612167049},
+				{34.54721207862149, -22.601138250908043, 2.4494708224851056},
+				{27.252112347063967, -17.333957213741964, 1.7939572972261986},
+				{22.136220863318535, -13.58174135765475, 1.3239942290606768},
+				{18.23386859471194, -10.665382752875207, 0.9558348510054231},
+				{15.074739357479356, -8.250845707055378, 0.6479649507467308},
+				{12.395834027626336, -6.147374976043035, 0.37632405966264065},
+				{10.033845199618787, -4.231238193764571, 0.12487215865958667},
+				{7.878295683212088, -2.4110836966053415, -0.11879632088330258},
+				{5.849999294105668, -0.6091871553388503, -0.3660043636725713},
+				{3.8954307877519203, 1.2505666304819774, -0.6289824716355205},
+				{2.0080926473041676, 3.248206411270806, -0.9227329066126548},
+				{0.3627474415841991, 5.43838487907263, -1.2646474510672459},
+				{0.2551261143744614, 7.4931625673983415, -1.6423087077762062},
+				{18.882780124267292, 1.9360996956299747, -1.2854724995519613},
+			},
+			{
+				{70.34125375229797, -48.7016347115682, 5.973070565613689},
+				{46.920385468796475, -31.997797798087365, 3.8469345239855235},
+				{35.55185205923767, -23.80768600387203, 2.802207690719875},
+				{28.46526107537622, -18.640087668718987, 2.1415952199521744},
+				{23.450203037167633, -14.929126993904713, 1.6658192269708438},
+				{19.606553291240786, -12.034268193639845, 1.2931238895274668},
+				{16.48814796659361, -9.63497661545639, 0.9823775305374378},
+				{13.84285124565553, -7.546358061476412, 0.7095993513007514},
+				{11.513219790401484, -5.647865484463425, 0.45882517910050485},
+				{9.392578546336713, -3.8503369762168287, 0.2177957352556064},
+				{7.404692413317908, -2.0778834115494416, -0.024570924343562197},
+				{5.498873619321617, -0.25620948266197974, -0.2801296688257458},
+				{3.6723293874824816, 1.6926376378184735, -0.5632918612379371},
+				{2.1071948020171622, 3.8210637410142434, -0.8906138509597},
+				{2.13442470060993, 5.797625674226386, -1.2492489162059222},
+				{22.01433000564649, -0.17716515166801547, -0.8542400053495744},
+			},
+			{
+				{69.40264362199494, -48.76420743893349, 6.26902705307958},
+				{47.67010357506644, -33.088436991542025, 4.2109235897200845},
+				{36.71160466391126, -25.110993902349996, 3.1639429154193346},
+				{29.770784813058405, -20.001075305727316, 2.4934712040957083},
+				{24.817155387020055, -16.303727723884307, 2.008152493653911},
+				{21.00160763598231, -13.407913674357, 1.6274802412964036},
+				{17.896483947614247, -11.003027396164773, 1.3103855635075574},
+				{15.257535859271245, -8.908092108443187, 1.0327613509738978},
+				{12.931145229151245, -7.004379018102031, 0.7785539774762925},
+				{10.812717063584746, -5.203773654918009, 0.5355102883777761},
+				{8.827130352952379, -3.43106211656278, 0.29266023008701775},
+				{6.923641270537998, -1.6119813862404282, 0.03832857755069674},
+				{5.096521031358976, 0.3335421131164397, -0.2418167241867186},
+				{3.51645432014521, 2.467628160452842, -0.5650232811503794},
+				{3.4816302023991965, 4.497471686451929, -0.9232867792420694},
+				{24.083179683682122, -1.5465680392022254, -0.5358167505867266},
+			},
+			{
+				{69.33633673485988, -49.422881545912176, 6.636993013448775},
+				{48.8008026215195, -34.450259695756195, 4.613102972652587},
+				{38.122739866756746, -26.59888577556821, 3.5542689701362895},
+				{31.267681399634483, -21.505398272624053, 2.86881353501203},
+				{26.33857072662724, -17.795431618801498, 2.3703096946589497},
+				{22.523867623427467, -14.878655605580668, 1.9786126577476861},
+				{19.40890997077078, -12.450730788678307, 1.6523146230508452},
+				{16.754311716175938, -10.332522927774637, 1.3669225877414974},
+				{14.408042531654678, -8.405532322004225, 1.1060672873759552},
+				{12.265405768098713, -6.581118411657707, 0.8572619449425837},
+				{10.24980030728944, -4.782906962411306, 0.6093727802378991},
+				{8.306860090111602, -2.9342808942055867, 0.3505812105669383},
+				{6.421786265544688, -0.9492190852505727, 0.066193669979819},
+				{4.73822728745677, 1.2535548714932623, -0.26275578577579467},
+				{4.460793243254183, 3.456579347171184, -0.6372146152484147},
+				{25.305826411118037, -2.3360662446372764, -0.29748312472062216},
+			},
+			{
+				{70.18072339058794, -50.74111586036214, 7.099889747660565},
+				{50.42260115844668, -36.18507101943787, 5.07660855690441},
+				{39.896782250336386, -28.370547990054185, 3.994611204018399},
+				{33.06532192574602, -23.24922022623239, 3.2880989887933256},
+				{28.12283043780333, -19.499040372358053, 2.7723601434693026},
+				{24.282149306335736, -16.541368551645412, 2.3665375435572713},
+				{21.135941302330725, -14.074185924605738, 2.0283809723587733},
+				{18.446534743865097, -11.917954911298322, 1.7326981511601585},
+				{16.061182086711646, -9.952714403622, 1.4625453242381588},
+				{13.87280362697878, -8.08759704293185, 1.2049252186428452},
+				{11.800388546279745, -6.242898462960867, 0.9481843952412226},
+				{9.781468483312477, -4.336434651144061, 0.6798595517891902},
+				{7.78441267318574, -2.271006245951997, 0.3841750826734771},
+				{5.909092222424384, 0.06418791357861406, 0.03929296410210138},
+				{5.205238292355549, 2.563481990270258, -0.36834732708362855},
+				{25.744944561049085, -2.6058166354305823, -0.12151396738313276},
+			},
+			{
+				{72.07869329837611, -52.860304331801515, 7.692717343542321},
+				{52.69934679707192, -38.43798572485713, 5.63259107269789},
+				{42.18732840229009, -30.559928337507234, 4.513154201986894},
+				{35.30966640632835, -25.359256218817222, 3.7778741342589996},
+				{30.311607368266277, -21.53731450199733, 3.2399972906066323},
+				{26.41632417838373, -18.5171070901748, 2.8165827025325854},
+				{23.217492779454787, -15.994348377516864, 2.463877063906732},
+				{20.475626054208366, -13.786482417640412, 2.155588690326279},
+				{18.03474756015092, -11.770188042190371, 1.8738896286305344},
+				{15.78300232425752, -9.850539124290238, 1.6049586925464185},
+				{13.631899947716182, -7.942158373052222, 1.3362178432316678},
+				{11.505867313020756, -5.953560071534242, 1.0539336544989761},
+				{9.346755637895566, -3.76943209268295, 0.7401747976813048},
+				{7.188584719015897, -1.234743680249664, 0.3682941905071515},
+				{5.85214853532516, 1.7017625111971475, -0.0925035843221832},
+				{25.304382754860786, -2.318371086232443, -0.0012535632425807741},
+			},
+			{
+				{75.3343127532111, -56.05043821365444, 8.473562500163542},
+				{55.89840453186355, -41.44013033103675, 6.328967347003711},
+				{45.23381638724098, -33.37330190446421, 5.15257782946951},
+				{38.22433185682757, -28.02811285656604, 4.378082627066964},
+				{33.1200010490483, -24.095583531416107, 3.8117521543328974},
+				{29.137563766614754, -20.987859816609117, 3.3666570338691986},
+				{25.86393163592784, -18.392512329525747, 2.9966108401708014},
+				{23.05344441932291, -16.120626873718823, 2.673671602177412},
+				{20.543893322957654, -14.043185304988276, 2.378723454546949},
+				{18.215938414957925, -12.059070953604017, 2.096738074063122},
+				{15.970264610387737, -10.074605368460107, 1.8137343646089104},
+				{13.71269020510567, -7.984749152087394, 1.5139529020858395},
+				{11.34711198164595, -5.6475195639256635, 1.1758734180310966},
+				{8.81217668282631, -2.8427090053364243, 0.7649043920711296},
+				{6.594399572492611, 0.7060472334735005, 0.22492631770408464},
+				{23.66506736685439, -1.3094915454237954, 0.05950570283916251},
+			},
+			{
+				{80.55686109781105, -60.832248817926896, 9.548071955760154},
+				{60.49765674029889, -45.599430861189205, 7.248730771394316},
+				{49.453198562781495, -37.16747105402087, 5.986030681372047},
+				{42.19571064846063, -31.58718851036185, 5.157095664858928},
+				{36.919336378293956, -27.492857810418975, 4.553752380043325},
+				{32.811292859016866, -24.267931898041613, 4.082135746403007},
+				{29.440871420620393, -21.583373924708948, 3.692192190865995},
+				{26.550219230000252, -19.239171165849385, 3.353502959819244},
+				{23.966905144836176, -17.09742901599532, 3.0450891832980624},
+				{21.560721605761255, -15.048114001335016, 2.750202403534859},
+				{19.21757252331449, -12.986048109849484, 2.4528435711551806},
+				{16.81825462066993, -10.787511400216859, 2.1342547394696005},
+				{14.21496200568007, -8.273133578972914, 1.7673900913747314},
+				{11.21215821270519, -5.128520368632831, 1.3049172051957698},
+				{7.801597247474072, -0.7411556971248521, 0.650037994707276},
+				{20.106130076345423, 0.8028989800328875, 0.04438367453463887},
+			},
+			{
+				{89.04993427905036, -68.30281673036428, 11.133270555292201},
+				{67.45775901527469, -51.730815602013095, 8.556148078596378},
+				{55.67117271794169, -42.64664928375763, 7.157262911417525},
+				{47.98826468256965, -36.69082856368131, 6.249644252362411},
+				{42.448311697065684, -32.36300149995204, 5.597313707923917},
+				{38.171231777952656, -28.98800493155882, 5.094252505103221},
+				{34.691157957567675, -26.2062233138284, 4.684066477037776},
+				{31.728654010289354, -23.799018313437248, 4.332502124599609},
+				{29.09524247680225, -21.614910235292275, 4.015841929575227},
+				{26.64533984366319, -19.531258804775657, 3.7148990613027317},
+				{24.245139702112493, -17.427310827832414, 3.410830237006869},
+				{21.742466192506516, -15.154107845451373, 3.080543628801305},
+				{18.92011961586209, -12.480549822479492, 2.6886500868413403},
+				{15.39517110250527, -8.956298026188243, 2.1674504059639204},
+				{10.41310210619013, -3.4471440611149027, 1.350059928510014},
+				{13.055458865759118, 4.84575011357341, -0.07619721724761583},
+			},
+			{
+				{104.0967684989113, -81.2074118682925, 13.766412572597936},
+				{79.0857660203523, -61.7856598220054, 10.642958475680736},
+				{65.85330113201175, -51.48464210686517, 9.00743570934116},
+				{57.432270117483114, -44.90441685256263, 7.978009285563559},
+				{51.49962652073848, -40.243799836650986, 7.261274025137635},
+				{47.031749208397734, -36.70770933296472, 6.7280365264394275},
+				{43.494278888569326, -33.87911476771955, 6.310718285964945},
+				{40.57036967969288, -31.508040180469695, 5.968938139803474},
+				{38.047877687572715, -29.42335024973936, 5.675145309388286},
+				{35.762149255932066, -27.486898592718966, 5.407171331721267},
+				{33.556926925529446, -25.560181702008936, 5.142850107068662},
+				{31.241064766966375, -23.464050558447077, 4.853612072055054},
+				{28.50767086599994, -20.89884517264078, 4.492050084113246},
+				{24.70818640651418, -17.214447935210114, 3.9571023070178306},
+				{17.909192466855032, -10.415881610992221, 2.950010658002193},
+				{-0.8712998999509664, 12.480986955841503, -0.27160882845208567},
+			},
+			{
+				{137.31623501441408, -109.23217118070573, 19.33374046343427},
+				{103.02217900846634, -82.21678878616078, 14.802299128546808},
+				{86.46308739412879, -69.1810262141787, 12.657267815322651},
+				{76.64859237152676, -61.460842643868354, 11.420402374917389},
+				{70.25797589761703, -56.44000889916392, 10.647240044842007},
+				{65.92111019754277, -53.039694116808455, 10.155824125868183},
+				{62.97366370219797, -50.7372168162804, 9.859334324249478},
+				{61.067592087763245, -49.259120835519866, 9.713990625844449},
+				{60.02283823624539, -48.46434577394572, 9.699529047167772},
+				{59.76600079999803, -48.29586850315634, 9.811614219882038},
+				{60.30782840829205, -48.7627488482848, 10.059976524266332},
+				{61.74188319648005, -49.93822474133624, 10.470506805219937},
+				{64.24717882517015, -51.95853356247436, 11.090596881128807},
+				{67.98539834798287, -54.927407509800304, 11.988102971724459},
+				{71.5947076488553, -57.639746861245435, 13.09334565952813},
+				{-2.0396292964079777e-11, 1.3497064912013707e-11, 5.977080004640934},
+			},
+		},
+		{
+			{
+				{104.6472645572352, -60.55182969577599, 2.7016676770535515},
+				{33.429277214514904, -14.25629205261701, -1.682134110718787},
+				{14.960099362792599, -1.9949246523224071, -2.902691171250144},
+				{6.0011171652379565, 3.8942686306488583, -3.428250464620811},
+				{0.4765175255978713, 7.527608183772047, -3.725137199350311},
+				{-3.452360084168738, 10.15224491606554, -3.9337999839140005},
+				{-6.548422673102457, 12.285193039637175, -4.110951781420504},
+				{-9.19903068469978, 14.192151423729811, -4.285587556056638},
+				{-11.638247881420833, 16.0404956345878, -4.476976605881339},
+				{-14.038632996175123, 17.96426826240922, -4.702585258089877},
+				{-16.561672579549835, 20.103561814120663, -4.983522514327584},
+				{-19.39894733196945, 22.64371214980952, -5.351053219046033},
+				{-22.833003669127336, 25.88378756950376, -5.8593597351534585},
+				{-27.366710630586503, 30.402235951353482, -6.61816952209483},
+				{-34.01229013099927, 37.55156032634618, -7.896957901271166},
+				{-40.61118895150126, 49.29407840719776, -10.314658627541084},
+			},
+			{
+				{96.23760057940113, -60.25226086440063, 4.880233697427171},
+				{44.988430482635344, -26.403237586831143, 1.4877866189616884},
+				{28.264793134344664, -15.037804971395733, 0.2540739647799587},
+				{18.941039179137583, -8.537002419137908, -0.4927116772248335},
+				{12.685587707175486, -4.078303315473618, -1.0220884153873815},
+				{8.04263815212421, -0.696453012337167, -1.4323336186089053},
+				{4.340910798547277, 2.0662399975650523, -1.774398001734252},
+				{1.2131115873708993, 4.4685101176611886, -2.0792692039796097},
+				{-1.5701036109715045, 6.679837855735493, -2.3687735903285168},
+				{-4.1722402387761415, 8.830770801227626, -2.661213806221644},
+				{-6.728973210284733, 11.043054237136424, -2.9753630348019975},
+				{-9.373162163693273, 13.456140918946812, -3.3347903259745255},
+				{-12.252267460146037, 16.261959313255115, -3.7747208320777093},
+				{-15.503246698115477, 19.75500381186987, -4.354910228247056},
+				{-18.69928807483448, 24.236633188001747, -5.168055775370715},
+				{-4.882007486358297, 22.35980428744376, -5.447445299626364},
+			},
+			{
+				{87.10713814242273, -56.15095897939028, 5.256721383515374},
+				{46.345697836718315, -28.6927121092965, 2.3060796514053976},
+				{31.385243397311946, -18.42127584497521, 1.1604230004329652},
+				{22.850473713803893, -12.441822122901007, 0.47073874049622594},
+				{17.02750412691542, -8.27203409391096, -0.025393709146850914},
+				{12.634484543930455, -5.050907223168987, -0.4196137078729374},
+				{9.091326863244369, -2.384365931897118, -0.7545944825789751},
+				{6.08370552988942, -0.05304454283134045, -1.0552124145028754},
+				{3.4163637656394297, 2.0865286928664903, -1.338980995386743},
+				{0.9531386503980138, 4.143804854150809, -1.6205790296858085},
+				{-1.4116064400397643, 6.217393292568097, -1.9147363379188358},
+				{-3.765425672448663, 8.412419416241638, -2.2390380631916678},
+				{-6.166352270117789, 10.855127631199332, -2.617508283189356},
+				{-8.520124513703694, 13.678196042364657, -3.083445788159888},
+				{-9.52414198711905, 16.615387523154286, -3.6431968440004745},
+				{15.398463947625292, 8.220503064196496, -3.012657409478594},
+			},
+			{
+				{80.69859291762114, -53.139232279370056, 5.450922317022689},
+				{46.62878468135706, -29.80278084628692, 2.8038168960048497},
+				{32.90355419170237, -20.25992601193313, 1.6996750494127941},
+				{24.89027863580755, -14.594167412451995, 1.0316951110117283},
+				{19.385237645373994, -10.626184291374967, 0.5547561629079907},
+				{15.222753575275666, -7.558554907850683, 0.17838757908085542},
+				{11.861629673918689, -5.017109243659342, -0.14038168717261681},
+				{9.008905869330805, -2.7947405305402286, -0.42585821450914196},
+				{6.48630603397948, -0.7592918340756217, -0.6942375124396172},
+				{4.1739318976702515, 1.1869857963855666, -0.9584293070803417},
+				{1.9851937567652944, 3.1286812266659276, -1.2308352445794215},
+				{-0.13900878452265647, 5.1502554490497365, -1.5255360289467808},
+				{-2.2022254328488393, 7.339897185685435, -1.860242230254856},
+				{-3.973597286066425, 9.740637555316965, -2.254190145913059},
+				{-3.642523353733954, 11.784631158241199, -2.671156508563662},
+				{27.91319334519976, -0.06852195421838811, -1.6116070976603671},
+			},
+			{
+				{76.23608743323793, -51.11432869922589, 5.624490764605091},
+				{46.752606418108705, -30.622920389979903, 3.1948553937454562},
+				{33.96802161759948, -21.623813957875413, 2.116190369834238},
+				{26.332136576946553, -16.16901123938103, 1.4554386492987754},
+				{21.041118161410697, -12.322971824742378, 0.9842126746842502},
+				{17.031203110401588, -9.347459947680875, 0.6148381293476763},
+				{13.796357296010308, -6.887515344619586, 0.30470687265015356},
+				{11.05841489124203, -4.743561798977366, 0.02940172347574117},
+				{8.647556347177428, -2.7878524270200726, -0.2272459352893375},
+				{6.451624273263512, -0.9273682358786883, -0.47770874794762047},
+				{4.394092296608946, 0.9156398206026731, -0.7333889366880139},
+				{2.4319311974590514, 2.8145013050885557, -1.0065365671309336},
+				{0.5927306743189281, 4.836648045566755, -1.3114433417227431},
+				{-0.8149922200995721, 6.977459455090263, -1.6600266016577614},
+				{0.31502116715443734, 8.511283194505951, -1.9955384777810612},
+				{36.2096755885655, -5.311142851893201, -0.7258545681230559},
+			},
+			{
+				{73.18989695408233, -49.87938986962883, 5.821013886571263},
+				{46.9809597041158, -31.42321339495624, 3.5479115070055975},
+				{34.92581997833338, -22.837840588860768, 2.4845944643645983},
+				{27.57363317782713, -17.531220521566617, 1.8237472810702946},
+				{22.43134138293499, -13.75953740193615, 1.3510703643890563},
+				{18.518752161562425, -10.833777100189915, 0.9815141554180105},
+				{15.360284735797098, -8.416148345862583, 0.6730562993660967},
+				{12.691411950549911, -6.314503971534545, 0.4014310375197897},
+				{10.349625245126965, -4.405024501033901, 0.1505520821863123},
+				{8.22778441030619, -2.597490299852891, -0.09189692250617235},
+				{6.254488624830958, -0.8172922636770407, -0.33694100338537586},
+				{4.3944414334575175, 1.0039113721554234, -0.59602114563218},
+				{2.6902743905608384, 2.924221757855884, -0.8818244501046983},
+				{1.4883662570634906, 4.918419292113352, -1.2030321836123916},
+				{3.0519048690063464, 6.192445198959649, -1.4951484181901291},
+				{42.1634919208512, -8.872174072312623, -0.11740387127334063},
+			},
+			{
+				{71.25978309447153, -49.31429753424124, 6.060712742754736},
+				{47.43387115810816, -32.33173794569869, 3.8969533621607115},
+				{35.93950806544219, -24.0547436189576, 2.840162083996471},
+				{28.797957979148098, -18.848215088018616, 2.1739845399317916},
+				{23.756810440502502, -15.117370185324576, 1.6952437301765937},
+				{19.90264866488614, -12.212439367933586, 1.3209142764186221},
+				{16.784395790482186, -9.809295456268414, 1.0093540712631468},
+				{14.1485128164169, -7.721694179044959, 0.7363704197530456},
+				{11.838425377688468, -5.829019102271615, 0.4859486789587137},
+				{9.75087731660688, -4.043267311017445, 0.24591900709931533},
+				{7.817544842745415, -2.2917036542820575, 0.005499724190211678},
+				{6.006161372944215, -0.5078179744333808, -0.24638232426237364},
+				{4.363449016953184, 1.364533409060267, -0.5218775948052405},
+				{3.24489776126454, 3.2983035310887745, -0.82906530650081},
+				{4.97055681189116, 4.493177034815667, -1.1039480430344841},
+				{46.889355326774606, -11.507565789969435, 0.33934295685902444},
+			},
+			{
+				{70.289610463902, -49.365459749571066, 6.358819261390073},
+				{48.19574470139069, -33.43713565124112, 4.265159643379877},
+				{37.117072159538445, -25.375124307692886, 3.205913120081768},
+				{30.122060181091616, -20.225932839393817, 2.5295567172096},
+				{25.142059000306354, -16.50793129736957, 2.0409909674849205},
+				{21.31543744380516, -13.60122623465554, 1.658441284974279},
+				{18.209804664296165, -11.19167127234726, 1.3403130550296691},
+				{15.579529475623941, -9.096911309431112, 1.062271056757957},
+				{13.271822557658487, -7.198088648282458, 0.8081948632440219},
+				{11.185429518989517, -5.408162027292784, 0.5659026749264288},
+				{9.25301960783091, -3.6549442093858753, 0.3247016169883737},
+				{7.442061033872184, -1.8717246642811245, 0.07365882853891308},
+				{5.795860753708764, 0.00035838929914292454, -0.1994019231436506},
+				{4.6574046260092326, 1.9458927796784753, -0.5036383132400324},
+				{6.323774332099582, 3.2065300774126206, -0.7814195680337411},
+				{51.089161166755574, -13.660383474461021, 0.7152948823061956},
+			},
+			{
+				{70.22618306708688, -50.03702033583076, 6.732401888681073},
+				{49.35371677030129, -34.82513778183983, 4.673501762816732},
+				{38.55719001263858, -26.888844083751575, 3.6018600908482084},
+				{31.647204130142576, -21.75471542919711, 2.9100632836392792},
+				{26.690729867260462, -18.02284342494623, 2.407966159613149},
+				{22.864038046943026, -15.094126261970125, 2.0141202726993495},
+				{19.747746510213833, -12.660622353113677, 1.6865616172321856},
+				{17.10102091154384, -10.541731964013652, 1.4005445787976079},
+				{14.7725513706418, -8.618720307813776, 1.139609852161673},
+				{12.66081081372766, -6.803870988673395, 0.8913142122040686},
+				{10.696689779592315, -5.023589281411487, 0.6447596169738047},
+				{8.843448299314867, -3.208332828858911, 0.388795128517842},
+				{7.133999160329134, -1.2921874130539246, 0.11071485809289966},
+				{5.880828946793335, 0.7318263809532116, -0.20087626718652965},
+				{7.287095348394236, 2.189244481423002, -0.4992929066873899},
+				{55.23007674302157, -15.597968590297631, 1.052148484886973},
+			},
+			{
+				{71.10973289963047, -51.3950351447254, 7.205231521394654},
+				{51.022505536045074, -36.601519294285644, 5.145947697636094},
+				{40.37566325722235, -28.69867833867215, 4.050155199013176},
+				{33.4867425137008, -23.534035484643884, 3.3366451882966914},
+				{28.515010191615605, -19.760050763600677, 2.816866270858078},
+				{24.66098864322952, -16.78906826463146, 2.408574598349725},
+				{21.512446350791635, -14.315270190859566, 2.0689127369998648},
+				{18.830104640861464, -12.157498216682852, 1.7724005288115412},
+				{16.461768918419743, -10.195406820739066, 1.50197392850681},
+				{14.30329920047485, -8.338862205315309, 1.2446447505611262},
+				{12.280774225693209, -6.510622443223228, 0.9889432420991332},
+				{10.348505854732936, -4.634930680698207, 0.7229747680619331},
+				{8.52049460224862, -2.633115565716499, 0.4327210172750654},
+				{7.059485303360982, -0.4639802007283718, 0.10323270215575346},
+				{8.002075645985471, 1.3244852594843965, -0.23399259638081646},
+				{59.685089415223715, -17.50294505641176, 1.3777811045394324},
+			},
+			{
+				{73.09259174505245, -53.58932147757848, 7.814143508599254},
+				{53.37470452733461, -38.91875525210991, 5.7151330949600885},
+				{42.73376979821395, -30.944995946691776, 4.580273048211089},
+				{35.79373512346291, -25.696530619209433, 3.837040508031807},
+				{30.763384081040844, -21.847985673282267, 3.294526955686021},
+				{26.852866636645533, -18.812677909603376, 2.868253577563287},
+				{23.65054768783515, -16.282142254210463, 2.513778329888981},
+				{20.915054829494288, -14.071954087221952, 2.2044702870446358},
+				{18.490729391390428, -12.058204340003075, 1.9223409831111369},
+				{16.26837591775542, -10.146468643830838, 1.6535358836201668},
+				{14.166123221481229, -8.25346214335486, 1.3855988606115395},
+				{12.124160658516086, -6.293357995970847, 1.105247119298008},
+				{10.127291910802487, -4.1674033473430505, 0.7960231718508662},
+				{8.363866511156413, -1.7838991671070825, 0.4372988210290097},
+				{8.614674687863712, 0.4895076749217094, 0.039852650634980315},
+				{64.84642214831564, -19.532446117725293, 1.715746843123123},
+			},
+			{
+				{76.50146219420724, -56.908882600663446, 8.621013737289235},
+				{56.69483279624737, -42.022282212867765, 6.431819961868166},
+				{45.8856230470918, -33.846332869546245, 5.2373509450017055},
+				{38.80532239820065, -28.44612035309757, 4.453474178654689},
+				{33.66397716835533, -24.48287836869229, 3.881685660327708},
+				{29.66375705052851, -21.3577439251779, 3.4332561136947755},
+				{26.38548194555955, -18.753442388640305, 3.061178721019262},
+				{23.58115152865941, -16.47880065115965, 2.7370947420018745},
+				{21.08854869812103, -14.403931035181307, 2.4416719010974255},
+				{18.790661899408565, -12.427936544246101, 2.159794397095213},
+				{16.59427813070905, -10.458809991853053, 1.877528294719484},
+				{14.41984173748352, -8.39629429587799, 1.5794233888048346},
+				{12.211025172586545, -6.1126255491285555, 1.2450879580330092},
+				{10.049601301085575, -3.442909373321644, 0.8448498064525478},
+				{9.33393565364825, -0.49344907693105977, 0.35925787789003233},
+				{71.2479414632052, -21.862749416874852, 2.0924758650658006},
+			},
+			{
+				{81.99792450614561, -61.917689811879974, 9.739881482535822},
+				{61.49749163010118, -46.350593811168295, 7.3850521439392995},
+				{50.279183965532916, -37.78543376860957, 6.09974097844611},
+				{42.93649858067038, -32.138201774490334, 5.259171792458131},
+				{37.61584412376089, -28.007040280866477, 4.6492082259168725},
+				{33.48683544656818, -24.76189899449297, 4.17371548599953},
+				{30.11125536050986, -22.067721012035403, 3.781595673855605},
+				{27.228160042257716, -19.72160025106333, 3.4418895062834522},
+				{24.664650824232364, -17.584370434795765, 3.133320304392905},
+				{22.292474147356426, -15.545877517087863, 2.8389813804021693},
+				{20.003123330102852, -13.502157648263337, 2.542829482204262},
+				{17.69086579029909, -11.333316019775344, 2.2262433400177084},
+				{15.244133755542615, -8.871482534477787, 1.8628701521530762},
+				{12.596458605284516, -5.847398546341397, 1.4088407576362698},
+				{10.573025945236303, -1.9756658050624631, 0.7967583517940763},
+				{79.7714395025057, -24.737175216938205, 2.5462560267281473},
+			},
+			{
+				{91.02057001349006, -69.82403063485194, 11.409197653140401},
+				{68.83416725999898, -52.79429791332228, 8.754076393255072},
+				{56.816386138584335, -43.53044631377481, 7.324048110178702},
+				{49.022436764983226, -37.4866474202526, 6.40095029215111},
+				{43.42702118831303, -33.11283274356166, 5.740361928361119},
+				{39.126260625449376, -29.715311981323424, 5.233067568325504},
+				{35.64406454416492, -26.926225533860006, 4.821212252846321},
+				{32.696612215761384, -24.52295554699054, 4.4697922682021645},
+				{30.09433645140866, -22.352189640991963, 4.154675487227405},
+				{27.69330993088919, -20.290838912071827, 3.8564365808477437},
+				{25.36507055275839, -18.219031205780762, 3.5560969682894634},
+				{22.970185363928987, -15.990842461833385, 3.23051502959544},
+				{20.324499076917405, -13.38387174551777, 2.844454552593731},
+				{17.157858942897406, -9.980333175424313, 2.331640784314034},
+				{13.427540952183504, -4.886869399791015, 1.5427466741616733},
+				{92.07880159043408, -28.496001357636047, 3.1426837733234922},
+			},
+			{
+				{107.29484987398004, -83.73768004581427, 14.236392767340417},
+				{81.29722558099688, -63.54300802797422, 10.97788200557611},
+				{67.7015755090996, -52.95601406671454, 9.291944758876511},
+				{59.1176442657568, -46.24626107945295, 8.239707574969813},
+				{53.11473961503742, -41.52822228697176, 7.513032859214852},
+				{48.630751157863685, -37.976425967387804, 6.977359028282572},
+				{45.115383399685925, -35.1609563384193, 6.562793840582777},
+				{42.24545069796589, -32.82623245678444, 6.22790555459064},
+				{39.80779144330736, -30.79926429336514, 5.944718688441567},
+				{37.640910876895546, -28.9425195257837, 5.6910024931283765},
+				{35.596669441136015, -27.120315557156378, 5.444761139969161},
+				{33.50059009944347, -25.159085783996957, 5.177719299492499},
+				{31.08264055761053, -22.769616743754245, 4.842657794852567},
+				{27.799441912408753, -19.32558072617949, 4.33779909187518},
+				{22.264874508810745, -12.945375645920565, 3.3609067744820145},
+				{110.67674300297145, -32.709055694416776, 3.9581503717772852},
+			},
+			{
+				{144.82968556334959, -115.31840009142306, 20.487981912377123},
+				{107.94228863570193, -86.23402736524885, 15.585030754012356},
+				{90.57641510144744, -72.55544930692497, 13.325228301855928},
+				{80.47995989426389, -64.613535952504, 12.050909317899846},
+				{74.05728713750635, -59.5727694807397, 11.278242131881154},
+				{69.85555783600849, -56.28855531783358, 10.813615558982013},
+				{67.1915397716928, -54.22368266530063, 10.567997176316648},
+				{65.73275046328423, -53.11810800370949, 10.500742134478068},
+				{65.34714027002515, -52.87077412359657, 10.600019229056267},
+				{66.0567906133814, -53.50378846671066, 10.877890648210746},
+				{68.05721605218181, -55.17903321630267, 11.37559404783691},
+				{71.82520268865858, -58.286319415617854, 12.18416208260617},
+				{78.44793567690056, -63.71108154477454, 13.504989108508267},
+				{90.77329858184774, -73.76835111293283, 15.860046244651706},
+				{119.52942636499859, -97.16117064779442, 21.212319586672763},
+				{-1.0964261244345454e-09, 7.288798808672686e-10, 22.738000198290237},
+			},
+		},
+		{
+			{
+				{104.93523329591581, -60.72976706070258, 2.718095958642305},
+				{33.49727980034617, -14.301970283871515, -1.6754761985733684},
+				{15.000455189724871, -2.022455744911427, -2.8982110569152595},
+				{6.031364947870998, 3.8736366975199776, -3.4247071152452433},
+				{0.5017314704799863, 7.510576908485277, -3.722123427979293},
+				{-3.4300534371180587, 10.137451755088504, -3.9311400315752185},
+				{-6.527933189934762, 12.27197826561446, -4.108560765287039},
+				{-9.179724296769402, 14.180188452337845, -4.283427724199262},
+				{-11.619715774780188, 16.0296574576296, -4.47504245289205},
+				{-14.0205522185784, 17.954570306847188, -4.700899531423508},
+				{-16.54371899690946, 20.09517486071875, -4.982145216977861},
+				{-19.380709641886035, 22.63706254673617, -5.350111535198579},
+				{-22.813677682634374, 25.879760834340118, -5.859116846228856},
+				{-27.343487165272386, 30.40241148769282, -6.619182141015381},
+				{-33.96579807785321, 37.55468266691685, -7.900110780046056},
+				{-39.83322808403718, 48.947181147421944, -10.276757221206106},
+			},
+			{
+				{96.41465437831862, -60.363001717143675, 4.891672283384838},
+				{45.03974287064949, -26.43479343984227, 1.4916466865585665},
+				{28.298188995819267, -15.058463924792171, 0.2569375872975147},
+				{18.968167487687918, -8.55389361664508, -0.4901802631953924},
+				{12.709725582545982, -4.093311627958754, -1.0197379161439686},
+				{8.065250226454376, -0.7103864640880823, -1.4300986118619414},
+				{4.362860240208417, 2.0529330318881818, -1.7722406676773557},
+				{1.2350317191638813, 4.455536527091514, -2.0771656646664702},
+				{-1.5476289670943073, 6.666970097211229, -2.3667078800103676},
+				{-4.1485299202283334, 8.817787509935378, -2.6591741869099255},
+				{-6.703032775280352, 11.029665644387721, -2.9733374840100097},
+				{-9.343195093624232, 13.441815773501018, -3.332753749150334},
+				{-12.21417636816903, 16.245330307922995, -3.772580168507729},
+				{-15.444224442649505, 19.73098327144549, -4.352190499678513},
+				{-18.550930930878245, 24.172874398057548, -5.161038828727406},
+				{-3.257176416230034, 21.535685187879974, -5.345949961626784},
+			},
+			{
+				{87.23520471989399, -56.2321341306069, 5.265922201896121},
+				{46.3915661541185, -28.720908631558842, 2.309623551378992},
+				{31.415894549589332, -18.439857654284808, 1.1629422850904756},
+				{22.87565543393172, -12.456987211508963, 0.47291099801558634},
+				{17.050349559257494, -8.285716864406748, -0.023358498059165338},
+				{12.656398844818833, -5.0639227946016305, -0.4176326702620318},
+				{9.11313526980487, -2.3971476262615266, -0.7526276951814894},
+				{6.106057152004969, -0.06589602445106299, -1.0532335248389928},
+				{3.439930598586769, 2.0733241807308738, -1.3369657963536505},
+				{0.9787967459689391, 4.129901497906284, -1.6184976413701821},
+				{-1.3824759428647497, 6.20225432057054, -1.9125407476312095},
+				{-3.7302272063840776, 8.395007886912229, -2.2366300282676077},
+				{-6.11912391052663, 10.83291894695388, -2.6146303000057185},
+				{-8.442667758912883, 13.642871164110257, -3.0791793708498627},
+				{-9.324962784452733, 16.5217293518881, -3.632188615809285},
+				{17.386588611868348, 7.233432425815977, -2.8955323972133535},
+			},
+			{
+				{80.800168190741, -53.20438799081497, 5.458859276926071},
+				{46.67135161462765, -29.8292034100929, 2.8072905982834886},
+				{32.93300164333927, -20.277837138394638, 1.7021552934735291},
+				{24.914715280898076, -14.608817249841179, 1.033797826041882},
+				{19.407503474830712, -10.639369243589526, 0.556694809895085},
+				{15.244237569420081, -7.571116908409506, 0.18026195477470877},
+				{11.883204819007771, -5.029537941411241, -0.13851542610896003},
+				{9.031277850691486, -2.807389771132851, -0.42396209390753503},
+				{6.510218360593986, -0.7724963329212207, -0.6922778619002752},
+				{4.200374560660434, 1.1728072621094083, -0.9563650653265419},
+				{2.015754498188356, 3.112866056943292, -1.2286007508777514},
+				{-0.10131975220914204, 5.13152189922972, -1.5230002645277314},
+				{-2.150489781837836, 7.3151754619225535, -1.8570778537337618},
+				{-3.8868447536490236, 9.700112168129518, -2.249299729526118},
+				{-3.4167676375294054, 11.677007378562324, -2.658558645433615},
+				{30.08547279260341, -1.1147045414328396, -1.4926928600495506},
+			},
+			{
+				{76.32196492854038, -51.17007380016689, 5.631700493705142},
+				{46.793145774770004, -30.648419954702263, 3.1983633345906153},
+				{33.9969981643234, -21.641642605134578, 2.1187397462612614},
+				{26.356468260684267, -16.183717436588882, 1.457593267276534},
+				{21.063377220627306, -12.336206793775352, 0.9861795724619752},
+				{17.05270687740478, -9.36003462955741, 0.6167191291382812},
+				{13.817978030670862, -6.899930398750151, 0.30656385275906467},
+				{11.080893037035992, -4.7562030764109915, 0.0312804539418372},
+				{8.671690791590766, -2.801096861217922, -0.2253034045587284},
+				{6.478481775015046, -0.9416857151348651, -0.47565276872730533},
+				{4.425380249508282, 0.899515596818147, -0.7311437140998351},
+				{2.470882048133951, 2.795167222899699, -1.0039559904098154},
+				{0.6467627363247969, 4.810780536479099, -1.3081721438270266},
+				{-0.7234398570939238, 6.934561357446578, -1.6549036842427245},
+				{0.555240856630496, 8.397166754645678, -1.9823859378400919},
+				{38.50833301509658, -6.386162186185277, -0.6084563196447895},
+			},
+			{
+				{73.26622476520852, -49.92957695542766, 5.827853964453093},
+				{47.020487753229496, -31.448468123288023, 3.551538556459886},
+				{34.954904903950286, -22.856023913192264, 2.487286660789295},
+				{27.598340514958974, -17.54637802310585, 1.8260302189376947},
+				{22.454040517697337, -13.773210621521784, 1.3531461365064963},
+				{18.540694948867987, -10.846745114438042, 0.9834845846378834},
+				{15.38232212345395, -8.428903538334929, 0.6749843888781821},
+				{12.714285371245747, -6.327436034590258, 0.4033645267551705},
+				{10.374152621202892, -4.418524564549766, 0.15253612523043067},
+				{8.25507039660626, -2.6120526378078486, -0.0898084019284302},
+				{6.286303123950894, -0.8336858085173022, -0.33466704452683904},
+				{4.434125737584876, 0.984231560085469, -0.593409779885514},
+				{2.745478875030007, 2.897840723862764, -0.8785139848683788},
+				{1.582230703826591, 4.874612849838249, -1.1978568769091593},
+				{3.2997212359983035, 6.075819614858408, -1.481940401830609},
+				{44.60443664220163, -9.985715335567127, 0.0002488926407789806},
+			},
+			{
+				{71.33059523958256, -49.36153238454468, 6.0674616489765825},
+				{47.47333368499511, -32.35740244191842, 3.9007911616382316},
+				{35.96928528927615, -24.07372035650402, 2.843071421535766},
+				{28.823523723194295, -18.864207034127585, 2.17646715577949},
+				{23.780393609384532, -15.131847232152602, 1.697500167878008},
+				{19.925455898507746, -12.226160990904923, 1.323047393944411},
+				{16.807259917472532, -9.822746982318343, 1.0114277222338386},
+				{14.172166610470203, -7.7352613148313205, 0.7384326510568915},
+				{11.86368316262479, -5.843090028495073, 0.48804455669847313},
+				{9.778845911172946, -4.058334869529657, 0.24810225947845316},
+				{7.850005328439402, -2.3085374842337862, 0.007851093527690077},
+				{6.0464803333589865, -0.5278732952626305, -0.2437120735607987},
+				{4.41933188847055, 1.3378540532384442, -0.5185332218199362},
+				{3.3396897049320105, 3.2543284493823426, -0.823909393752737},
+				{5.221878602317992, 4.376262046614412, -1.0909259131458173},
+				{49.54223932883705, -12.695770882432798, 0.46201412156830335},
+			},
+			{
+				{70.35794670561974, -49.411784730044076, 6.3657295831966225},
+				{48.236136966240174, -33.46393073112516, 4.269320933493871},
+				{37.14821807031664, -25.39541226183305, 3.2091308014077837},
+				{30.14905530393085, -20.243211005326863, 2.532323344128308},
+				{25.16705148253915, -16.52363672147963, 2.0435099765259452},
+				{21.33961263658496, -13.616114744541658, 1.6608185502461354},
+				{18.233987996626325, -11.206228566695074, 1.3426142688683815},
+				{15.604447911299674, -9.11152128328185, 1.0645448508713948},
+				{13.298281463708669, -7.213133003063075, 0.8104856079970528},
+				{11.214520487850015, -5.424120238162473, 0.5682619428273659},
+				{9.286498526157459, -3.6725620110291395, 0.32720604703590106},
+				{7.483251853588136, -1.8924137555161737, 0.07645165005006503},
+				{5.852387710928947, -0.02671113251201216, -0.19598212659387765},
+				{4.752462320532215, 1.9020197211602967, -0.49850389594017164},
+				{6.5761026471479145, 3.090567014623243, -0.7686857638054462},
+				{54.059839530652226, -14.974253700085505, 0.8490217009245832},
+			},
+			{
+				{70.29472434307387, -50.0843255269828, 6.739743042181268},
+				{49.396209115271745, -34.85394691129805, 4.678136903125216},
+				{38.59056839573157, -26.91111958254112, 3.6055099868334732},
+				{31.676376127265275, -21.773876702835178, 2.913227157666223},
+				{26.717824532694365, -18.040336993914398, 2.4108560320785135},
+				{22.890247252646766, -15.110723850486721, 2.0168478401338312},
+				{19.77390324867704, -12.67682082818096, 1.6891960472902994},
+				{17.127854777093145, -10.557919745038033, 1.4031361003965364},
+				{14.800862203011128, -8.63527554590231, 1.142202653820571},
+				{12.691671732184867, -6.821258215222027, 0.8939575432356162},
+				{10.731815337950938, -5.0425201551415935, 0.6475245512977008},
+				{8.88607566337478, -3.2301473672550443, 0.3918133486511339},
+				{7.191562731978151, -1.3200350401914782, 0.1142996458682122},
+				{5.9760788356705525, 0.6879360838056805, -0.19570510775440988},
+				{7.538909316965076, 2.0748779551921688, -0.48686329166017644},
+				{58.665059473450334, -17.104075911035007, 1.2041927648281487},
+			},
+			{
+				{71.18130315436105, -51.4454126686926, 7.213339093265262},
+				{51.06862728475122, -36.63353549105949, 5.151271870814663},
+				{40.412471476690584, -28.72389742701518, 4.054417790497821},
+				{33.51914947568685, -23.555934712039388, 3.3403712929874922},
+				{28.545196970831732, -19.78013738097241, 2.8202842339880165},
+				{24.690188695214026, -16.808155575961823, 2.4118059838751815},
+				{21.541520801102827, -14.33388144736936, 2.07203264210595},
+				{18.859799191495778, -12.176036385317264, 1.7754624115408748},
+				{16.492887026622498, -10.214253354145075, 1.505022911374749},
+				{14.336900586135503, -8.358470668061058, 1.2477284157144082},
+				{12.318530161591314, -6.531669703823034, 0.9921270545637866},
+				{10.393546740450681, -4.65867278552153, 0.7263772144682205},
+				{8.579990672036732, -2.6624965419789097, 0.4366242033284464},
+				{7.155482111301558, -0.5084446742075569, 0.10857143733581047},
+				{8.252555246935795, 1.2119099470890855, -0.221810140648668},
+				{63.803702133062615, -19.297706612912968, 1.558165571060575},
+			},
+			{
+				{73.17075803519562, -53.64552534823078, 7.8234970380318645},
+				{53.4266703458613, -38.95574777736025, 5.721477629002934},
+				{42.77580649159061, -30.97461690394626, 4.585429642394593},
+				{35.83099090374546, -25.722485905740424, 3.841586962421288},
+				{30.79818636949058, -21.871914116630688, 3.2987195627598527},
+				{26.88654003660529, -18.8354719268211, 2.8722300705854784},
+				{23.684012205519824, -16.304374746663445, 2.517623664603653},
+				{20.94909388499994, -14.094058732292064, 2.208243842225104},
+				{18.52616672313293, -12.080578050291416, 1.9260907614108347},
+				{16.306271188746226, -10.169563365628942, 1.6573091850082609},
+				{14.208113651265371, -8.277925989216811, 1.3894562922832403},
+				{12.17326839414225, -6.320361513099298, 1.1092935281848588},
+				{10.190381602284369, -4.199658036259182, 0.8005056904658284},
+				{8.462054753659435, -1.8301480590107815, 0.44305116922654425},
+				{8.863746380988783, 0.37838588856907646, 0.051943403589901835},
+				{70.01197371106204, -21.773179566496896, 1.9404630854512814},
+			},
+			{
+				{76.59158289963851, -56.97516955998151, 8.63239182920164},
+				{56.756208568994325, -42.06714658935567, 6.439739922326011},
+				{45.93584150293447, -33.88277792479828, 5.243875917229987},
+				{38.850104875025266, -28.47833922523544, 4.459278334696911},
+				{33.70594384027828, -24.512754875963047, 3.8870729022815373},
+				{29.70440386508939, -21.386311910443577, 3.438391286278394},
+				{26.42583960449496, -18.781364445336177, 3.066163661627628},
+				{23.62207945345591, -16.506570255041783, 2.7419997515759915},
+				{21.130922590258635, -14.431983867213145, 2.4465516864623718},
+				{18.83556998962759, -12.45674291204774, 2.164698973349969},
+				{16.643350354142044, -10.489006401095548, 1.8825155634453818},
+				{14.476013370107708, -8.428977012359924, 1.5845852505568965},
+				{12.280847384948933, -6.150262101231953, 1.2506333882793936},
+				{10.153074066797716, -3.4934128738278214, 0.8514934329118471},
+				{9.582906498777657, -0.6045029572762497, 0.3716132676585401},
+				{78.13550021364799, -24.83991253222022, 2.3907745329408683},
+			},
+			{
+				{82.10952715740092, -62.001760182404546, 9.75473035507201},
+				{61.57475654871862, -46.408648990993896, 7.395581923676965},
+				{50.34299493531851, -37.83317963447299, 6.10852052636042},
+				{42.99375258720002, -32.18079248067935, 5.267055232631275},
+				{37.66972346059373, -28.04681778805934, 4.656585364354785},
+				{33.53915928234444, -24.800158931350996, 4.180800122782033},
+				{30.16326703284486, -22.105296027878296, 3.7885211138352783},
+				{27.280874142112445, -19.759105118994636, 3.448747973343412},
+				{24.71906469945112, -17.622332283698526, 3.1401823044830457},
+				{22.34977266371987, -15.58483429850818, 2.845907606704536},
+				{20.06500098270556, -13.542782946669652, 2.5498821109772027},
+				{17.760248645427467, -11.376678857522935, 2.2335065251789548},
+				{15.32732672980727, -8.91983521022799, 1.870514430548033},
+				{12.712239949310053, -5.907717044014986, 1.4174382868138753},
+				{10.826975031283517, -2.090930062068075, 0.8102789564358954},
+				{89.84390571006844, -29.078474610136308, 2.9810887229253282},
+			},
+			{
+				{91.17406424474406, -69.94254195540405, 11.430687691258491},
+				{68.94108536512678, -52.876928367261655, 8.769450204598702},
+				{56.90530801598395, -43.59908839292274, 7.336997017007178},
+				{49.1027740325336, -37.548485533614084, 6.412701968907905},
+				{43.503124171920994, -33.171153741580035, 5.7514817717307105},
+				{39.20062478627824, -29.771957784444556, 5.243871462682804},
+				{35.71839625236527, -26.982400314760888, 4.83190285422583},
+				{32.77229009125256, -24.579562085214608, 4.4805149733452705},
+				{30.172678190590887, -22.410002579402825, 4.165545369547121},
+				{27.7758066346701, -20.35062421976044, 3.8675535637277396},
+				{25.453721026517194, -18.28167578230512, 3.5675570087252595},
+				{23.06819865689317, -16.057589714503994, 3.2424247387265432},
+				{20.4382986600466, -13.456982929442596, 2.856972061667075},
+				{17.30532695905757, -10.06596751134081, 2.345187596106},
+				{13.705350092700725, -5.02129679747406, 1.5603250957520949},
+				{109.3854264591009, -35.93625004607732, 3.8875233589048803},
+			},
+			{
+				{107.54700662595936, -83.93723974923947, 14.27345576591823},
+				{81.47086666053899, -63.68104647872511, 11.004188981639201},
+				{67.84660939240267, -53.071524305134545, 9.314279421879704},
+				{59.24998666422757, -46.351679872656526, 8.260266202641374},
+				{53.2417459211879, -41.62927725315332, 7.532838865308194},
+				{48.75674046405037, -38.076446265438854, 6.99701185815508},
+				{45.24343566386774, -35.26226767699124, 6.582712584680357},
+				{42.37817669181238, -32.930740817053554, 6.248430243432747},
+				{39.94777146109411, -30.908764690753273, 5.966161744218897},
+				{37.79105940015556, -29.058913790262626, 5.713680799877443},
+				{35.76070126115849, -27.24583338331337, 5.46902108377013},
+				{33.68388093478081, -25.296613875886038, 5.203961470579503},
+				{31.294396546379627, -22.9234104565139, 4.871369864871442},
+				{28.060644866890016, -19.503690865169116, 4.369646823531504},
+				{22.666645867143686, -13.178516375971789, 3.3978203997935146},
+				{153.58941431650808, -51.1034826986827, 5.79424506657498},
+			},
+			{
+				{145.44288899252385, -115.81528573734367, 20.58221439006691},
+				{108.33992313445951, -86.55885396843527, 15.648332701361564},
+				{90.90846507216922, -72.82801374187913, 13.37919693677618},
+				{80.7898657454331, -64.8687200591229, 12.101961204848276},
+				{74.36580561773711, -59.827355493900164, 11.329541807840142},
+				{70.17683490449004, -56.554074361698376, 10.867400019169361},
+				{67.53850011588824, -54.510749403385084, 10.626378256467268},
+				{66.12017204680595, -53.43892213868435, 10.566189023448205},
+				{65.79481853015041, -53.24172522127057, 10.675881238533323},
+				{66.59454913160474, -53.94960472790332, 10.969245713065},
+				{68.73497894701802, -55.74113661894462, 11.490967827336933},
+				{72.73707290732584, -59.04280799525588, 12.339646149075886},
+				{79.80244208305226, -64.83502909622244, 13.736260893154144},
+				{93.17103255884487, -75.75819592923567, 16.26987169235741},
+				{125.96557105877906, -102.50184098910474, 22.312993969961454},
+				{-19.832273713392127, 12.87830516784968, 75674.04065101262},
+			},
+		},
+	},
+	{
+		{
+			{
+				{-4.716719558502895e+06, 3.8408723848353187e+06, -847451.6480311153},
+				{-3.442559336348619e+06, 2.7412255240045367e+06, -614054.6237504045},
+				{-2.7479007634267113e+06, 2.1421111373043163e+06, -487352.53927211906},
+				{-2.3008592856691014e+06, 1.756685237460503e+06, -406035.14794751734},
+				{-1.985452337981026e+06, 1.484754696055247e+06, -348746.2997925348},
+				{-1.7494806047044704e+06, 1.2812518813364203e+06, -305903.46341798874},
+				{-1.565619807919754e+06, 1.1225995295869824e+06, -272504.7392443875},
+				{-1.4180350899012303e+06, 995142.3956602883, -245659.2080774653},
+				{-1.2968479111238203e+06, 890367.1869892172, -223568.1754821066},
+				{-1.195543482100353e+06, 802663.0593502873, -205048.38020162907},
+				{-1.1096340004662182e+06, 728166.9440472638, -189286.57279690896},
+				{-1.0359131977030598e+06, 664121.2336326159, -175703.31493612163},
+				{-972025.8603653277, 608501.4363127383, -163873.90233569517},
+				{-916198.9939392649, 559784.1138538303, -153479.1956694792},
+				{-867067.6650556767, 516797.59309115424, -144274.20254713477},
+				{-823563.7968030203, 478625.5448167982, -136067.5985032988},
+			},
+			{
+				{-4.3488011991682695e+06, 3.588338365168272e+06, -806295.0471967114},
+				{-3.169939849996472e+06, 2.560438630613488e+06, -585992.5384705662},
+				{-2.522008726400277e+06, 1.9957515122342126e+06, -465381.90012896253},
+				{-2.1033831970138187e+06, 1.630955338210879e+06, -387634.78465257474},
+				{-1.8073785967529435e+06, 1.3729595698179738e+06, -332717.2415963683},
+				{-1.585645073690354e+06, 1.1795978236771233e+06, -291576.9839731176},
+				{-1.4127619760614503e+06, 1.0287128659039597e+06, -259467.93216223683},
+				{-1.2739467588083073e+06, 907427.6580942522, -233637.6582548535},
+				{-1.1599582808491332e+06, 807696.6346905681, -212369.7315905281},
+				{-1.064689691017788e+06, 724206.4216719789, -194532.79273099347},
+				{-983926.3131046458, 653292.0802371853, -179347.85542615555},
+				{-914656.4915835499, 592336.2887288925, -166259.53730708134},
+				{-854664.2846245248, 539414.3805347306, -154860.11210531724},
+				{-802279.630212181, 493076.86337970314, -144843.03317371264},
+				{-756217.0468255114, 452208.42711185315, -135972.74266400863},
+				{-715469.6260259238, 415936.1743264172, -128065.19414053277},
+			},
+			{
+				{-4.032741171974541e+06, 3.370474531006317e+06, -770708.9237950582},
+				{-2.9342406766312392e+06, 2.40354326709876e+06, -561604.6319518844},
+				{-2.3260497180933105e+06, 1.8683555964472636e+06, -446243.9460315758},
+				{-1.9316932505318841e+06, 1.521309614322237e+06, -371585.65348923474},
+				{-1.6522925847541508e+06, 1.2753262008563573e+06, -318722.82091305766},
+				{-1.4427590978424891e+06, 1.0907176812967886e+06, -279059.5208063002},
+				{-1.2792846975181308e+06, 946539.1332350492, -248069.47411205198},
+				{-1.1479879456781202e+06, 830584.8326774425, -223120.38648689789},
+				{-1.0401691193044116e+06, 735209.7796643729, -202566.80452320236},
+				{-950070.8377639549, 655357.1361876079, -185322.29047643417},
+				{-873713.9664907372, 587534.1134320158, -170637.8167808476},
+				{-808252.9798263521, 529243.9984080633, -157978.77737612042},
+				{-751590.5438306432, 478647.84990030807, -146952.12584916467},
+				{-702146.1288644467, 434360.6733265197, -137262.27661814383},
+				{-658701.7635025202, 395315.54208288214, -128681.87252778074},
+				{-620303.9517713037, 360677.7398732662, -121033.27347666983},
+			},
+			{
+				{-3.7584045142173776e+06, 3.1805528726198003e+06, -739602.6855107307},
+				{-2.7286344413521783e+06, 2.266160113111911e+06, -540210.2238746413},
+				{-2.1546834361466565e+06, 1.7565758610449338e+06, -429433.1319908842},
+				{-1.781298173133641e+06, 1.4249774938461122e+06, -357477.60112768447},
+				{-1.5162685273057786e+06, 1.1894643092023619e+06, -306414.95243156597},
+				{-1.317301774227699e+06, 1.0124903912512378e+06, -268046.2747230799},
+				{-1.161979147916013e+06, 874163.1265294746, -238037.28584987583},
+				{-1.0371946458764536e+06, 762859.0899984065, -213860.60705495597},
+				{-934717.9793647683, 671282.9010208712, -193933.03829617682},
+				{-849093.8119825561, 594601.1029194817, -177207.50007962913},
+				{-776547.5452636585, 529471.3616233114, -162961.25587962457},
+				{-714376.4968394047, 473501.4061777777, -150677.80451463957},
+				{-660588.043430399, 424928.6104879423, -139977.2341039287},
+				{-613678.0441176636, 382423.13831766293, -130573.35642210065},
+				{-572488.7443695123, 344961.6875324359, -122246.2447973711},
+				{-536111.290501878, 311741.59376949293, -114823.66958886634},
+			},
+			{
+				{-3.5181399855775405e+06, 3.013495280857606e+06, -712156.3435178082},
+				{-2.547870948910215e+06, 2.1449193773974376e+06, -521287.32030896447},
+				{-2.003742763512215e+06, 1.6577941852989877e+06, -414554.6621220349},
+				{-1.6486722458188506e+06, 1.3397800814993074e+06, -344988.9488402059},
+				{-1.396209596790113e+06, 1.1134852606595703e+06, -295519.49840237276},
+				{-1.2064841240967805e+06, 943232.9420786379, -258296.2025201482},
+				{-1.0582920011349476e+06, 810058.3105897319, -229155.3021935302},
+				{-939201.2901837172, 702847.4110091574, -205661.707741422},
+				{-841392.8032589214, 614613.2204360641, -186287.44110329053},
+				{-759675.1611658237, 540719.1551155676, -170020.37178090966},
+				{-690453.4289318931, 477955.6575472245, -156161.03215784312},
+				{-631149.339707969, 424022.1541979358, -144208.9114322883},
+				{-579862.8320807014, 377223.8619863569, -133795.82410882274},
+				{-535156.947794972, 336279.8758424985, -124643.97420602196},
+				{-495925.3890701835, 300204.22141143517, -116539.79220771903},
+				{-461300.0776773297, 268223.7306828308, -109316.04781565099},
+			},
+			{
+				{-3.3060333791283453e+06, 2.8653675703684725e+06, -687734.8465732968},
+				{-2.3878428761920487e+06, 2.037181803665389e+06, -504428.2188803615},
+				{-1.869945530083696e+06, 1.5699467498714372e+06, -401298.47461920383},
+				{-1.5310203145187665e+06, 1.2639883499049495e+06, -333865.36598958925},
+				{-1.2896419463071278e+06, 1.0458772050604095e+06, -285817.51604994776},
+				{-1.108071623076461e+06, 881594.8309664484, -249616.53386166185},
+				{-966168.571686404, 752993.5629236633, -221249.72261748026},
+				{-852098.4801718291, 649414.8252972708, -198365.08354627507},
+				{-758402.9990268481, 564144.1212984584, -179483.7182945173},
+				{-680125.2606349648, 492720.682475641, -163624.84905612917},
+				{-613826.6513478907, 432051.48078866734, -150109.56798050125},
+				{-557041.3991296472, 379919.31090017874, -138451.96471871756},
+				{-507949.4819432276, 334688.2844078172, -128294.06786056136},
+				{-465175.0925894758, 295122.434015687, -119365.83383653802},
+				{-427656.7761502827, 260268.59730382555, -111459.31335118151},
+				{-394562.32609526947, 229379.58430216793, -104411.68618782754},
+			},
+			{
+				{-3.117478276358351e+06, 2.733108913413921e+06, -665846.7270508556},
+				{-2.2452855250043822e+06, 1.9408451197619387e+06, -489308.5505359996},
+				{-1.7506651732004462e+06, 1.4913793942754397e+06, -389416.6871096022},
+				{-1.4260880344288917e+06, 1.1962040594234648e+06, -323901.47491682746},
+				{-1.1945674004632311e+06, 985416.7912125915, -277132.3613438386},
+				{-1.0202496423939129e+06, 826476.250647471, -241850.5392959418},
+				{-883938.4171766066, 701966.1623852325, -214179.44090532532},
+				{-774328.7212377021, 601633.7689147238, -191841.5191259096},
+				{-684284.7131815385, 519009.9221962815, -173402.37277370432},
+				{-609057.0152468723, 449790.3699806249, -157909.2340950499},
+				{-545349.1588631811, 390988.8286438016, -144702.18642734404},
+				{-490793.37817114516, 340461.0852564641, -133308.12675214134},
+				{-443641.2651134491, 296624.4175202703, -123378.37924103615},
+				{-402570.9591524664, 258282.64082038205, -114649.78205701824},
+				{-366562.5276772096, 224513.038211929, -106919.60909396404},
+				{-334815.18575140927, 194591.45715145237, -100028.97926486956},
+			},
+			{
+				{-2.94880033694684e+06, 2.6142696642792094e+06, -646098.1711654093},
+				{-2.117583546022254e+06, 1.8542243090765919e+06, -475669.2729513812},
+				{-1.6437768840158381e+06, 1.4207514489950517e+06, -378708.8025491144},
+				{-1.3320475030481645e+06, 1.1352920343064053e+06, -314931.0297870942},
+				{-1.109357455428786e+06, 931105.4812937003, -269320.2776117149},
+				{-941535.2433875033, 776977.4590013865, -234870.48803015827},
+				{-810229.270986258, 656150.4696649085, -207828.53425388405},
+				{-704610.164393601, 558739.1401465195, -185984.72442984226},
+				{-617830.776275323, 478495.17024089955, -167944.89680519857},
+				{-545327.4627604748, 411255.536516569, -152781.76303450897},
+				{-483930.32131602446, 354129.38546730333, -139852.4075365361},
+				{-431360.460912827, 305039.1735829976, -128695.53431174906},
+				{-385934.54594403366, 262450.83478157956, -118970.9915888693},
+				{-346378.63627378136, 225203.58659781364, -110421.76457795125},
+				{-311709.66338176944, 192402.03048816742, -102849.84010123796},
+				{-281155.33295911073, 163342.9920719291, -96099.94929212812},
+			},
+			{
+				{-2.7970617789251995e+06, 2.5068936767598195e+06, -628176.4658083676},
+				{-2.0026103067930872e+06, 1.775946500521332e+06, -463299.62617399043},
+				{-1.547543997245971e+06, 1.3569647930719946e+06, -369010.81619239855},
+				{-1.2473963851228103e+06, 1.0803175603586987e+06, -306817.51336165157},
+				{-1.0326683538652617e+06, 882117.2629131337, -262262.5558702117},
+				{-870701.5467834779, 732351.979933009, -228570.57450312932},
+				{-743906.420280549, 614862.7717090838, -202101.35355596532},
+				{-641880.5333874053, 520095.82877219387, -180706.75586233765},
+				{-558037.6987463906, 442004.0840903316, -163029.55114513857},
+				{-487982.98718725063, 376553.43814311887, -148165.8191268058},
+				{-428659.9007981341, 320939.17531654367, -135488.10162390285},
+				{-377870.6374515338, 273145.0192987395, -124545.99089318149},
+				{-333990.20550154836, 231680.4233775193, -115006.96210974207},
+				{-295788.23073918105, 195417.38451293876, -106619.76292481938},
+				{-262314.6193030084, 163484.88940633583, -99190.60399541998},
+				{-232823.35568017562, 135199.23749544143, -92567.56505263712},
+			},
+			{
+				{-2.6598631925154375e+06, 2.40937765761106e+06, -611824.8751790933},
+				{-1.8986228121563601e+06, 1.7048851454336504e+06, -452027.0827590772},
+				{-1.460535762199757e+06, 1.2991140404129662e+06, -360188.0223539922},
+				{-1.1708916425775378e+06, 1.0305056163446393e+06, -299447.878170979},
+				{-963385.9354178228, 837766.1843155404, -255860.86749528704},
+				{-806728.831145601, 691978.7401179211, -222863.07654295606},
+				{-684021.7723542457, 577530.289720327, -196917.87026783318},
+				{-585250.1974958986, 485170.67558125, -175933.92394298885},
+				{-504064.6167484803, 409036.3089388132, -158587.85143334998},
+				{-436223.09465698816, 345210.7852630663, -143997.17626876204},
+				{-378773.1504201498, 290968.7911683542, -131548.75338643458},
+				{-329589.9234552727, 244349.42854435326, -120802.06708994355},
+				{-287101.09603696584, 203901.98110286705, -111431.66287848758},
+				{-250116.37616897793, 168528.4367485082, -103191.52484218475},
+				{-217716.22240303844, 137380.61788436782, -95891.85192646239},
+				{-189177.41290847328, 109791.79030603546, -89383.65371751164},
+			},
+			{
+				{-2.5352461186036994e+06, 2.320414473937016e+06, -596835.2495533398},
+				{-1.80417796241326e+06, 1.640105895159975e+06, -441708.7861290055},
+				{-1.3815627172059077e+06, 1.246444953000213e+06, -352128.3575976463},
+				{-1.1014964743923813e+06, 985209.005357933, -292728.0447014673},
+				{-900577.0734195154, 797476.6538341015, -250032.89229586557},
+				{-748760.8645188867, 655334.7472540161, -217674.20802093003},
+				{-629778.3374429962, 543670.6410105597, -192210.89141392402},
+				{-533970.315417632, 453514.0255516862, -171604.2749874994},
+				{-455202.51370104507, 379169.082905577, -154562.077154459},
+				{-389372.66023076605, 316827.6255648044, -140221.69810056387},
+				{-333623.02304802515, 263837.0639500981, -127983.1569763828},
+				{-285895.78426749, 218287.7120048424, -117415.13620716069},
+				{-244667.39632768126, 178765.86712066806, -108198.76704714021},
+				{-208783.46277657372, 144200.57120561617, -100092.79543889253},
+				{-177351.97084195475, 113764.33653924378, -92911.03231602651},
+				{-149671.81619318394, 86806.87802870311, -86507.34478684622},
+			},
+			{
+				{-2.4215764661776517e+06, 2.2389090331148338e+06, -583032.7350625268},
+				{-1.7180710003537128e+06, 1.580827303477693e+06, -432225.41762340337},
+				{-1.309626142785111e+06, 1.1983229204120012e+06, -344737.64319146855},
+				{-1.0383383277953761e+06, 943881.6710559803, -286578.6818837876},
+				{-843455.221872527, 760762.6188485457, -244709.28474474518},
+				{-696074.70723667, 621977.9388098957, -212941.81173017257},
+				{-580503.390665749, 512876.2660821681, -187923.84752477007},
+				{-487406.85123011173, 424744.5086254241, -167665.3869438316},
+				{-410849.5153330054, 352042.92263101577, -150903.31172778882},
+				{-346857.11462679564, 291062.8171166029, -136793.3232560359},
+				{-292659.4902000567, 239219.50778116018, -124747.87770904251},
+				{-246259.30614107367, 194649.5848078203, -114343.97484049035},
+				{-206178.07196862123, 155973.69526783074, -105268.86412859337},
+				{-171294.60154281382, 122146.14634473156, -97285.79380487419},
+				{-140742.41039656886, 92358.70980910845, -90211.95798389401},
+				{-113839.9501989251, 65975.76529037653, -83903.76094354683},
+			},
+			{
+				{-2.3175010115996413e+06, 2.1639563089663587e+06, -570273.8126858153},
+				{-1.6392908448527777e+06, 1.5263930154918476e+06, -423477.03953755426},
+				{-1.24388438655885e+06, 1.1542125666661183e+06, -337936.67737707496},
+				{-980678.1025734744, 906060.2880790299, -280932.63532927295},
+				{-791353.5454149772, 727211.2167129789, -239831.23237599735},
+				{-648055.0638061623, 591530.9792073328, -208612.8625807481},
+				{-535621.8487386319, 484797.43629639165, -184008.15695255456},
+				{-445018.5405931442, 398535.7723377006, -164072.47820733392},
+				{-370491.9977189653, 327350.31875083246, -147569.7512653845},
+				{-308186.3634868425, 267625.1265734156, -133672.90021534215},
+				{-255411.18807000958, 216837.36488602735, -121805.6747926764},
+				{-210226.57513613327, 173168.0106021929, -111553.13788826903},
+				{-171194.79764021822, 135269.03626659332, -102608.18862451267},
+				{-137225.1007065394, 102117.89726969591, -94738.1701573519},
+				{-107474.97623655555, 72924.56139834892, -87763.49007694083},
+				{-81280.82297932838, 47067.006551832485, -81542.94733903992},
+			},
+			{
+				{-2.2218698029867597e+06, 2.0947832833044739e+06, -558435.3383495698},
+				{-1.5669804109139852e+06, 1.4762456369978376e+06, -415378.90465516597},
+				{-1.183619886713832e+06, 1.1136557718738238e+06, -331657.54479290603},
+				{-927887.7371814009, 871350.5923929706, -275732.8832324747},
+				{-743701.4636139099, 696468.2010192036, -235348.3064543319},
+				{-604176.8911939631, 563671.6598080385, -204642.2526935431},
+				{-494642.5926692452, 459135.33555747813, -180422.50610202996},
+				{-406341.3784749234, 374607.6185500085, -160787.2125175934},
+				{-333688.4774735225, 304826.48057580576, -144525.5331176797},
+				{-272937.74543645105, 246262.41028079714, -130826.49596046208},
+				{-221473.18502712608, 196450.73094297387, -119124.57675514268},
+				{-177407.2279787837, 153613.0736187306, -109012.24687318751},
+				{-139339.8158289704, 116430.47750707541, -100187.66461574058},
+				{-106209.1283524324, 83902.49954773721, -92422.09757038718},
+				{-77193.78176694934, 55255.27512803201, -85538.8433183952},
+				{-51647.77187714811, 29880.08186271985, -79399.01572178918},
+			},
+			{
+				{-2.1337118611215944e+06, 2.0307374185948998e+06, -547413.7888143529},
+				{-1.500409423059782e+06, 1.4299093269274996e+06, -407858.70540571015},
+				{-1.128223671889769e+06, 1.0762645080701741e+06, -325843.18360236345},
+				{-879428.2070586076, 839413.8302968163, -270930.58212545677},
+				{-700011.0718547319, 668230.7095107011, -231217.60771717376},
+				{-563988.1602903693, 538121.7789095046, -200991.0507673134},
+				{-457143.0998041927, 435632.4231232251, -177131.35327082116},
+				{-370975.7466514252, 352718.24682866654, -157776.5700665876},
+				{-300058.79683030536, 284243.03712204495, -141739.76829233964},
+				{-240747.93842073716, 226757.96843320195, -128225.11636835823},
+				{-190495.6855732216, 177852.1384102483, -116677.03468857182},
+				{-147463.29985815118, 135785.29958351885, -106694.99373693173},
+				{-110286.29780811403, 99266.06679634447, -97982.13652921583},
+				{-77929.59102816874, 67314.61958300171, -90313.4503147116},
+				{-49591.31775583363, 39172.020014324626, -83514.87310176232},
+				{-24641.18737526892, 14241.555158152954, -77449.65988412712},
+			},
+			{
+				{-2.0522016475450792e+06, 1.9712637662213598e+06, -537121.3683259925},
+				{-1.438949466718162e+06, 1.3869734022028567e+06, -400853.99517885124},
+				{-1.0771678127354844e+06, 1.0417005539115462e+06, -320443.6144897272},
+				{-834833.4157627386, 809955.4080637048, -266483.0137267762},
+				{-659859.8882846241, 642235.4488163637, -227401.76224381788},
+				{-527097.9510019685, 514640.2984991061, -197625.57994384124},
+				{-422756.60299873515, 414064.418547139, -174103.73796554824},
+				{-338575.23199889995, 332657.65227218485, -155011.93029611424},
+				{-269272.7850754453, 265401.21986151184, -139185.6476116446},
+				{-211300.15883781033, 208922.15963398994, -125843.37439772137},
+				{-162174.35724849658, 160860.5401538689, -114439.03277476606},
+				{-120101.46033989376, 119511.24879324628, -104578.55415571781},
+				{-83750.672561231, 83609.11468294643, -95969.8492764688},
+				{-52110.481678883225, 52192.64197421851, -88391.23651353487},
+				{-24399.004779609444, 24518.256789972285, -81671.39162807658},
+				{-0.014527250662671009, 0.008048178952171175, -75675.47335582343},
+			},
+		},
+		{
+			{
+				{-1418.9635360361815, 1155.4693986097789, -254.91342846052206},
+				{-1035.690205230859, 824.6900771052689, -184.70675249046724},
+				{-826.7319249838384, 644.471523486123, -146.59377139690667},
+				{-692.2559624967147, 528.5295619491819, -122.13219889657968},
+				{-597.3751774726178, 446.7266219105766, -104.89829585619717},
+				{-526.3887945654287, 385.5069818558812, -92.00977506119024},
+				{-471.0776702699484, 337.7785897969466, -81.96207274459977},
+				{-426.67842099787833, 299.4337748323203, -73.88558164102706},
+				{-390.2198113619515, 267.91191016847006, -67.23928194614172},
+				{-359.7421944879731, 241.52522743251072, -61.667247071895794},
+				{-333.8952258827042, 219.1114657986841, -56.92481477698034},
+				{-311.7149333801622, 199.8414491061326, -52.837741605111255},
+				{-292.49281684373034, 183.10613759950806, -49.27825755724478},
+				{-275.69525999734964, 168.44711622011698, -46.15034731992187},
+				{-260.91191892735964, 155.51209270483162, -43.38033828235369},
+				{-247.82154396524842, 144.0254381895247, -40.91067710548648},
+			},
+			{
+				{-1308.2783686674609, 1079.496630559912, -242.53185863894035},
+				{-953.6735386520523, 770.3010206046129, -176.2644534012871},
+				{-758.770755994497, 600.4380900418931, -139.9837562055823},
+				{-632.8430253041903, 490.7022483672946, -116.59628439099289},
+				{-543.798842578114, 413.09119304524137, -100.07572867624981},
+				{-477.0958463271201, 354.922426963158, -87.69943236341437},
+				{-425.08686831926786, 309.5305535498828, -78.03968938822749},
+				{-383.32578932180996, 273.04250174618807, -70.2686316944668},
+				{-349.0326298615458, 243.03801720852033, -63.869947072153124},
+				{-320.3704882412348, 217.91883743565583, -58.50331492397653},
+				{-296.07174040315124, 196.58267641996142, -53.93444221071366},
+				{-275.2303892935419, 178.242176821269, -49.99626091757873},
+				{-257.1798937297296, 162.31842527565257, -46.56613471502274},
+				{-241.41783010185264, 148.3753090550524, -43.5518248162561},
+				{-227.55757594844204, 136.0774313652368, -40.88250304520588},
+				{-215.296275932027, 125.16221840437193, -38.502802222166174},
+			},
+			{
+				{-1213.194503010106, 1013.9541839242695, -231.82610423810172},
+				{-882.763446771786, 723.0990447532849, -168.92738066827513},
+				{-699.8159420339589, 562.1107850443216, -134.22612865525826},
+				{-581.1883052108481, 457.714161575637, -111.76776446909898},
+				{-497.1390007959493, 383.71680415493915, -95.86535084461359},
+				{-434.10572171589047, 328.1810027838471, -83.93332974424632},
+				{-384.92722185154446, 284.8066933083877, -74.61023666943449},
+				{-345.4277070331738, 249.92223590166924, -67.10426253456731},
+				{-312.9905180462952, 221.22814730444503, -60.920476427050836},
+				{-285.8837520129592, 197.20329385782352, -55.73207985055127},
+				{-262.91064559483175, 176.7971362245624, -51.31377312491156},
+				{-243.2149232402095, 159.25843602746355, -47.50471639387594},
+				{-226.16606510741184, 144.03432265902745, -44.18673201628049},
+				{-211.2884491625137, 130.7079768117831, -41.270862831025205},
+				{-198.215850300385, 118.9586269191324, -38.68875335023813},
+				{-186.66124031676281, 108.53500617300027, -36.38693746883881},
+			},
+			{
+				{-1130.6642139743708, 956.8193394539971, -222.46840740059415},
+				{-820.9077272494412, 681.7681582789155, -162.49107346267073},
+				{-648.2590065626238, 528.4809256542201, -129.16846961919745},
+				{-535.9400851881443, 428.73147047661314, -107.52321545018174},
+				{-456.2140998550132, 357.88403057786957, -92.1624008472385},
+				{-396.3594190352835, 304.6448299221485, -80.61982680330699},
+				{-349.63307629243616, 263.0307037972665, -71.59186564262006},
+				{-312.092543713072, 229.54513266038563, -64.31825131611556},
+				{-281.26235113519033, 201.9938020289075, -58.32278086176168},
+				{-255.50149630441825, 178.92282753702816, -53.290511007532686},
+				{-233.67463316998376, 159.32683328191095, -49.004032356360554},
+				{-214.96862287694591, 142.48613040225027, -45.307972218455774},
+				{-198.78422199449955, 127.87061170424455, -42.08806846307537},
+				{-184.66909601190565, 115.08033144127187, -39.25824668903008},
+				{-172.27475373549677, 103.80731512397739, -36.75232408554522},
+				{-161.32793491916863, 93.81019368745369, -34.518505274958585},
+			},
+			{
+				{-1058.382282757704, 906.5612145865803, -214.21136242742352},
+				{-766.525782588565, 645.2936732803674, -156.7983149138448},
+				{-602.847965251367, 498.7622827074327, -124.69230793724887},
+				{-496.03841494781574, 403.09927168667423, -103.76597809943982},
+				{-420.0923054474415, 335.0245000643739, -88.88435874387733},
+				{-363.017823369235, 283.8075362521916, -77.68639826284871},
+				{-318.4362110242249, 243.74322249369416, -68.91954122555852},
+				{-282.6084724245197, 211.4889532990071, -61.8514171693726},
+				{-253.1825173310062, 184.94297106257923, -56.02240200959202},
+				{-228.59687229368913, 162.71063573929277, -51.12805449970904},
+				{-207.7699763834893, 143.82639018561744, -46.95795829019394},
+				{-189.92649683493752, 127.5983790833862, -43.36158784246958},
+				{-174.4946200763822, 113.51658800055232, -40.22815334762291},
+				{-161.0424469862796, 101.19597162006299, -37.47413731407168},
+				{-149.23705631146905, 90.33986028301399, -35.03528424605193},
+				{-138.81728126903786, 80.71563128927768, -32.861280921896046},
+			},
+			{
+				{-994.5732606579712, 861.999824278479, -206.86474720460697},
+				{-718.3811006127664, 612.880817576517, -151.72628000379436},
+				{-562.5943689436258, 472.3331877686232, -120.70421762468153},
+				{-460.6410353840243, 380.2962837193197, -100.4193253767889},
+				{-388.02944111812917, 314.6834752678236, -85.96538939717242},
+				{-333.4080353239294, 265.262277426509, -75.07494392355265},
+				{-290.71840023821466, 226.57384640407636, -66.54098123001576},
+				{-256.4008704805784, 195.41215587719327, -59.65603887097929},
+				{-228.21220418381478, 169.75767008881022, -53.97530724713113},
+				{-204.6611588957149, 148.268420273809, -49.20373401782339},
+				{-184.71370812275325, 130.01427205770148, -45.137156631990834},
+				{-167.627953303855, 114.32814294744547, -41.6293851526226},
+				{-152.8563522188783, 100.71790889376565, -38.57273599911964},
+				{-139.98509679336922, 88.81179616759518, -35.88597914408129},
+				{-128.6950554802568, 78.32320698600947, -33.506583334261684},
+				{-118.73577639558303, 69.02731298073962, -31.385561311429317},
+			},
+			{
+				{-937.8478280883425, 822.2109652523162, -200.27992196296253},
+				{-675.4928866214732, 583.8983451067011, -147.17765235129514},
+				{-526.7078867025494, 448.69578847037565, -117.12956774530751},
+				{-429.07067713944423, 359.90260441151554, -97.42161249522758},
+				{-359.4244592491285, 296.49299372975855, -83.35236381137389},
+				{-306.98459158461867, 248.67861673861333, -72.73840670636643},
+				{-265.9769315478075, 211.22077796328054, -64.41371026066058},
+				{-233.00119360539858, 181.03568049404817, -57.69324216176475},
+				{-205.91097907003396, 156.17743711843056, -52.14554106439632},
+				{-183.27758168254454, 135.35128839301544, -47.484017032356086},
+				{-164.10932885283364, 117.65886457756206, -43.51014517016459},
+				{-147.69412841806786, 102.45530594662084, -40.08164428951559},
+				{-133.50608587073341, 89.26454202924181, -37.0936312567087},
+				{-121.14753514245017, 77.72671103575895, -34.46693826190686},
+				{-110.31165325440776, 67.56425711547135, -32.14058840946336},
+				{-100.75755556884154, 58.55935046632545, -30.06678965678203},
+			},
+			{
+				{-887.1034302830858, 786.4604418413734, -194.33906426609744},
+				{-637.0735265990313, 557.8386296017497, -143.07435237726966},
+				{-494.5493782731065, 427.4468121002987, -113.90804850257233},
+				{-400.77688798683033, 341.57634606052744, -94.72277108881227},
+				{-333.786944254872, 280.15225341614064, -81.00196105808295},
+				{-283.3009878809888, 233.78553445698094, -70.63829366451813},
+				{-243.79915017599455, 197.43578584704625, -62.5028885547077},
+				{-212.02388961229147, 168.12942662461842, -55.93106517548638},
+				{-185.91562151565063, 143.9870277723937, -50.50347862635892},
+				{-164.1017377847869, 123.75645155205628, -45.94122196805071},
+				{-145.62857983629127, 106.56801654386149, -42.05088905666367},
+				{-129.81081953866627, 91.79693909689146, -38.69374762187712},
+				{-116.1420078660565, 78.981654703043, -35.767456997561204},
+				{-104.23898352478243, 67.773067082372, -33.19472142536973},
+				{-93.8059871304557, 57.90179335322923, -30.91597485691118},
+				{-84.61073612769074, 49.15633055525851, -28.88450996246296},
+			},
+			{
+				{-841.4540592284891, 754.1575945369071, -188.94757946189785},
+				{-602.4834963055705, 534.2888862445637, -139.3530246887914},
+				{-465.5966480900284, 408.25622089094855, -110.99041266593876},
+				{-375.30793568850214, 325.03642694991674, -92.28173337725453},
+				{-310.7130321242484, 265.4130670356077, -78.87852443633527},
+				{-261.98845982543804, 220.35875633451988, -68.74283183355094},
+				{-223.84340044608882, 185.01293429553718, -60.77968904038569},
+				{-193.1490411764118, 156.5020755644195, -54.34300621440846},
+				{-167.92414830115217, 133.0071188112999, -49.02450978619354},
+				{-146.84677193542245, 113.31465721494041, -44.55231380902267},
+				{-128.99759632338126, 96.58108587157749, -40.737689740586525},
+				{-113.71548134130516, 82.1998730154119, -37.44514807372227},
+				{-100.5115572714209, 69.72264398610896, -34.574670965518045},
+				{-89.01577925355761, 58.81009244827227, -32.050672709455},
+				{-78.94239518172253, 49.20026864638395, -29.81487579297418},
+				{-70.06690529840067, 40.68742430928403, -27.821563384582067},
+			},
+			{
+				{-800.1796819394716, 724.8218332124328, -184.0286464342492},
+				{-571.1983007219302, 512.910065082892, -135.96173575440594},
+				{-439.4190652703994, 390.85138441495053, -108.33606479353122},
+				{-352.28982463251396, 310.04971021135054, -90.06451159007447},
+				{-289.86731840161923, 252.0689239574967, -76.95245323607911},
+				{-242.7399610682761, 208.21117630254167, -67.02557783706786},
+				{-205.82457361419978, 173.78002728998516, -59.220068433658234},
+				{-176.10915389008176, 145.99333463106748, -52.9069199295673},
+				{-151.68361224940853, 123.08719922745007, -47.688035399483354},
+				{-131.27201905805197, 103.88361616512506, -43.297982660844696},
+				{-113.98626828260151, 87.56282029357331, -39.55233249035156},
+				{-99.18720743981295, 73.53496824600215, -36.31857544592347},
+				{-86.40195089310853, 61.363746418906345, -33.4988279349489},
+				{-75.27238268274627, 50.71880122747217, -31.01907515471788},
+				{-65.52185396103997, 41.344980835430746, -28.822223030561947},
+				{-56.93294629840108, 33.04180024540174, -26.863464250642526},
+			},
+			{
+				{-762.6891937191205, 698.0581196240763, -179.51923000591756},
+				{-542.7838655659548, 493.4211337685612, -132.85752005689287},
+				{-415.65848972250177, 375.00517659780917, -105.91125225388612},
+				{-331.41041728006616, 296.42126322344524, -88.04274671215681},
+				{-270.9690934420352, 239.94666012039997, -75.19898083765423},
+				{-225.2979188101387, 197.18552920458723, -65.46435671305018},
+				{-189.5030202425986, 163.5920369528604, -57.80382567264459},
+				{-160.67898544359167, 136.46795930914212, -51.60416869600084},
+				{-136.98068053177175, 114.10007383528634, -46.4766933091895},
+				{-117.17420944791785, 95.34290618116935, -42.161931169713526},
+				{-100.39998026491612, 79.39860010798982, -38.47942543767915},
+				{-86.03893347876642, 65.6926500261595, -35.29941957031536},
+				{-73.63279294475988, 53.799827663009005, -32.52600108065263},
+				{-62.834347665781124, 43.39800811869608, -30.086605636905855},
+				{-53.37527213933461, 34.238287851138736, -27.92523161049121},
+				{-45.044623815056724, 26.125019775028974, -25.997911082999938},
+			},
+			{
+				{-728.4928600076473, 673.5387266508764, -175.3671200917757},
+				{-516.8779677452544, 475.5872110340666, -130.00453128099818},
+				{-394.0147541730925, 360.52690882232594, -103.6876902268877},
+				{-312.4072732022033, 283.98689050714154, -86.19259842588706},
+				{-253.78174888246738, 228.90004025125404, -73.59723547276576},
+				{-209.44477203830996, 187.14872219708258, -64.04044289197093},
+				{-174.67595238895592, 154.32600140139968, -56.51387266389014},
+				{-146.66763430967507, 127.81109745460589, -50.418963313145085},
+				{-123.63428558973199, 105.93757031313012, -45.375755342445295},
+				{-104.38059117250016, 87.58998128735334, -41.13031797752236},
+				{-88.0731367457049, 71.99070409890545, -37.505882153148896},
+				{-74.11131224166877, 58.57939571593035, -34.375246376274745},
+				{-62.05025773287337, 46.94106366200663, -31.64432711473556},
+				{-51.552777706487895, 36.761180948494406, -29.24190553352742},
+				{-42.35819208958418, 27.796613283647353, -27.112993113898828},
+				{-34.26150765699779, 19.856180603576885, -25.2144001735138},
+			},
+			{
+				{-697.1815296739918, 650.9894761110563, -171.52870212101973},
+				{-493.17604704712136, 459.21049427527237, -127.37263165903059},
+				{-374.2344848123259, 347.2553421127449, -101.6415041990141},
+				{-295.05823215651264, 272.60734564079166, -84.49388650536936},
+				{-238.10453823650812, 218.8047606121323, -72.1295105047429},
+				{-194.9955853198109, 177.98740424734254, -62.73792153009704},
+				{-161.17071051904048, 145.8770240617213, -55.33566381529874},
+				{-133.91232684077224, 119.92462816117559, -49.33784582094818},
+				{-111.48983915051654, 98.5071547205433, -44.372653104002104},
+				{-92.74350278989077, 80.53701818479978, -40.19131905545339},
+				{-76.86404198924643, 65.25535249095978, -36.62051296544257},
+				{-63.267860649213645, 52.11494770754578, -33.535414930389244},
+				{-51.52247204624649, 40.71030251395485, -30.843645695202007},
+				{-41.2999180874115, 30.733934603719135, -28.475239816423212},
+				{-32.34657068717053, 21.948058539111262, -26.376152251439365},
+				{-24.462924679057515, 14.165635621447505, -24.503917861149674},
+			},
+			{
+				{-668.4107561908377, 630.1792223693935, -167.9672564883853},
+				{-471.42024107222693, 444.12324325146295, -124.93630313299344},
+				{-356.1024005623562, 335.05324357762896, -99.75240781331762},
+				{-279.1740477748003, 262.1637986960159, -82.92941985401262},
+				{-223.7661058493744, 209.55451961897992, -70.78069188176936},
+				{-181.79222845438164, 169.60447048169362, -61.543185729468746},
+				{-148.8394424961733, 138.15510784396392, -54.25674565674293},
+				{-122.27349479848675, 112.72425710346782, -48.349280156331176},
+				{-100.41463747157053, 91.72924008129965, -43.45660180117389},
+				{-82.13605446773454, 74.10840319011211, -39.334779012523285},
+				{-66.65081786315623, 59.120346007605704, -35.81369950480486},
+				{-53.39108388854759, 46.230084344468644, -32.770771952029015},
+				{-41.93582153942072, 35.04095126518399, -30.1152113512315},
+				{-31.965625107788767, 25.25202085106386, -27.778224335729192},
+				{-23.23339551817422, 16.630485192895904, -25.706647837333453},
+				{-15.544708771714834, 8.993159099382924, -23.858693818582378},
+			},
+			{
+				{-641.8885267216249, 610.9117220460793, -164.65164623285523},
+				{-451.39082200340584, 430.1822964509871, -122.67379795966895},
+				{-339.43447464860947, 323.80310377829335, -98.00305731019739},
+				{-264.5925711924548, 252.55425333357562, -81.48446718958726},
+				{-210.6193587808876, 201.057900100866, -69.53780480400005},
+				{-169.6987499700639, 161.9162799398875, -60.444537236431096},
+				{-137.55486285755626, 131.08262864888957, -53.266398222999435},
+				{-111.63084313199442, 106.13719299351902, -47.44332546622345},
+				{-90.2941832714719, 85.53502888862276, -42.61829941788652},
+				{-72.44866429232556, 68.23871392810568, -38.55193174255881},
+				{-57.32812451035556, 53.523166571585804, -35.07713354464433},
+				{-44.37935724262674, 40.86482408585749, -32.07340633556308},
+				{-33.19197542781125, 29.875271322614026, -29.451461716735825},
+				{-23.454518995286236, 20.259705235647374, -27.14360619470356},
+				{-14.925954112622296, 11.789965460131011, -25.097504034163155},
+				{-7.416576637259732, 4.286464230890516, -23.272002123403084},
+			},
+			{
+				{-617.3656781118501, 593.0192808896137, -161.55529337187863},
+				{-432.89944430248147, 417.26474354497714, -120.56646960113811},
+				{-324.0725109216026, 313.40373659270074, -96.37853995396496},
+				{-251.17411856640842, 243.6906898503304, -80.14633579826351},
+				{-198.53736888419903, 193.23587475094965, -68.38965174072545},
+				{-158.59766975892484, 154.85042312213014, -59.43186672113242},
+				{-127.20684642003626, 124.5923031627922, -52.35534722950394},
+				{-101.8801847055628, 100.10027460770938, -46.61137336821983},
+				{-81.02921948672157, 79.86477069111292, -41.849684334476315},
+				{-63.586260301469984, 62.87108663177069, -37.83517498801473},
+				{-48.80450754332991, 48.40943871287152, -34.40360596352055},
+				{-36.144400706049694, 35.96696907944034, -31.436450566931423},
+				{-25.205474702951037, 25.162994901393986, -28.84582989003538},
+				{-15.683674836282966, 15.708449089829516, -26.56508585998562},
+				{-7.3436189572118415, 7.379523957521705, -24.54266126191301},
+				{-3.9406137620057594e-12, 2.3219589235637016e-12, -22.73800019837411},
+			},
+		},
+		{
+			{
+				{-378.1085804113699, 307.8754166352452, -67.83455741941404},
+				{-276.1024251939506, 219.83882865042636, -49.15004257046335},
+				{-220.48039356649213, 171.86498150767156, -39.004581096326746},
+				{-184.67746124820482, 140.9944140509729, -32.49130602218361},
+				{-159.41087790157115, 119.20824564548282, -27.901171194382936},
+				{-140.50302013795834, 102.89964540448615, -24.467340509124707},
+				{-125.76691832488108, 90.18156211013572, -21.789513181941498},
+				{-113.93507774333679, 79.96099012266441, -19.636314461532482},
+				{-104.21684520854006, 71.55654540120949, -17.86378929779356},
+				{-96.09072876338789, 64.51908984430706, -16.377227300116115},
+				{-89.19736264761073, 58.539313738749584, -15.111521244950424},
+				{-83.28019229304964, 53.39653832472162, -14.020300324283003},
+				{-78.15064506645282, 48.92866340848937, -13.06955948048308},
+				{-73.66668732299912, 45.01367181072781, -12.233743370711327},
+				{-69.71909123687892, 41.55778806880395, -11.493242168259535},
+				{-66.22234017621038, 38.487639499416865, -10.832735673252348},
+			},
+			{
+				{-348.6095800331937, 287.62754248256584, -64.53481262539042},
+				{-254.23749030179152, 205.3390230769884, -46.899496195579616},
+				{-202.3583045510569, 160.1231384710501, -37.24209321725909},
+				{-168.83175356052826, 130.90550430602946, -31.014949065104307},
+				{-145.1194902658255, 110.23585310648781, -26.614859678859222},
+				{-127.35234051919646, 94.73988876190161, -23.317501992064898},
+				{-113.49563412099397, 82.64417736331752, -20.743044744738214},
+				{-102.36635536958785, 72.91818809436735, -18.671230054292355},
+				{-93.22477860649406, 64.91791978191087, -16.964683830403974},
+				{-85.58209733464066, 58.21807167059893, -15.532852609637652},
+				{-79.1009738837864, 52.52530726407532, -14.313391335069113},
+				{-73.54029272431565, 47.63008595493498, -13.261840712079653},
+				{-68.72265546937953, 43.3783318443568, -12.345563352741847},
+				{-64.51433579616742, 39.65396832985868, -11.54001008270721},
+				{-60.812423660681404, 36.36772051640672, -10.826328952938395},
+				{-57.536301594510654, 33.44969344132889, -10.189782282551718},
+			},
+			{
+				{-323.269947829741, 270.1608660886739, -61.68194532137915},
+				{-235.33420775133354, 192.7560247780632, -44.94372776197681},
+				{-186.6382132406555, 149.90337973210347, -35.70697872859909},
+				{-155.05544517487715, 122.10761331298019, -29.72730897208522},
+				{-132.67320523055855, 102.400366103235, -25.49188116239308},
+				{-115.88324188234294, 87.60564622070042, -22.312879109535142},
+				{-102.7802538522325, 76.04729310040562, -19.82811109051433},
+				{-92.25319628659207, 66.74841027931363, -17.826921821199907},
+				{-83.60582664643171, 59.09715487157801, -16.177631385807935},
+				{-76.37729458680741, 52.6887643244841, -14.793287657599741},
+				{-70.24912983453397, 47.24368200381805, -13.613940909052365},
+				{-64.9934561467324, 42.56198597543536, -12.59679077235566},
+				{-60.44246680496319, 38.49653594774818, -11.710387538765184},
+				{-56.469572364296184, 34.93639367645394, -10.931056223377794},
+				{-52.977294471324065, 31.79617951535043, -10.24060361154065},
+				{-49.889230448910546, 29.00901009932598, -9.624798505032897},
+			},
+			{
+				{-301.27660200288307, 254.93565763549745, -59.18849414571767},
+				{-218.8449306021837, 181.73857753595212, -43.22816287679655},
+				{-172.89078674514286, 140.93640992764932, -34.358546011466565},
+				{-142.98766557894456, 114.37808157080116, -28.595429617798516},
+				{-121.75652450598439, 95.50963982759639, -24.504258542494313},
+				{-105.812901433341, 81.32652288837268, -21.428995575817467},
+				{-93.36284248646612, 70.23693732136302, -19.02284843230376},
+				{-83.35740008224838, 61.31060954696375, -17.083560747115328},
+				{-75.13791818645431, 53.963697016455235, -15.484439518815726},
+				{-68.26774614546879, 47.809342396102764, -14.141689620117065},
+				{-62.44476476554055, 42.58001433255458, -12.997462589676188},
+				{-57.452582397420954, 38.08419163296337, -12.010414104554421},
+				{-53.13171950858645, 34.18079548781161, -11.150137312967061},
+				{-49.361787009628316, 30.763380941388082, -10.393725066021522},
+				{-46.05002836158313, 27.74997622498233, -9.723562882419897},
+				{-43.123706816480976, 25.076333846046477, -9.125864617172457},
+			},
+			{
+				{-282.0146769821505, 241.54351136177382, -56.98845302205285},
+				{-204.34795651096442, 172.01590725009436, -41.71084648305667},
+				{-160.7819247389525, 133.01237314356786, -33.16517378598641},
+				{-132.3455075127948, 107.54207244600431, -27.593507591182437},
+				{-112.12069390545054, 89.4119123810822, -23.62995996937404},
+				{-96.91728114999081, 75.76728998530146, -20.646485644404457},
+				{-85.0382591775507, 65.09041665489248, -18.309889092645207},
+				{-75.48885674383564, 56.491991419917426, -16.425340821009208},
+				{-67.64325998319626, 49.41281156158078, -14.870561982693165},
+				{-61.08598836635045, 43.48179189387898, -13.564553781427431},
+				{-55.52924039615001, 38.44200207935554, -12.451329866420808},
+				{-50.76668874526886, 34.109331362243246, -11.49083668486885},
+				{-46.646156141527456, 30.34805064011784, -10.653592094290408},
+				{-43.05269384092994, 27.055682333310187, -9.917369907823382},
+				{-39.89769044220191, 24.153264779361976, -9.265068956555313},
+				{-37.11162885454257, 21.578879421226894, -8.683297865115826},
+			},
+			{
+				{-265.01069676894264, 229.6696904035705, -55.031081048525},
+				{-191.5134487231031, 163.37596689988615, -40.35900760317343},
+				{-150.0479074041253, 125.96537179981098, -32.10193064219053},
+				{-122.90421586238652, 101.46045255447095, -26.70106478532702},
+				{-103.56710023775148, 83.98579194498723, -22.8514121576149},
+				{-89.01676447503635, 70.81932447446431, -19.949838968567047},
+				{-77.64145793912539, 60.50882341670756, -17.67527443493111},
+				{-68.49413959543385, 52.20134226978843, -15.83951985336337},
+				{-60.97796707509014, 45.3595646999676, -14.324240357508652},
+				{-54.69615327707946, 39.62642640080504, -13.050936840567227},
+				{-49.373549299006704, 34.75442675187233, -11.965288042454498},
+				{-44.81272745753022, 30.566049862716152, -11.028394835571957},
+				{-40.867971256126516, 26.930329440638147, -10.211602202764714},
+				{-37.42914767632187, 23.748321187946086, -9.493293090805144},
+				{-34.41131609636523, 20.943744930626277, -8.856825503018356},
+				{-31.747811720097342, 18.45675727743904, -8.289161187361044},
+			},
+			{
+				{-249.89421631135016, 219.06772170847913, -53.27673538743334},
+				{-180.07982732857116, 155.65036799545103, -39.14668561998692},
+				{-140.4779118286299, 119.66257456047371, -31.148904359816942},
+				{-114.48309776057998, 96.02116952644162, -25.901651610531474},
+				{-95.93536265746927, 79.13307165701924, -22.15443627902695},
+				{-81.96578227724547, 66.39442251541344, -19.32649585877071},
+				{-71.03826079607589, 56.411587644591464, -17.107666534529255},
+				{-62.248174062477, 48.36415261074984, -15.315720204754102},
+				{-55.024453855374055, 41.7344036641201, -13.835875310283726},
+				{-48.98695705864084, 36.17784232038323, -12.591885554568885},
+				{-43.87180750915699, 31.455423097816972, -11.53093057682771},
+				{-39.48951083274623, 27.395545555020917, -10.6151519168602},
+				{-35.700112407477484, 23.871516788785865, -9.816640183837176},
+				{-32.39777570418088, 20.787563470791206, -9.114327417918753},
+				{-29.500836262913847, 18.069812433771876, -8.491985506202962},
+				{-26.945175784368594, 15.660283355323543, -7.936894460385062},
+			},
+			{
+				{-236.37129359393285, 209.541832466429, -51.693996638990896},
+				{-169.83706616868074, 148.70371955135082, -38.05305478795064},
+				{-131.90147356228684, 113.99640289063002, -30.29000634256043},
+				{-106.93532013848629, 91.13300570290818, -25.18190986952479},
+				{-89.09464468861167, 74.77348436956413, -21.527473101791674},
+				{-75.64519747705496, 62.42025460172431, -18.766184320830405},
+				{-65.11854855980066, 52.73245316424791, -16.59776741363477},
+				{-56.648060677480366, 44.9189979901979, -14.845411197548275},
+				{-49.68576899054188, 38.47986273623779, -13.397559245335529},
+				{-43.86645985329343, 33.08189274967719, -12.180010409618458},
+				{-38.93637402889623, 28.493678210520628, -11.141306964897629},
+				{-34.71314015563724, 24.548962245217762, -10.244535232110579},
+				{-31.06197891056585, 21.124913020869027, -9.462462694953784},
+				{-27.880914134972166, 18.12862271580355, -8.774519801988768},
+				{-25.091225869449595, 15.48839361170169, -8.164854069457414},
+				{-22.631085163988025, 13.147929433728965, -7.621034312489993},
+			},
+			{
+				{-224.20574733607754, 200.93454369837553, -50.25764261852001},
+				{-160.61465229439466, 142.4259417340245, -37.061218987486114},
+				{-124.17933886994429, 108.87882811297837, -29.512101039383513},
+				{-100.14040222820117, 86.7209789098758, -24.5308844045513},
+				{-82.93721823422241, 70.84081401062856, -20.96100994964301},
+				{-69.9566323493847, 58.836977083979285, -18.260427016672875},
+				{-59.7911645052036, 49.41646295132951, -16.13788505609182},
+				{-51.60843257136721, 41.814816074283954, -14.42152070702841},
+				{-44.88132162401355, 35.54807546538686, -13.002724267163986},
+				{-39.258098937320696, 30.293394041643857, -11.809163375888987},
+				{-34.49414459346195, 25.826301983402686, -10.790625408841555},
+				{-30.413524230337543, 21.98540007355378, -9.911059893230002},
+				{-26.886136816545097, 18.651366945737983, -9.143852657234307},
+				{-23.81349678550873, 15.73390037584058, -8.468889383649685},
+				{-21.119547306483877, 13.163280963204452, -7.870660616389994},
+				{-18.744530519854568, 10.884744008958268, -7.336998857451114},
+			},
+			{
+				{-213.2056612919759, 193.11773744095223, -48.94719248590948},
+				{-152.27275974872737, 136.72662972816, -36.15732990799469},
+				{-117.19668681395675, 104.23714349984196, -28.804362008640933},
+				{-93.99861023863674, 82.72290596362305, -23.93950997326683},
+				{-77.37362939766437, 67.27997216570014, -20.44715093431308},
+				{-64.81818647443936, 55.59467182198177, -17.80216963802537},
+				{-54.980050952378285, 46.41767132899662, -15.721604884349569},
+				{-47.05792280261426, 39.00883229945276, -14.038140024922917},
+				{-40.54361823117567, 32.898872909331644, -12.64587377130152},
+				{-35.09765056557064, 27.774367101996514, -11.474191451290165},
+				{-30.483704978399327, 23.417189356061332, -10.474024767066284},
+				{-26.53169820227067, 19.6703816899454, -9.610116463068572},
+				{-23.11578270027763, 16.417832535588012, -8.85642046638475},
+				{-20.140647242228052, 13.571622246569344, -8.193240613186521},
+				{-17.53265735019551, 11.063840506492545, -7.605382463807482},
+				{-15.233939403417613, 8.841123248420848, -7.080920620309305},
+			},
+			{
+				{-203.21349957588188, 185.98611375716717, -47.74584253714959},
+				{-144.6956810553322, 131.53085751308717, -35.329931918127336},
+				{-110.85803648388695, 100.01078536865364, -28.157788822211497},
+				{-88.42671663751328, 79.08680056026668, -23.400223596827185},
+				{-72.32902143214258, 64.04477222740266, -19.979290502983684},
+				{-60.161165073652874, 52.65138784969172, -17.385497343972336},
+				{-50.621286073857625, 43.69738721490788, -15.343538115745993},
+				{-42.9364456100971, 36.464961502821545, -13.690297018367728},
+				{-36.615743590136866, 30.49831368508782, -12.322375513835194},
+				{-31.330878048235018, 25.492674149807577, -11.170746125010854},
+				{-26.85312158685578, 21.235747502611762, -10.187397793388316},
+				{-23.01773759122051, 17.574657312445673, -9.337805964184433},
+				{-19.702766022719462, 14.396241943035449, -8.596449197428157},
+				{-16.815797335914134, 11.614771465294863, -7.9440173920766775},
+				{-14.285411713160189, 9.16399823212953, -7.365606875305047},
+				{-12.055458520767088, 6.99184547823095, -6.849515656674923},
+			},
+			{
+				{-194.09875480974205, 179.45232354999965, -46.639676129367885},
+				{-137.7868714624784, 126.77601024761105, -34.56946854864221},
+				{-105.08337139032217, 96.14891205544889, -27.564839931301133},
+				{-83.35475295774027, 75.76887891225724, -22.906668104301808},
+				{-67.74030545328166, 61.096214900262375, -19.551862556322813},
+				{-55.92755146451919, 49.971626699755745, -17.00541595419658},
+				{-46.660786677352455, 41.22281052651564, -14.999126907602394},
+				{-39.19308219783073, 34.15256336977133, -13.373779940115892},
+				{-33.049396426799646, 28.317536178330666, -12.028300442236914},
+				{-27.911693046439144, 23.420951511633838, -10.89513460338009},
+				{-23.558210110778052, 19.25589705806299, -9.927252799376072},
+				{-19.829120129862677, 15.673265001395379, -9.090810474904506},
+				{-16.606032888311756, 12.562617408716111, -8.360772954228718},
+				{-13.799203714023692, 9.840246080265295, -7.718188230613688},
+				{-11.339246949604409, 7.441438444034643, -7.1484222569170806},
+				{-9.17159473420106, 5.315306233598149, -6.6399801498824536},
+			},
+			{
+				{-185.75240346151023, 173.44329687524854, -45.61706881353995},
+				{-131.4651630090734, 122.40936287281447, -33.86790599116016},
+				{-99.80515561739384, 92.6085383567484, -27.019150351388976},
+				{-78.72349577856049, 72.73201411579778, -22.453462871529215},
+				{-63.553960324614145, 58.40115346409221, -19.160145569069744},
+				{-52.068034385683646, 47.52515880705244, -16.657681360211107},
+				{-43.05250962257863, 38.96596320368022, -14.68449196273683},
+				{-35.78442079397677, 32.04546399884314, -13.084999248745593},
+				{-29.803343240856414, 26.331853869683947, -11.76029598032904},
+				{-24.800704967902682, 21.535766505131825, -10.644202574788109},
+				{-20.561166739827115, 17.45528138095438, -9.690604416591595},
+				{-16.929428106993054, 13.944785208535228, -8.866290750149918},
+				{-13.79039104852968, 10.89636549164031, -8.146680453941723},
+				{-11.056768654338077, 8.22818844765083, -7.513155078834333},
+				{-8.661051452200125, 5.876964626917266, -6.9513316501896965},
+				{-6.550131554397186, 3.7929075267985457, -6.449908083094256},
+			},
+			{
+				{-178.08267081154585, 167.89743754197693, -44.66823466688231},
+				{-125.6618390689001, 118.38620774418173, -33.218442527914966},
+				{-94.96601189915305, 89.35308247384022, -26.51531221120814},
+				{-74.48250049085222, 69.94452599666691, -22.036024693154804},
+				{-59.72430505571695, 55.931244454696824, -18.80010971250131},
+				{-48.540453830194494, 45.28608983973576, -16.338665257483296},
+				{-39.75703073418315, 36.9028434809785, -14.39631223905599},
+				{-32.673241764778645, 30.12117980189854, -12.820878263834167},
+				{-26.842190729847726, 24.52003607592092, -11.5154855297768},
+				{-21.96406686946501, 19.816945711688792, -10.415241040204018},
+				{-17.829477084634973, 15.814635353029912, -9.474886621801065},
+				{-14.287312197970419, 12.370744692477407, -8.661804569883566},
+				{-11.225522209611487, 9.379711827921668, -7.951838013982922},
+				{-8.55909566225215, 6.761447397430079, -7.326680403713011},
+				{-6.222260087935007, 4.453986211528197, -6.772183453292745},
+				{-4.163259282868683, 2.408566884595577, -6.27722523573792},
+			},
+			{
+				{-171.01175852508968, 162.7624544647259, -43.78487623054547},
+				{-120.31834998867146, 114.66839145619969, -32.615281887615154},
+				{-90.51689694242428, 86.35122300989494, -26.048702461844965},
+				{-70.58854902247703, 67.37922465965121, -21.65042649212239},
+				{-56.21212996082468, 53.662115232119184, -18.468295828458295},
+				{-45.30856588595229, 43.23211771323832, -16.045248530197757},
+				{-36.74041244219361, 35.01275108199795, -14.131729185070022},
+				{-29.82746744662524, 28.360296794234912, -12.57876591674551},
+				{-24.13540331366099, 22.863731489370185, -11.291388116556519},
+				{-19.372550057116115, 18.24703559377434, -10.205911683817881},
+				{-15.335039833714681, 14.31727768280889, -9.277882956036619},
+				{-11.875657972164815, 10.935136351194581, -8.475241134484055},
+				{-8.885186491109232, 7.997245220458928, -7.774227596701578},
+				{-6.280727948991329, 5.425143961321543, -7.156828462404457},
+				{-3.998123496654604, 3.1581038134230113, -6.609115581696052},
+				{-1.9868724939296438, 1.1483203465851286, -6.120135951136853},
+			},
+			{
+				{-164.47328901550668, 157.9936672110144, -42.959911471246},
+				{-115.38451202844588, 111.22316027805942, -32.05345472730009},
+				{-86.41565443720873, 83.57599162181096, -25.61534636259405},
+				{-67.00441370227999, 65.01264794351057, -21.29328489217855},
+				{-52.98360297971695, 51.57269804748869, -18.16171880588667},
+				{-42.341053128873554, 41.34393652517505, -15.774735898252},
+				{-33.973294362609664, 33.2777445290446, -13.888269892541814},
+				{-27.21931695172168, 26.745970337196216, -12.356366591661274},
+				{-21.656510931269356, 21.3470026995397, -11.085853658910706},
+				{-17.000796657375865, 16.81086622577057, -10.01418665560626},
+				{-13.05345791352098, 12.948699669241448, -9.097670140872715},
+				{-9.670910881788835, 9.624029814761606, -8.304767993968422},
+				{-6.746577520710954, 6.735547560136468, -7.612096645539656},
+				{-4.199530685210498, 4.206318644450198, -7.001917723896568},
+				{-1.9671151485876088, 1.9767722739540947, -6.460510217571563},
+				{-8.126432394075006e-14, 4.862587858656952e-14, -5.977080004642534},
+			},
+		},
+		{
+			{
+				{-182.8060525167901, 148.81346877890613, -32.634068737333294},
+				{-133.70928190313157, 106.4379352685038, -23.642143244634873},
+				{-106.92255963514049, 83.3309781075484, -18.75577496575527},
+				{-89.66800046696932, 68.44966637419057, -15.615723066240752},
+				{-77.48163699604565, 57.938013999946925, -13.400452368916955},
+				{-68.3545771067692, 50.06168518410001, -11.741352811449188},
+				{-61.23511144292245, 43.91323765457803, -10.44598854912227},
+				{-55.5136336231079, 38.967005197061006, -9.403115992308068},
+				{-50.80981848677243, 34.89523445068709, -8.543510498725519},
+				{-46.87277101941358, 31.48184654978157, -7.821619173812348},
+				{-43.52956433199807, 28.57800507353394, -7.206120828367821},
+				{-40.65672016090835, 26.077500593148184, -6.674702963792268},
+				{-38.163461454293284, 23.90230073469786, -6.2109995407923195},
+				{-35.98140087581648, 21.993656345045604, -5.802708462302862},
+				{-34.05793618915294, 20.306406913671125, -5.440387630555886},
+				{-32.35187598692295, 18.805210371540202, -5.116658755182108},
+			},
+			{
+				{-168.53480304609874, 139.0174395252889, -31.037856489415013},
+				{-123.11991973802742, 99.41525108452171, -22.552350507987683},
+				{-98.13838516066114, 77.63911386356527, -17.9016242060519},
+				{-81.98183217174758, 63.55554615353078, -14.89976649763433},
+				{-70.54525177539152, 53.582838780346904, -12.776310711965138},
+				{-61.9684770378029, 46.09881754787616, -11.183160321656342},
+				{-55.273232789417094, 40.25084453668653, -9.937757581042241},
+				{-49.89064781296085, 35.54339116488544, -8.93422440309919},
+				{-45.4649557817455, 31.66673936191554, -8.106512944081265},
+				{-41.761025198712865, 28.416311995119564, -7.411077331171593},
+				{-38.61655405240746, 25.650980929493507, -6.817930243285312},
+				{-35.915511716166, 23.269912515885796, -6.3056824459361245},
+				{-33.572512126707565, 21.198947841950456, -5.858628618126425},
+				{-31.523176270220517, 19.382205794116036, -5.464952837489839},
+				{-29.717950622565393, 17.776699000147822, -5.115579280885026},
+				{-28.118008183377537, 16.348764880888424, -4.803411238884259},
+			},
+			{
+				{-156.2784711912378, 130.56949628441492, -29.65830553546239},
+				{-113.9663086352166, 93.32237924212177, -21.605572367228003},
+				{-90.51933313833803, 72.68603038021176, -17.15784025429444},
+				{-75.29995438357531, 59.288368295250564, -14.275448757187691},
+				{-64.50470170989662, 49.78001046000506, -12.231508027747006},
+				{-56.399153436258835, 42.63438159208528, -10.695527036285567},
+				{-50.06738085807254, 37.04573933086183, -9.493455734617172},
+				{-44.97519930055809, 32.54441437971509, -8.524048153303434},
+				{-40.78780094724038, 28.83619517372363, -7.724003690643472},
+				{-37.28353027328905, 25.72640921426443, -7.051514318666892},
+				{-34.30918108496174, 23.08057466710476, -6.477748820059251},
+				{-31.755106020311718, 20.8024978108566, -5.9821187978162484},
+				{-29.540544110012263, 18.821365854060037, -5.549492389308949},
+				{-27.60455810494168, 17.083777054895037, -5.16847584768827},
+				{-25.900214183618022, 15.548623838291602, -4.830311535944951},
+				{-24.3907183309544, 14.183697172751035, -4.528147435362},
+			},
+			{
+				{-145.64217029242516, 123.2073228775989, -28.452900099613665},
+				{-105.98220228948917, 87.98841768950709, -20.775254038602874},
+				{-83.85650206506627, 68.34058847045533, -16.504608861383698},
+				{-69.446648431971, 55.53962173205858, -13.726712429395993},
+				{-59.20625810744852, 46.435824797925264, -11.752405486325875},
+				{-51.50871190791359, 39.58522679028552, -10.266514892032493},
+				{-45.49171919950967, 34.22273977601675, -9.102414251485097},
+				{-40.65101031885452, 29.901162766290607, -8.162907125189642},
+				{-36.669886013313906, 26.339779489261748, -7.387097773783398},
+				{-33.338350899866896, 23.352548283490187, -6.734700870877833},
+				{-30.511078040651757, 20.81078461485125, -6.177898889942249},
+				{-28.083954840787293, 18.622345237836043, -5.696805994141963},
+				{-25.980233848545254, 16.719340328845195, -5.276793608978208},
+				{-24.141966523666348, 15.050535171495927, -4.9068386184382415},
+				{-22.524495253278747, 13.576465938224684, -4.57846278793718},
+				{-21.092793357092855, 12.266195118566701, -4.285029000280545},
+			},
+			{
+				{-136.32751547305406, 116.73258524725635, -27.389578849001893},
+				{-98.9627543574334, 83.28173268636529, -20.040997639549555},
+				{-77.98747070641414, 64.50068470958917, -15.926545635498783},
+				{-64.28428577195389, 52.22418100194151, -13.240996471545682},
+				{-54.528831458898686, 43.476343863883876, -11.328272689174636},
+				{-47.188030197616946, 36.88543085424123, -9.886687725467356},
+				{-41.44627322204985, 31.722000419917947, -8.756166975477466},
+				{-36.8253801501144, 27.55858880140262, -7.843093318942938},
+				{-33.024468556798176, 24.126350466306512, -7.088698764109751},
+				{-29.843739565243368, 21.246843230831487, -6.454046149811815},
+				{-27.144762964532756, 18.79649107994071, -5.9122158805389855},
+				{-24.828274520964786, 16.686722223260606, -5.44394466093664},
+				{-22.821044856518007, 14.852225428698219, -5.035049987388853},
+				{-21.067746668469738, 13.243678487020032, -4.674837290630916},
+				{-19.525719652668556, 11.82306875896982, -4.355076036864151},
+				{-18.161488570902556, 10.560581621486579, -4.069319635748726},
+			},
+			{
+				{-128.10489205407563, 110.99254248188008, -26.443711760515466},
+				{-92.74785979428752, 79.09931597906711, -19.38688184832528},
+				{-72.78413222740652, 61.085666570159965, -15.41153014202079},
+				{-59.70361708561145, 49.27436028560862, -12.808340149629768},
+				{-50.37582306235774, 40.84247750094439, -10.950557895383545},
+				{-43.34970085184167, 34.482089672109474, -9.548494539813305},
+				{-37.8506834299391, 29.49532330839667, -8.44791569047553},
+				{-33.42356785134169, 25.472220843835203, -7.558398651157364},
+				{-29.78141975008888, 22.154477089990593, -6.823075810122736},
+				{-26.7334408278214, 19.370403021984984, -6.204214689805151},
+				{-24.14729343125571, 17.00096498637316, -5.675698306876395},
+				{-21.927999090157726, 14.960768343814058, -5.218819925866707},
+				{-20.005439930714793, 13.186796633922956, -4.819795876325069},
+				{-18.326608022880862, 11.631430169459803, -4.4682253544280375},
+				{-16.85061106063518, 10.257948485899927, -4.156099301724279},
+				{-15.545347604958092, 9.037537008379509, -3.8771414734237704},
+			},
+			{
+				{-120.79475707909049, 105.86765372604793, -25.596067178608592},
+				{-87.21062757181797, 75.35942138829414, -18.80030208657526},
+				{-68.14414581878017, 58.03100225837651, -14.949888584893076},
+				{-55.616874692651756, 46.635688625779245, -12.420747941034467},
+				{-46.66928856778115, 38.48645856042559, -10.612366360263438},
+				{-39.92294135802459, 32.332279036687446, -9.245825558030688},
+				{-34.639673667624855, 27.503479583258418, -8.17214295532449},
+				{-30.384696178031124, 23.605763091590273, -7.303771235406937},
+				{-26.883479611327715, 20.390261765420984, -6.58555433052301},
+				{-23.9532370900774, 17.691336899684604, -5.9808450881207},
+				{-21.46705742169681, 15.394026352413785, -5.464249735512363},
+				{-19.333781328059647, 13.415784309384359, -5.017563069351468},
+				{-17.486064914694328, 11.69565250697466, -4.627360816660375},
+				{-15.872968800283926, 10.187538592118765, -4.283506876570951},
+				{-14.455177994489647, 8.855881509362405, -3.978191735398989},
+				{-13.201817042987267, 7.67276410079124, -3.7052926058759525},
+			},
+			{
+				{-114.2547205186343, 101.26301860880355, -24.831410750147356},
+				{-82.2492334940157, 71.99636040758735, -18.271152662429504},
+				{-63.984812835720895, 55.28446118450391, -14.533809522120043},
+				{-51.9527837965217, 44.26385279093894, -12.071731083450874},
+				{-43.345685206035895, 36.369272879978766, -10.308080915660552},
+				{-36.84986263210585, 30.400825478603615, -8.973687318353559},
+				{-31.759711354134406, 25.714233857752877, -7.924327252063184},
+				{-27.658719010461397, 21.929316993499192, -7.075061319340329},
+				{-24.283473114246416, 18.805719963834974, -6.37228643663133},
+				{-21.458371065699495, 16.183264384442023, -5.780340420472354},
+				{-19.0613704338592, 13.950663883606971, -5.27448587275886},
+				{-17.004740073211842, 12.027945232381681, -4.8369727576482},
+				{-15.223626913680235, 10.356009142113551, -4.454702944355641},
+				{-13.66894953963605, 8.890142953990075, -4.117780509187735},
+				{-12.302810080411342, 7.595833059727714, -3.818576964157464},
+				{-11.095434815121529, 6.445973120470759, -3.551108710539363},
+			},
+			{
+				{-108.37042580294484, 97.10233613253905, -24.137518684056168},
+				{-77.78105255323285, 68.95675329439905, -17.791238963044584},
+				{-60.23861329450346, 52.803328783317916, -14.156918796756052},
+				{-48.65289529435221, 42.12244856433175, -11.755971156650247},
+				{-40.35272483986538, 34.45875603288189, -10.033081668533969},
+				{-34.08269266213051, 28.658646206880366, -8.727961315578344},
+				{-29.16651093990582, 24.10086593564043, -7.7007303783278545},
+				{-25.20414508572802, 20.418044814181133, -6.868830868790794},
+				{-21.942212843786855, 17.377558105320105, -6.180078227121368},
+				{-19.21159735064608, 14.82418763005452, -5.599710042158442},
+				{-16.894653425547123, 12.649986980532331, -5.103588496930666},
+				{-14.906747119634446, 10.777320898558076, -4.674379293300524},
+				{-13.185276372832183, 9.1487797379022, -4.299282141675882},
+				{-11.68283926148094, 7.720904961529496, -3.9686204109029446},
+				{-10.362819185299134, 6.460135127585852, -3.67493086074454},
+				{-9.196438737284598, 5.3401009855368216, -3.4123569185727995},
+			},
+			{
+				{-103.04898811386519, 93.3235581967487, -23.50446927663608},
+				{-73.73836114967159, 66.19678230141793, -17.35384771585537},
+				{-56.849901548821116, 50.552343467617796, -13.813965387496072},
+				{-45.668850287063606, 40.18130207418764, -11.469069525774646},
+				{-37.647012587615286, 32.728163902979006, -9.783535980701854},
+				{-31.581682982013227, 27.081496076292908, -8.505222261820185},
+				{-26.823143440023962, 22.641050192241273, -7.498236677651616},
+				{-22.986307925925725, 19.05115271204491, -6.682209041769221},
+				{-19.82689958986183, 16.086240469102144, -6.0062582658181665},
+				{-17.181692049816963, 13.595627804348116, -5.436448746058589},
+				{-14.937035185217542, 11.474420838005031, -4.949193569660504},
+				{-13.011109722365022, 9.647121304415645, -4.527540358806199},
+				{-11.343359730269112, 8.057864045563571, -4.158962378400093},
+				{-9.887910204793814, 6.66433694076629, -3.833984358573435},
+				{-8.609309618841477, 5.433848950117435, -3.545294754303354},
+				{-7.4796866685912935, 4.3407046135995735, -3.2871535682870223},
+			},
+			{
+				{-98.21419151218251, 89.87570799998774, -22.924125357356036},
+				{-70.06514093992028, 63.680148088379745, -16.953427939876924},
+				{-53.77242542365487, 48.50214664473664, -13.500585901014894},
+				{-42.96031227643147, 38.41519976542326, -11.207358203968953},
+				{-35.19225229202902, 31.1550853145137, -9.556239074259555},
+				{-29.313510662286827, 25.649010094278943, -8.302599541138358},
+				{-24.6985879381091, 21.315995945442634, -7.314230012073759},
+				{-20.976035789236732, 17.811107992527365, -6.51278121996506},
+				{-17.90988903609067, 14.91526877058403, -5.848576293185402},
+				{-15.342300369292154, 12.481956437894517, -5.288443443492552},
+				{-13.163268881868122, 10.409081648791895, -4.809304611964766},
+				{-11.293546842842675, 8.623109608474362, -4.394560111394668},
+				{-9.674448012038825, 7.069594245922133, -4.031935759068237},
+				{-8.261492833300824, 5.707276758292659, -3.7121421337079727},
+				{-7.020294672508035, 4.504265799367497, -3.428007656745246},
+				{-5.923810517948716, 3.435484939817585, -3.173899859114483},
+			},
+			{
+				{-93.80291876499969, 86.71651464650228, -22.38975026276519},
+				{-66.71466896375107, 61.376528045801365, -16.58535074907879},
+				{-50.96743959528006, 46.62810308485054, -13.21312571534346},
+				{-40.493385018099744, 36.80291573746865, -10.967755315726938},
+				{-32.95786685894177, 29.720605154050293, -9.348491631520366},
+				{-27.25004483500601, 24.343963493776354, -8.117670392613725},
+				{-22.76660967363652, 20.10978082608604, -7.1464985829333445},
+				{-19.14861836576876, 16.683030221282483, -6.358502898517918},
+				{-16.167730983070598, 13.850620195460682, -5.705124413534386},
+				{-13.671036614489374, 11.4698725180319, -5.153900372039143},
+				{-11.5518839575079, 9.441286849442593, -4.682224979804683},
+				{-9.733386041101145, 7.693140964125563, -4.273825794847591},
+				{-8.158573250556886, 6.17229876116625, -3.916662896315672},
+				{-6.784247352349717, 4.838473704690133, -3.601619196775014},
+				{-5.5769995946424356, 3.6605125559324, -3.3216528627567676},
+				{-4.510547642613194, 2.6139102022210836, -3.0712310670591223},
+			},
+			{
+				{-89.76246010914637, 83.81062962799373, -21.895718831430997},
+				{-63.64767768215951, 59.26039847338884, -16.24572620862582},
+				{-48.4022539996758, 44.90939251669336, -12.948501528778264},
+				{-38.23938845649716, 35.32645845633612, -10.74765339564974},
+				{-30.917926207693363, 28.408653349891296, -9.158004785956818},
+				{-25.367384771114672, 23.15169400346366, -7.94837669637657},
+				{-21.004882390637253, 19.0088286216212, -6.993160552193358},
+				{-17.482996208248956, 15.654212955200562, -6.217632209648868},
+				{-14.580413792159403, 12.880304868557028, -5.574275184546842},
+				{-12.148774811938257, 10.54798976470383, -5.03128778580556},
+				{-10.084516242272615, 8.560167739090668, -4.566504433626403},
+				{-8.312927890302188, 6.846797028901973, -4.163957633796221},
+				{-6.778623072782348, 5.355955923447805, -3.81182569376843},
+				{-5.439585132065907, 4.0482591206299565, -3.501152008919082},
+				{-4.263307103552761, 2.8932374883783454, -3.225015525141145},
+				{-3.224208162677076, 1.8669153750019958, -2.9779761377445437},
+			},
+			{
+				{-86.04845857679959, 81.12826469687141, -21.437297091043273},
+				{-60.83093394608065, 57.310124291247206, -15.931262061880165},
+				{-46.04910491603706, 43.32830249958104, -12.704094575692647},
+				{-36.17390198640229, 33.970481346788276, -10.544832167031531},
+				{-29.050306043513014, 27.205493420060282, -8.98282563976069},
+				{-23.645102587694137, 22.059646447795057, -7.792959523362409},
+				{-19.394295425680752, 18.001496489213746, -6.852605376120695},
+				{-15.961119168280513, 14.713744680843137, -6.088676567867534},
+				{-13.130765002507244, 11.99401430675887, -5.454632553790739},
+				{-10.759084786838823, 9.706508070943356, -4.919290445173271},
+				{-8.745374403989052, 7.756360496858629, -4.460896628956074},
+				{-7.016938842664878, 6.075093919094588, -4.0637689001656705},
+				{-5.5198568405966215, 4.611916778197109, -3.7162896538793104},
+				{-4.213205580693958, 3.328282365343581, -3.4096523128924328},
+				{-3.0653129055639945, 2.1943580078453215, -3.137048690060631},
+				{-2.0512473842222314, 1.1866605636717216, -2.8931252949791753},
+			},
+			{
+				{-82.62332296531679, 78.64413931844993, -21.01047232341116},
+				{-58.23612990363348, 55.50724802844961, -15.63915358865463},
+				{-43.88426829005683, 41.86967248500924, -12.477666834143117},
+				{-34.27600958891688, 32.721817230634926, -10.35738978387401},
+				{-27.336021747377554, 26.099316995812792, -8.821278335906435},
+				{-22.065641802606617, 21.05701060063211, -7.649907198437811},
+				{-17.918401965075674, 17.07774582703011, -6.723447131931251},
+				{-14.567434361996186, 13.852205867084963, -5.9703501259269025},
+				{-11.803971963829714, 11.18283986735261, -5.344992656580678},
+				{-9.487780296317847, 8.936949854741206, -4.81677318613588},
+				{-7.520811667936152, 7.021757804346947, -4.364325029850668},
+				{-5.832243494165085, 5.370247065325955, -3.9722338418448278},
+				{-4.369516046495528, 3.9326815614860418, -3.6290735629770396},
+				{-3.092722721337559, 2.671297618250425, -3.3261783669275387},
+				{-1.970966853438206, 1.5568567325614266, -3.0568459105863273},
+				{-0.979920209646224, 0.5663354520605365, -2.8158038941565735},
+			},
+			{
+				{-79.45498887405392, 76.3366585670779, -20.611820566089712},
+				{-55.83900894472815, 53.83592902732925, -15.366996888449929},
+				{-41.88735694267581, 40.520452721625205, -12.267294665680753},
+				{-32.5276990353615, 31.56910735801175, -10.183688148115067},
+				{-25.758696480798918, 25.079919641257046, -8.671917038815787},
+				{-20.61383575735213, 20.134430834433577, -7.5179137462163075},
+				{-16.562976212751796, 16.22887775448868, -6.604487087161622},
+				{-13.288474337541096, 13.061424962829943, -5.8615395849413146},
+				{-10.587195539345176, 10.439045537990209, -5.244312252942828},
+				{-8.322554255914143, 8.2319469206397, -4.722751538878289},
+				{-6.398979528272059, 6.349307727621528, -4.27585537539185},
+				{-4.747394421735114, 4.725480528480024, -3.8884617402866746},
+				{-3.3165084076314373, 3.3117181971817247, -3.549324938017523},
+				{-2.067362036931465, 2.0709905506403827, -3.249911618884698},
+				{-0.9697813106608266, 0.9746155125235157, -2.9836190165983205},
+				{-7.648200162022673e-14, 5.298123391095859e-14, -2.74525117920247},
+			},
+		},
+		{
+			{
+				{-115.04158829148446, 93.5972106644367, -20.313452034818916},
+				{-84.45332314771004, 67.19320019578176, -14.713118536818492},
+				{-67.7458019482335, 52.77600453420764, -11.664771933878948},
+				{-56.967442610487716, 43.474738267143906, -9.701734882677595},
+				{-49.342173548828846, 36.8917455692451, -8.313591066039079},
+				{-43.6208983289615, 31.94877459051888, -7.271350672347394},
+				{-39.14962768384185, 28.081608296532217, -6.455452451814121},
+				{-35.54920207975792, 24.963328831440307, -5.796761699031995},
+				{-32.58299436792744, 22.39003676582988, -5.252241893608231},
+				{-30.094846361860476, 20.22725534520734, -4.793561296121633},
+				{-27.977082708922698, 18.38231700060963, -4.401230724458979},
+				{-26.15278367977678, 16.78906448480581, -4.061359815600883},
+				{-24.565374139069743, 15.398868993660047, -3.7637549751303707},
+				{-23.1722124356385, 14.17510101989232, -3.5007498769203624},
+				{-21.94048326948349, 13.089589463537667, -3.26645736509841},
+				{-20.84447694786416, 12.120276819947968, -3.0562746054204384},
+			},
+			{
+				{-106.04596223188823, 87.42173003033163, -19.307477877318405},
+				{-77.76298676121043, 62.755691840868444, -14.024786399958936},
+				{-62.185571754823, 49.17256338022419, -11.124319575369125},
+				{-52.09465866297006, 40.371415495334304, -9.248065372727945},
+				{-44.93885611171481, 34.12638988674366, -7.917614759109321},
+				{-39.56213259301238, 29.42947635323688, -6.916835166177225},
+				{-35.35644720325671, 25.750779544378272, -6.132357719869087},
+				{-31.9681302180394, 22.7822578612963, -5.498412142918576},
+				{-29.175924177016732, 20.3313102306019, -4.973954574657957},
+				{-26.833538043437887, 18.270666994724216, -4.531912645632415},
+				{-24.83994850692499, 16.512491954181556, -4.153634469973982},
+				{-23.12289970427152, 14.993998194788682, -3.8258094581338855},
+				{-21.629193190527484, 13.668986026647143, -3.5386590760969816},
+				{-20.318698481274186, 12.50262500256056, -3.2848225199141075},
+				{-19.160510619057394, 11.468107510488332, -3.0586428853138847},
+				{-18.130401708030657, 10.544430029860443, -2.855694402900399},
+			},
+			{
+				{-98.32464379543572, 82.10002436904487, -18.438802447252073},
+				{-71.98205671354656, 58.90800214228111, -13.42720980625984},
+				{-57.36426948347734, 46.03837237698717, -10.653978843453086},
+				{-47.859502997537554, 37.66674943619127, -8.852660191936337},
+				{-41.104872456224605, 31.712605786192714, -7.572119408963668},
+				{-36.022936170650006, 27.22772315237768, -6.6072437085000075},
+				{-32.04461735203355, 23.711537534517493, -5.849990497723505},
+				{-28.83792554087954, 20.87218349752077, -5.237490297398436},
+				{-26.194704335543932, 18.526761965038666, -4.730420258731409},
+				{-23.977088025923788, 16.554201838003138, -4.302797091407744},
+				{-22.089741274399547, 14.870827412361514, -3.9366936979601843},
+				{-20.464400755550678, 13.416757066644788, -3.6193017425206215},
+				{-19.050761455252257, 12.1478868128695, -3.341202472310422},
+				{-17.81084642430847, 11.030939414281526, -3.095300041563465},
+				{-16.715390123996777, 10.040285105818342, -2.8761373021111627},
+				{-15.741437064568702, 9.155831291621693, -2.6794421426163493},
+			},
+			{
+				{-91.62635541373916, 77.46489247339916, -17.680313111046758},
+				{-66.94073744276686, 55.540939679495544, -12.90341947243529},
+				{-53.14842131503, 43.289481416699985, -10.241067237237315},
+				{-44.149516971531014, 35.29115662478367, -8.505227251329574},
+				{-37.74168699754747, 29.59021098054736, -7.268352398614946},
+				{-32.914794917182576, 25.290032716913288, -6.334906522047659},
+				{-29.13325099221589, 21.91545730867143, -5.6014892542693975},
+				{-26.083742609348395, 19.18865873971123, -5.007764174584519},
+				{-23.5694213395772, 16.93517225510932, -4.515913251894298},
+				{-21.45970401707009, 15.039322161361513, -4.100906368225721},
+				{-19.664177384018792, 13.421057730965552, -3.7454512112512615},
+				{-18.11803627605054, 12.023030671035738, -3.43717955857977},
+				{-16.77348378674415, 10.802965548026735, -3.1669880474482026},
+				{-15.594410929703052, 9.728941045877018, -2.9280131641269267},
+				{-14.55297497143097, 8.776356070568632, -2.7149729535461886},
+				{-13.627325222282426, 7.925913852466284, -2.5237300845646757},
+			},
+			{
+				{-85.76158080997212, 73.39015296384753, -17.011601791687912},
+				{-62.50864621101544, 52.57056793310783, -12.440405602901297},
+				{-49.43446515282637, 40.860663949362525, -9.875757533698547},
+				{-40.87681021118856, 33.1901692942005, -8.197732512500219},
+				{-34.77186091782479, 27.711827155853896, -6.999442798529308},
+				{-30.16782905687429, 23.574105366258024, -6.093775162547224},
+				{-26.558242758000453, 20.324088181919947, -5.381422046945112},
+				{-23.64606398341489, 17.696270437256853, -4.804283682758378},
+				{-21.24431231917554, 15.523599129472805, -4.325872105284592},
+				{-19.22876723794963, 13.695144557648138, -3.92200025735249},
+				{-17.513319824194596, 12.134047094041955, -3.575937546687868},
+				{-16.036191349612377, 10.785189894963876, -3.2757053323197187},
+				{-14.751781266441538, 9.607908949059867, -3.0124794886102086},
+				{-13.625617219852165, 8.57147830033362, -2.7796022271329086},
+				{-12.631103620995157, 7.652203982740485, -2.57194673058485},
+				{-11.747358531904542, 6.831491178893086, -2.385495017597205},
+			},
+			{
+				{-80.58471675229964, 69.77878884028863, -16.417015618100542},
+				{-58.584051884779576, 49.931317263288975, -12.02803068119407},
+				{-46.14086612217671, 38.700491529654215, -9.550321232257083},
+				{-37.9717484178831, 31.320562324060763, -7.923816993767369},
+				{-32.13374089630809, 26.039666985941277, -6.759925404814678},
+				{-27.726180872191904, 22.046066360733093, -5.879019359291447},
+				{-24.268176002592295, 18.906530868625694, -5.185436246054545},
+				{-21.477010342177408, 16.366474010579783, -4.623070613894277},
+				{-19.174398701586938, 14.26541814961629, -4.15662135490378},
+				{-17.241732442706322, 12.496647327987832, -3.7626540591011564},
+				{-15.596701997175442, 10.986136814597945, -3.4249397602119425},
+				{-14.180201638483085, 9.680753019888485, -3.1318477777710427},
+				{-12.94857165080795, 8.541264612427588, -2.874803408267589},
+				{-11.868786498778888, 7.5380086788278895, -2.6473326215944235},
+				{-10.915353472192143, 6.648098120944264, -2.4444471634283897},
+				{-10.068247560899774, 5.8535625709031, -2.262235637882611},
+			},
+			{
+				{-75.9820706177764, 66.55497867386931, -15.884349475594636},
+				{-55.08644898939629, 47.57123221770362, -11.658281032490551},
+				{-43.202592583489064, 36.76788237953421, -9.258599662410385},
+				{-35.378483640732, 29.647609638200816, -7.678384776772044},
+				{-29.777667164859803, 24.543238988716514, -6.545401567065178},
+				{-25.544699636394252, 20.678482525196237, -5.68673776123087},
+				{-22.221364156645798, 17.637684767443346, -5.0100057699055895},
+				{-19.537659995182423, 15.176019157637802, -4.460893806666391},
+				{-17.323029918162703, 13.138890804230616, -4.005168809461899},
+				{-15.463856910154442, 11.423355971534287, -3.6200738832537738},
+				{-13.881212919785128, 9.957928913999455, -3.289831681280555},
+				{-12.51837243406985, 8.691252857202095, -3.003124807664989},
+				{-11.33340466607173, 7.58538032039045, -2.7516031148235696},
+				{-10.294573349718428, 6.611601156559496, -2.5289579929235684},
+				{-9.377368813488498, 5.7477515081170125, -2.330325902268719},
+				{-8.562530020334687, 4.976420764409102, -2.151891359572892},
+			},
+			{
+				{-71.86358724001828, 63.658605489111665, -15.403946985684719},
+				{-51.95132196529207, 45.44862039362559, -11.324740284711199},
+				{-40.56716903614473, 35.02963438417073, -8.995626816550809},
+				{-33.05173032742543, 28.143104222930234, -7.457306380475435},
+				{-27.663218485443846, 23.197678849076226, -6.352293182331194},
+				{-23.586518684747197, 19.4489114620159, -5.5137468673325065},
+				{-20.38367731867936, 16.496959264683394, -4.852245693460111},
+				{-17.796071052213296, 14.10578711380562, -4.3151034992495445},
+				{-15.660063810271843, 12.12610289859334, -3.8690556068129007},
+				{-13.86651205827634, 10.458364855777567, -3.491959527856381},
+				{-12.33951978762201, 9.033377774868939, -3.168447483104207},
+				{-11.024496549323255, 7.801388185275147, -2.887486171989451},
+				{-9.881062898474124, 6.725607252507903, -2.6409290527093914},
+				{-8.87863969819893, 5.778184751077692, -2.4226174735274246},
+				{-7.993599735071734, 4.9376096065569675, -2.2278009245738626},
+				{-7.2073678828557055, 4.186976427819361, -2.0527508239793746},
+			},
+			{
+				{-68.15702069219043, 61.04138908215194, -14.96806859442371},
+				{-49.1263824033802, 43.52964358516384, -11.022211712055705},
+				{-38.19180574681812, 33.458630210557565, -8.757355556975023},
+				{-30.954401192375038, 26.783905232209072, -7.2572015989452785},
+				{-25.757178775610033, 21.98251683539925, -6.177661405644329},
+				{-21.821257622334233, 18.3388240896886, -5.357424644223474},
+				{-18.72692227673709, 15.467312223166656, -4.709774261193752},
+				{-16.225801348928677, 13.13991960352433, -4.183507543334095},
+				{-14.16050011413964, 11.212166387882446, -3.7462437619820603},
+				{-12.42591136041895, 9.58759924995194, -3.3764013097622296},
+				{-10.94887594614721, 8.199102898850414, -3.0589862740164175},
+				{-9.676731233010502, 6.998379946430873, -2.7832246481274856},
+				{-8.570498862956997, 5.949693887482824, -2.541155682287591},
+				{-7.600644947839562, 5.025975525339571, -2.3267575269359195},
+				{-6.744339521612814, 4.206306663659663, -2.1353827543118724},
+				{-5.983627201217802, 3.474240737161694, -1.9633820084041127},
+			},
+			{
+				{-64.80374810294941, 58.6641081419481, -14.570438636270984},
+				{-46.568818175516206, 41.78655613815009, -10.746442634214867},
+				{-36.04127812526606, 32.03251046543998, -8.540455660572919},
+				{-29.05584756436472, 25.550855739049084, -7.075278101144515},
+				{-24.0320148701223, 20.880754212825963, -6.019071118942259},
+				{-20.223670029167074, 17.33279335756855, -5.215593051935489},
+				{-17.227618992784485, 14.534522992215603, -4.580608805422103},
+				{-14.804786586432707, 12.265157845567636, -4.064277698026172},
+				{-12.803441343085224, 10.38461171016077, -3.6350307667885295},
+				{-11.122140200179466, 8.799251821379174, -3.2718014844784555},
+				{-9.690209344051858, 7.443862701283865, -2.9599392328133742},
+				{-8.456737186827896, 6.271477050336194, -2.688908046130824},
+				{-7.384018217622988, 5.2473196436039435, -2.4509176923058402},
+				{-6.443468250210052, 4.345034710341175, -2.240071837499922},
+				{-5.612981756311953, 3.5442455735285523, -2.0518175978997086},
+				{-4.8751665321666575, 2.8289247012364487, -1.8825782526459403},
+			},
+			{
+				{-61.75571022738185, 56.494569927064155, -14.205914942829494},
+				{-44.24325108855898, 40.19639645551144, -10.493920131362266},
+				{-34.08633746126, 30.73267862425777, -8.342162683074399},
+				{-27.33053184196016, 24.42796553716047, -6.909209824176819},
+				{-22.464722119189993, 19.878162135617924, -5.87448827730741},
+				{-18.772613537277774, 16.417875688102338, -5.0864286816781785},
+				{-15.866065331161774, 13.68663604088959, -4.463086279178224},
+				{-13.514479985068997, 11.470335023418041, -3.9558778523678977},
+				{-11.571293571402279, 9.632919821030988, -3.5339839840692138},
+				{-9.93840771608816, 8.08334750815022, -3.1768137197348834},
+				{-8.547417836572402, 6.75814717183063, -2.8700333474074675},
+				{-7.349011428774655, 5.611572923738532, -2.6033265879572127},
+				{-6.3066455059856015, 4.609732237467956, -2.36906052935449},
+				{-5.3926033398845075, 3.7269243920081796, -2.1614545973522827},
+				{-4.585441136861256, 2.9432698607683183, -1.9760430688188975},
+				{-3.8682811397472827, 2.243125755374093, -1.8093161569634724},
+			},
+			{
+				{-58.973140258344, 54.5061027808881, -13.870244005302768},
+				{-42.120199258344975, 38.740001330553596, -10.261717467780423},
+				{-32.302504317401066, 29.543544955971186, -8.160163359438046},
+				{-25.757013546429736, 23.401786392042162, -6.757044201517867},
+				{-21.03593855624486, 18.962743077539113, -5.742201224999174},
+				{-17.450256456437806, 15.583133937735731, -4.968393992720421},
+				{-14.625614196088758, 12.913530423221944, -4.355801903167705},
+				{-12.339185371930043, 10.745982364685252, -3.857008446267861},
+				{-10.449145260551802, 8.948157999887306, -3.4418897132289343},
+				{-8.860463896110053, 7.431404044202034, -3.09029599091457},
+				{-7.5068188972052425, 6.133859350462023, -2.788187531515172},
+				{-6.340365229861433, 5.010905023060307, -2.5254517777497276},
+				{-5.325626827702232, 4.029462927088888, -2.2946016539088707},
+				{-4.435683111980008, 3.164436624726434, -2.0899638531908016},
+				{-3.649697633241266, 2.396405139419587, -1.9071533845343713},
+				{-2.9512647922781827, 1.7100802977759162, -1.7427224300632134},
+			},
+			{
+				{-56.422853765385774, 52.67642078947684, -13.559876924493656},
+				{-40.17490468623954, 37.40125391350847, -10.047377145030215},
+				{-30.669141528875848, 28.451945154609017, -7.992507660546674},
+				{-24.317166127205518, 22.460928982994243, -6.617130578821624},
+				{-19.72925776672145, 18.12431260028524, -5.6207597218956185},
+				{-16.241460200319082, 14.819265616567023, -4.860183838676617},
+				{-13.492109139525544, 12.206583203203518, -4.257561310034452},
+				{-11.265535071771835, 10.084020345870991, -3.7665630025048054},
+				{-9.424279771125532, 8.322693649572402, -3.357713258472604},
+				{-7.876141092955156, 6.83616457499471, -3.0112735697756396},
+				{-6.556715878383316, 5.564063910103691, -2.7134780729438166},
+				{-5.419511804718768, 4.462817176200873, -2.4544039548459056},
+				{-4.4300363195019745, 3.500100862706579, -2.2266999184850085},
+				{-3.562102752200463, 2.651378377100097, -2.0247924849785193},
+				{-2.7954345021096936, 1.8976534924634734, -1.8443717622849398},
+				{-2.114061186494858, 1.223966535772348, -1.6820475499773313},
+			},
+			{
+				{-54.07694446223135, 50.98675757468669, -13.271829246555361},
+				{-38.38642887899359, 36.166503203680826, -9.848820798073419},
+				{-29.168734248378524, 27.446688404449354, -7.83754056117118},
+				{-22.995565720231102, 21.595685501144526, -6.488064398117448},
+				{-18.530690559313754, 17.354171310691466, -5.508927216717902},
+				{-15.133293904464864, 14.118310325229968, -4.7606834770121695},
+				{-12.453439547421281, 11.55840384595905, -4.167342861626917},
+				{-10.282077399508912, 9.477514391670208, -3.6835938150489755},
+				{-8.48578933854749, 7.7499673509846065, -3.280567338140616},
+				{-6.974990203603855, 6.29138517951833, -2.938909683628961},
+				{-5.687053143791131, 5.042786963603218, -2.645111189113893},
+				{-4.576737859351026, 3.9615699792105716, -2.3894264729058188},
+				{-3.610462093182268, 3.01611272598231, -2.164631155007526},
+				{-2.762718417813156, 2.1823993223072025, -1.9652450281347622},
+				{-2.013748349076775, 1.441828514577069, -1.7870283341101703},
+				{-1.3479842497163887, 0.779746030265929, -1.6266446539859454},
+			},
+			{
+				{-51.911777754336136, 49.42119757595133, -13.003572946945193},
+				{-36.73694778290737, 35.024111133722585, -9.66427902328407},
+				{-27.786325187735102, 26.518202523106513, -7.69384856447055},
+				{-21.779009539971806, 20.79773195953328, -6.368643255811249},
+				{-17.42823932291405, 16.64484517442749, -5.405643140706967},
+				{-14.114649523359205, 13.473417460785921, -4.668935297525578},
+				{-11.499187006851473, 10.962622775471797, -4.084267715076809},
+				{-9.378947958451983, 8.920479940088311, -3.607284631155102},
+				{-7.624266822469913, 7.224311385522471, -3.209686880701415},
+				{-6.147989568057544, 5.791664615247395, -2.872482060300563},
+				{-4.889139599953845, 4.564855336899617, -2.582401046421467},
+				{-3.803639783914926, 3.502188276700023, -2.329864981157963},
+				{-2.8587535085219145, 2.572697363228579, -2.1077685495107747},
+				{-2.029604297347758, 1.7528527653959054, -1.910719005487098},
+				{-1.296914898740279, 1.0244218218059042, -1.7345423239755553},
+				{-0.645491710690022, 0.373035188958538, -1.5759524672875715},
+			},
+			{
+				{-49.907205691797635, 47.96615418687559, -12.752952280308723},
+				{-35.21119671103002, 33.96409571593967, -9.492236186689293},
+				{-26.5090675058618, 25.658252742750765, -7.560217399840867},
+				{-20.656133053669013, 20.059891293435726, -6.257831999095192},
+				{-16.411558602987608, 15.989878178763446, -5.30999286052493},
+				{-13.175934067507587, 12.878660237699988, -4.584112237945134},
+				{-10.620341876799296, 10.413721680114985, -4.007575849072335},
+				{-8.547605625127279, 8.407725651677776, -3.5369287262719165},
+				{-6.831557616947637, 6.740803466402479, -3.144408755979913},
+				{-5.3873102229530865, 5.33230681942604, -2.8113640295388254},
+				{-4.155425314739174, 4.125766505043212, -2.5247520156011114},
+				{-3.092910074602465, 3.0803374844197737, -2.2751506654324705},
+				{-2.167816134113217, 2.1656676502759473, -2.0555667345094735},
+				{-1.3558550628069659, 1.3586823547327103, -1.860689761347463},
+				{-0.6381980523992924, 0.641494024546126, -1.686407532312948},
+				{-3.8483529761584486e-14, 2.809527348461678e-14, -1.5294813607531654},
+			},
+		},
+		{
+			{
+				{-85.29526558382692, 69.32638619969116, -14.78758276284958},
+				{-63.00448268521479, 50.08148439190645, -10.709238057230376},
+				{-50.81136543921644, 39.55388362926681, -8.483950167076484},
+				{-42.92761979378555, 32.74360739811607, -7.0461284584322055},
+				{-37.335541690856495, 27.908502174970454, -6.025475077609914},
+				{-33.12774413856897, 24.265499834275797, -5.255935927174984},
+				{-29.82915627489837, 21.404845818010617, -4.650805216952348},
+				{-27.1642694353594, 19.08904931340927, -4.159928575787891},
+				{-24.961057424374893, 17.16991161440471, -3.7520673133908065},
+				{-23.105919859180236, 15.549627348610898, -3.40664183061824},
+				{-21.520464539194826, 14.160745989542544, -3.1094838126214244},
+				{-20.148639924558623, 12.95506975138979, -2.8504856908964986},
+				{-18.949177332305293, 11.89713483887777, -2.6222221311971503},
+				{-17.890935922195048, 10.960197755079356, -2.419102233864043},
+				{-16.949919763242224, 10.123664207982028, -2.2368270511881247},
+				{-16.10730115574319, 9.371385936732402, -2.0720306163800792},
+			},
+			{
+				{-78.60275714682241, 64.7304747584633, -14.03922311352649},
+				{-58.00858026813242, 46.76669123268978, -10.195393219768297},
+				{-46.64650768003647, 36.85368035912969, -8.079332173256377},
+				{-39.268155862345765, 30.411972864159118, -6.705665337792754},
+				{-34.02114298597169, 25.825980710937426, -5.727698777868693},
+				{-30.06650540685443, 22.364350550831958, -4.9888572884400295},
+				{-26.962942956478127, 19.64259131677756, -4.407000665167051},
+				{-24.453679973515055, 17.43710708094916, -3.934455788432411},
+				{-22.377986861563564, 15.608017402052827, -3.541454123657665},
+				{-20.62951685037108, 14.062824387644076, -3.2083456256946303},
+				{-19.134768898890872, 12.737636417007632, -2.921580263630644},
+				{-17.841121456546002, 11.586738981256717, -2.6714787515249645},
+				{-16.70978959180955, 10.576458383600785, -2.450922447619245},
+				{-15.711479730986971, 9.681377012072309, -2.2545462357251593},
+				{-14.823601204006764, 8.881905379355999, -2.0782213166838024},
+				{-14.02841616678227, 8.16267175424277, -1.9187125312235749},
+			},
+			{
+				{-72.86501161959652, 60.77597455071749, -13.394132879973169},
+				{-53.69559290572851, 43.89607879776014, -9.749949182974229},
+				{-43.03768714174563, 34.507608254023374, -7.727644469627887},
+				{-36.08939032802594, 28.381757879010156, -6.409250494514171},
+				{-31.136690599422433, 24.009732483357094, -5.468133554074497},
+				{-27.398269347296228, 20.704089857586812, -4.755821621490438},
+				{-24.461397834113985, 18.10187608547015, -4.1940915553495834},
+				{-22.08519665169129, 15.991373643251388, -3.7374042231075544},
+				{-20.11854356170762, 14.239827789573862, -3.357257689390201},
+				{-18.461271445709713, 12.759294356584311, -3.034803788604902},
+				{-17.04405202021559, 11.48893898231257, -2.757026370518887},
+				{-15.817193232681987, 10.38518250905105, -2.5146162386919775},
+				{-14.744033446966538, 9.41589174973685, -2.300719918659555},
+				{-13.796859476062187, 8.556790832623465, -2.110167585907129},
+				{-12.954283644991595, 7.789155952359602, -1.9389776515704142},
+				{-12.199501577629992, 7.098284644427576, -1.7840281471767563},
+			},
+			{
+				{-67.89148530075082, 57.335690361641696, -12.831667065275283},
+				{-49.9363101842239, 41.38626791588246, -9.359942901465427},
+				{-39.88301041791701, 32.45134952835251, -7.419172189991241},
+				{-33.30522367628686, 26.59949203466029, -6.148981186949585},
+				{-28.60653990657624, 22.413383237560016, -5.240044177285695},
+				{-25.05490804391172, 19.243398111842044, -4.5509151119080515},
+				{-22.262105528111128, 16.74519277129787, -4.006777224644283},
+				{-20.000921333409433, 14.71733709474448, -3.563950736116243},
+				{-18.128509311235582, 13.03325782357045, -3.1950387763501595},
+				{-16.550033241931338, 11.608966026022273, -2.8818934562604697},
+				{-15.199768520314356, 10.386285337027822, -2.6119651779714483},
+				{-14.030555346890559, 9.323494472180519, -2.376268318431717},
+				{-13.00756879855516, 8.389801351830082, -2.1681813178164466},
+				{-12.104463579486293, 7.561924500804958, -1.982705187037475},
+				{-11.30089375588283, 6.821894326442099, -1.815987123602146},
+				{-10.580863603631688, 6.155591587481488, -1.6650041824253126},
+			},
+			{
+				{-63.53898376790955, 54.31397932193049, -12.336341224393399},
+				{-46.63188225285139, 39.173404526060075, -9.015472470182956},
+				{-37.103725532775016, 30.635127582897706, -7.1464164133318615},
+				{-30.84863907164322, 25.02349234146284, -5.91871312449334},
+				{-26.371493205970634, 21.000581369909455, -5.038165346163345},
+				{-22.98287216638885, 17.949740612256964, -4.36949415938313},
+				{-20.315824964963657, 15.542896860385735, -3.8408800787748896},
+				{-18.155029645186133, 13.58763112837932, -3.410281889689424},
+				{-16.36484978881257, 11.96279197977924, -3.051277552910482},
+				{-14.855098594960234, 10.58786305272883, -2.74633734593421},
+				{-13.563197307690462, 9.407003335581456, -2.48332370319853},
+				{-12.444198258592097, 8.380124802150874, -2.2535368427962372},
+				{-11.464881962502275, 7.477614826828222, -2.050560105310127},
+				{-10.600099316423073, 6.6770667268671895, -1.8695454424700428},
+				{-9.830415694038212, 5.96117453715958, -1.7067538330964596},
+				{-9.14054297140702, 5.316332249572427, -1.559249805325722},
+			},
+			{
+				{-59.69782987970671, 51.63753298581441, -11.896315185314064},
+				{-43.70542957617733, 37.207769889764066, -8.708846735608844},
+				{-34.63804005684969, 29.019830345901966, -6.9034994058651415},
+				{-28.666727413909054, 23.62079838020222, -5.713600017233572},
+				{-24.384593050034113, 19.742442509942986, -4.858324143938386},
+				{-21.13952064203403, 16.797163723498596, -4.207864278104265},
+				{-18.58321726406772, 14.47126128757276, -3.6930655216504853},
+				{-16.510807774938225, 12.58028849834151, -3.2733451338796367},
+				{-14.792998963549328, 11.007895854318477, -2.923149423787023},
+				{-13.34369855119298, 9.67664490215786, -2.62549956767158},
+				{-12.103100212479635, 8.532765716846164, -2.368625292100726},
+				{-11.028206935769674, 7.537619287176617, -2.1440816474879907},
+				{-10.087215538118805, 6.662639676712113, -1.9456346459884581},
+				{-9.256032547644377, 5.886196658185133, -1.7685707915178486},
+				{-8.516027769568671, 5.1915733466695855, -1.6092524715146275},
+				{-7.8525378174686224, 4.565619323728928, -1.4648221557132328},
+			},
+			{
+				{-56.282610974803546, 49.24921367877146, -11.50238129674049},
+				{-41.096284118844395, 35.45008136744942, -8.43400291873097},
+				{-32.43681430078682, 27.574308832952365, -6.685751086098766},
+				{-26.71718536920859, 22.36501393233147, -5.529770038295586},
+				{-22.608137629489878, 18.615735379694673, -4.697172619976475},
+				{-19.490499888110886, 15.764720096944432, -4.0630511796265285},
+				{-17.03249671068452, 13.511078736328233, -3.5606411987473177},
+				{-15.03851527772981, 11.677480242599724, -3.1506696109284507},
+				{-13.384892399247292, 10.151864812709517, -2.808362917127577},
+				{-11.989172817141839, 8.859545212269369, -2.5172374095847694},
+				{-10.794014312042982, 7.748603531490481, -2.2658529531428466},
+				{-9.758154368769722, 6.781696850544114, -2.045993648269398},
+				{-8.851049583750576, 5.9311956081710155, -1.8515896768858615},
+				{-8.049545686978062, 5.176164249589291, -1.6780484416908878},
+				{-7.3357283211754964, 4.500412923901605, -1.5218234229474399},
+				{-6.695490106798453, 3.891198795651648, -1.3801270799817211},
+			},
+			{
+				{-53.22582984236644, 47.10382634115574, -11.147271806093082},
+				{-38.755947910686295, 33.86889609655102, -8.186098745144086},
+				{-30.460499031902174, 26.27345560163753, -6.489415516192445},
+				{-24.96580256479044, 21.234757367479997, -5.36409405086843},
+				{-21.011524203034334, 17.601570018449323, -4.551994856382026},
+				{-18.007839388947406, 14.83532338267233, -3.932634666181548},
+				{-15.63771561075851, 12.646640174902886, -3.441410618176895},
+				{-13.713818100905371, 10.86459038674189, -3.0402349487629032},
+				{-12.117519963729162, 9.380975839242408, -2.705040545303681},
+				{-10.769621517408266, 8.123587099639417, -2.4197920493585414},
+				{-9.614987257186346, 7.042174696643328, -2.1733482786589398},
+				{-8.613908519054327, 6.100563518880407, -1.9577007210765809},
+				{-7.736970752863263, 5.27196775660096, -1.7669281690266287},
+				{-6.961861425561959, 4.536077806042642, -1.5965474333118077},
+				{-6.271307805695845, 3.8771786800593793, -1.4430943998512373},
+				{-5.651700918390816, 3.282894740936032, -1.3038448179131201},
+			},
+			{
+				{-50.47344932536745, 45.16515187609231, -10.825173861267814},
+				{-36.64520033998039, 32.438752197206426, -7.9612210473494525},
+				{-28.67691812902236, 25.09681269062411, -6.311438722783648},
+				{-23.384627376960577, 20.212529726085886, -5.214016675672954},
+				{-19.56966513615227, 16.684433509775957, -4.420565456826076},
+				{-16.668545854783144, 13.994901994306886, -3.8146261720586216},
+				{-14.377492745748585, 11.864976818303978, -3.333564769160038},
+				{-12.516622546457024, 10.129526665469273, -2.9403737483537133},
+				{-10.97184527246724, 8.683853595463386, -2.6116299654866446},
+				{-9.666895565249185, 7.457994863116555, -2.331706815328174},
+				{-8.54862762073392, 6.403214096063138, -2.0897356387244264},
+				{-7.57873417615412, 5.484395341078284, -1.8778969361428648},
+				{-6.72881895518051, 4.675518029915559, -1.6904049030405561},
+				{-5.977328787023374, 3.9568402212929525, -1.5228759984653084},
+				{-5.307569809143582, 3.3130775181521375, -1.3719211317790883},
+				{-4.706382719367807, 2.732187195034979, -1.2348736471447161},
+			},
+			{
+				{-47.98170279191361, 43.40382343381805, -10.5313829997097},
+				{-34.73199083396885, 31.138814166370064, -7.7561738397875875},
+				{-27.05963663217432, 24.02754644305498, -6.14931276341864},
+				{-21.950607247337178, 19.283874975889045, -5.077431229254502},
+				{-18.261807217414088, 15.851470552942276, -4.301044195449979},
+				{-15.45355056065919, 13.231764826070416, -3.707377178983587},
+				{-13.23405759956463, 11.155289720950922, -3.2356007508103324},
+				{-11.430195848000796, 9.46220007619997, -2.8496981249094757},
+				{-9.931988279417192, 8.05099029167812, -2.5268368528017926},
+				{-8.665830817870518, 6.853747189211361, -2.251765256076805},
+				{-7.5803827365313685, 5.823114882161183, -2.013864601857962},
+				{-6.638608370581522, 4.924943747535373, -1.805488682151193},
+				{-5.813035497606748, 4.133911336287722, -1.6209757886617213},
+				{-5.082800078799906, 3.4307935074185885, -1.4560336692026923},
+				{-4.431733589303646, 2.8006985637331923, -1.3073419312789059},
+				{-3.8470848162487967, 2.231887341193714, -1.1722866333239248},
+			},
+			{
+				{-45.7147697423253, 41.79577860355588, -10.262051088856568},
+				{-32.98987917174521, 29.951869831829484, -7.568321705962925},
+				{-25.586741833882115, 23.05168125759159, -6.000959412964662},
+				{-20.644566604703154, 18.436748222766354, -4.952585827169748},
+				{-17.0706397245544, 15.091939439280747, -4.191896520051053},
+				{-14.346910881192985, 12.536119615751685, -3.6095098226764346},
+				{-12.192522856781382, 10.508515102804186, -3.1462599012663395},
+				{-10.440494730685138, 8.85412703904666, -2.76704368168095},
+				{-8.984599463967784, 7.47437763807294, -2.4495735625514468},
+				{-7.753660264143158, 6.303233779268376, -2.178943657998231},
+				{-6.697983122516892, 5.294605933888053, -1.9447656862025422},
+				{-5.781692552721317, 4.415230866211497, -1.7395531736470038},
+				{-4.978159458926933, 3.640426406980917, -1.5577587530910275},
+				{-4.267148579178252, 2.951443228038643, -1.3951742459481817},
+				{-3.6329707014970665, 2.333749653594898, -1.2485424969670678},
+				{-3.0632469380133647, 1.7758847614092228, -1.1152980648082458},
+			},
+			{
+				{-43.64305454135749, 40.32111301191428, -10.013999985796765},
+				{-31.39686448102778, 28.863576855995785, -7.395472376667635},
+				{-24.239920649962727, 22.157519136039735, -5.864642226786577},
+				{-19.450428762759202, 17.66103428026979, -4.838011977733223},
+				{-15.981612358236157, 14.396795596312376, -4.091832822123023},
+				{-13.335197442492229, 11.899702852122966, -3.5198636835497035},
+				{-11.240324245814723, 9.916989259488702, -3.064480205202098},
+				{-9.535646964170127, 8.298121821641395, -2.691426292037296},
+				{-8.11837538111412, 6.947221561853973, -2.3789194281932353},
+				{-6.919557919975999, 5.799988555425096, -2.1123742388405633},
+				{-5.891010437726054, 4.811500684870614, -1.8816159845446663},
+				{-4.9979212752629465, 3.9493117515530347, -1.6793061503661157},
+				{-4.214434439999218, 3.189329664671968, -1.5000032332417514},
+				{-3.5208911975058217, 2.5132425821127726, -1.3395768565559167},
+				{-2.9020417882843152, 1.9068504647461118, -1.194828354623753},
+				{-2.3458486652436648, 1.3589486348573598, -1.0632371225776254},
+			},
+			{
+				{-41.741893224832616, 38.96321879237213, -9.784581757636394},
+				{-29.93449457256655, 27.86188926646456, -7.235787530423953},
+				{-23.003752721783815, 21.335194576184584, -5.738899237356473},
+				{-18.354616656019328, 16.94817652085595, -4.732469640774783},
+				{-14.98240780626102, 13.75836919091379, -3.999761517809794},
+				{-12.407018611688446, 11.31549227940476, -3.4374545382699764},
+				{-10.36678463941319, 9.37418747875795, -2.9893592914526446},
+				{-8.70554686411184, 7.788056252748219, -2.622008410483425},
+				{-7.323679780206245, 6.463718789806825, -2.3140897391289577},
+				{-6.1542813133597285, 5.338480242673555, -2.0513163238140364},
+				{-5.1505581022303595, 4.368499535875723, -1.8237121873296027},
+				{-4.278678423374077, 3.5220869413192912, -1.6240764759726247},
+				{-3.5134983861814675, 2.7756965833740086, -1.4470661359494486},
+				{-2.835890244466167, 2.1114214788652275, -1.28862310532768},
+				{-2.231008935231303, 1.5153684066611273, -1.1456030534768622},
+				{-1.6871312263229514, 0.9765696959780333, -1.0155270060217338},
+			},
+			{
+				{-39.990569115004135, 37.70812855446723, -9.57157243359153},
+				{-28.587180442992757, 26.93661578225801, -7.087714218919232},
+				{-21.865162758823548, 20.57632942135847, -5.622490861925353},
+				{-17.345586379769035, 16.29088767709958, -4.634904499028469},
+				{-14.062529669094307, 13.170112959629188, -3.914752421150403},
+				{-11.552647834068006, 10.777481308759883, -3.3614420589351237},
+				{-9.562771503304846, 8.87451860437042, -2.920125378158458},
+				{-7.941536630569858, 7.318670171579197, -2.5580725543190974},
+				{-6.592244435001331, 6.018880187616858, -2.2544112654507864},
+				{-5.449888561628049, 4.9139464022086585, -1.9951335548053035},
+				{-4.468962145170481, 3.961032915588351, -1.7704492064075867},
+				{-3.616539889556834, 3.1291532435340166, -1.573285967249938},
+				{-2.8681365267755528, 2.3952691611249413, -1.3983927067350466},
+				{-2.2051153552521106, 1.7418498473200184, -1.2417788470589188},
+				{-1.6130055093826403, 1.1552870628855094, -1.100350734895004},
+				{-1.0803743465551057, 0.6248332141156934, -0.9716682037359077},
+			},
+			{
+				{-38.37155452385374, 36.544009815559804, -9.373090267844054},
+				{-27.341662947246945, 26.079075928728955, -6.9499315693398},
+				{-20.812991899364032, 19.87376246375844, -5.514359165952073},
+				{-16.413460529134756, 15.682922271968117, -4.544414397306203},
+				{-13.212977439867396, 12.62640307837867, -3.8360078763494148},
+				{-10.763728845404447, 10.280500347355385, -3.2911042806865276},
+				{-8.82042522788838, 8.413161895016822, -2.8561142469358427},
+				{-7.236153022225539, 6.885420532240875, -2.4990002379891965},
+				{-5.916930769265718, 5.608389794566481, -2.1993027710606885},
+				{-4.799512434147133, 4.522260690792721, -1.9432757039209687},
+				{-3.8395857736035044, 3.5851354627189234, -1.7213030784954344},
+				{-3.0050671217530414, 2.766683817830012, -1.5264332235323606},
+				{-2.2720826648373578, 2.0443410757395872, -1.3535011543335038},
+				{-1.6224514969000978, 1.40092718765388, -1.1985794999946244},
+				{-1.0420500216290847, 0.8230995694571344, -1.0586220452872506},
+				{-0.5197152289703784, 0.3003157159985387, -0.9312249318952578},
+			},
+			{
+				{-36.869920227611225, 35.46077110128203, -9.187532163999379},
+				{-26.186593717457864, 25.28182960451306, -6.821308942390475},
+				{-19.837659051202667, 19.221335600398717, -5.41359570262814},
+				{-15.549737358655344, 15.118895907647543, -4.460222739995261},
+				{-12.425987872205909, 12.122380515529219, -3.7628398016502747},
+				{-10.033040453126592, 9.82007404937751, -3.225817242016924},
+				{-8.132941816734895, 7.985936348478485, -2.7967508362473477},
+				{-6.582924237432061, 6.484360241156295, -2.4442550936642315},
+				{-5.29153827806387, 5.228491359761955, -2.1482593628086066},
+				{-4.197178333028454, 4.159825749985738, -1.8952640320197536},
+				{-3.256645377747917, 3.2373442323580646, -1.675817166253706},
+				{-2.4386399268123777, 2.431330876766109, -1.483080539405545},
+				{-1.7198577743754557, 1.719664212023233, -1.311970169714507},
+				{-1.0825425206911634, 1.0854923692868674, -1.1586180848174505},
+				{-0.5128939259414044, 0.5157212176974857, -1.0200226232888556},
+				{-3.378253662222741e-14, 2.1264716173306896e-14, -0.893814009603434},
+			},
+		},
+		{
+			{
+				{-71.43617154516903, 57.97251220710685, -12.07173433584971},
+				{-53.22762107273614, 42.24968769300461, -8.744860653036792},
+				{-43.25896264273159, 33.63592295496587, -6.925159186151187},
+				{-36.79822864321287, 28.046354277979297, -5.744472125917908},
+				{-32.201437373084715, 24.062353602522034, -4.9020658015388126},
+				{-28.730305538951736, 21.04714302237371, -4.263251473700571},
+				{-25.998450885298897, 18.667640713204282, -3.757720586084159},
+				{-23.781740657317464, 16.730742051274913, -3.34478918425707},
+				{-21.94012131101309, 15.115853222496916, -2.999095841390158},
+				{-20.380993639371237, 13.743289573484594, -2.703911291648123},
+				{-19.04034931174561, 12.55800396452327, -2.4476978458503114},
+				{-17.87231830720643, 11.520573111528911, -2.22220574125232},
+				{-16.84302891027889, 10.601904511330963, -2.02135653736525},
+				{-15.92682450371615, 9.779976160901798, -1.840556099639235},
+				{-15.103837578839133, 9.03774661567803, -1.6762546739881037},
+				{-14.358380318391532, 8.361769002496809, -1.5256554491090424},
+			},
+			{
+				{-65.79347668786615, 54.09437761877314, -11.440490028805122},
+				{-48.9958095773082, 39.439563702429076, -8.309583347825553},
+				{-39.71638394058568, 31.337144361096964, -6.581097987499126},
+				{-33.67419770172193, 26.054003652420633, -5.454016435662877},
+				{-29.362818983247752, 22.277006408259172, -4.647304446097776},
+				{-26.10077817500488, 19.412401374493783, -4.034173950750165},
+				{-23.52970366124297, 17.14810674528935, -3.5481198857951144},
+				{-21.44099215268509, 15.302562983443572, -3.150525176164564},
+				{-19.703952447679345, 13.762086509252002, -2.8172531125708433},
+				{-18.231996023387648, 12.451401118563144, -2.5323488168877835},
+				{-16.96516681655372, 11.318414960342837, -2.2847895724497023},
+				{-15.860435496051393, 10.325765723658852, -2.0666821101770596},
+				{-14.88598602351388, 9.445845090115869, -1.8722029425707856},
+				{-14.017688996956533, 8.65772852055163, -1.6969452054485865},
+				{-13.236836722859886, 7.945204116550218, -1.537499766629382},
+				{-12.528638645427792, 7.295463655304654, -1.3911773469373934},
+			},
+			{
+				{-60.96698612514152, 50.767124837551954, -10.898124597991323},
+				{-45.349218466609905, 37.01192890305495, -7.933293678586834},
+				{-36.65157747230261, 29.344160212425543, -6.282778418100694},
+				{-30.964281940612576, 24.322619501802638, -5.201699772162579},
+				{-26.895528319087706, 20.72277753257508, -4.425686326617208},
+				{-23.811512354646478, 17.98725595698593, -3.8346732739800395},
+				{-21.377464577760836, 15.821798281371114, -3.36540428359442},
+				{-19.397906901600763, 14.0546725353903, -2.9810321121061576},
+				{-17.750078884825744, 12.578089551991962, -2.6584706008892214},
+				{-16.35249244540956, 11.320539377352326, -2.3824299721621034},
+				{-15.148634797818996, 10.232457918815689, -2.142330198785198},
+				{-14.097892978690306, 9.278247242412181, -1.9305846464870433},
+				{-13.170201034074088, 8.43157042771424, -1.7415891922053879},
+				{-12.342730588779808, 7.6724434419296585, -1.57109811019996},
+				{-11.597762477654014, 6.985365442026042, -1.4158232435327802},
+				{-10.921271186666102, 6.358075654438495, -1.273167780441995},
+			},
+			{
+				{-56.79031210535666, 47.879083074533284, -10.426482243714142},
+				{-42.174528312030276, 34.89323888141603, -7.604548429809988},
+				{-33.97478040909953, 27.599972908596655, -6.021592067768848},
+				{-28.5923056103646, 22.804606788075922, -4.980494767929505},
+				{-24.732361938908785, 19.35821419168964, -4.2312026322706755},
+				{-21.801737179088445, 16.73462156753831, -3.659457111653857},
+				{-19.48583478638116, 14.654917058750755, -3.204816034229587},
+				{-17.600423280210787, 12.95584819471425, -2.8319682760143094},
+				{-16.029550479476708, 11.53472010269022, -2.5187407225916045},
+				{-14.696113004607232, 10.323281800289635, -2.250422168439086},
+				{-13.546561371049657, 9.274158441712844, -2.016817910541004},
+				{-12.542363899834552, 8.353281163637925, -1.8106083016748828},
+				{-11.654963005279077, 7.535415350000869, -1.6263802366773812},
+				{-10.862652194386543, 6.801392582924096, -1.4600282872570092},
+				{-10.148565036481763, 6.136328704594602, -1.3083695896860421},
+				{-9.499336179670054, 5.528437725210904, -1.168887772074895},
+			},
+			{
+				{-53.13919167961347, 45.346811651018704, -10.012030333256279},
+				{-39.385593178351385, 33.027531574127565, -7.314661622435454},
+				{-31.61706840066382, 26.060763106065565, -5.790937226807049},
+				{-26.499376096928806, 21.46311728911648, -4.784974415666088},
+				{-22.821094758817875, 18.1510533139556, -4.0591885261377785},
+				{-20.02402996106045, 15.62551178494206, -3.5043995770753087},
+				{-17.811041815693834, 13.620952092587956, -3.0626330323795687},
+				{-16.007651772907355, 11.981524825706309, -2.6999268491118493},
+				{-14.50382076091616, 10.608988608117107, -2.3949105928201946},
+				{-13.226257958081478, 9.437944178040295, -2.1333820168574418},
+				{-12.123992737094035, 8.42293362997841, -1.9054854994809012},
+				{-11.160305869946368, 7.531227569740613, -1.7041363673934833},
+				{-10.3079539488327, 6.738557250803881, -1.5240896148687464},
+				{-9.546208287361894, 6.026468996445927, -1.3613632446634873},
+				{-8.858945898678419, 5.380619064522582, -1.2128669887901866},
+				{-8.23337715969937, 4.7896375175429675, -1.0761551788001424},
+			},
+			{
+				{-49.91894900019095, 43.10669718173828, -9.644475991162045},
+				{-36.91577482157685, 31.371472418807137, -7.056923015246965},
+				{-29.524661421207515, 24.69229541736747, -5.585668263498682},
+				{-24.639265787379202, 20.26918494235584, -4.610882806686419},
+				{-21.120545943360955, 17.075814649786267, -3.905968864492352},
+				{-18.440858798200498, 14.636947793048472, -3.3662380322883783},
+				{-16.31833521672207, 12.698820385857742, -2.9359027641234245},
+				{-14.587043664021556, 11.112113467119821, -2.5821981561718426},
+				{-13.142133644861268, 9.78251838766891, -2.284466580856374},
+				{-11.913662848460602, 8.647155956051753, -2.028957948749121},
+				{-10.852927162360778, 7.662263071289392, -1.8061179243510046},
+				{-9.924801083829859, 6.7962949206108245, -1.6090706076099004},
+				{-9.10319720358317, 6.025837045531073, -1.4327203149439403},
+				{-8.368247179720285, 5.33306794528171, -1.2731946517658173},
+				{-7.7044823180703075, 4.704121842647824, -1.1274855336329515},
+				{-7.099619844902758, 4.127996772976438, -0.9932100163095315},
+			},
+			{
+				{-47.05618669985108, 41.10938727983153, -9.315851483606032},
+				{-34.71273037730626, 29.89098534377486, -6.8260678003858235},
+				{-27.654995297152286, 23.46743798396879, -5.401716407218339},
+				{-22.975194273405638, 19.19972891019828, -4.454836503049428},
+				{-19.59781729007155, 16.112110212413032, -3.768609865445925},
+				{-17.022141164373906, 13.750480708743726, -3.242359285686482},
+				{-14.979783678232385, 11.87154654740912, -2.8222537662859324},
+				{-13.312373474294704, 10.331802339127693, -2.47660046328731},
+				{-11.919654426555077, 9.040443407693273, -2.185380420831274},
+				{-10.73465147596401, 7.936837097169409, -1.9352487726283814},
+				{-9.710670021435252, 6.978731629603679, -1.7169211962237234},
+				{-8.813998086575905, 6.135638681807795, -1.523708860426511},
+				{-8.01957380986963, 5.384905716809415, -1.3506498355520629},
+				{-7.308292248657259, 4.709275018561883, -1.1939698590913979},
+				{-6.665265390668839, 4.095307034029979, -1.050734393990245},
+				{-6.078660441212754, 3.5323279543062425, -0.9186166082077746},
+			},
+			{
+				{-44.49312946483358, 39.31600203179881, -9.019892914009686},
+				{-32.734783588936914, 28.558906970930753, -6.617908091376857},
+				{-25.973951701334524, 22.364412803361873, -5.235822786709589},
+				{-21.47753964434829, 18.23613240657935, -4.314112439748059},
+				{-18.226316076200906, 15.243433081523174, -3.6447415560711764},
+				{-15.743486807860371, 12.951127237398314, -3.1306459122641015},
+				{-13.77268263912997, 11.12530750190545, -2.719759504594071},
+				{-12.162275051861307, 9.627686067546568, -2.3813573554125527},
+				{-10.816109200369832, 8.370605114218039, -2.0959973180509137},
+				{-9.669860397508993, 7.295450337567703, -1.8507005287362377},
+				{-8.67862966166451, 6.361328022732058, -1.6364265131534006},
+				{-7.809968401307706, 5.538699226842796, -1.4466553322561033},
+				{-7.039731509392107, 4.805596033007634, -1.276545763278051},
+				{-6.349496535203072, 4.145267242209456, -1.1224120598638454},
+				{-5.724894691341936, 3.5446561954005933, -0.981385994504587},
+				{-5.154495684127631, 2.9933839121013683, -0.8511913123319498},
+			},
+			{
+				{-42.18368078627746, 37.69549324083835, -8.751607971567998},
+				{-30.94834514624352, 27.35331857864249, -6.42907139542744},
+				{-24.453866839273314, 21.36553727238741, -5.085346796385732},
+				{-20.12218049085152, 17.36321245925847, -4.186494478215879},
+				{-16.984314396987774, 14.456274148350463, -3.532428556463591},
+				{-14.584911409883402, 12.226589787926494, -3.029363879396279},
+				{-12.678383931860013, 10.448729702698717, -2.626838442203929},
+				{-11.11916197948967, 8.989122854802986, -2.2950074069609676},
+				{-9.814778457662975, 7.762957707330932, -2.0149532747083354},
+				{-8.703292921635432, 6.713445990930998, -1.7740300733692824},
+				{-7.741422153093675, 5.800922733400018, -1.5634190649004973},
+				{-6.897854560463637, 4.9967079042581855, -1.3767538283903946},
+				{-6.149270128727186, 4.279452864090979, -1.2093028062628446},
+				{-5.477860682974815, 3.632864498020063, -1.057460628583196},
+				{-4.869724820786795, 3.044232391554792, -0.9184192529413889},
+				{-4.313794698157138, 2.5034442173801086, -0.7899483239334963},
+			},
+			{
+				{-40.0906157146329, 36.22276445705104, -8.50696878671833},
+				{-29.326044415747447, 26.256337616221057, -6.256811502279937},
+				{-23.072074964379773, 20.456303167301268, -4.948126119577952},
+				{-18.88927490325706, 16.56846062320098, -4.070160539778573},
+				{-15.853882664977776, 13.739467673191392, -3.430074530947513},
+				{-13.52988027860354, 11.56667611537915, -2.9370790947366787},
+				{-11.681422574526264, 9.832364230457658, -2.5421795591682184},
+				{-10.168419562889683, 8.407252828826035, -2.216336646089683},
+				{-8.9017417541875, 7.209121267972574, -1.9411131049256551},
+				{-7.821607314938564, 6.182843706767868, -1.7041676416976994},
+				{-6.886196126781953, 5.289873781816448, -1.4968843958414744},
+				{-6.065226208740533, 4.502313256283327, -1.3130373423743755},
+				{-5.336124678474716, 3.799377023383234, -1.147995159351485},
+				{-4.681639571633521, 3.16518871025219, -0.9982259006618096},
+				{-4.088292717479789, 2.587352856297297, -0.8609764796555862},
+				{-3.5453445961271792, 2.055999738076853, -0.7340584510286476},
+			},
+			{
+				{-38.18354470254568, 34.87730726722543, -8.282689545228306},
+				{-27.84535368516307, 25.253227392059696, -6.098869426550687},
+				{-21.809826387749162, 19.624691965489284, -4.82237287067375},
+				{-17.76234783670423, 15.841474971984786, -3.9635983372370522},
+				{-14.820088674131249, 13.083699330164496, -3.336350506002743},
+				{-12.56458737075392, 10.962861318828665, -2.852594552969533},
+				{-10.768856245827866, 9.268289294533249, -2.464686068071612},
+				{-9.297791823968197, 7.874632201317803, -2.1443273678945984},
+				{-8.065303148299394, 6.702041412187923, -1.8735233301957754},
+				{-7.0135740407022125, 5.696913138006676, -1.640213092878319},
+				{-6.102116778961852, 4.821725029903783, -1.435967452202863},
+				{-5.301586949810405, 4.0492943431457675, -1.2546894842064682},
+				{-4.590091913906115, 3.3593515360382957, -1.0918399815085262},
+				{-3.9508860461462856, 2.736401377788406, -0.9439544323872157},
+				{-3.3708764484987683, 2.168336403936821, -0.8083302019708218},
+				{-2.8396225602367338, 1.6455087717362278, -0.6828173220279795},
+			},
+			{
+				{-36.43741190159089, 33.642195351267894, -8.076062745475543},
+				{-26.487559798211485, 24.331731216318037, -5.953369601161063},
+				{-20.651472296335196, 18.860658610034204, -4.706595436043181},
+				{-16.72759967990451, 15.173529370021711, -3.865541596499398},
+				{-13.870388141387295, 12.481131478821382, -3.2501403774284534},
+				{-11.677404676743521, 10.407952964377959, -2.7749023791371665},
+				{-9.929759240276736, 8.749805336944823, -2.3934323327349203},
+				{-8.496910526695757, 7.38495183697537, -2.0781188434132094},
+				{-7.29554861315991, 6.235726773509297, -1.8113759276083716},
+				{-6.269656647896859, 5.249927014584499, -1.5814021540457692},
+				{-5.379966505411642, 4.390972336201525, -1.3799409144921424},
+				{-4.5979918092476835, 3.6323380281777893, -1.201014587001446},
+				{-3.9024622916972818, 2.9542285008289824, -1.0401690284230134},
+				{-3.277095459757289, 2.3414987498254467, -0.8940019591764308},
+				{-2.7091507516227065, 1.7823058735704873, -0.7598572835774684},
+				{-2.188461041029065, 1.2672058789451974, -0.6356205290209291},
+			},
+			{
+				{-34.8313709110274, 32.50333118820256, -7.884836802538213},
+				{-25.23698495573087, 23.48156757282429, -5.818741319094136},
+				{-19.58384264448681, 18.155737220671543, -4.59953888903845},
+				{-15.77337586343661, 14.557242744773307, -3.774921178962476},
+				{-12.994155272524818, 11.925114218243456, -3.1704989749459824},
+				{-10.858456628083761, 9.895831940803873, -2.703146793344631},
+				{-9.154830112657152, 8.271198337027185, -2.3276304945657067},
+				{-7.7569289768938505, 6.932818136817019, -2.016976795786095},
+				{-6.58400099280837, 5.805044051489174, -1.753980092181439},
+				{-5.581684199759897, 4.836967858065958, -1.527080059768869},
+				{-4.711831992712764, 3.9928800417481733, -1.3281804065369354},
+				{-3.946746783193768, 3.2468637562241796, -1.151414247565761},
+				{-3.265730202663627, 2.5795609784262252, -0.9924063357893878},
+				{-2.6529251342935325, 1.976149857827976, -0.847812068574985},
+				{-2.0959145042780754, 1.4250315004059388, -0.7150184609996426},
+				{-1.5847821976689238, 0.9169499133206763, -0.5919439218578029},
+			},
+			{
+				{-33.347931629630686, 31.44887422833263, -7.707123302635474},
+				{-24.080388948655052, 22.69404258288103, -5.693658536002552},
+				{-18.59576573700025, 17.502736343835632, -4.500139020904298},
+				{-14.889755275090499, 13.9863221804109, -3.690827186047043},
+				{-12.182317031850065, 11.409960017058019, -3.0966194184857585},
+				{-10.099287375739568, 9.421249613298727, -2.6365951811907196},
+				{-8.4360839766454, 7.827553936885738, -2.266604321247281},
+				{-7.070233988542809, 6.513580445334583, -1.9602694117643742},
+				{-5.923347845027855, 5.40555610574684, -1.7007399826045513},
+				{-4.942592189629859, 4.453774856247815, -1.4766807200361225},
+				{-4.090856060634536, 3.6233351746342257, -1.2801448503935466},
+				{-3.3411699164481417, 2.888883951962233, -1.105368685500994},
+				{-2.6733629215822403, 2.2314686719144623, -0.9480504372384527},
+				{-2.071970059602854, 1.6365667321582498, -0.8048991590215308},
+				{-1.5248722350437558, 1.0928050481655565, -0.6733419458694297},
+				{-1.022384429975045, 0.5911015483663434, -0.5513277727628733},
+			},
+			{
+				{-31.97230480516649, 30.46880140814864, -7.541325847593311},
+				{-23.006505312807004, 21.961749080235123, -5.576993209537679},
+				{-17.677693119471368, 16.895500787025146, -4.407486481112166},
+				{-14.068227376284307, 13.455361162980301, -3.6124792557412997},
+				{-11.427065090919477, 10.930765998921082, -3.0278074287545858},
+				{-9.392597806289695, 8.9796672832652, -2.574615246257461},
+				{-7.766608495025385, 7.414609831275084, -2.2097684853841204},
+				{-6.4302167523417415, 6.1231935407960085, -1.9074482744546088},
+				{-5.3072242787955535, 5.033392551104988, -1.6511369784796501},
+				{-4.346215190784528, 4.09662109364405, -1.4297100615394314},
+				{-3.5110384724766734, 3.2787301968371, -1.235360710146291},
+				{-2.7753989764235754, 2.554891409083498, -1.0624217488096603},
+				{-2.1196141100266694, 1.9065292638640112, -0.9066600205977244},
+				{-1.5285813951311285, 1.3193991313151714, -0.7648346580591897},
+				{-0.990456964756416, 0.7823374891651932, -0.6344101344448647},
+				{-0.4957690550014236, 0.28642356929115137, -0.5133639221350429},
+			},
+			{
+				{-30.691892741343683, 29.55456552099542, -7.386084838670698},
+				{-22.005677558885036, 21.27833043622971, -5.467778743292283},
+				{-16.82140362877528, 16.328723535049434, -4.320798510270915},
+				{-13.301436250467626, 12.959679470109373, -3.5392030359312043},
+				{-10.72162654942243, 10.483272310086756, -2.9634608958260014},
+				{-8.732035427306062, 8.56712774412228, -2.5166567706071716},
+				{-7.140368231660815, 7.0286372328073785, -2.15661196378804},
+				{-5.831088490881592, 5.758106819777383, -1.8580330359385289},
+				{-4.730037666431167, 4.685145117182574, -1.6047153710533835},
+				{-3.787118970021893, 3.762213954565482, -1.3857325509773841},
+				{-2.9670742029553385, 2.955867601859704, -1.1934092092633743},
+				{-2.2442349334161316, 2.2417674420696296, -1.022168720710601},
+				{-1.599371816992249, 1.6016896495771902, -0.8678422459838191},
+				{-1.017718525539198, 1.0216485329632026, -0.7272357959845515},
+				{-0.4876860784074713, 0.49067557527357386, -0.597848802067476},
+				{-4.016108112089492e-14, 2.7776354757144402e-14, -0.4776853802928018},
+			},
+		},
+		{
+			{
+				{-66.14884341124744, 53.566207442531656, -10.833996147836844},
+				{-49.80786486940028, 39.456924643894695, -7.856208204213382},
+				{-40.87635158932933, 31.732400141574274, -6.226142731672919},
+				{-35.081661200197786, 26.70881533037673, -5.164382253639645},
+				{-30.949409793325888, 23.115340612554647, -4.402674770709384},
+				{-27.819559130428683, 20.38329997182536, -3.8212292699670045},
+				{-25.34709620328065, 18.215580176082685, -3.3575772201741647},
+				{-23.331856050199004, 16.43989451043173, -2.9755586266263228},
+				{-21.64858834097817, 14.94849341138596, -2.6525959534264496},
+				{-20.214251427600182, 13.66997530569439, -2.373751120565508},
+				{-18.971182074222643, 12.554784462870805, -2.128671617611469},
+				{-17.87776837181033, 11.567176390109442, -1.9099004402271968},
+				{-16.902962728959487, 10.680492547075788, -1.711883198418798},
+				{-16.02288865307087, 9.874239011737448, -1.5303549101592797},
+				{-15.218648829429112, 9.132199848916368, -1.3619443763820125},
+				{-14.47485095886368, 8.44116846300265, -1.2039083885918886},
+			},
+			{
+				{-60.85802983485821, 49.92343176502393, -10.241079598515688},
+				{-45.82268487459372, 36.80593583947608, -7.445829347607122},
+				{-37.52464394167879, 29.553545550202223, -5.900421086857927},
+				{-32.11303042198661, 24.812039929382497, -4.888368943820176},
+				{-28.24103687069, 21.408658787791783, -4.159756393340093},
+				{-25.301084259099223, 18.81455699063695, -3.602120044965172},
+				{-22.973955752997412, 16.752014212574046, -3.156512199111699},
+				{-21.073763936983816, 15.059388222227504, -2.788683791517451},
+				{-19.48385463638282, 13.635291924643242, -2.4771882722589087},
+				{-18.12669728849597, 12.412376054538862, -2.2078014528604175},
+				{-16.948337076491438, 11.343794646640497, -1.970644139425906},
+				{-15.909754172493779, 10.395685238036238, -1.758585245328505},
+				{-14.981768067319077, 9.542735490949275, -1.5663013396323588},
+				{-14.14187919605859, 8.765436056303647, -1.3896945015785915},
+				{-13.372223981888892, 8.048302285929426, -1.2255158571256732},
+				{-12.658195916978597, 7.378675272441575, -1.0711119895366754},
+			},
+			{
+				{-56.35258275197417, 46.814687330686056, -9.73461602367768},
+				{-42.40127101484867, 34.52626826054488, -7.0928600312369126},
+				{-34.634491137432896, 27.672438324796854, -5.619306879295895},
+				{-29.54574891235203, 23.170199991507424, -4.649633161479722},
+				{-25.893765013206863, 19.92851968507199, -3.9493067869249088},
+				{-23.114661593634114, 17.45197183960922, -3.4120521072673458},
+				{-20.91080897212001, 15.47917733029607, -2.981906242626828},
+				{-19.10830551021997, 13.857489659832506, -2.626244330645503},
+				{-17.597730067233105, 12.490909864117386, -2.324582768211959},
+				{-16.306197240584073, 11.315525420404656, -2.0633058436822838},
+				{-15.182881898381877, 10.286790656139924, -1.8329379824697218},
+				{-14.190953674859715, 9.372441443823744, -1.6266259795759936},
+				{-13.302809930657157, 8.548308613829773, -1.439243141302639},
+				{-12.497114938588235, 7.795718317862032, -1.2668335850821695},
+				{-11.756878811171214, 7.099805643165533, -1.106252113262654},
+				{-11.068159128259037, 6.448374943985901, -0.954921055906621},
+			},
+			{
+				{-52.466665879919454, 44.127735764008825, -9.296307020813487},
+				{-39.43027472571006, 32.543712075110115, -6.785736336528332},
+				{-32.11563217434099, 26.03124624766386, -5.3740633851586574},
+				{-27.30278927989205, 21.734725711863753, -4.4410107751743},
+				{-23.839253420059983, 18.632378353244746, -3.765173132818711},
+				{-21.198136491934935, 16.257263490930242, -3.2455827822653758},
+				{-19.100150324834456, 14.361979759254558, -2.8288454648263865},
+				{-17.381603675559113, 12.8015936156369, -2.4837368815396967},
+				{-15.939248657918375, 11.484733436704724, -2.190604850099186},
+				{-14.70416985318086, 10.35044140629946, -1.9363593946705544},
+				{-13.628219505050813, 9.356150756432994, -1.711873733247999},
+				{-12.6764414602078, 8.4709726402263, -1.5105346958586705},
+				{-11.822584204731173, 7.671721252361002, -1.3273853434367484},
+				{-11.04630870281974, 6.940440911412006, -1.1585921131776016},
+				{-10.331373319156292, 6.262798792266217, -1.0010988312358062},
+				{-9.664404643727854, 5.626996510205148, -0.8523926200620462},
+			},
+			{
+				{-49.07770305621026, 41.77959057701786, -8.912655817987613},
+				{-36.82440208728162, 30.80238339753847, -6.515770721732335},
+				{-29.89947372440945, 24.585976463012308, -5.158060284739724},
+				{-25.32522685532761, 20.468419279766007, -4.2570280420962385},
+				{-22.02496514061539, 17.487487193015237, -3.602630812782303},
+				{-19.503542090172722, 15.200849522140064, -3.0985162779904916},
+				{-17.49745964929338, 13.373217117263739, -2.693529490965997},
+				{-15.85183587440864, 11.866352910821588, -2.357669099839528},
+				{-14.468757206871853, 10.592907927069858, -2.0720095748676193},
+				{-13.282742408685104, 9.494494983232796, -1.8239206126922085},
+				{-12.247961429815081, 8.53026964214001, -1.6045803687525193},
+				{-11.331081249481079, 7.6705400107366515, -1.407585398574391},
+				{-10.507020698582899, 6.892975793195367, -1.228126901372361},
+				{-9.756304136281523, 6.180245632418726, -1.0624778550661875},
+				{-9.06333826159914, 5.51847885418161, -0.9076594292187921},
+				{-8.415243222400056, 4.896221988245594, -0.7612148850408289},
+			},
+			{
+				{-46.09310570192813, 39.70754078815783, -8.573486880558255},
+				{-34.51823332729386, 29.259384839064744, -6.2763097850941625},
+				{-27.932952347772112, 23.30256083281708, -4.96617479430112},
+				{-23.56721098395923, 19.342301734554674, -4.093430466000422},
+				{-20.40983991214059, 16.468219129652624, -3.457991126910079},
+				{-17.993258123384653, 14.259496563006252, -2.967565080639111},
+				{-16.06771828021045, 12.491460052587465, -2.5729718266307864},
+				{-14.486024022324514, 11.031750592218302, -2.245289647387207},
+				{-13.154921473652173, 9.796553703461392, -1.9662349190203203},
+				{-12.011931748341086, 8.729742121820012, -1.7235834647510968},
+				{-11.01325209977768, 7.791985816243306, -1.5087827772473745},
+				{-10.126969619590822, 6.954645152732937, -1.3156145097632457},
+				{-9.329027165383334, 6.196141543011939, -1.1394004346314626},
+				{-8.600704586102388, 5.499691808489426, -0.9765072683594748},
+				{-7.926976129563448, 4.851830827758361, -0.8240240901094916},
+				{-7.2953947256729865, 4.2414079587841, -0.6795433826224025},
+			},
+			{
+				{-43.441614003187695, 37.86329713600206, -8.270982209940986},
+				{-32.46074549996361, 27.881230538047383, -6.062171366712485},
+				{-26.17436611381633, 22.154196825835946, -4.794385602591711},
+				{-21.992517627859396, 18.33345057533537, -3.946860277605746},
+				{-18.96130722806841, 15.554219009824335, -3.328330879712297},
+				{-16.63734523599078, 13.414688879028756, -2.850115276721465},
+				{-14.782980847485863, 11.69958248142556, -2.4647912833434784},
+				{-13.25778912388269, 10.281750596534126, -2.1443995551522934},
+				{-11.972625721314452, 9.08051371379484, -1.871228881584728},
+				{-10.867661252205892, 8.041749687712224, -1.633417227119207},
+				{-9.90088256905675, 7.127472114739813, -1.4226521381205923},
+				{-9.041630456815245, 6.309974633338918, -1.2328800910687239},
+				{-8.26675173504482, 5.568344954450735, -1.059538972311146},
+				{-7.558193167570364, 4.8862854586127895, -0.8990787169920129},
+				{-6.901430298182193, 4.2506901537989865, -0.748648599791231},
+				{-6.284399149220921, 3.650676923256715, -0.6058847303329005},
+			},
+			{
+				{-41.06747678048674, 36.20905840211635, -7.999034900520555},
+				{-30.611547503812755, 26.641388571405063, -5.869258289918187},
+				{-24.590473742891906, 21.11949638885589, -4.639490707983161},
+				{-20.57213036068084, 17.423481713826245, -3.8146304370510986},
+				{-17.65317758735043, 14.729097746812046, -3.211301717741866},
+				{-15.411653955086175, 12.65147029300243, -2.7440602816421307},
+				{-13.620645934421228, 10.983712660617181, -2.367063614380717},
+				{-12.145746840783177, 9.603332846646756, -2.053217593548044},
+				{-10.901467596804583, 8.432455252749374, -1.7853257359710861},
+				{-9.830336390232258, 7.418751335168122, -1.5518515760547067},
+				{-8.891932667575887, 6.525436323866069, -1.3446983348650412},
+				{-8.056712761514897, 5.725638071075972, -1.1579604195102189},
+				{-7.302327653905352, 4.999039427899749, -0.9871797835461757},
+				{-6.611317337386528, 4.329776172564742, -0.8288808305625947},
+				{-5.969603540360706, 3.7050628500435527, -0.6802666450686433},
+				{-5.365464103506427, 3.1142574963053815, -0.5390123807447951},
+			},
+			{
+				{-38.92643624062177, 34.714798009977024, -7.752804036461049},
+				{-28.938231753227047, 25.51855190996474, -5.694287107650845},
+				{-23.154431802420575, 20.181169047172613, -4.498907060649474},
+				{-19.282507464830797, 16.597461368312846, -3.694562950506814},
+				{-16.46412343652491, 13.979490998375745, -3.1049929573035833},
+				{-14.296456406170247, 11.95760560200839, -2.647680678310622},
+				{-12.56220014590108, 10.332470777023236, -2.278213894631985},
+				{-11.132338582374114, 8.985789649229497, -1.970282303493746},
+				{-9.924658544989128, 7.842213129284118, -1.7071557285532277},
+				{-8.8838015273671, 6.851028712151229, -1.4775925287566622},
+				{-7.970774403799, 5.976533856449767, -1.2736910769601042},
+				{-7.1570330102675115, 5.192607197919622, -1.0896794762599993},
+				{-6.420948497745524, 4.479466721220696, -0.9211935935339663},
+				{-5.745591964151247, 3.8216375921006547, -0.7648249345127737},
+				{-5.117284792050962, 3.206622354622395, -0.6178250390730025},
+				{-4.524611742485541, 2.6239953338008126, -0.477904295069369},
+			},
+			{
+				{-36.98289397689462, 33.35634899287783, -7.5284011295825675},
+				{-27.414472845181454, 24.49539671420797, -5.534593667910464},
+				{-21.84429922643511, 19.325066701251753, -4.370525511867515},
+				{-18.104317083282286, 15.843111333468267, -3.5848710114170963},
+				{-15.37656460750393, 13.294367973137666, -3.007831065167769},
+				{-13.27543807262591, 11.322962129226944, -2.559555783849201},
+				{-11.592289271448642, 9.73640457627938, -2.1969370263818933},
+				{-10.202964254833853, 8.420203148726165, -1.8943793981488426},
+				{-9.028205652510763, 7.301300422474208, -1.6355779691018097},
+				{-8.014561706385827, 6.330449315652679, -1.409559808239023},
+				{-7.124326731165005, 5.472928027560769, -1.2086009813171354},
+				{-6.329857461612958, 4.70329491795438, -1.0270511613574225},
+				{-5.6101736436321685, 4.002251890366517, -0.8606314712263493},
+				{-4.948824371241755, 3.354675866584439, -0.7059942396703405},
+				{-4.332490747518058, 2.748329525640384, -0.5604349077965494},
+				{-3.750034327411881, 2.1729830055269894, -0.4216958725200171},
+			},
+			{
+				{-35.20786996728539, 32.1140248014334, -7.322664717947154},
+				{-26.01863721921186, 23.557674020922313, -5.387991156779361},
+				{-20.64193386957222, 18.539478422421578, -4.252603938160797},
+				{-17.021497902224162, 15.150218361175094, -3.48407159152548},
+				{-14.375836337901152, 12.664515047092168, -2.9185047229028442},
+				{-12.334941891904672, 10.739045622600326, -2.4784974355823706},
+				{-10.69801923349943, 9.187564174377723, -2.1221379896689774},
+				{-9.345327294352876, 7.899050247494892, -1.8244870069437973},
+				{-8.200291788988832, 6.8025373665083615, -1.5696296523487556},
+				{-7.211191297939659, 5.850114856945459, -1.346839307742478},
+				{-6.341487676102596, 5.0079545044031075, -1.1485547285616293},
+				{-5.564353805983085, 4.2512331898403515, -0.9692367158423185},
+				{-4.859396244020253, 3.561092475751027, -0.804684179117261},
+				{-4.210595983798115, 2.922728391682031, -0.6516048448832368},
+				{-3.6049589275913103, 2.3241394573839127, -0.5073341176398435},
+				{-3.031596760707949, 1.7552736214468623, -0.36964361062389356},
+			},
+			{
+				{-33.577505907681584, 30.971607877142173, -7.132995386666464},
+				{-24.732749243119848, 22.693534010580176, -5.252664700882978},
+				{-19.53216482407359, 17.814601157819535, -4.1436871923050775},
+				{-16.02054999076631, 14.510187911135969, -3.390919543790128},
+				{-13.449557739864733, 12.082143436607337, -2.8359080274362194},
+				{-11.46339172841015, 10.198645923003223, -2.403499563969459},
+				{-9.868420604585445, 8.67917598774634, -2.0528861419664217},
+				{-8.548930934553022, 7.415898370993287, -1.759733626809031},
+				{-7.43079707493382, 6.339764455177734, -1.5084869158698695},
+				{-6.463875840734441, 5.404088413792661, -1.288646314962529},
+				{-5.612692855146966, 4.575860003471921, -1.0928002466451887},
+				{-4.851163437025431, 3.830821302348884, -0.9155115481857311},
+				{-4.159426870127105, 3.150514764262994, -0.7526503891195275},
+				{-3.521855346718913, 2.5204267330565338, -0.6009751342641837},
+				{-2.925748351469274, 1.9287699343667064, -0.45785767921847587},
+				{-2.3604433914317964, 1.3656538278928854, -0.32109636615938486},
+			},
+			{
+				{-32.0719495041622, 29.915595601039744, -6.957233016063491},
+				{-23.541710122370883, 21.89301529164932, -5.127091876316995},
+				{-18.502161639603393, 17.142136089562083, -4.042546196000775},
+				{-15.089990555974484, 13.915701395532075, -3.3043571122822955},
+				{-12.5871441523518, 11.540585962907599, -2.759096706842793},
+				{-10.650844698067486, 9.695561519350237, -2.333699105305662},
+				{-9.094030628561802, 8.20538789698174, -1.9883796119152537},
+				{-7.80468307744445, 6.965166492397875, -1.6993652011953335},
+				{-6.710921773387068, 5.907616002030935, -1.4514340520619422},
+				{-5.76404938856593, 4.98717808641911, -1.2342964552177822},
+				{-4.929564540822434, 4.171594180762692, -1.0406790812827302},
+				{-4.182060062293862, 3.437124528431426, -0.8652387941399495},
+				{-3.5021598854522615, 2.7656780091546085, -0.7039112400133462},
+				{-2.874590758871216, 2.143005408744878, -0.553501173519001},
+				{-2.286917135781371, 1.557513917277566, -0.411413848667788},
+				{-1.728679445879537, 0.9994592533547346, -0.27547204764361544},
+			},
+			{
+				{-30.67450988612862, 28.934629421599347, -6.793564045585315},
+				{-22.432698694022076, 21.147652940553545, -5.009981841771836},
+				{-17.540945816363497, 16.51497582909246, -3.9481308593780677},
+				{-14.219929016083647, 13.360448341162245, -3.2234745900323616},
+				{-11.779423561543007, 11.034058286430474, -2.6872537537730063},
+				{-9.888636576095209, 9.224381116969463, -2.2683451125381593},
+				{-8.366559987991335, 7.761065804085084, -1.9279169808673986},
+				{-7.104579353348986, 6.541933153168434, -1.642718779463071},
+				{-6.03288208274602, 5.501337161888346, -1.3978387342000542},
+				{-5.104100421023363, 4.594761219494032, -1.1831821852808762},
+				{-4.28462569759049, 3.7906396872645973, -0.9916039317143956},
+				{-3.5496703014712803, 3.0657089447423953, -0.817847718356757},
+				{-2.880299356985691, 2.4022131597398153, -0.6579094645558928},
+				{-2.2615604975802692, 1.7861438450563598, -0.5086364498193016},
+				{-1.6812562611337474, 1.2060841944489826, -0.36746439173989376},
+				{-1.1291078185097387, 0.6524214922213099, -0.23223834041139446},
+			},
+			{
+				{-29.371008730772502, 28.01905623548224, -6.640450385161494},
+				{-21.394704530427454, 20.45017296836193, -4.9002280165206065},
+				{-16.639005918173012, 15.926957805374888, -3.8595330746998755},
+				{-13.401728811144055, 12.838913042812198, -3.1474790888020685},
+				{-11.018328418260664, 10.557466872911105, -2.6196618928400826},
+				{-9.16909438237457, 8.780306369504556, -2.2067737957044486},
+				{-7.678620507893723, 7.341627156622785, -1.8708742193919183},
+				{-6.441442242785402, 6.141778189612312, -1.5892009101762263},
+				{-5.389658133082581, 5.11663208322494, -1.3471315652944174},
+				{-4.4771269109812675, 4.22263771906138, -1.1347532828303983},
+				{-3.6710608308300885, 3.428869741893404, -0.9450399236505737},
+				{-2.947239421825578, 2.7125026182749696, -0.7728156560595131},
+				{-2.287129131278729, 2.056087249752858, -0.6141319201271415},
+				{-1.6760670564039104, 1.445833791338351, -0.46587494580986016},
+				{-1.1020682468453633, 0.8704839356996431, -0.3255081952594408},
+				{-0.5550130888804201, 0.3205423059850789, -0.19089690255401676},
+			},
+			{
+				{-28.149274298238634, 27.160586372988384, -6.496574127216849},
+				{-20.41815798382825, 19.7942501496594, -4.796870688248708},
+				{-15.787988327625088, 15.37266600761916, -3.7759570758602115},
+				{-12.627732097677509, 12.34620074815806, -3.0756692056054984},
+				{-10.296641688057623, 10.106250592773183, -2.555680982386093},
+				{-8.48529693646316, 8.35900402004232, -2.1483878177403826},
+				{-7.023496149740076, 6.9429007487342504, -1.816685380001821},
+				{-5.808699982114328, 5.760648397339223, -1.5382694178674008},
+				{-4.774779230186681, 4.749534301823048, -1.2987887357387904},
+				{-3.8767270052057725, 3.8669044970321904, -1.08850025466138},
+				{-3.082511751665866, 3.082426311467308, -0.9004886927832483},
+				{-2.3684323667079776, 2.373677548191591, -0.7296527454162587},
+				{-1.716319904937842, 1.7234895043956355, -0.5720949946937606},
+				{-1.1117717823241346, 1.118270460311393, -0.42473730499049306},
+				{-0.5429918471365238, 0.5469043343541189, -0.2850683556047546},
+				{-3.572492351305297e-14, 2.4045450227834422e-14, -0.15097163922833132},
+			},
+		},
+		{
+			{
+				{-66.86575431387752, 53.996715151463945, -10.583312528273753},
+				{-50.89246538308155, 40.21393972432645, -7.686729102080447},
+				{-42.225353308306154, 32.712517410057686, -6.106919489077059},
+				{-36.61721061931614, 27.83873358675191, -5.076240878766046},
+				{-32.6212327005649, 24.348130954992314, -4.333748464541876},
+				{-29.59380506273781, 21.687274928474118, -3.7635992564967777},
+				{-27.19944275595852, 19.567784013902013, -3.3055252638863637},
+				{-25.243537730765432, 17.822423890695834, -2.9246247498056},
+				{-23.603992450474944, 16.34629180585156, -2.599029551070493},
+				{-22.19933590667063, 15.069391364036338, -2.314160504717938},
+				{-20.97231491299972, 13.942520569670707, -2.059774326955186},
+				{-19.880756216161544, 12.929413288113356, -1.828320395775244},
+				{-18.892112958136448, 12.002048058695847, -1.6139602678010034},
+				{-17.979975875199294, 11.137644271109451, -1.4119397067426414},
+				{-17.12164970758824, 10.316570792907969, -1.218150743287803},
+				{-16.296272281059036, 9.520715513634235, -1.0287888595875443},
+			},
+			{
+				{-61.395328005239435, 50.217095761427714, -9.967629505879934},
+				{-46.76453258757542, 37.45867269265204, -7.260193839914274},
+				{-38.739254248052255, 30.438523745064636, -5.767231464460977},
+				{-33.515532306208996, 25.850068427735167, -4.787331196574956},
+				{-29.778456752201574, 22.55050275624116, -4.07856112281412},
+				{-26.938175281942815, 20.027332020501994, -3.532619167599766},
+				{-24.685495934185145, 18.012003879919167, -3.092844291148956},
+				{-22.840303934657328, 16.348124786445176, -2.7262856665878537},
+				{-21.2891941372171, 14.937245665102617, -2.412225347370491},
+				{-19.95624196611666, 13.713458012577702, -2.1368058885705046},
+				{-18.78789992701612, 12.63026878358144, -1.8902573278864458},
+				{-17.744553917608837, 11.65326287873659, -1.6653481680438433},
+				{-16.795461167715747, 10.75570298464418, -1.4564568752481757},
+				{-15.915491091869429, 9.9156937795373, -1.2589733136333938},
+				{-15.082838521253072, 9.114187410777394, -1.0688770155232457},
+				{-14.277222441621824, 8.333404881650088, -0.8824019757938721},
+			},
+			{
+				{-56.77531936734874, 47.02223049731863, -9.447092874630002},
+				{-43.245489619320985, 35.10908139279064, -6.896627617014797},
+				{-35.7526753246925, 28.490562487646617, -5.476522403850927},
+				{-30.849766930027332, 24.141582133859348, -4.539448003208074},
+				{-27.329581770563248, 21.002932616062715, -3.8592108890586134},
+				{-24.646522509679606, 18.59603964088584, -3.3337956407010285},
+				{-22.513148047882638, 16.668856818054987, -2.9095611278554085},
+				{-20.761374328114446, 15.074042639130711, -2.555194327243521},
+				{-19.285029318882717, 13.71854789414961, -2.2509443895239984},
+				{-18.01281141156601, 12.539888219818744, -1.9835609071288367},
+				{-16.894264519809948, 11.49383963959236, -1.7436715583014823},
+				{-15.89190928708183, 10.547535061909455, -1.5243119783073087},
+				{-14.976505704933967, 9.675306691275877, -1.3200407696127985},
+				{-14.123984663811516, 8.855990212396412, -1.1263654805325751},
+				{-13.313275194111617, 8.071010947060794, -0.939334332814498},
+				{-12.524570855551097, 7.3028490499937515, -0.7552073522584584},
+			},
+			{
+				{-52.81598397952715, 44.28213140193188, -9.000422523180022},
+				{-40.20571851275916, 33.07924209061687, -6.582607295566966},
+				{-33.1619139528932, 26.80134109753056, -5.224616138094128},
+				{-28.530859518851237, 22.656396873910538, -4.324206563869323},
+				{-25.195038136238768, 19.655245150448565, -3.6684580062453396},
+				{-22.64593098893445, 17.34791135953938, -3.1606882213128835},
+				{-20.614396219287343, 15.496319322545384, -2.749826825090761},
+				{-18.94249981146955, 13.960807949805588, -2.4059578815573377},
+				{-17.530183973673754, 12.652916687135601, -2.1101563347585053},
+				{-16.31005972941836, 11.513072798705593, -1.849690073433322},
+				{-15.234288682997168, 10.498980964610741, -1.6155257496266413},
+				{-14.267198376565684, 9.579087342857813, -1.4009258182071935},
+				{-13.380818735979886, 8.728627547549474, -1.2006005805168531},
+				{-12.55197479344717, 7.927049531371185, -1.0101550236134667},
+				{-11.760211007227413, 7.15616851125841, -0.8256920019329109},
+				{-10.98611716553701, 6.398670821132391, -0.6434889561162941},
+			},
+			{
+				{-49.37943280890491, 41.902342537505724, -8.612192842022377},
+				{-37.549241598929974, 31.305512450420295, -6.308214047356987},
+				{-30.88941162235343, 25.320506749851326, -5.0039159255303},
+				{-26.49177180767582, 21.351669610209232, -4.135305786774288},
+				{-23.314646997171575, 18.469465239904476, -3.5008358060062346},
+				{-20.881058068585947, 16.24839597709339, -3.0084161052737284},
+				{-18.937483217371184, 14.46237991282769, -2.609196161911827},
+				{-17.33467240218201, 12.97836304235213, -2.274468317082384},
+				{-15.977806852950463, 11.711836547507028, -1.9860221256705302},
+				{-14.802852992796067, 10.605735848082146, -1.7315737837681395},
+				{-13.764217643179048, 9.619425772363172, -1.5023825823849986},
+				{-12.827777057804331, 8.72248039640645, -1.2919059611617623},
+				{-11.966647703680277, 7.8909077737621045, -1.0949835358440556},
+				{-11.158417356932636, 7.104675090228024, -0.9073019628265491},
+				{-10.383153750528335, 6.345923493969951, -0.7250081114532201},
+				{-9.62178448960178, 5.597507428511878, -0.5443909866818945},
+			},
+			{
+				{-46.36295093495963, 39.81250436523394, -8.270941018530745},
+				{-35.20340244587596, 29.739698793325175, -6.0659544002251575},
+				{-28.875934763551953, 24.00958383684146, -4.808630490205123},
+				{-24.681002090171884, 20.194465530107017, -3.9679131505564342},
+				{-21.641975436978544, 17.416270741880403, -3.352133156530941},
+				{-19.3090585968717, 15.270723345580915, -2.8732065400968985},
+				{-17.442231975908822, 13.542167915776755, -2.4842235731441016},
+				{-15.899766216539902, 12.103302751513242, -2.1575341821483667},
+				{-14.59138492598891, 10.873062013735032, -1.87555355119774},
+				{-13.455965185689456, 9.796566192648434, -1.6263900518127283},
+				{-12.449861056049135, 8.834624839793992, -1.4015584051596768},
+				{-11.540290574084057, 7.957791107233745, -1.194685475229778},
+				{-10.701309661286391, 7.142740407388534, -1.0007222792946782},
+				{-9.911163695567563, 6.369884965977355, -0.8154246656800564},
+				{-9.150370736261648, 5.621641889075008, -0.6349754046408812},
+				{-8.400151671843197, 4.881006091023743, -0.45567116878998043},
+			},
+			{
+				{-43.688381088301966, 37.95907925282739, -7.96796559158763},
+				{-33.11211947359719, 28.344589957390518, -5.85005614381716},
+				{-27.07539012175756, 22.83861737562031, -4.634261711874779},
+				{-23.058249331753142, 19.158996825120425, -3.8182538319073656},
+				{-20.14053517574183, 16.472603428809354, -3.219046490763033},
+				{-17.896150517050934, 14.393769736557447, -2.7520897254503667},
+				{-16.09687704814448, 12.716003075137264, -2.372189140847274},
+				{-14.607568560765783, 11.317062721877992, -2.052630122452601},
+				{-13.341927892037184, 10.118913168198992, -1.7763811847646087},
+				{-12.241382254819626, 9.068597257606644, -1.5318971716829644},
+				{-11.26399073865558, 8.12819370328248, -1.3109178947461297},
+				{-10.378144531243835, 7.269113236844768, -1.107218795804378},
+				{-9.558718905495596, 6.4686128501828914, -0.9158477244186904},
+				{-8.784530996767867, 5.707489510642549, -0.7326196813912099},
+				{-8.036490006697276, 4.968396377748114, -0.5537470386994185},
+				{-7.296074839991382, 4.234448202662094, -0.3755315707815668},
+			},
+			{
+				{-41.29512690646016, 36.30055920300765, -7.696536325650063},
+				{-31.23133671567271, 27.090946107793204, -5.6559944478949555},
+				{-25.45128502184466, 21.78388130195172, -4.477255480800449},
+				{-21.591428507970278, 18.224721745564576, -3.683328370107866},
+				{-18.78115017152034, 15.62001424775456, -3.098939251257525},
+				{-16.61522553448791, 13.600572398666381, -2.6426869165569133},
+				{-14.875852125519371, 11.968033258610728, -2.2709075871674114},
+				{-13.433700684030214, 10.604650160895188, -1.9577217612955036},
+				{-12.205991602155386, 9.435078543442579, -1.686591727249058},
+				{-11.13640571175051, 8.408066909495206, -1.4462810659681098},
+				{-10.18450722830846, 7.486817903821228, -1.2287295787610595},
+				{-9.319720760011139, 6.643499042680535, -1.027844017853978},
+				{-8.517640979986142, 5.855877579837983, -0.8387570111098643},
+				{-7.757585046212996, 5.105085125640243, -0.6573344734388075},
+				{-7.02080370920138, 4.373978044818709, -0.47981336671434105},
+				{-6.289004201049174, 3.6457745530683687, -0.3024988357857887},
+			},
+			{
+				{-39.135401738833714, 34.8042128746918, -7.451358878417485},
+				{-29.525871674579943, 25.95541253596159, -5.480164186067382},
+				{-23.9742464341125, 20.82627218674651, -4.3347574742348565},
+				{-20.254562058698937, 17.375001463565532, -3.560713604020889},
+				{-17.54008565173508, 14.843486962244747, -2.9896712599955007},
+				{-15.44414260933331, 12.877268328992589, -2.5430593917304836},
+				{-13.758203417466456, 11.285257195492132, -2.1785915791416546},
+				{-12.358121557652309, 9.953730124201476, -1.8711399391738814},
+				{-11.16425149703926, 8.809750728243674, -1.6046108421549925},
+				{-10.122280411605109, 7.803591977701718, -1.3680450305095129},
+				{-9.1931101170666, 6.899458501786002, -1.153561227750205},
+				{-8.347080805342932, 6.070189539949959, -0.9551829597312106},
+				{-7.560421564064336, 5.294002073938512, -0.7681174658220601},
+				{-6.812887904316346, 4.552319579003938, -0.588274644377008},
+				{-6.086028738304927, 3.828173397230473, -0.41191190059959565},
+				{-5.361752376015582, 3.1048707819430112, -0.23533644057958925},
+			},
+			{
+				{-37.1709167618696, 33.44381897164843, -7.228202559578452},
+				{-27.967176478304857, 24.919038124382592, -5.319647744204113},
+				{-22.62023866941709, 19.950155273362736, -4.204438120062252},
+				{-19.026252357921667, 16.596126941907233, -3.448418814604513},
+				{-16.397683477646, 14.130579997342085, -2.8894745834714906},
+				{-14.364476073638805, 12.21231557432221, -2.451597950107585},
+				{-12.72641981966294, 10.656803705177094, -2.0937512158855918},
+				{-11.36401971557393, 9.353948513532561, -1.7914878245884795},
+				{-10.200441737844859, 8.232983233397439, -1.5291162567656458},
+				{-9.183170305498177, 7.245551710642817, -1.2959276423774138},
+				{-8.274302160356855, 6.3567565410374165, -1.0842016768264053},
+				{-7.444991724376465, 5.540035520139903, -0.8880661996910033},
+				{-6.672028365019548, 4.7740026919100265, -0.7027942874392334},
+				{-5.935551003092626, 4.040335571287733, -0.5243337979959882},
+				{-5.217366582189559, 3.3222147671915243, -0.3489589414936573},
+				{-4.499556782469063, 2.6030219916949835, -0.17297773729196592},
+			},
+			{
+				{-35.37051672530848, 32.19804897265354, -7.023635348046813},
+				{-26.531709318897764, 23.9661979356405, -5.172046604427645},
+				{-21.369250672939803, 19.142514669536343, -4.0843640715958145},
+				{-17.888545481323906, 15.87659511978879, -3.344779070940095},
+				{-15.337338379604184, 13.47078263135542, -2.7968607353322557},
+				{-13.360569235416113, 11.595904500440819, -2.3669396884297},
+				{-11.765542342601, 10.073382891379595, -2.015117809049323},
+				{-10.436964183846001, 8.796413209264506, -1.7175700240103966},
+				{-9.30053750241283, 7.696194555153496, -1.458971040937361},
+				{-8.305364542895786, 6.725609585741635, -1.2288393600682064},
+				{-7.414613260782889, 5.8505685790457465, -1.0196000992656573},
+				{-6.6001630770098085, 5.045043521492487, -0.8254745049388134},
+				{-5.8392968970971415, 4.287998280192384, -0.6417936989758329},
+				{-5.112485865287228, 3.5613294505217516, -0.4645380353748558},
+				{-4.401755278267646, 2.848341747137046, -0.2899950751240113},
+				{-3.689329082315295, 2.1324745429204763, -0.1144721248894083},
+			},
+			{
+				{-33.70845281892188, 31.049286488697085, -6.834830983520209},
+				{-25.199720008425817, 23.083790179282314, -5.035356230701182},
+				{-20.20430222359584, 18.392310266110663, -3.9729013201654553},
+				{-16.826059745821755, 15.206553976708813, -3.2483738184761513},
+				{-14.344703379568424, 12.855015478253497, -2.710549029606978},
+				{-12.418791720210793, 11.019495919762173, -2.287903060431997},
+				{-10.862458081003462, 9.526851124309529, -1.9415838120910163},
+				{-9.56422494024106, 8.27327880559774, -1.6483361844025992},
+				{-8.452094761630319, 7.191767671155479, -1.393170049786406},
+				{-7.476631173486026, 6.23632383850425, -1.1658111911326194},
+				{-6.60196379009394, 5.373585293619953, -0.9588164180019525},
+				{-5.800616204043619, 4.578000019810948, -0.7664905883574599},
+				{-5.050302385562929, 3.828837704611345, -0.5842156992589318},
+				{-4.331775404224103, 3.1081799396089638, -0.4079993758317804},
+				{-3.627236783646482, 2.3994289388121315, -0.23413894394405713},
+				{-2.9190150273876303, 1.6860605269609867, -0.058938851809035034},
+			},
+			{
+				{-32.1630900621289, 29.98274458143781, -6.659425247835826},
+				{-23.954318263888116, 22.260620740963876, -4.907870598971816},
+				{-19.110665911818025, 17.68997478177018, -3.868639820472192},
+				{-15.82529067261289, 14.577360375261303, -3.1579624119440473},
+				{-13.40704496488071, 12.275225497912208, -2.6294088952032975},
+				{-11.526926995485583, 10.475440518666336, -2.2134347728119548},
+				{-10.00530930265103, 9.00984723351439, -1.8721529870362386},
+				{-8.734196521723812, 7.777394588903747, -1.5828335813555205},
+				{-7.643683459722015, 6.712706315856571, -1.3307943402019684},
+				{-6.6856561575298885, 5.770809400407788, -1.1059504915353071},
+				{-5.825106356135407, 4.918996927534194, -0.9009781268030389},
+				{-5.0351260041492285, 4.132138465892824, -0.7102566360197988},
+				{-4.293798899606599, 3.389766649749148, -0.5292120016291808},
+				{-3.582107684857211, 2.6741128412685606, -0.3538738157023534},
+				{-2.8823824534874025, 1.9686466154622024, -0.18054513006024556},
+				{-2.1770085328985895, 1.2568523370510407, -0.005524423227273955},
+			},
+			{
+				{-30.715912579440776, 28.985787665058027, -6.495406078940567},
+				{-22.780732995830995, 21.486914779675025, -4.78810690504876},
+				{-18.07523039814581, 17.027002927158666, -3.7703323838511755},
+				{-14.874026452767636, 13.98120892664588, -3.072430474786258},
+				{-12.512687051765115, 11.724037447604221, -2.552410710621037},
+				{-10.673632333736553, 9.956643924562437, -2.14256353553095},
+				{-9.18296222193122, 8.515466022138215, -1.805896167011509},
+				{-7.935871160159777, 7.301983273303021, -1.5201643220939791},
+				{-6.864362026296807, 6.252316741853129, -1.2709694179875095},
+				{-5.921517036263687, 5.3224210311853195, -1.0483999535549398},
+				{-5.073096783456891, 4.480176399856337, -0.8452397622389111},
+				{-4.292687519950046, 3.7008210898642604, -0.6559340271270296},
+				{-3.5586798822868864, 2.964106866350062, -0.4759457742961106},
+				{-2.852228815945933, 2.2523765367669304, -0.3013208718613432},
+				{-2.155737050275147, 1.5491315019516303, -0.12836331631815764},
+				{-1.4515866024059447, 0.8378284290294902, 0.04663871559733899},
+			},
+			{
+				{-29.3507309153321, 28.04739267219887, -6.34102683613195},
+				{-21.665694570211773, 20.753910685679127, -4.674743518066432},
+				{-17.08594648292718, 16.39559516485276, -3.676842248201216},
+				{-13.960819602373709, 13.410797632397015, -2.990742144576411},
+				{-11.65049163644998, 11.194429387553068, -2.478580612444462},
+				{-9.8479206501522, 9.456246362652545, -2.074356409257352},
+				{-8.384486307954562, 8.036939246534963, -1.741908604625878},
+				{-7.158313864396066, 6.840321875708557, -1.4594433888446174},
+				{-6.103147549564636, 5.803887754784207, -1.2128237831053499},
+				{-5.173148033512137, 4.884432123381456, -0.992296514380107},
+				{-4.334754298008938, 4.0503566893685194, -0.7907420656782009},
+				{-3.5619716986842795, 3.277214875535733, -0.6026626646622638},
+				{-2.833430599269281, 2.5449311242902977, -0.42355110082858205},
+				{-2.1303945104013025, 1.8359171152331328, -0.24946323526901185},
+				{-1.4352745886256657, 1.133664990213899, -0.07669841542522154},
+				{-0.7303802335821126, 0.42156103030416414, 0.09847084139767857},
+			},
+			{
+				{-28.053020220314114, 27.1577012313272, -6.1947349040191595},
+				{-20.596884482242565, 20.053500115619308, -4.566565640930687},
+				{-16.131304065894238, 15.788322971693592, -3.587094489301472},
+				{-13.074463668653557, 12.858998294190224, -2.9118936867416774},
+				{-10.809325415797765, 10.679400970296976, -2.40695498845753},
+				{-9.038614457759461, 8.967286635127492, -2.007873698789944},
+				{-7.598596287385685, 7.5672953311651305, -1.6792651427492031},
+				{-6.39009479535909, 6.385398264455615, -1.399754122292736},
+				{-5.348441774815215, 5.360345764680757, -1.1554448383500884},
+				{-4.428763685038804, 4.449690365995315, -0.9367278539961681},
+				{-3.5980877464190817, 3.622289770952532, -0.7365692955813368},
+				{-2.830760706318329, 2.853957348006563, -0.5495194340823568},
+				{-2.10558175227742, 2.124741071904457, -0.371093118254878},
+				{-1.4038558604332079, 1.417076402486494, -0.19734948563951366},
+				{-0.7079377350777563, 0.7144041755523068, -0.024577370644910678},
+				{-4.2356815924310047e-14, 2.9055699502159875e-14, 0.15097163922832518},
+			},
+		},
+		{
+			{
+				{-73.06460779219957, 58.80869030047483, -11.180550504352276},
+				{-56.0859705708434, 44.18582496262987, -8.128279886392553},
+				{-47.033153263595786, 36.349876862229806, -6.4838698575673765},
+				{-41.23354635620859, 31.29659108320032, -5.4148689917805894},
+				{-37.13182187260352, 27.69297774340675, -4.644535659539085},
+				{-34.04413887087973, 24.95297342128694, -4.051341700779213},
+				{-31.616190044914678, 22.772905675366534, -3.572368757936998},
+				{-29.642797516124993, 20.9768487944147, -3.171176656402898},
+				{-27.99479431953048, 19.453946130982228, -2.824790937227643},
+				{-26.585027228176337, 18.12926422572459, -2.517655360806851},
+				{-25.35075707488337, 16.948696174183432, -2.238499385761468},
+				{-24.243596672620892, 15.870315816290066, -1.9785415185703754},
+				{-23.223038922538354, 14.858792742063693, -1.730323310684984},
+				{-22.251507531836477, 13.881083168577858, -1.4868006165980203},
+				{-21.289483569489576, 12.902129526188023, -1.2404227439204332},
+				{-20.289076840846842, 11.879108877806669, -0.9818816678962415},
+			},
+			{
+				{-66.84729108011669, 54.486477856311886, -10.474919167620865},
+				{-51.414280671974815, 41.04910814384533, -7.642069890594161},
+				{-43.07978821975882, 33.75577954283886, -6.096234029213785},
+				{-37.70216495097319, 29.01893708684469, -5.084230772472091},
+				{-33.879482189462394, 25.62412501661383, -4.351452762100007},
+				{-30.989459748166148, 23.032305055701123, -3.7850300548561653},
+				{-28.70758289158616, 20.96235670667906, -3.32615015727103},
+				{-26.844950475778624, 19.25058140773351, -2.9405784201939},
+				{-25.282066050185257, 17.793336067055503, -2.6066309430589287},
+				{-23.93787032597808, 16.520198027634056, -2.309551732411707},
+				{-22.75361294408314, 15.379980900160172, -2.03858241012151},
+				{-21.683553790759138, 14.33265753154037, -1.7852671116438497},
+				{-20.688893680927823, 13.344064223017016, -1.5423357260838457},
+				{-19.73301391241132, 12.381708993037243, -1.3028108822663376},
+				{-18.77662715037301, 11.410444605240684, -1.0590722294323796},
+				{-17.771187701927126, 10.386509666596943, -0.8015486067709783},
+			},
+			{
+				{-61.674047715506916, 50.89329331724022, -9.888728570849702},
+				{-47.48236293192483, 38.41307822939401, -7.2340117924702145},
+				{-39.73319005728194, 31.564095308762614, -5.769305916317649},
+				{-34.702130273806304, 27.08835099639218, -4.80454704279441},
+				{-31.10990457409406, 23.8667302970303, -4.103044191068446},
+				{-28.383907951982366, 21.39835295360539, -3.558994772135756},
+				{-26.223808464888343, 19.420498615107658, -3.116954578423197},
+				{-24.453972689881304, 17.779481732739878, -2.744503808874451},
+				{-22.962811130403274, 16.377579908802623, -2.421021843849565},
+				{-21.67425652645187, 15.148074625595298, -2.1324111210618946},
+				{-20.532826449377428, 14.042171479975586, -1.8683320333423847},
+				{-19.494942229863117, 13.02138431352341, -1.6205903103867012},
+				{-18.523182979804318, 12.052470437128115, -1.382059235214022},
+				{-17.58167177921, 11.103325640763845, -1.1457970062314973},
+				{-16.631235210855944, 10.138620277883321, -0.9040945037885892},
+				{-15.622671670098386, 9.113651098086326, -0.6471143562544586},
+			},
+			{
+				{-57.29236775895245, 47.85334011111179, -9.39303596973683},
+				{-44.1196005654713, 36.16278101534859, -6.88607748409558},
+				{-36.8566599821071, 29.684654406637506, -5.489419875710001},
+				{-32.115376930255415, 25.428176409586342, -4.564513176623608},
+				{-28.71675075650813, 22.3526291552716, -3.889490569496208},
+				{-26.12910405030957, 19.98873795317546, -3.3644373399224436},
+				{-24.072126381517275, 18.089090707464184, -2.936726590991961},
+				{-22.381206184994713, 16.50835607108387, -2.5754598711551915},
+				{-20.951337657660634, 15.153758905247551, -2.2609083379439507},
+				{-19.71061486979881, 13.96167496997655, -1.9795255097476034},
+				{-18.606277673910327, 12.885301119239358, -1.7213197793452082},
+				{-17.596540268906246, 11.887420656627029, -1.4783100945650904},
+				{-16.64511015242513, 10.935526665840955, -1.2434796016187728},
+				{-15.716690947654877, 9.997782286843359, -1.0098997054080223},
+				{-14.77214321736624, 9.038616196005284, -0.7697610209961466},
+				{-13.761638199587656, 8.012411097024055, -0.5129650936483678},
+			},
+			{
+				{-53.52381594659349, 45.242496365056425, -8.967458670964053},
+				{-41.20282821006153, 34.21527757330855, -6.585292040027887},
+				{-34.350445830700735, 28.051683360599398, -5.246631999368208},
+				{-29.85517351109955, 23.982117727909117, -4.355847255952525},
+				{-26.621554349010726, 21.03153495452673, -3.7035651706639086},
+				{-24.15223070500992, 18.7572969981144, -3.1948617259806196},
+				{-22.18374909491717, 16.924939793129504, -2.7795055569855807},
+				{-20.560802769089843, 15.396213384395582, -2.427893799968795},
+				{-19.183953266041886, 14.082539755552894, -2.1210569718509737},
+				{-17.984815305001057, 12.922917311370037, -1.845916573820382},
+				{-16.912928661354705, 11.872223508158754, -1.5927741834772176},
+				{-15.928014401277196, 10.89430059903344, -1.3538246266170673},
+				{-14.994714696465348, 9.957234737798782, -1.122135379248142},
+				{-14.078190714749969, 9.029366394249674, -0.8907748183057809},
+				{-13.139283003232908, 8.07484625691468, -0.6518270164427484},
+				{-12.127582510260039, 7.047190974092216, -0.3949430309754257},
+			},
+			{
+				{-50.23844959202558, 42.97045232536471, -8.597205583127028},
+				{-38.64066981993784, 32.509074052827515, -6.3220603072990755},
+				{-32.139814340416336, 26.615929696549944, -5.033515301579049},
+				{-27.856134082706426, 22.707751876731624, -4.172324287163174},
+				{-24.764902814763584, 19.865385661460635, -3.5398120759570038},
+				{-22.397980644703615, 17.668978354368303, -3.0453479251341635},
+				{-20.50631322433571, 15.895173994969964, -2.640766327593968},
+				{-18.942558666458954, 14.411807223923585, -2.2975827979723475},
+				{-17.612049291794058, 13.13390734611865, -1.997482921209368},
+				{-16.449416279618312, 12.002723298221584, -1.7277905754505147},
+				{-15.406165630655819, 10.97456736312847, -1.4790572119557617},
+				{-14.443298512229168, 10.014174019053751, -1.24362411129663},
+				{-13.526237982217866, 9.090109959328336, -1.0146232105455582},
+				{-12.620506221722769, 8.170823971106433, -0.7851078639624685},
+				{-11.6868863804168, 7.220167363677498, -0.5470527909449416},
+				{-10.674443636335369, 6.1908491107196975, -0.28987235368603365},
+			},
+			{
+				{-47.33914688245548, 40.96977679623562, -8.271263167364491},
+				{-36.36365927912956, 30.997459009690342, -6.089113878406524},
+				{-30.16741507828834, 25.33962357913741, -4.844390252304879},
+				{-26.06776452114969, 21.572338789822812, -4.00915369627116},
+				{-23.100704770122835, 18.824670842645848, -3.3940131660772805},
+				{-20.823299717744078, 16.696510752429262, -2.9120788049212725},
+				{-18.99895022866779, 14.974150022641185, -2.516988576361018},
+				{-17.487214653132774, 13.530713131411014, -2.1812347791663536},
+				{-16.197560413047086, 12.284364547329506, -1.8870744549269287},
+				{-15.06723090724275, 11.178304660830454, -1.6221811139886082},
+				{-14.04942938970299, 10.170082248233738, -1.3773211951509905},
+				{-13.106253181458515, 9.22518896373857, -1.144958744148399},
+				{-12.203780591121635, 8.312580783636283, -0.9182742940905723},
+				{-11.30781578597074, 7.400759755205108, -0.6902970362423563},
+				{-10.379055901241474, 6.453264782717336, -0.4528916226513845},
+				{-9.366092558306104, 5.422057034241332, -0.19525075235547587},
+			},
+			{
+				{-44.751589614130864, 39.18893537980351, -7.981239291034278},
+				{-34.317763588538234, 29.644139227234422, -5.88082273921714},
+				{-28.388204601159607, 24.193131477664352, -4.67481482211186},
+				{-24.450133070060076, 20.55001314131121, -3.862563007045774},
+				{-21.59231884633139, 17.885953366614316, -3.2628293329297344},
+				{-19.393819808218044, 15.818145033501269, -2.7920200803515054},
+				{-17.628929712964606, 14.14134876544977, -2.405364472850761},
+				{-16.16324746298099, 12.733334855236341, -2.076216624280643},
+				{-14.909859654545908, 11.515018553167074, -1.7873368846875939},
+				{-13.808291814403294, 10.43130693004442, -1.526705237528672},
+				{-12.813223034793417, 9.440821851019194, -1.285274443004865},
+				{-11.887693594168958, 8.50969988437792, -1.055611764236271},
+				{-10.998330554394816, 7.607209540181816, -0.8309332248151384},
+				{-10.111151179340935, 6.701859329839738, -0.6042364820363297},
+				{-9.186739544987269, 5.756865297636523, -0.36727635210447285},
+				{-8.173253795816104, 4.7234937873691765, -0.10903840010310448},
+			},
+			{
+				{-42.41762386259469, 37.587668831859155, -7.720599696185503},
+				{-32.45998414784063, 28.42027929764678, -5.6927295387125305},
+				{-26.76594779383279, 23.152652386067814, -4.5212344152227395},
+				{-22.97085418852424, 19.619830369173133, -3.7295090780101248},
+				{-20.20983461768861, 17.030130894230286, -3.1435496395880147},
+				{-18.081337000687533, 15.016059645753826, -2.6826951581413083},
+				{-16.369273969997124, 13.379881740975128, -2.3035919605358686},
+				{-14.944580118198967, 12.003483389497097, -1.9803612073385946},
+				{-13.723535431195979, 10.810212470952248, -1.6962101072395295},
+				{-12.647672749889074, 9.746478555000001, -1.439389148638593},
+				{-11.672960282713149, 8.771838749989232, -1.201013323745602},
+				{-10.763249418540237, 7.85297816797421, -0.9737364857633233},
+				{-9.88562112932589, 6.959410056857243, -0.7507988043970347},
+				{-9.006240288407353, 6.059605778887684, -0.5251598733026632},
+				{-8.085545074097636, 5.116444954023604, -0.2884647913093927},
+				{-7.071277605872566, 4.080536782704406, -0.029504392244257494},
+			},
+			{
+				{-40.290708784847666, 36.13383041892808, -7.484147066483876},
+				{-30.75525881687793, 27.302420455550994, -5.521223357550213},
+				{-25.270708152633315, 22.19856852379332, -4.380732302394913},
+				{-21.602893839509363, 18.764347914344196, -3.6074694229584563},
+				{-18.928070666603453, 16.241158810330525, -3.03390800734221},
+				{-16.86193634497509, 14.275177783235154, -2.582019021003796},
+				{-15.19696910099111, 12.675373755655322, -2.209720979937671},
+				{-13.808852474407196, 11.327306214999311, -1.8918228060426472},
+				{-12.616702165056621, 10.156487695686554, -1.6119310126363735},
+				{-11.563824397293741, 9.110656218394501, -1.3585359960602537},
+				{-10.607314250634685, 8.150184429379065, -1.1228941783513078},
+				{-9.711715768526606, 7.242219716648465, -0.8977312890784649},
+				{-8.844473686149561, 6.356456148334916, -0.676301178495869},
+				{-7.971831352843357, 5.461282356209524, -0.45151879620729884},
+				{-7.054033832346409, 4.519218556371832, -0.21491843835586918},
+				{-6.038389428870314, 3.48022976324425, 0.04489517022127896},
+			},
+			{
+				{-38.33268282332006, 34.80114463950082, -7.267653399790831},
+				{-29.17418344936635, 26.270954629183855, -5.363301805148083},
+				{-23.87695406307304, 21.314205185487328, -4.250843183309604},
+				{-20.322877932675237, 17.96853590876712, -3.4942831731242534},
+				{-17.72500297877872, 15.5050517660149, -2.931941056914351},
+				{-15.714495779902952, 13.582227695049642, -2.488167398201386},
+				{-14.091517499738016, 12.01506266227688, -2.122030670453002},
+				{-12.736004368983945, 10.692413782713766, -1.808960193234039},
+				{-11.569601211922688, 9.54172803543067, -1.5329209629047076},
+				{-10.537182971345715, 8.511919552360633, -1.2826166016728096},
+				{-9.596825002204497, 7.564068583164265, -1.0494263557112806},
+				{-8.713651361385843, 6.665703188111697, -0.8261341101009002},
+				{-7.855377632006426, 5.7866328933197115, -0.605996921399385},
+				{-6.988244334575714, 4.895110024253577, -0.38187747877208056},
+				{-6.072230492225803, 3.95325503979781, -0.1451957068088311},
+				{-5.054140369618815, 2.9103649410090404, 0.11562686088684662},
+			},
+			{
+				{-36.51136022269096, 33.56754866227555, -7.067589710975481},
+				{-27.69123471113015, 25.30894053122114, -5.2163880207430315},
+				{-22.56202442303897, 20.484831950693746, -4.129404562196845},
+				{-19.109675925661588, 17.21887097894066, -3.3880202858559016},
+				{-16.5804102648867, 14.809028882570344, -2.8358679031122276},
+				{-14.619363350296625, 12.924917590709708, -2.3994633664292313},
+				{-13.033629443704632, 11.386991233021563, -2.038920569795227},
+				{-11.70697031500015, 10.087080142704682, -1.730231015194185},
+				{-10.563290573796756, 8.954363195418713, -1.4576823362850575},
+				{-9.548848271846866, 7.938792626558543, -1.2101673528779584},
+				{-8.622559466838254, 7.002053966957104, -0.9791706944929376},
+				{-7.750013030163311, 6.111973104395123, -0.757520706803599},
+				{-6.8990904164790186, 5.238401743680699, -0.5384661356862309},
+				{-6.035924184631716, 4.349386324203335, -0.31480751157144266},
+				{-5.120112282407286, 3.4065786833551166, -0.07784264343901061},
+				{-4.0978066910460855, 2.3585299847422956, 0.18419547476765802},
+			},
+			{
+				{-34.79862720129627, 32.41388823624422, -6.880915697361208},
+				{-26.283261279639532, 24.401107301024386, -5.0781786974789105},
+				{-21.3047501116398, 19.696773785727228, -4.014426462008007},
+				{-17.94306458239377, 16.502489625481342, -3.286861320947207},
+				{-15.474541601915654, 14.140681209984253, -2.7439750267902574},
+				{-13.55701016360201, 12.291103439262708, -2.314264881293799},
+				{-12.003851943487136, 10.779168106001524, -1.958799489258226},
+				{-10.702279063981049, 9.499392853364249, -1.654081206773265},
+				{-9.578211599194228, 8.382504618119057, -1.3846878824584268},
+				{-8.579113908555128, 7.379362712621783, -1.1396789222398955},
+				{-7.664600317614518, 6.452154282156804, -0.9106269285551896},
+				{-6.800595984275843, 5.568911495953932, -0.6903898559529335},
+				{-5.9550181861837235, 4.69943815550426, -0.472194207364244},
+				{-5.093745401163407, 3.8114776794579517, -0.24876443732215106},
+				{-4.1758127288974825, 2.8660995395260365, -0.011261918570237913},
+				{-3.1464550921231402, 1.8109518894538377, 0.2522861559835457},
+			},
+			{
+				{-33.16878724344916, 31.32279758438432, -6.70490196091451},
+				{-24.928039610279455, 23.532912823358355, -4.946503095707725},
+				{-20.084027384484, 18.936502606302053, -3.903960835481921},
+				{-16.802255439971113, 15.8062663670649, -3.188969036058151},
+				{-14.386574667351193, 13.487019206918848, -2.6544869942693707},
+				{-12.506412748367094, 11.667800259527217, -2.230833512769847},
+				{-10.98087570369067, 10.178542209457442, -1.8799519322971692},
+				{-9.700288003165282, 8.916191413816792, -1.57880900090814},
+				{-8.592353311680618, 7.812848543392261, -1.3122421938413749},
+				{-7.6055619954343054, 6.820147208548792, -1.0694548672301938},
+				{-6.700068083772738, 5.900661957314153, -0.8420887519342897},
+				{-5.841975735801368, 5.022520458507577, -0.6230137692972284},
+				{-4.999063812638919, 4.155358832898355, -0.40541587545671437},
+				{-4.13674462041251, 3.2664761590753373, -0.1819230465786366},
+				{-3.2132076143517208, 2.316178352076497, 0.056463960235544626},
+				{-2.1723407716579772, 1.2509421733576163, 0.32195753749000344},
+			},
+			{
+				{-31.59692713605862, 30.277611444385172, -6.536961107217028},
+				{-23.602660353138074, 22.689507421807708, -4.819171246022286},
+				{-18.877071533916016, 18.189539888171474, -3.7959469649769595},
+				{-15.66397323703834, 15.11562483557016, -3.092325791931658},
+				{-13.292515781026472, 12.833189206014568, -2.5653951358391214},
+				{-11.442787626398403, 11.039811332576576, -2.1471552253066744},
+				{-9.939122352292047, 9.569555180985756, -1.8003521924995876},
+				{-8.674640703104147, 8.32155274244659, -1.5023734080977709},
+				{-7.5785944599281665, 7.229101906561469, -1.2382852183534467},
+				{-6.600298769286149, 6.244463816077679, -0.9974102812987424},
+				{-5.700253318679523, 5.330462358927774, -0.7714370806486155},
+				{-4.844533206265001, 4.455175709269238, -0.5532247491711961},
+				{-4.000535098084146, 3.5879057151401814, -0.33589338954194714},
+				{-3.1328987304467195, 2.6953025932273618, -0.11194470227961632},
+				{-2.198556855895402, 1.736641662627895, 0.1278181016310456},
+				{-1.1394486990715684, 0.6568426159522903, 0.3958988165584393},
+			},
+			{
+				{-30.057029004195584, 29.261131318801663, -6.37446096143306},
+				{-22.28138843335365, 21.854378868350768, -4.693779482687673},
+				{-17.65689546031982, 17.438889979679086, -3.6879944006924466},
+				{-14.499544850896648, 14.412754248193242, -2.994493111556832},
+				{-12.161939166467869, 12.160498720751823, -2.4741973693441808},
+				{-10.334037870247851, 10.38759768322233, -2.06066520031398},
+				{-8.844956747197752, 8.931890111471896, -1.7173785922538936},
+				{-7.590298021431151, 7.694449883710649, -1.4221014453102954},
+				{-6.500598583260569, 6.60957597058347, -1.1600950679071913},
+				{-5.5257506392779865, 5.6299782942539105, -0.9207718055051705},
+				{-4.626344105369208, 4.71855014575696, -0.6958380651446447},
+				{-3.768142134898231, 3.8431241386457904, -0.4781115314387077},
+				{-2.9178290520202808, 2.972442068052735, -0.26061190094932934},
+				{-2.038874947952313, 2.072238116297635, -0.03567483417608543},
+				{-1.0864708530356586, 1.1004365844564228, 0.20614012469768758},
+				{-8.18573033040247e-14, 5.436873077672195e-14, 0.4776853802927917},
+			},
+		},
+		{
+			{
+				{-85.22271170636508, 68.35386996653168, -12.655546162095929},
+				{-65.60106839204079, 51.52002452880282, -9.178247399129642},
+				{-55.48087893465104, 42.77117917068664, -7.353005320909062},
+				{-49.136473226022176, 37.23023005865941, -6.180959651826671},
+				{-44.733695934138645, 33.33408537994187, -5.342104830757123},
+				{-41.48223701907666, 30.408852072083842, -4.698481270935549},
+				{-38.97776177445335, 28.1093499697237, -4.179352499743656},
+				{-36.98787708848976, 26.23672133233687, -3.7438547128168262},
+				{-35.366495874238815, 24.665429278980415, -3.3660487260429073},
+				{-34.01416636278287, 23.309400786268142, -3.027979950490175},
+				{-32.85721207420469, 22.104164206518718, -2.716001936925244},
+				{-31.8349653491415, 20.995824920615753, -2.4184824819763926},
+				{-30.88979610334241, 19.932310337276505, -2.1239426725105353},
+				{-29.955797712638187, 18.853225475326546, -1.8188441761338068},
+				{-28.939604046784346, 17.67238914139003, -1.4837108205839276},
+				{-27.67557056811466, 16.236690708025623, -1.0839036763474412},
+			},
+			{
+				{-77.48850565855332, 62.925051055509215, -11.765632751035989},
+				{-59.877578201079395, 47.641787721069846, -8.575267947505825},
+				{-50.64691505881044, 39.57037348198856, -6.873746345522108},
+				{-44.80693865619539, 34.41235045672238, -5.771577586202378},
+				{-40.72607929867347, 30.761532744086868, -4.977913915035885},
+				{-37.693226560523556, 28.004733990822377, -4.365942405688191},
+				{-35.3416084646273, 25.825352718398573, -3.8701320539359023},
+				{-33.45894391915993, 24.03975564601022, -3.4523806538697523},
+				{-31.910808997527077, 22.53120872243452, -3.0883463267086535},
+				{-30.604850195787098, 21.218988787654837, -2.7610507354340594},
+				{-29.471762323791186, 20.041910317378015, -2.45745375392854},
+				{-28.453408633859762, 18.94795022711236, -2.166272644827828},
+				{-27.49318645193991, 17.885695187206334, -1.876146587448358},
+				{-26.524559386978495, 16.79395778730749, -1.5733534374659746},
+				{-25.450882467770626, 15.583246833650735, -1.2376662619131524},
+				{-24.097175545124408, 14.091103635351768, -0.8322697147578685},
+			},
+			{
+				{-71.21294372398462, 58.53400914833129, -11.047147548127072},
+				{-55.162538130054244, 44.45919923866589, -8.081624656583985},
+				{-46.63625048919727, 36.926212679467305, -6.478922594879605},
+				{-41.20038189465453, 32.07596380351559, -5.433153905622933},
+				{-37.37984907996418, 28.62406135957029, -4.676246127465835},
+				{-34.52545394463042, 26.004980252876575, -4.090187924274724},
+				{-32.300031379276774, 23.924726982637935, -3.6135866566942036},
+				{-30.507261220320995, 22.21178260281433, -3.21054558099909},
+				{-29.02203380532672, 20.75644928564195, -2.8580010606386526},
+				{-27.757698134023126, 19.48229003453262, -2.539753880600023},
+				{-26.648477297298694, 18.330742663965143, -2.2432406750330376},
+				{-25.638274185515534, 17.251241032410114, -1.9574382823687337},
+				{-24.67127507874678, 16.19278759640053, -1.671039039564485},
+				{-23.680293286282744, 15.093299588564504, -1.3700901216958437},
+				{-22.56562874323949, 13.860025403432115, -1.033584770617108},
+				{-21.143658519709525, 12.321521246913424, -0.6225458169123386},
+			},
+			{
+				{-66.00326251851101, 54.90205097951414, -10.45389143135644},
+				{-51.19855748118569, 41.79552695293593, -7.669456854799602},
+				{-43.24380175271321, 34.70083524554669, -6.1475672179845935},
+				{-38.139006361851585, 30.10343101779826, -5.148311741392098},
+				{-34.533453434617556, 26.816145976476342, -4.421906677076533},
+				{-31.82763028015518, 24.311850434467125, -3.8574696759184017},
+				{-29.70829003533999, 22.314872773812535, -3.396977646061721},
+				{-27.992075943588524, 20.66355494317526, -3.006335311134351},
+				{-26.561478536398276, 19.253943413904125, -2.6635238459680552},
+				{-25.334517486485716, 18.01310148001744, -2.3529781137345256},
+				{-24.248309624190185, 16.884594919542554, -2.0625159726181472},
+				{-23.248417260025725, 15.81900113254141, -1.7813080653083841},
+				{-22.279620643016926, 14.7655537793493, -1.4980539398392458},
+				{-21.274052953209644, 13.661202326782693, -1.1985404244471},
+				{-20.12913688717644, 12.410005217325763, -0.8609724556815213},
+				{-18.653303598763127, 10.83199958735598, -0.4442727306729234},
+			},
+			{
+				{-61.59422710924755, 51.84134013791432, -9.954813101904007},
+				{-47.806965412144784, 39.52830558021243, -7.319511160849241},
+				{-40.32535526396194, 32.79745954490595, -5.8650004534260365},
+				{-35.49687820716657, 28.411562782310934, -4.904795140573365},
+				{-32.07198606712902, 25.262848770505197, -4.204132642080438},
+				{-29.49191096581699, 22.855779121507666, -3.658026614486206},
+				{-27.463126509720095, 20.92982863800592, -3.2112519934777404},
+				{-25.813009212708653, 19.331517746708872, -2.8312126122115915},
+				{-24.430363624058828, 17.961665431968157, -2.496758771339779},
+				{-23.23709808254983, 16.750239584007137, -2.1928498256771576},
+				{-22.17273613656641, 15.642555626418273, -1.9076126933697908},
+				{-21.18420272527734, 14.590094079052049, -1.630362677236725},
+				{-20.216715982187903, 13.542179650095472, -1.349773530413253},
+				{-19.201703710360793, 12.434746852068594, -1.051353508991951},
+				{-18.033872805324147, 11.168755564417042, -0.7125246934262185},
+				{-16.51442647500461, 9.556272083095864, -0.29020481833549927},
+			},
+			{
+				{-57.79951291623428, 49.22021418374138, -9.528212610826428},
+				{-44.85920306195947, 37.56960343213512, -7.018005058968446},
+				{-37.77591850589606, 31.145771454164095, -5.620589307630482},
+				{-33.18168052843547, 26.93950218988085, -4.693665177566807},
+				{-29.910859729703848, 23.90911801741764, -4.01504056121677},
+				{-27.438713308098997, 21.585535546351196, -3.4846909210198125},
+				{-25.488255649154848, 19.72096067484508, -3.0497531086413145},
+				{-23.8958953131815, 18.168804140725477, -2.6788980189336593},
+				{-22.555762492397587, 16.83390860164585, -2.3517096136108075},
+				{-21.393048161280813, 15.648684557604886, -2.053586988448403},
+				{-20.349301250549953, 14.559901940367725, -1.772910367800279},
+				{-19.37257384617524, 13.519756280741648, -1.4991021609699346},
+				{-18.408395866654462, 12.477554887491465, -1.2207860942068418},
+				{-17.38746980216456, 11.368187316365871, -0.9231810105928354},
+				{-16.201937311893605, 10.089619072098756, -0.5829403974947189},
+				{-14.646487690823179, 8.446535099755723, -0.15509218779386572},
+			},
+			{
+				{-54.48364555339352, 46.943200528281835, -9.1584077876628},
+				{-42.25971297354146, 35.85423952933026, -6.754757787343326},
+				{-35.51654798816511, 29.693048614133453, -5.406389497370067},
+				{-31.12350151391578, 25.641291445886115, -4.508195627657698},
+				{-27.985714778268395, 22.71318494777287, -3.848670483451192},
+				{-25.60730242500883, 20.462132403933122, -3.3320269164480885},
+				{-23.72535753797888, 18.651192230675388, -2.9074234144208937},
+				{-22.183994844586078, 17.139657478967578, -2.5446159351576085},
+				{-20.881894417678975, 15.835804904246475, -2.2238153820033726},
+				{-19.747050184498566, 14.674121511652904, -1.930793612976863},
+				{-18.722731310883173, 13.602596241602656, -1.6541400870509466},
+				{-17.757925541416714, 12.57398851194683, -1.3833548454093783},
+				{-16.798368918295964, 11.537500853760605, -1.1069931409092766},
+				{-15.774019181713602, 10.426956449607832, -0.8099779060115704},
+				{-14.574586521775124, 9.137431506055641, -0.4682128235055668},
+				{-12.98891376892529, 7.46681204610007, -0.03495825009538435},
+			},
+			{
+				{-51.54491980111542, 44.93887857765651, -8.833714440803922},
+				{-39.93518173774148, 34.332392919543054, -6.52202104839213},
+				{-33.48592234414731, 28.398496014846895, -5.216281220738272},
+				{-29.267580565651798, 24.48107500547005, -4.343162472822757},
+				{-26.24584134114324, 21.642271136898263, -3.7003664616512957},
+				{-23.949625754208505, 19.45484748453515, -3.1957704338845256},
+				{-22.12816285386738, 17.691225324840897, -2.7802837928335307},
+				{-20.632220214921766, 16.215775675543423, -2.424601996300898},
+				{-19.364406568563187, 14.939735648672208, -2.1094770989514373},
+				{-18.25513067180895, 13.79937210715233, -1.8209996176761214},
+				{-17.249131253350182, 12.743700035630056, -1.5479324979326419},
+				{-16.296170177308674, 11.725916690877613, -1.2798294374773482},
+				{-15.342094741506335, 10.695044211999258, -1.005162254780288},
+				{-14.316090844829208, 9.583817439224106, -0.7085532990293489},
+				{-13.105541012861616, 8.284514565345953, -0.3651758201213301},
+				{-11.494012362464078, 6.588748165872808, 0.07336006361346803},
+			},
+			{
+				{-48.904499477620874, 43.152158480812496, -8.545165724956533},
+				{-37.8274316164549, 32.964736557318936, -6.313712162258612},
+				{-31.634649167589565, 27.229434565452635, -5.045390278865102},
+				{-27.5693295475065, 23.42982164650142, -4.194360817581538},
+				{-24.649612532650956, 20.669622161350155, -3.566350733192817},
+				{-22.425997556683466, 18.538452523961634, -3.0724404553345073},
+				{-20.658290384177263, 16.816894546713193, -2.665071044179992},
+				{-19.20305899407773, 15.373743073174754, -2.31575881813198},
+				{-17.96633264159598, 14.122806687041823, -2.0057265656928114},
+				{-16.880612607753406, 13.001885467314006, -1.7213386697274928},
+				{-15.891893883263634, 11.9608567520465, -1.4515016032088184},
+				{-14.950568455305769, 10.953244582701567, -1.1858021905933678},
+				{-14.002500327712118, 9.927817790174934, -0.9126153613716819},
+				{-12.976057085864607, 8.816189737666829, -0.6162576380728778},
+				{-11.756347088354874, 7.507899782818381, -0.27118775856004845},
+				{-10.122079042228409, 5.788704491459558, 0.17253300013253323},
+			},
+			{
+				{-46.49910481379573, 41.53912710246927, -8.285661601697546},
+				{-35.88842204243669, 31.719036844465748, -6.12488174819917},
+				{-29.921125972721914, 26.158550052272446, -4.889673553180141},
+				{-25.99062180629927, 22.462900580783593, -4.058250819317444},
+				{-23.161015491073165, 19.772273363321126, -3.443406013205349},
+				{-21.001773613378447, 17.69109661788852, -2.959045360189929},
+				{-19.282005780009715, 16.00712495138896, -2.558960811212884},
+				{-17.863376423982626, 14.593034031201558, -2.215390889818026},
+				{-16.654908970979136, 13.364876554560116, -1.9099699184660281},
+				{-15.590920207209477, 12.261773805588206, -1.6292978935648808},
+				{-14.618470504287684, 11.23431970828013, -1.3623985549983404},
+				{-13.68844294642833, 10.236259268007704, -1.0988729186771165},
+				{-12.746611017606462, 9.21602746816028, -0.8269850782550671},
+				{-11.720440655281394, 8.104057887930528, -0.5307372102295072},
+				{-10.492738008686583, 6.787153198400577, -0.18388128462224543},
+				{-8.83754496486296, 5.045465024783458, 0.264995909552044},
+			},
+			{
+				{-44.27575905814411, 40.063377941695826, -8.049368483955833},
+				{-34.076387689434796, 30.56755561046832, -5.951311809275134},
+				{-28.308173223211245, 25.161683424405396, -4.7455905983886035},
+				{-24.496653849867858, 21.558059280000705, -3.9316666742313315},
+				{-21.746627496292824, 18.92912961974977, -3.328606651027492},
+				{-19.64438301361803, 16.89244392985602, -2.852828496659764},
+				{-17.967275126245315, 15.24210224512872, -2.4593231177613886},
+				{-16.581469114886158, 13.854199250101505, -2.1209672677962725},
+				{-15.398612639282605, 12.6467471108463, -1.8197555036371855},
+				{-14.354588006983933, 11.559997449696992, -1.5424893520066245},
+				{-13.397337246154727, 10.545121656984712, -1.2782852765471353},
+				{-12.478085182689748, 9.555973766548073, -1.0167391505640677},
+				{-11.542376035887466, 8.540552710280041, -0.7459873356771367},
+				{-10.516625418423093, 7.428007595684336, -0.4497022009203689},
+				{-9.28117579123606, 6.102311170801275, -0.10092201349337905},
+				{-7.605256175346061, 4.338012854712946, 0.3532072292248719},
+			},
+			{
+				{-42.187563202898495, 38.69310518379237, -7.831251357950336},
+				{-32.352382082712374, 29.48476692466309, -5.789169313092339},
+				{-26.75979537476911, 24.215745345565043, -4.6098000770953025},
+				{-23.052756091503554, 20.693407713533652, -3.8115325998409233},
+				{-20.372407419211847, 18.118966319538423, -3.2190445646106016},
+				{-18.320070150072404, 16.12166734558784, -2.7509996040568234},
+				{-16.680445159578884, 14.501248079024899, -2.3634570804018473},
+				{-15.323679228386101, 13.136817530660359, -2.0298583995960953},
+				{-14.16370798810398, 11.948088592445574, -1.7325120174203956},
+				{-13.137737085891496, 10.876259532936754, -1.4583886948558404},
+				{-12.194394363039317, 9.872932920178084, -1.1966724899101093},
+				{-11.285063888161424, 8.891937496299887, -0.9369317284298369},
+				{-10.354858618793742, 7.880689638544616, -0.6671515107386865},
+				{-9.32887859600178, 6.766866456766354, -0.3706461292935419},
+				{-8.084612707014914, 5.43135531230671, -0.0197080272698893},
+				{-6.385787606113142, 3.6427158595857754, 0.4399860034690635},
+			},
+			{
+				{-40.18963411705489, 37.39837672884039, -7.626645638737494},
+				{-30.676495256848476, 28.44491721594846, -5.6346453720501595},
+				{-25.237311731359714, 23.29627952603445, -4.478813598274405},
+				{-21.62032125636865, 19.84489690365401, -3.694515529968903},
+				{-18.999387388955725, 17.31779535545418, -3.1114756176614837},
+				{-16.98935117861087, 15.354699277738543, -2.6503738534587695},
+				{-15.381481742400515, 13.760363584052644, -2.268223233834259},
+				{-14.049436958836203, 12.416542971392014, -1.938962790509052},
+				{-12.909113109735804, 11.244399944032837, -1.6451703363126515},
+				{-11.898778615389789, 10.18588425395165, -1.3739522822757975},
+				{-10.967508153771945, 9.192854570387464, -1.1145311230344717},
+				{-10.066588478605661, 8.218929313538833, -0.8564177288363637},
+				{-9.140375461846835, 7.210709550179621, -0.5874092543254337},
+				{-8.112170982534058, 6.094060390096942, -0.29041011591052307},
+				{-6.855803012608472, 4.746237099116768, 0.06310974684778661},
+				{-5.127843081108932, 2.928750429646766, 0.5290739812353713},
+			},
+			{
+				{-38.234223941731805, 36.14794910122917, -7.430772089764633},
+				{-29.00266078899655, 27.418750947382282, -5.483483011779778},
+				{-23.693521691331107, 22.37385572834851, -4.348493807642771},
+				{-20.150243686591647, 18.98231927628445, -3.576483807239079},
+				{-17.576405475640723, 16.494483112618756, -3.0017402888743865},
+				{-15.59910571914351, 14.55950660434501, -2.5467599071643594},
+				{-14.015502301120646, 12.986609314914027, -2.1694053025513407},
+				{-12.702313508470443, 11.659835302230983, -1.8440481108191282},
+				{-11.577039675949447, 10.50152519395838, -1.5534879626802904},
+				{-10.578684739669907, 9.45413996223279, -1.2849266806056567},
+				{-9.656426153848388, 8.469548335481687, -1.0275855225641863},
+				{-8.761038098668084, 7.500865345035584, -0.770871794072158},
+				{-7.83554251285566, 6.4934714378565435, -0.5023318091888476},
+				{-6.800530726615021, 5.370783398928974, -0.2043631945183277},
+				{-5.524547205245513, 4.005320408476164, 0.15254680489441985},
+				{-3.753506645712954, 2.149228329941878, 0.6262399449964671},
+			},
+			{
+				{-36.263402192278605, 34.90460717441706, -7.238045833791413},
+				{-27.26973739628157, 26.36797632065948, -5.330193119456731},
+				{-22.061768055737208, 21.40738808798371, -4.213136858596011},
+				{-18.569953658426233, 18.061482482936146, -3.451459481562824},
+				{-16.025288459117352, 15.6018979574372, -2.8836046067806578},
+				{-14.06616379483072, 13.686372207473655, -2.4337125933785355},
+				{-12.49503285895597, 12.128081098338738, -2.0603962080611806},
+				{-11.191183783424268, 10.812966269340897, -1.7383876721690357},
+				{-10.073243829441797, 9.664189828031377, -1.4506472509301451},
+				{-9.080442656713824, 8.624360056536451, -1.1844131797051582},
+				{-8.161466918908273, 7.644942450605403, -0.9288408663799343},
+				{-7.265812951576987, 6.678020137261393, -0.673152758476157},
+				{-6.334061636278448, 5.666988229881609, -0.40452729064369436},
+				{-5.282301810688949, 4.531581855203687, -0.1046685185433411},
+				{-3.970565945224409, 3.1373996770928314, 0.25723914239838946},
+				{-2.127245340825081, 1.2226473265710136, 0.7416063404223768},
+			},
+			{
+				{-34.195467173512675, 33.6166448404041, -7.040834982250851},
+				{-25.382576411144147, 25.233621203158226, -5.166421244571359},
+				{-20.23108750733633, 20.32906581059994, -4.063419407786536},
+				{-16.75303325996722, 17.00600902963402, -3.3092002600302073},
+				{-14.205783840822399, 14.556136563644726, -2.7460617789510686},
+				{-12.238520669034504, 12.645148833078458, -2.299638185197706},
+				{-10.65839454878504, 11.089621594212748, -1.929176215580733},
+				{-9.346512370489462, 9.77680419042724, -1.6096616139562852},
+				{-8.221743825800118, 8.630043117337753, -1.3241099614871896},
+				{-7.222526946807829, 7.591392319243526, -1.0596862752402798},
+				{-6.295854389529915, 6.611098307079439, -0.8053559866556171},
+				{-5.388412581265667, 5.639173508762025, -0.5499991888401514},
+				{-4.436187970380349, 4.615557154309648, -0.2802019965563156},
+				{-3.3474543896492532, 3.4540353377901245, 0.02337103207572984},
+				{-1.9683001706433068, 2.009600059883354, 0.39339100144294303},
+				{-1.9943012997945226e-13, 1.3907044065222248e-13, 0.8938140096034137},
+			},
+		},
+		{
+			{
+				{-103.464159393959, 82.71676480437314, -15.008404845372961},
+				{-79.13822122129761, 61.97547959807474, -10.784402694577215},
+				{-67.22904305743243, 51.7115337779641, -8.661144233603602},
+				{-60.027818663733505, 45.41161714653271, -7.329366941649574},
+				{-55.20081878168175, 41.101691517130206, -6.39184175337387},
+				{-51.77355287113176, 37.95596221601243, -5.682020668313605},
+				{-49.2593470934984, 35.56048469053386, -5.1159786717586755},
+				{-47.38469063459321, 33.680935277171244, -4.64570156853718},
+				{-45.981515864470765, 32.17139075797603, -4.240701869679162},
+				{-44.937866086630144, 30.932361145979648, -3.879505518751489},
+				{-44.17170318298831, 29.88823013450695, -3.544992718735975},
+				{-43.613597756911545, 28.971930907118537, -3.2211098770981734},
+				{-43.18971242956669, 28.109310702889065, -2.8893507048401084},
+				{-42.793215664893516, 27.192344863100328, -2.522587186633554},
+				{-42.20870217505771, 26.00829360575784, -2.0687598772539624},
+				{-40.81416218640066, 23.96084239514633, -1.386846364453671},
+			},
+			{
+				{-93.15617659380328, 75.3888664592425, -13.800318337380302},
+				{-71.74353391565046, 56.905195021647486, -9.99243078908106},
+				{-61.02996995504855, 47.558846190933934, -8.037103216199291},
+				{-54.470029519715254, 41.75203328932543, -6.796391176939163},
+				{-50.02803222728948, 37.74203919069774, -5.915708624367852},
+				{-46.8412913955411, 34.78912023370161, -5.2442415268830915},
+				{-44.47414493874181, 32.51845581249148, -4.705215302641811},
+				{-42.679077284080904, 30.715720802305018, -4.2543139252871685},
+				{-41.30195948556119, 29.245879220365303, -3.8631342137886873},
+				{-40.23812409215768, 28.015385013581632, -3.511438830917811},
+				{-39.40857853244697, 26.951481089891278, -3.1828442137802373},
+				{-38.743639960709366, 25.987605254541545, -2.8616757354917652},
+				{-38.1657802975101, 25.04760116386825, -2.529423376084429},
+				{-37.55929417685485, 24.017314524351978, -2.1582321714912958},
+				{-36.688192110334185, 22.667442476597515, -1.693158507339708},
+				{-34.8627302897556, 20.339602964897395, -0.9798341187882408},
+			},
+			{
+				{-85.10044175384621, 69.69445679132978, -12.864311551828374},
+				{-65.83785474947724, 52.88205424416579, -9.366217156494834},
+				{-56.033238413210015, 44.23500676401457, -7.539517494914991},
+				{-49.96975069915769, 38.81060918054361, -6.369703463215741},
+				{-45.83084053612911, 35.03685456448427, -5.533861175388137},
+				{-42.837440938032415, 32.238544814779985, -4.893044054432905},
+				{-40.592570695561584, 30.070576010284285, -4.375929823925047},
+				{-38.86872779167964, 28.334010100741228, -3.941048664004494},
+				{-37.522755858181185, 26.902329408007887, -3.5616147311365616},
+				{-36.4559784890968, 25.686768060195853, -3.2183557822688154},
+				{-35.5922365222769, 24.617008174543376, -2.8954591601923316},
+				{-34.862234190253886, 23.62710565850963, -2.577521548163089},
+				{-34.18623184249725, 22.639482425460546, -2.245949465619503},
+				{-33.44216729246246, 21.534966481755898, -1.8720907134860976},
+				{-32.37734975285018, 20.069387970432714, -1.398006649708158},
+				{-30.239451146117432, 17.523697718159987, -0.6554545081696221},
+			},
+			{
+				{-78.6103371304187, 65.13693502809744, -12.117478582572982},
+				{-60.99653128002344, 49.60895390701925, -8.858656968510049},
+				{-51.905485819480496, 41.511709091142286, -7.133517468578535},
+				{-46.23761279844245, 36.392294172161144, -6.020417379202264},
+				{-42.34368443701242, 32.80942211279425, -5.220833996280497},
+				{-39.50935133520698, 30.137947638022165, -4.605068588325508},
+				{-37.367893821246426, 28.0560343607459, -4.106082535392026},
+				{-35.7076500880585, 26.37690828072013, -3.684656915045404},
+				{-34.39428865368894, 24.98086450195176, -3.3152911001913994},
+				{-33.33408852091077, 23.783024166751265, -2.9794799439703326},
+				{-32.45339449581889, 22.715107565240753, -2.6618567691802233},
+				{-31.68350266417338, 21.711731504984687, -2.3472053512328523},
+				{-30.94317601019062, 20.694137120493746, -2.016788099415067},
+				{-30.10532891936856, 19.538713880754965, -1.6411115123180808},
+				{-28.902744213690763, 17.98740533192274, -1.159194999608207},
+				{-26.524628105858827, 15.262316451869648, -0.3888455176387506},
+			},
+			{
+				{-73.2517302042317, 61.40327970611851, -11.507714566006332},
+				{-56.940288857180285, 46.8911042311011, -8.43895834170188},
+				{-48.423760722802534, 39.236733088600815, -6.795922278584115},
+				{-43.078492602946234, 34.365969718041875, -5.729169829856819},
+				{-39.386849340458184, 30.940519601213918, -4.959493656980407},
+				{-36.68593483485397, 28.375068296982516, -4.364586016276232},
+				{-34.633297615710404, 26.366435524039286, -3.8808531657732916},
+				{-33.03009570504086, 24.737672346122903, -3.470894796391316},
+				{-31.749226949478516, 23.374622727991227, -3.110251311321319},
+				{-30.701110122901774, 22.19560640713002, -2.7810369521746625},
+				{-29.814267479722314, 21.134039928074774, -2.4682364656030042},
+				{-29.02062449049934, 20.125000494320542, -2.1567572592109885},
+				{-28.237828867459083, 19.088660843753274, -1.8276755843637846},
+				{-27.33457754642581, 17.897335848764552, -1.450636067490021},
+				{-26.030587081899856, 16.279816330074954, -0.9616231021134577},
+				{-23.46091702190376, 13.402064728521497, -0.1647966867925351},
+			},
+			{
+				{-68.73480384113284, 58.28530223276622, -11.000481168738727},
+				{-53.47642578637908, 44.594626065856666, -8.086032411116133},
+				{-45.431933147315654, 37.30391164632, -6.510621611435564},
+				{-40.35470778479852, 32.63949705707708, -5.482401862600578},
+				{-36.83306483571483, 29.346067845376403, -4.737798533373729},
+				{-34.24590739676179, 26.870658782151626, -4.160529259145764},
+				{-32.270639301089034, 24.925332865275887, -3.689822047136758},
+				{-30.71898540482706, 23.341214953400648, -3.289771114386295},
+				{-29.469859632826775, 22.008736932081234, -2.9367731847619942},
+				{-28.43717637964874, 20.848880296708895, -2.6134492205246342},
+				{-27.55134655357646, 19.796476751454875, -2.305063076841217},
+				{-26.7449000111186, 18.78696292470219, -1.9965984400258774},
+				{-25.934595902466967, 17.739506116302007, -1.6689178336105912},
+				{-24.98546126215437, 16.522615837256005, -1.2908008432620302},
+				{-23.605277907947976, 14.852580509111384, -0.7952439477526453},
+				{-20.87959680253773, 11.842634661884388, 0.026669426753438936},
+			},
+			{
+				{-64.85683648896539, 55.63810121371057, -10.571839451243049},
+				{-50.46610507610368, 42.623585487213546, -7.784835263209671},
+				{-42.81591498608217, 35.63610597830205, -6.265963838558196},
+				{-37.9648643685237, 31.145418214564565, -5.270232199012908},
+				{-34.5882140703295, 27.96425989218019, -4.5469370016263175},
+				{-32.09942913859349, 25.566369929352803, -3.9847857893345897},
+				{-30.192428920617132, 23.676455832737858, -3.525351873364327},
+				{-28.6877817671563, 22.132369999418522, -3.1339743463643766},
+				{-27.4694757042015, 20.828384757185713, -2.787763000628188},
+				{-26.454407221508873, 19.68771846681192, -2.469757724193482},
+				{-25.574630123564766, 18.64638881582813, -2.165445344534748},
+				{-24.76323273306689, 17.640102922885756, -1.859850357018722},
+				{-23.93623355319061, 16.587065952955708, -1.5336043263989259},
+				{-22.955396317585716, 15.352203074501809, -1.1546302579394843},
+				{-21.517522292980694, 13.639914474324053, -0.6530106135907594},
+				{-18.66333665797022, 10.514440753837041, 0.19253359907353562},
+			},
+			{
+				{-61.46972546123028, 53.356569251276625, -10.204521563782837},
+				{-47.804938923877415, 40.906418489221224, -7.524212684679073},
+				{-40.488619770520096, 34.174919068098255, -6.053183487267758},
+				{-35.83084997811755, 29.832205545534556, -5.085160734440732},
+				{-32.579385251882584, 26.74762843742149, -4.380184285340818},
+				{-30.176697633247166, 24.417263311646025, -3.8311460612811508},
+				{-28.330636892264895, 22.576435152866996, -3.3815912740950385},
+				{-26.869267931689873, 21.068664715757286, -2.997906892634428},
+				{-25.680906846487602, 19.791470545476628, -2.657802047531354},
+				{-24.6850167545694, 18.66996539466315, -2.344665604657},
+				{-23.81508630189145, 17.641164464951746, -2.04416289147998},
+				{-23.00471031065833, 16.6409692070329, -1.7413321575602099},
+				{-22.16926855344681, 15.58665555309963, -1.4165635470783815},
+				{-21.167577279136854, 14.339751613432131, -1.0369384517344822},
+				{-19.68628008381667, 12.593343039033773, -0.5297129833397124},
+				{-16.725628328195064, 9.36630270504126, 0.3380110124481508},
+			},
+			{
+				{-58.46033036137005, 51.361257646959345, -9.885577714603565},
+				{-45.4106494658957, 39.38736570606709, -7.295547888358639},
+				{-38.38010572397462, 32.87400942946604, -5.865385658512124},
+				{-33.88913023557398, 28.658458477389882, -4.921214708579044},
+				{-30.7467681673822, 25.657716500478216, -4.232140044884552},
+				{-28.420153463274335, 23.386743912309264, -3.694597106445253},
+				{-26.629025806293154, 21.589867211664156, -3.253803594252351},
+				{-25.207871573662384, 20.11542290175508, -2.877035162791664},
+				{-24.048730539395777, 18.86369180774927, -2.5425077023762115},
+				{-23.073288140584033, 17.76139791841767, -2.2339018132314266},
+				{-22.216287516675415, 16.746394576601336, -1.9370249859743986},
+				{-21.411773620740387, 15.75470943146966, -1.6369076531666364},
+				{-20.574553747403407, 14.70271052317738, -1.3136916307345754},
+				{-19.560735963657113, 13.448679353571675, -0.9336331752561495},
+				{-18.047485120332222, 11.674885270457287, -0.4212498364220878},
+				{-14.99823670509336, 8.357969732715443, 0.46729832304378},
+			},
+			{
+				{-55.73768048937795, 49.58927568917999, -9.604873335143235},
+				{-43.214415131716365, 38.020547233438876, -7.091836075956302},
+				{-36.430289100505654, 31.694225035288785, -5.696816155627827},
+				{-32.08407832285802, 27.588530303683704, -4.773313979179486},
+				{-29.037293992095627, 24.660967660731494, -4.098148650858836},
+				{-26.77827321473709, 22.442596836703917, -3.5707777530573024},
+				{-25.0370007480409, 20.685426767194713, -3.1378454145828405},
+				{-23.65350396878426, 19.241904373106035, -2.767383748373752},
+				{-22.523040620134196, 18.014665696811782, -2.4380376344881256},
+				{-21.569220799879925, 16.931802248426653, -2.1337307596633046},
+				{-20.727875773837077, 15.931862766981686, -1.8403815317436363},
+				{-19.93343243126745, 14.950932097060884, -1.542993701145768},
+				{-19.10015096891867, 13.904471032910035, -1.2214540370913685},
+				{-18.081581746825535, 12.647610096446213, -0.8412058394487356},
+				{-16.545884305930876, 10.852160367624075, -0.32409983379549495},
+				{-13.422289321539411, 7.454837990458894, 0.5841110708244154},
+			},
+			{
+				{-53.223582875029216, 47.98774401877415, -9.35402506438885},
+				{-41.15363289334835, 36.76512671480473, -6.906944326645442},
+				{-34.58233970140505, 30.59930643262682, -5.542231018573853},
+				{-30.361609154695564, 26.588464759673858, -4.636694359552181},
+				{-27.39835520269519, 23.72477575026781, -3.9737542413490408},
+				{-25.199305142516327, 21.553093231334227, -3.4554548692829803},
+				{-23.503325787223748, 19.831999120148108, -3.0296583799362993},
+				{-22.15523756105541, 18.417445409484742, -2.665039531501696},
+				{-21.05307115466604, 17.214064322168944, -2.3406039325046653},
+				{-20.12208453141961, 16.151090932382875, -2.0404745579650028},
+				{-19.299025015909137, 15.167627855083909, -1.7506514657360435},
+				{-18.518603339444144, 14.199732367217408, -1.456092344819116},
+				{-17.694494288340557, 13.16191816477795, -1.1364179895296682},
+				{-16.677707686831685, 11.906166576716995, -0.7562582850778209},
+				{-15.127535332727733, 10.09393579199674, -0.23483010948217065},
+				{-11.940106771526231, 6.623102131250775, 0.6922044297785765},
+			},
+			{
+				{-50.84387773932733, 46.50790149926518, -9.125470152637956},
+				{-39.16396308326924, 35.5801713165839, -6.734847933294962},
+				{-32.77476603497053, 29.550898580466104, -5.396184936745534},
+				{-28.661105659153794, 25.620995321878592, -4.5062164293817935},
+				{-25.769515966013838, 22.812417882381716, -3.8540188254233647},
+				{-23.622768337555918, 20.68184924954856, -3.3438477615908195},
+				{-21.967445200543352, 18.993477897380174, -2.924600402649176},
+				{-20.65249307863249, 17.606215942299052, -2.565491525802743},
+				{-19.578292793606945, 16.42635210854548, -2.245823569742934},
+				{-18.67146046682439, 15.38403642585603, -1.9498755581378584},
+				{-17.869447926693297, 14.418760999165045, -1.6636977217890663},
+				{-17.107082569453272, 13.466426733538576, -1.3721768541543697},
+				{-16.297295712546635, 12.440478060615932, -1.0546452312413497},
+				{-15.288333952009797, 11.18957268809078, -0.6748914773701751},
+				{-13.730146462514961, 9.364450768504941, -0.14945379473427156},
+				{-10.484462697397532, 5.823332881208846, 0.7961122341036128},
+			},
+			{
+				{-48.517061174897705, 45.09772819527066, -8.911342692045425},
+				{-37.16758211680919, 34.41729503431133, -6.568568580687867},
+				{-30.92876571898441, 28.500784899503113, -5.251949724920026},
+				{-26.901721143568068, 24.63725111610627, -4.375242509019269},
+				{-24.067815659161433, 21.87425088248217, -3.7323587983904436},
+				{-21.963906323293788, 19.778596658394193, -3.229434881459135},
+				{-20.34327926985262, 18.119219440998116, -2.8162365051035896},
+				{-19.05837835005882, 16.75747098400352, -2.4624198229511602},
+				{-18.011482187471742, 15.600937713827, -2.147517649826526},
+				{-17.13020032951078, 14.58040812710126, -1.8559152002770987},
+				{-16.35236318104442, 13.63552586019436, -1.5736711517788058},
+				{-15.612582405635292, 12.701797237393146, -1.2855608830948893},
+				{-14.822625187556904, 11.691292953129757, -0.9705780947732469},
+				{-13.827243625849823, 10.448800972670899, -0.591582904040355},
+				{-12.265283595577081, 8.613007159181857, -0.062229073242329194},
+				{-8.958082021789332, 4.998117025963145, 0.9026361975661312},
+			},
+			{
+				{-46.13464820053267, 43.68959239745164, -8.701646082997657},
+				{-35.05059830188117, 33.20680018699968, -6.398212404269094},
+				{-28.921616260940624, 27.37479036025658, -5.099303817613582},
+				{-24.951655123745756, 23.55837142653717, -4.233174845955668},
+				{-22.153332449109453, 20.82729415451519, -3.597872162872198},
+				{-20.076178546671407, 18.75712569591195, -3.101126149559065},
+				{-18.479373656087866, 17.120779102206747, -2.6934147731004976},
+				{-17.218222074406714, 15.781500205270234, -2.3447290126779143},
+				{-16.196303474561898, 14.647709352120627, -2.0347475291739037},
+				{-15.341615570662857, 13.650396382220574, -1.747887119224099},
+				{-14.591714508107922, 12.728904936719085, -1.4701417076454195},
+				{-13.880218792405701, 11.81781498421388, -1.1860916947755529},
+				{-13.116628541572814, 10.827053695422842, -0.87427204400916},
+				{-12.140172863643702, 9.596070632372209, -0.4963822173184819},
+				{-10.573578150356065, 7.7477885671566185, 0.03739561486838971},
+				{-7.17999849575856, 4.039579974210693, 1.0248415533520312},
+			},
+			{
+				{-43.51817040419161, 42.173678682249566, -8.480389878103454},
+				{-32.60535113078641, 31.82258978690835, -6.206047427383989},
+				{-26.51164832259889, 26.027696849746857, -4.918381513599354},
+				{-22.536163607997317, 22.220810416828275, -4.058175419935449},
+				{-19.72225197879669, 19.492262095578194, -3.4271444010264864},
+				{-17.632228141306914, 17.42580417780419, -2.934409608658701},
+				{-16.030799656685677, 15.797721765876553, -2.5309984023094536},
+				{-14.775259979774445, 14.472370495817568, -2.1870757192797075},
+				{-13.769267652167038, 13.358090310087272, -1.8823045139760881},
+				{-12.939737534750792, 12.385058612422705, -1.6009694146594302},
+				{-12.222219499288435, 11.491168716123441, -1.3288235415981406},
+				{-11.547149448769998, 10.608626218761128, -1.0500356978307561},
+				{-10.818507634047418, 9.643014104080105, -0.742350561490427},
+				{-9.863846264022513, 8.424135216373914, -0.3656418998055628},
+				{-8.274310783144943, 6.548133879262988, 0.17509380358361495},
+				{-4.698330539245771, 2.6761434883854274, 1.196125741618178},
+			},
+			{
+				{-40.28758430865613, 40.317174364202174, -8.213917666415666},
+				{-29.31902248280884, 29.954230788615458, -5.9486395131695256},
+				{-23.03728989481659, 24.06362986300697, -4.655316164503339},
+				{-18.854104488675958, 20.150526058788188, -3.7873455315590867},
+				{-15.856664154447975, 17.33172085845593, -3.1505028371090242},
+				{-13.624867347341493, 15.200593903843792, -2.655190152879037},
+				{-11.927385185964447, 13.534808278733223, -2.25249528656133},
+				{-10.618474234144195, 12.196103988858539, -1.9121131968567233},
+				{-9.595706099719886, 11.088599325177873, -1.6130505308729541},
+				{-8.778743611591926, 10.137678709635026, -1.3388761755912373},
+				{-8.094983239853686, 9.275866377615841, -1.0745396501961937},
+				{-7.464540557887676, 8.428383382721671, -0.8031301218620767},
+				{-6.775107788158644, 7.489301935915047, -0.5005346275187914},
+				{-5.821158821905418, 6.264118347715376, -0.12255911373299952},
+				{-4.108746314506724, 4.285205754933132, 0.436659203355676},
+				{-4.9991156525728e-13, 3.36434260177292e-13, 1.5294813607531168},
+			},
+		},
+		{
+			{
+				{-124.43500993865082, 99.2466781435875, -17.7779315325087},
+				{-93.79513059831486, 73.30405378517223, -12.573368064830289},
+				{-79.69703770468065, 61.20334154316951, -10.093508966128246},
+				{-71.53804466309539, 54.05900452436178, -8.584473461849939},
+				{-66.31428220023474, 49.34867175211464, -7.546945702486735},
+				{-62.81921944872215, 46.05539325915343, -6.778443893354582},
+				{-60.47186213551466, 43.68543902565903, -6.17938506534355},
+				{-58.96070464592744, 41.96941879051396, -5.693942745662907},
+				{-58.10822358433677, 40.74798592666347, -5.287259173345466},
+				{-57.81123586885746, 39.92075050325885, -4.935010973071641},
+				{-58.011154804050015, 39.41960001412274, -4.617580535802394},
+				{-58.67583300009534, 39.190490545280255, -4.315498804635644},
+				{-59.781083117229805, 39.17240896449567, -4.003508707001831},
+				{-61.26753030390583, 39.249465252813664, -3.6373528269259183},
+				{-62.8566286599087, 39.064002367725315, -3.106532404807725},
+				{-62.680345171018956, 36.69529184881515, -1.919427823517544},
+			},
+			{
+				{-110.66473701700849, 89.33280244093719, -16.13405803933834},
+				{-84.33583430691655, 66.74290343983931, -11.543592064643631},
+				{-71.85707347214314, 55.89214122398352, -9.292193541339637},
+				{-64.51090594766256, 49.379566728886445, -7.900851361150886},
+				{-59.73699728866311, 45.02765755825177, -6.9332855275759},
+				{-56.48661132552857, 41.94146855788865, -6.209255135558425},
+				{-54.24643311744267, 39.67978020784866, -5.638879962703834},
+				{-52.73584737121355, 37.99773629190572, -5.171101144118973},
+				{-51.78982995765157, 36.74688086406687, -4.773536389934113},
+				{-51.306169158057116, 35.829536684994494, -4.423103256266134},
+				{-51.21776501930087, 35.17399732762233, -4.10064500170246},
+				{-51.47361331940158, 34.71617995488398, -3.7865516045759526},
+				{-52.01606780405603, 34.37618359317115, -3.4547406996269814},
+				{-52.72643272894607, 34.002926896169264, -3.0586571662845565},
+				{-53.20882643348275, 33.1625305002787, -2.480072698741106},
+				{-51.17142746303461, 29.586113454434805, -1.1630385844635043},
+			},
+			{
+				{-100.36187284378201, 81.97254052373322, -14.918330076408154},
+				{-77.03608965025607, 61.723591228392095, -10.759273742110258},
+				{-65.73425142906245, 51.78269865625582, -8.675086344635375},
+				{-58.994765874614444, 45.741950372280385, -7.37196822835828},
+				{-54.566192566133076, 41.66468961322465, -6.457962548033951},
+				{-51.51244179817994, 38.74321308435617, -5.768815619224287},
+				{-49.36969230017202, 36.57467539867536, -5.221748522068378},
+				{-47.8808230034029, 34.93274002568337, -4.769265666277753},
+				{-46.89139928166029, 33.67782308459752, -4.380897076004571},
+				{-46.30199967869077, 32.71550029092302, -4.034590574911988},
+				{-46.04232845568197, 31.973235312388358, -3.711674949819971},
+				{-46.052116997043356, 31.382187538176645, -3.3926038998229964},
+				{-46.256193594249, 30.852359235986285, -3.050821835046386},
+				{-46.503439452783425, 30.21214378542166, -2.6379770439456722},
+				{-46.32715300410333, 28.97583860527719, -2.028483809018341},
+				{-43.062713574045915, 24.533098013741494, -0.5926123701180448},
+			},
+			{
+				{-92.34178859587591, 76.29597478749913, -13.984676425027303},
+				{-71.21738478024035, 57.76436702114648, -10.143664564210276},
+				{-60.80681352022429, 48.512457853872284, -8.186607087405704},
+				{-54.53688779069128, 42.83652944047115, -6.951851687147216},
+				{-50.38201908024102, 38.97627451096591, -6.080070913390293},
+				{-47.49005529078395, 36.18900507338213, -5.418974923631023},
+				{-45.4347408643458, 34.10094629463621, -4.891169974636003},
+				{-43.977299898459165, 32.50012203103758, -4.4519025417649285},
+				{-42.97214050566344, 31.254169652571278, -4.072204938899055},
+				{-42.323075365673546, 30.271797465715633, -3.730864835531561},
+				{-41.958932698097584, 29.480730088826963, -3.4096406266255372},
+				{-41.81459106533055, 28.809696350783334, -3.08908232707828},
+				{-41.80467287613583, 28.162532616201894, -2.7422549667449427},
+				{-41.757735771264635, 27.35359227963116, -2.319160786271932},
+				{-41.15611923503332, 25.85547745965264, -1.6868534140078997},
+				{-36.98104858247041, 20.714308960604537, -0.1362808318550254},
+			},
+			{
+				{-85.90877015100335, 71.7941851618499, -13.247895475885175},
+				{-66.45848299539233, 54.56737441407028, -9.649460320902037},
+				{-56.74374524533548, 45.852373539577805, -7.791751475244032},
+				{-50.847384093628186, 40.465897077851125, -6.611279232061313},
+				{-46.914930667570495, 36.78119224370202, -5.773537078898109},
+				{-44.15879536883052, 34.10549454812276, -5.135451009566525},
+				{-42.18204803189091, 32.08764305484617, -4.623817825615225},
+				{-40.76055412072617, 30.527036040951636, -4.196041061006339},
+				{-39.75612279312841, 29.29723304101648, -3.824351533036367},
+				{-39.07576611482054, 28.30977527280969, -3.4882211955841105},
+				{-38.648532419377005, 27.493092732966748, -3.169769573070067},
+				{-38.40672559602795, 26.77463822733793, -2.849629009317715},
+				{-38.25861782247998, 26.054124339880897, -2.500511413787368},
+				{-38.0188260585529, 25.13529838567607, -2.07084818961927},
+				{-37.129170127409, 23.45447118265255, -1.420367840265879},
+				{-32.23617677915714, 17.721002324785427, 0.24060072340768104},
+			},
+			{
+				{-80.62362028432226, 68.14751166784565, -12.65466517764749},
+				{-62.4813951614922, 51.93714939174115, -9.245741466228008},
+				{-53.322580198794086, 43.649418503686256, -7.467227238019685},
+				{-47.72983598765313, 38.49711049177387, -6.3306496661252},
+				{-43.981806826646874, 34.95709286651946, -5.520826019235524},
+				{-41.341758801273954, 32.37577174347721, -4.901928624349661},
+				{-39.43615320639931, 30.4199225363859, -4.404076030398578},
+				{-38.05275390945448, 28.898103194887174, -3.9863963597774106},
+				{-37.05961563277062, 27.688777990880237, -3.622088841899254},
+				{-36.36676157736063, 26.706001872316037, -3.2911859834682105},
+				{-35.90401934731205, 25.87909348279977, -2.976106613096792},
+				{-35.602428927183524, 25.134877184658993, -2.657543494368702},
+				{-35.366208576232715, 24.370167701300126, -2.3078692778585883},
+				{-34.99991649021765, 23.38026187132516, -1.8740168457067332},
+				{-33.91154590417755, 21.56890482789277, -1.2085445423043792},
+				{-28.438358355822132, 15.325035719105749, 0.5568713361143712},
+			},
+			{
+				{-76.1921350182226, 65.14340359463675, -12.169706619579397},
+				{-59.09261874110194, 49.738769194411496, -8.911283211622541},
+				{-50.38584253228433, 41.79622950319891, -7.196785679726803},
+				{-45.04408496807329, 36.83617696859771, -6.096183584719264},
+				{-41.45157798705312, 33.4172287946733, -5.309574932501192},
+				{-38.91237258746245, 30.91699107956688, -4.706909225258146},
+				{-37.071903673495335, 29.016664821819095, -4.22097138018838},
+				{-35.72775183480163, 27.53227850830198, -3.8122840871461303},
+				{-34.75329886208425, 26.34640022588365, -3.454836848331514},
+				{-34.06136276123184, 25.375313115734226, -3.1291247711268064},
+				{-33.582866856359864, 24.549305674943316, -2.8178153511077286},
+				{-33.248432456796905, 23.79499370634765, -2.5016440686162364},
+				{-32.959765729413284, 23.007146208202347, -2.152662447609246},
+				{-32.51406718655524, 21.974197994141896, -1.7163937669450555},
+				{-31.29063534060896, 20.070501777654155, -1.0385055706259034},
+				{-25.345471853164597, 13.385600301751534, 0.8243268234367339},
+			},
+			{
+				{-72.40634109299815, 62.63334275213917, -11.768551860308845},
+				{-56.1502755455605, 47.87458403382395, -8.630850227359241},
+				{-47.816037462025356, 40.213791148896604, -6.96856933991274},
+				{-42.68458138853435, 35.413308666162884, -5.8977367465152755},
+				{-39.22511077985425, 32.09693532355748, -5.130645479925701},
+				{-36.7748380093184, 29.667372081473665, -4.541888409158872},
+				{-34.99479466340327, 27.817531426785933, -4.066406591457717},
+				{-33.690766483917386, 26.369592904204016, -3.665858235112011},
+				{-32.74073330674491, 25.209592891931713, -3.314882218023439},
+				{-32.0601124856724, 24.255806074090543, -2.9943584233990452},
+				{-31.58113331429923, 23.439540690152715, -2.6871635563774867},
+				{-31.234615893867364, 22.68752538896756, -2.3740657487909824},
+				{-30.92092140927647, 21.893166557189005, -2.02682262008946},
+				{-30.432024318861817, 20.83938142438286, -1.5896578200457299},
+				{-29.123047460500402, 18.87421472404664, -0.9017396044729853},
+				{-22.79521027012415, 11.808709446110365, 1.0512629840912437},
+			},
+			{
+				{-69.11085874527302, 60.50840734430916, -11.433464701843334},
+				{-53.54414378592017, 46.27023812538399, -8.392986512092284},
+				{-45.5198982509633, 38.8406673950446, -6.77347587414301},
+				{-40.56643045436235, 34.1735494312459, -5.727422038690197},
+				{-37.2220779081404, 30.94493151666498, -4.976881522521999},
+				{-34.85130810697543, 28.57780016982445, -4.4001885088335335},
+				{-33.12811104686376, 26.774624377367797, -3.934030994972149},
+				{-31.865206797947742, 25.362679687159222, -3.5409935404562622},
+				{-30.944592253792965, 24.230960702731064, -3.1962502791635012},
+				{-30.284126031984542, 23.29954663025946, -2.8810034105264495},
+				{-29.817514331243835, 22.5008173552871, -2.5783092128207237},
+				{-29.47628840752245, 21.761903293599566, -2.2689665781863386},
+				{-29.160455357130868, 20.975469053437752, -1.9244766775807838},
+				{-28.658642449839284, 19.920181370126162, -1.4878948255225968},
+				{-27.306158993812723, 17.920981142848753, -0.7923788063034284},
+				{-20.672470342095423, 10.528755463394623, 1.243765462958739},
+			},
+			{
+				{-66.18189606611526, 58.68429249144677, -11.150968899404848},
+				{-51.18190244200956, 44.86525641548797, -8.188549226440816},
+				{-43.41675439183102, 37.625247861535144, -6.603999765024641},
+				{-38.6146216656582, 33.06972964468304, -5.578591994886185},
+				{-35.370562319493125, 29.916618452111088, -4.842169171626204},
+				{-33.07162049699616, 27.605285226792542, -4.276065361676998},
+				{-31.402631257661263, 25.845990587325048, -3.8183758960768763},
+				{-30.182220246064066, 24.470236242947866, -3.432436777814595},
+				{-29.29592924260343, 23.36955632192745, -3.0938618793210573},
+				{-28.66393718945204, 22.465691237578273, -2.78412448743923},
+				{-28.221591733195424, 21.692162750484915, -2.486438915212589},
+				{-27.901611221813287, 20.976797667426013, -2.181640745943902},
+				{-27.604579347873077, 20.212142206109633, -1.841022561913294},
+				{-27.117587454676286, 19.173902855234125, -1.406623251346774},
+				{-25.760576200479793, 17.16734233075392, -0.7061617654715294},
+				{-18.890492705882814, 9.498275877527032, 1.4063613875680776},
+			},
+			{
+				{-63.51180486917962, 57.09068700983694, -10.910137338163453},
+				{-48.97686683683306, 43.604973026326284, -8.009486802280572},
+				{-41.42701055296277, 36.5183623270289, -6.453160475245717},
+				{-36.7527237159788, 32.05535172843853, -5.44483995531691},
+				{-33.59579131884136, 28.967089075271836, -4.720482856951836},
+				{-31.362002718721946, 26.70603371026898, -4.1637868837841765},
+				{-29.74527642777798, 24.98872690879617, -3.7139595826745477},
+				{-28.569270444359795, 23.650144463993012, -3.3349347958048807},
+				{-27.722665715616266, 22.583999727661485, -3.0026820107609162},
+				{-27.127864264580364, 21.713583549524678, -2.6989015823355222},
+				{-26.72202500186327, 20.97365480842311, -2.4069504117609983},
+				{-26.43953236369941, 20.293062085941237, -2.1077138356916616},
+				{-26.182493176535907, 19.564906658372568, -1.7723341058256346},
+				{-25.738331074421996, 18.563321906253595, -1.3420051628493985},
+				{-24.41627739175574, 16.577588536038526, -0.6396453277845814},
+				{-17.37636296653482, 8.680206183334846, 1.5426148789085647},
+			},
+			{
+				{-60.99244381396951, 55.66040130960678, -10.700910965512895},
+				{-46.83178943355481, 42.43032008945354, -7.847346891494403},
+				{-39.45564501487742, 35.46310078435477, -6.313070159024297},
+				{-34.88592389769958, 31.074287170421087, -5.318593404254726},
+				{-31.802728444915935, 28.040681006926672, -4.6044957417606},
+				{-29.62731642365336, 25.82490131365226, -4.056263448981523},
+				{-28.061147027573092, 24.14840591127607, -3.613945403276689},
+				{-26.93211455216283, 22.848950206782973, -3.2419293330020067},
+				{-26.131644869328596, 21.82208617502838, -2.9164598323586417},
+				{-25.584250756861998, 20.99255283482019, -2.6194220443565954},
+				{-25.229056924079252, 20.29645727494289, -2.3343024184616787},
+				{-25.00260060915792, 19.66403541187644, -2.042054080180463},
+				{-24.809515062931833, 18.98970102398437, -1.7137364697617263},
+				{-24.439566045037353, 18.047556931030584, -1.2898866538747495},
+				{-23.19619338220718, 16.11486229537929, -0.5893001614995487},
+				{-16.054240422999257, 8.038910619025941, 1.655997583126324},
+			},
+			{
+				{-58.48674535413652, 54.31151786177921, -10.511439079001255},
+				{-44.60892471446576, 41.259519265583535, -7.690670910924699},
+				{-37.359860846967905, 34.37533784312883, -6.172249778218837},
+				{-32.86606187684182, 30.039965337030598, -5.188320833592491},
+				{-29.838808619383574, 27.04900806118381, -4.4827003868989985},
+				{-27.712088074351815, 24.872615839035703, -3.942128663572683},
+				{-26.193553306368276, 23.23590120123061, -3.5072356844929984},
+				{-25.114543297614613, 21.978700892520454, -3.142715027999397},
+				{-24.368731585225994, 20.998007555639383, -2.824995090952164},
+				{-23.882477166166623, 20.21983215737027, -2.5360911204792553},
+				{-23.596870854889843, 19.581681043488807, -2.2595956311233687},
+				{-23.45097717323572, 19.015526035134428, -1.976541631793053},
+				{-23.35293463942331, 18.417907037813283, -1.6579730062517608},
+				{-23.096959573709064, 17.564585660307543, -1.243960046757879},
+				{-21.985646807356286, 15.724815348311083, -0.549822919409732},
+				{-14.813862292322465, 7.522856378441854, 1.7518177355832782},
+			},
+			{
+				{-55.7654591159451, 52.90865152957349, -10.322438930342107},
+				{-42.05671317806239, 39.943905043924076, -7.518791905697174},
+				{-34.861536071435594, 33.09151652548139, -6.0084961430793475},
+				{-30.389922093403882, 28.775253592226797, -5.030515650526954},
+				{-27.38017348016858, 25.8042753960333, -4.330716360542328},
+				{-25.27780659827641, 23.654423238000994, -3.796638420890903},
+				{-23.795904033174654, 22.053449301078896, -3.3692399427406388},
+				{-22.768378599769783, 20.84245684323597, -3.013334330455388},
+				{-22.09014014436658, 19.919141336444387, -2.705379964738063},
+				{-21.688359683806247, 19.210155426527752, -2.4273951206137947},
+				{-21.505211386998862, 18.65397295836583, -2.1629826313499274},
+				{-21.48178154826687, 18.18420790178117, -1.893201390778407},
+				{-21.52990533424024, 17.699987227386906, -1.589086862855957},
+				{-21.44963194068803, 16.980102682030985, -1.190354273295229},
+				{-20.54587718915377, 15.288546516984116, -0.5092008403628487},
+				{-13.417215791735641, 7.0108627809606725, 1.8435630829495961},
+			},
+			{
+				{-52.31647880166781, 51.14730854341238, -10.090519415570485},
+				{-38.535057171616344, 38.10285540056264, -7.278741837725856},
+				{-31.171174298091113, 31.145027471987817, -5.7584352938514725},
+				{-26.522784031015195, 26.732043789105745, -4.772458358695174},
+				{-23.368964563116023, 23.691329504008937, -4.0687467969256925},
+				{-21.175397911462948, 21.507646357872755, -3.5356870569082113},
+				{-19.663404448079696, 19.91178276132822, -3.1143289853169054},
+				{-18.668240217866607, 18.74454951223097, -2.7691809153248257},
+				{-18.08234497053856, 17.901691987863984, -2.476220778754187},
+				{-17.828556175543003, 17.307325517862573, -2.216937144077678},
+				{-17.84414251835209, 16.897500967271093, -1.9744167914912765},
+				{-18.06577922646821, 16.60407939262673, -1.7292362718118757},
+				{-18.402481310334725, 16.325554649623605, -1.4519315152561947},
+				{-18.653356118102153, 15.84044956464789, -1.0813016608601353},
+				{-18.11240863566935, 14.399063727212798, -0.4249166660643769},
+				{-11.038806211881507, 6.036981292741378, 1.9862426458780935},
+			},
+			{
+				{-46.205257757628175, 47.86243962508607, -9.648557332672114},
+				{-30.753747260677397, 33.774596555548385, -6.697700838387937},
+				{-21.4168060583174, 25.676252695540466, -5.036546307401222},
+				{-15.112433487648884, 20.35787981106167, -3.948988797510521},
+				{-10.80705015485037, 16.7293940368191, -3.1893141616539027},
+				{-7.877272430161746, 14.205526794692604, -2.633468113568512},
+				{-5.944597338560579, 12.449639632247479, -2.212027804775278},
+				{-4.776415534782386, 11.258648537937889, -1.882979274104771},
+				{-4.2266629014233565, 10.50401491002362, -1.6187150723717354},
+				{-4.202606940870843, 10.100200715208718, -1.3991329130322012},
+				{-4.64299999276128, 9.984209832373613, -1.2070007669457727},
+				{-5.49666970261752, 10.095440009053954, -1.0231418648356638},
+				{-6.683999314437734, 10.339370007205986, -0.8177179866324472},
+				{-7.980926038351569, 10.478622621018218, -0.5249816933250949},
+				{-8.458745644719226, 9.606305745452094, 0.07537238266586259},
+				{-2.302051363865016e-12, 1.5952026554731197e-12, 2.7452511792022456},
+			},
+		},
+		{
+			{
+				{-139.74676428906508, 111.32210982082873, -19.819878389742346},
+				{-103.90923705322881, 81.12413962199106, -13.822335306182106},
+				{-88.15878935040007, 67.646414218498, -11.078184102068613},
+				{-79.3338360336251, 59.916220923770396, -9.446343822479566},
+				{-73.88571998390408, 54.96721061744851, -8.34550859774933},
+				{-70.43129906028382, 51.63686612144355, -7.545814622696913},
+				{-68.32633370698773, 49.37675780046341, -6.936544450549062},
+				{-67.2441426402637, 47.900218617325606, -6.457034292890579},
+				{-67.02040372592647, 47.05129322450015, -6.070556724246214},
+				{-67.58989063850213, 46.74933936862986, -5.7526318411716515},
+				{-68.96187349981778, 46.96400217998658, -5.484643673718851},
+				{-71.21608358379831, 47.70350831023644, -5.248784525914635},
+				{-74.51338405580834, 49.00676490421011, -5.020937129046182},
+				{-79.10762814891825, 50.91514027890262, -4.752547369320194},
+				{-85.19585437467263, 53.237463305843946, -4.288555233627927},
+				{-89.54759059057615, 52.07596403382456, -2.4747561324064495},
+			},
+			{
+				{-123.08454400766477, 99.22905159198557, -17.80727291477361},
+				{-92.86055784260874, 73.40505077489289, -12.607099065285983},
+				{-79.08566491304914, 61.45672418955511, -10.141907258667695},
+				{-71.2055704244479, 54.46544346742557, -8.648341713093464},
+				{-66.24685543716124, 49.91260662566881, -7.6265075507410565},
+				{-63.02262669216955, 46.78794943146235, -6.8742765918223},
+				{-60.96791328643296, 44.60521243599325, -6.292583274797172},
+				{-59.78709945963623, 43.10348398684971, -5.826133722821283},
+				{-59.32098657112869, 42.133706976415695, -5.440582805218675},
+				{-59.489867872532805, 41.60875095030054, -5.112053751761247},
+				{-60.267278645540635, 41.478187890836985, -4.8211326785044575},
+				{-61.6668187055938, 41.71168652726709, -4.54775492424033},
+				{-63.730192848221186, 42.27817910974675, -4.263503637254101},
+				{-66.4825876908086, 43.08396277728207, -3.9113318913749464},
+				{-69.62724860662445, 43.64036047764046, -3.315442173450194},
+				{-68.04398726354037, 38.647502802598176, -1.1100950005030767},
+			},
+			{
+				{-111.00843760629043, 90.54194548887777, -16.367782331200512},
+				{-84.53529490978609, 67.64629234546906, -11.704914409412703},
+				{-72.15193857451688, 56.776495927386215, -9.437597266777834},
+				{-64.95982258987277, 50.32325557877807, -8.045086596819202},
+				{-60.37147960561126, 46.06913710825908, -7.0826235501201715},
+				{-57.335872647623525, 43.109373802885706, -6.367392021703361},
+				{-55.34490224210655, 41.002180113746675, -5.808648135545504},
+				{-54.12714763706887, 39.50630939386219, -5.355082426508453},
+				{-53.53049921466745, 38.47980264954404, -4.9742860184207505},
+				{-53.47039485529265, 37.834329721155974, -4.643154999200542},
+				{-53.90393032465179, 37.51074314598174, -4.342225037854538},
+				{-54.813245556882514, 37.46076378370817, -4.05062470729413},
+				{-56.184090536548176, 37.620614634212004, -3.738102314578168},
+				{-57.93730635403199, 37.83382796875313, -3.343386002387777},
+				{-59.55306418734056, 37.4678245130066, -2.6770715743100637},
+				{-54.39855106160339, 29.883639444586926, -0.09519990921755166},
+			},
+			{
+				{-101.8372143662431, 84.01575352912761, -15.291665270836475},
+				{-78.02703135856292, 63.19911161431424, -11.012144617191062},
+				{-66.67163006851368, 53.125286182633936, -8.891443018990222},
+				{-60.00170943761289, 47.07959432205432, -7.575598161430954},
+				{-55.703763052034866, 43.058482298532475, -6.659216520306933},
+				{-52.82549432509222, 40.23377867417409, -5.97355277013675},
+				{-50.90126005001317, 38.196761740622776, -5.434039121874397},
+				{-49.67872513139678, 36.72146603532309, -4.992400430705554},
+				{-49.01285079924919, 35.672329057801, -4.617781546341933},
+				{-48.81821167008867, 34.96183003783512, -4.287811939048886},
+				{-49.04397289528891, 34.52696234374789, -3.9832115676706277},
+				{-49.655844816906345, 34.31025556857591, -3.6828107556804435},
+				{-50.60982818114143, 34.23040423622996, -3.355360500894664},
+				{-51.770732718188476, 34.09584250518876, -2.936722249045601},
+				{-52.48996481336018, 33.18214608398767, -2.2254972524836223},
+				{-44.671912812058686, 23.448704113054163, 0.7445376102171561},
+			},
+			{
+				{-94.63384087360973, 78.95946906142767, -14.462856263135958},
+				{-72.79460414059531, 59.6779723196362, -10.467397222315348},
+				{-62.22467798085132, 50.21031230215924, -8.458606830974185},
+				{-55.96334266314273, 44.48211819034552, -7.202466355416457},
+				{-51.8993558370694, 40.64743572310363, -6.3227040211066665},
+				{-49.15470585351551, 37.93551775662263, -5.661146607036977},
+				{-47.29638804487918, 35.96290486086483, -5.13793331665724},
+				{-46.08736505173094, 34.51570928026848, -4.7071464522542525},
+				{-45.38910419775376, 33.46392704529396, -4.339181363327228},
+				{-45.117129322465914, 32.721644694685295, -4.012313098599224},
+				{-45.21694167694784, 32.22436193033627, -3.707512804181876},
+				{-45.645378460704535, 31.90975198807631, -3.4035135050285534},
+				{-46.3415893412554, 31.686384684453724, -3.068415746346164},
+				{-47.13730123653589, 31.34069469067056, -2.6357899619213945},
+				{-47.29742190685166, 30.07960070980201, -1.8928338529451496},
+				{-37.351005566031695, 18.486618177032312, 1.4577197890929525},
+			},
+			{
+				{-88.82940870600729, 74.9559890890236, -13.8115616327363},
+				{-68.49151533019712, 56.83766461961762, -10.03179118156463},
+				{-58.536504617584356, 47.84154918492373, -8.110114280566549},
+				{-52.60221544101829, 42.36571017291751, -6.9013178301787965},
+				{-48.73086343892177, 38.68315884651338, -6.05116923808001},
+				{-46.101703198565076, 36.06712209656546, -5.40959660255908},
+				{-44.30737226567476, 34.15380838815497, -4.9003890694122845},
+				{-43.123287482360475, 32.73901398555443, -4.479486659449866},
+				{-42.416771766253284, 31.697532739346272, -4.118298320065705},
+				{-42.10519933913236, 30.94538569514983, -3.795655772009339},
+				{-42.13281794272177, 30.417887349656464, -3.492792843230048},
+				{-42.4519255058886, 30.050332016744353, -3.1884387296909886},
+				{-42.99218093116762, 29.745415548563606, -2.8502288426961004},
+				{-43.565152609176394, 29.27507427509295, -2.4097918510520735},
+				{-43.37302342918457, 27.79111513389845, -1.6434710467339284},
+				{-31.67237090112314, 14.563016941890536, 2.0715302846805863},
+			},
+			{
+				{-84.0544801635737, 71.73691319903591, -13.29311925964238},
+				{-64.88237843248686, 54.51334836486711, -9.679353209096154},
+				{-55.41707158571154, 45.88894349422339, -7.826264020186232},
+				{-49.74912850793347, 40.616504473675185, -6.655453910245884},
+				{-46.03930060492532, 37.06007264772096, -5.829569391062896},
+				{-43.51173062369229, 34.526962639677045, -5.2048104129512165},
+				{-41.77952800331976, 32.668877786980836, -4.707822941324338},
+				{-40.628399604460704, 31.289471437691947, -4.296023875777671},
+				{-39.93094252441801, 30.267705385791093, -3.9416502522631163},
+				{-39.60689862295788, 29.52169129102651, -3.624014414905153},
+				{-39.60081723686686, 28.98740572279057, -3.324610270464473},
+				{-39.86330620559178, 28.599421318316097, -3.022222073879451},
+				{-40.31940281126267, 28.257449817891864, -2.684156659733529},
+				{-40.769123421462524, 27.72413132035171, -2.2404008798033064},
+				{-40.36868132761229, 26.106518696120606, -1.457203891864759},
+				{-27.17662882444491, 11.403995078037745, 2.6098402818097544},
+			},
+			{
+				{-80.05453960326058, 69.12012120949461, -12.877493583732383},
+				{-61.797977119483036, 52.58852829521412, -9.391909381920444},
+				{-52.72714395742383, 44.258912907311135, -7.593024123249059},
+				{-47.27898602195121, 39.15187757683198, -6.452881692010489},
+				{-43.706759000860046, 35.70137503482096, -5.647072902387223},
+				{-41.270167194998415, 33.2412493822658, -5.036658072793219},
+				{-39.598861825993, 31.435482114793516, -4.55052274914483},
+				{-38.48717729932587, 30.094165340380478, -4.147262181422204},
+				{-37.81252449179288, 29.09993645461966, -3.799795410130912},
+				{-37.49739111966635, 28.373119080449424, -3.4878558520888463},
+				{-37.48780309824855, 27.851019892049813, -3.1931977959005837},
+				{-37.73485811662557, 27.468813257276132, -2.8947155015814663},
+				{-38.162746613555925, 27.125577248808135, -2.559521025349271},
+				{-38.56675364136973, 26.579023496540938, -2.116279252477091},
+				{-38.07166437604374, 24.901308965000332, -1.3221958925410713},
+				{-23.57917400369576, 8.836983985343831, 3.090499658879345},
+			},
+			{
+				{-76.64339828251082, 66.97576677208033, -12.543591019773256},
+				{-59.108818437846054, 50.97648096352921, -9.156147303547895},
+				{-50.357676272258324, 42.88021863641485, -7.3998859070583425},
+				{-45.09253911332224, 37.90815653564111, -6.284508069786925},
+				{-41.639130189688196, 34.54756840542462, -5.495422935177161},
+				{-39.28549085073372, 32.152846886524216, -4.89742014235674},
+				{-37.67476034438371, 30.39769873766728, -4.421127469741835},
+				{-36.60861832813821, 29.09753863937011, -4.026077961936391},
+				{-35.96896555917437, 28.138319180005414, -3.685757001010449},
+				{-35.681403351597154, 27.442735212111597, -3.3802766447812944},
+				{-35.69448864812668, 26.950070417643094, -3.0916633034566225},
+				{-35.96176872569405, 26.597366043540386, -2.7989885252449564},
+				{-36.41003720081211, 26.285344763999788, -2.4693257788209744},
+				{-36.836269481353995, 25.77115376531205, -2.0303964695152374},
+				{-36.34874657037773, 24.10292120893018, -1.2317496427010322},
+				{-20.706162902346094, 6.75897453908045, 3.5255918819296697},
+			},
+			{
+				{-73.67511843503873, 65.20630817006403, -12.275970963493641},
+				{-56.707330558143184, 49.60812839233248, -8.961732956222098},
+				{-48.21479701888302, 41.69400837409659, -7.238381655099756},
+				{-43.10242434722695, 36.83153613404135, -6.142832960039212},
+				{-39.75255292500753, 33.54777151422523, -5.367723911279707},
+				{-37.47580940389147, 31.212742745265135, -4.780628152034846},
+				{-35.926387833422396, 29.50778509138292, -4.313498161354191},
+				{-34.91231689024956, 28.252741986380638, -3.926604147470836},
+				{-34.31980057928847, 27.336645343492957, -3.593905641777986},
+				{-34.07799213045759, 26.684809352838034, -3.29586834724768},
+				{-34.13908925715801, 26.239237177718064, -3.014820581822831},
+				{-34.461144619679246, 25.940254555070883, -2.7301042226158296},
+				{-34.977241610834184, 25.6928058500632, -2.4089553770406242},
+				{-35.493114882870685, 25.25851838736203, -1.978627477440603},
+				{-35.11791355640542, 23.67469469278883, -1.182798028043326},
+				{-18.462606203230276, 5.121032993159991, 3.92194816290401},
+			},
+			{
+				{-71.02380094291703, 63.73285957756984, -12.062670022791801},
+				{-54.491572889551136, 48.421492100440375, -8.799729299793155},
+				{-46.20427412614495, 40.64400285904834, -7.100672432674218},
+				{-41.21779407568377, 35.868538992961774, -6.020620548244171},
+				{-37.95803668557192, 32.65030818276157, -5.257167262681313},
+				{-35.75342449693502, 30.370712524590008, -4.679833281288515},
+				{-34.26717767966733, 28.71690169667979, -4.221524829430838},
+				{-33.31287780354226, 27.512408635668677, -3.8430734618564966},
+				{-32.78097599496589, 26.649228423279997, -3.51883859585193},
+				{-32.6047522085063, 26.055682768457316, -3.229633453423549},
+				{-32.74137037436506, 25.677454457816328, -2.9581446325905185},
+				{-33.15585317529312, 25.45995774144217, -2.684120998941472},
+				{-33.7920942247589, 25.315674131596904, -2.3752403615109734},
+				{-34.47349826897732, 25.017301699700262, -1.9589214083689965},
+				{-34.33248942754219, 23.60869770551994, -1.1752719084437426},
+				{-16.820966949803253, 3.926403352175381, 4.280715133460249},
+			},
+			{
+				{-68.55989074882697, 62.480189413871564, -11.892928127052537},
+				{-52.34128088452968, 47.34689688090636, -8.660460455161385},
+				{-44.20691969194816, 39.66157337350735, -6.9774681660629465},
+				{-39.319035901678745, 34.95089115200967, -5.9088513901578885},
+				{-36.13559450361395, 31.787415400649586, -5.1550138207965785},
+				{-33.99857726249877, 29.559967712738526, -4.586629870389052},
+				{-32.57843716727188, 27.959842516954865, -4.137207536981216},
+				{-31.69363270613053, 26.813619830770058, -3.767975215761455},
+				{-31.238913224406414, 26.016255855023605, -3.4536303548775424},
+				{-31.152443703644302, 25.499663143835953, -3.1753484763449658},
+				{-31.398061561499855, 25.21453384100587, -2.9162696812036075},
+				{-31.951023769579507, 25.11388955531854, -2.6567588338373738},
+				{-32.772240428260176, 25.122485790378313, -2.3653509038223555},
+				{-33.71543701095809, 25.03369977618053, -1.9705391385380964},
+				{-33.96831804999843, 23.922935348156535, -1.2119295806862984},
+				{-15.82783438267856, 3.244549883525589, 4.595079148630448},
+			},
+			{
+				{-66.10247133937443, 61.34753574927627, -11.752911714711916},
+				{-50.06789314425197, 46.27691579561244, -8.529274172761367},
+				{-42.02492016768872, 38.63386732246018, -6.853664638231233},
+				{-37.200090544399934, 33.96160887060411, -5.79219680558364},
+				{-34.073180440749645, 30.839671225369198, -5.045957459618695},
+				{-31.99612541259272, 28.660599153621572, -4.485998802535997},
+				{-30.645061348601544, 27.118269182688184, -4.046079917189802},
+				{-29.842670902711593, 26.04169361802633, -3.6876541699014744},
+				{-29.488007132650832, 25.328828073359144, -3.3856902946758187},
+				{-29.52493476835704, 24.915931681205628, -3.121751836844906},
+				{-29.926077019846268, 24.760525290787733, -2.879573103606604},
+				{-30.681388340172713, 24.826907714089568, -2.6404555560964287},
+				{-31.77796315645813, 25.05940668377001, -2.374447771456601},
+				{-33.119708072864164, 25.287555530118368, -2.012549769159533},
+				{-34.000917800213855, 24.6584524375041, -1.2982788577101965},
+				{-15.636444522989828, 3.2545181989979146, 4.84393318688192},
+			},
+			{
+				{-63.29772940604532, 60.135638816662286, -11.615214046122762},
+				{-47.274209950774164, 44.982802170356, -8.374871037714211},
+				{-39.2134264654473, 37.30425146987904, -6.694786220094429},
+				{-34.372411418147976, 32.620004574676, -5.633724344971257},
+				{-31.247950952551005, 29.508713613262735, -4.891576260832612},
+				{-29.20135017937954, 27.364407422561612, -4.33912066641308},
+				{-27.914024806196206, 25.88246625634556, -3.9100202712100756},
+				{-27.211994314281778, 24.893502795978943, -3.5657018619165415},
+				{-26.997514114445522, 24.297912446456884, -3.28122018269704},
+				{-27.21953394059071, 24.036442804395435, -3.038440633960901},
+				{-27.860505540935407, 24.075115357420426, -2.8217806899712423},
+				{-28.929904943519972, 24.394300497943032, -2.613886906939419},
+				{-30.45416002993338, 24.969297875249495, -2.387357223042412},
+				{-32.420586578041416, 25.692839137195694, -2.078168693786997},
+				{-34.323368437774626, 25.857548364180772, -1.4409985342523723},
+				{-16.58438885173389, 4.356945285542011, 4.975306345010228},
+			},
+			{
+				{-59.158906885635254, 58.2708199423575, -11.399255552920414},
+				{-42.653664462521476, 42.696298556585596, -8.090891876783994},
+				{-34.074145356113476, 34.67760440577608, -6.366127237752557},
+				{-28.748035818894095, 29.711740858783926, -5.272840238190111},
+				{-25.243318317037946, 26.40124579925234, -4.511799085540732},
+				{-22.96511208998886, 24.157528116800588, -3.9547120374082834},
+				{-21.61535272897266, 22.68331781309941, -3.5351596772751432},
+				{-21.023946957892782, 21.8092513766385, -3.213729016354475},
+				{-21.087606996115706, 21.43243000402447, -2.9643496245807452},
+				{-21.745066623150027, 21.489911244572045, -2.767785168681514},
+				{-22.968644625791892, 21.946956526514057, -2.607686619681162},
+				{-24.7684233834809, 22.793647651740468, -2.466972101657731},
+				{-27.205365395649263, 24.04222117973024, -2.321022777463677},
+				{-30.389259546532752, 25.689683911629995, -2.1144011088176224},
+				{-34.19835820628551, 27.328851275606034, -1.6270044150076775},
+				{-19.225191968183232, 7.413826131245634, 4.867371182884155},
+			},
+			{
+				{-45.9215906844376, 51.11541610935352, -10.442081789248084},
+				{-19.105155134076945, 29.444789362148224, -6.3099674454438865},
+				{-2.830357208176839, 17.278084519673314, -4.093788321283629},
+				{7.467231687224846, 9.872602984095339, -2.758148575835318},
+				{14.274918571537123, 5.101848632409337, -1.8863908353910848},
+				{18.882780124267292, 1.9360996956299747, -1.2854724995519613},
+				{22.01433000564649, -0.17716515166801547, -0.8542400053495744},
+				{24.083179683682122, -1.5465680392022254, -0.5358167505867266},
+				{25.305826411118037, -2.3360662446372764, -0.29748312472062216},
+				{25.744944561049085, -2.6058166354305823, -0.12151396738313276},
+				{25.304382754860786, -2.318371086232443, -0.0012535632425807741},
+				{23.66506736685439, -1.3094915454237954, 0.05950570283916251},
+				{20.106130076345423, 0.8028989800328875, 0.04438367453463887},
+				{13.055458865759118, 4.84575011357341, -0.07619721724761583},
+				{-0.8712998999509664, 12.480986955841503, -0.27160882845208567},
+				{-2.0396292964079777e-11, 1.3497064912013707e-11, 5.977080004640934},
+			},
+		},
+		{
+			{
+				{-145.0188061005346, 115.48074699057196, -20.525415485962338},
+				{-107.27848616414319, 83.72958478846743, -14.240127466296588},
+				{-90.95200447147293, 69.77344048874514, -11.404702978149695},
+				{-81.90514737159117, 61.848196278034614, -9.73200027237551},
+				{-76.39215427786931, 56.827177687450835, -8.611225247539098},
+				{-72.96849423655642, 53.497219230724475, -7.802971836855273},
+				{-70.96997458914673, 51.29228886659641, -7.192826562512325},
+				{-70.06815387620647, 49.9221103694243, -6.718720608400506},
+				{-70.10897984975182, 49.235703246797776, -6.343683393160175},
+				{-71.04998011904948, 49.16557564374196, -6.043813401913055},
+				{-72.94095049154926, 49.70547242973691, -5.801882336758292},
+				{-75.93233362766965, 50.905536486575485, -5.602436855222406},
+				{-80.31391808506373, 52.879715714771095, -5.424933470918514},
+				{-86.59412041244254, 55.81272021887903, -5.225352422451868},
+				{-95.50172122150283, 59.78305611863501, -4.842450391131231},
+				{-103.62293217290716, 59.996586200076564, -2.699784475880827},
+			},
+			{
+				{-127.28712562056378, 102.57842178244874, -18.375615876846762},
+				{-95.66936394566547, 75.6004668963636, -12.959094826698506},
+				{-81.44980100851697, 63.27677858913986, -10.421180859773322},
+				{-73.39420823797848, 56.12818463065276, -8.894003896642399},
+				{-68.38242998950413, 51.51513492913268, -7.855193621062286},
+				{-65.18020072013115, 48.3877845844097, -7.0950971218483065},
+				{-63.20622449394526, 46.24540002216306, -6.511617013137138},
+				{-62.16210523248719, 44.82318738595396, -6.048192039061389},
+				{-61.89435015256921, 43.97437956068781, -5.670067278786471},
+				{-62.336964094836354, 43.619429545275565, -5.353484114788229},
+				{-63.487523302112464, 43.72178415526107, -5.079542649411442},
+				{-65.39966468370352, 44.27410047384016, -4.828971647061889},
+				{-68.18286883305981, 45.28306446743599, -4.574080814936088},
+				{-71.98029421400209, 46.716103493554684, -4.256644760500153},
+				{-76.67107836321891, 48.14312389359103, -3.681691656215424},
+				{-75.31761657677065, 42.230769184124064, -0.9168230609627064},
+			},
+			{
+				{-114.57566228057361, 93.41375373596549, -16.855422930378108},
+				{-86.9911092468471, 69.58607437750473, -12.016080587244469},
+				{-74.24017276456253, 58.4015078089327, -9.68701545282464},
+				{-66.90048607969938, 51.813752029069725, -8.265312597438287},
+				{-62.2664836447309, 47.50685314939845, -7.287749141270641},
+				{-59.24802211969581, 44.542989278141114, -6.565176507031346},
+				{-57.32299540896537, 42.46787093409831, -6.004230102559418},
+				{-56.216902742711454, 41.03641486041072, -5.552442302275556},
+				{-55.7811741299807, 40.10771986371033, -5.176939331653844},
+				{-55.94063022745916, 39.59841532281731, -4.854539320838364},
+				{-56.66874935338493, 39.458536613575994, -4.565921580601028},
+				{-57.974131514439115, 39.65451820653869, -4.290382100954851},
+				{-59.884908326256685, 40.145044836381395, -3.997340353063994},
+				{-62.387624814117075, 40.803604126562306, -3.6223804204272385},
+				{-65.01635761100034, 40.983591882815546, -2.9525833975916935},
+				{-58.09018758416214, 30.925409846521926, 0.44945078530137106},
+			},
+			{
+				{-105.00246262784022, 86.5895314898272, -15.72922784671311},
+				{-80.25064512451648, 64.97393088218378, -11.29717444802233},
+				{-68.5757149550976, 54.62293931458773, -9.121545482513092},
+				{-61.77597242786549, 48.457167696987824, -7.779312136227252},
+				{-57.4372845831843, 44.388179609467144, -6.8490578539686595},
+				{-54.573419753658825, 41.55880657579499, -6.156445067701857},
+				{-52.70626403613972, 39.5491056988468, -5.614548407792698},
+				{-51.58036017956616, 38.12939975550815, -5.174014606173395},
+				{-51.0530983695024, 37.16457774512076, -4.803508241785935},
+				{-51.04616961620094, 36.57073188056595, -4.480490755349361},
+				{-51.52117807970607, 36.29166170603892, -4.185653081502085},
+				{-52.463511415486686, 36.28054107573107, -3.897710956798411},
+				{-53.859338121038995, 36.47127124825846, -3.584629979476679},
+				{-55.61513807013417, 36.68799079776526, -3.1782782393615583},
+				{-57.081827307901996, 36.158926814505776, -2.4513933084645205},
+				{-45.93649515397099, 22.583159999232958, 1.6227065584480087},
+			},
+			{
+				{-97.53701719758918, 81.3439619862275, -14.868948169010231},
+				{-74.86265285271263, 61.34611020770838, -10.735772475214818},
+				{-64.00403067534567, 51.62507162175667, -8.676346976562979},
+				{-57.62446775753659, 45.78608723105559, -7.395608338001786},
+				{-53.523150382100305, 41.90685042813109, -6.502782515541999},
+				{-50.7914622572774, 39.19019065662417, -5.834569879667899},
+				{-48.984965085961136, 37.24227075147604, -5.3089210902728805},
+				{-47.8635574106374, 35.845624788327456, -4.878896758657166},
+				{-47.29056209221248, 34.87040677012005, -4.514412099071564},
+				{-47.18740475832479, 34.23361844257883, -4.1935337381978455},
+				{-47.50992763141923, 33.876337184943274, -3.8971231652618497},
+				{-48.23110663290979, 33.74481430571974, -3.6036698313840794},
+				{-49.314032236806874, 33.75920979242822, -3.2802823141890265},
+				{-50.6201167053575, 33.714612734488526, -2.856123442530699},
+				{-51.38566753017243, 32.75240448074102, -2.090818748476253},
+				{-36.83134982518742, 16.10179656898089, 2.6424529428605217},
+			},
+			{
+				{-91.56179844828999, 77.22330095280763, -14.198587244192392},
+				{-70.45607986325963, 58.439526993783765, -10.290129389731295},
+				{-60.232095839174136, 49.20475349594433, -8.320427771558059},
+				{-54.18715995858491, 43.62398635304065, -7.088134713731946},
+				{-50.280931744678625, 39.899154380904264, -6.2254428743503345},
+				{-47.66420176968601, 37.27866070772307, -5.577437847931499},
+				{-45.918874524506265, 35.38888001275306, -5.065824819824346},
+				{-44.81740535027941, 34.022154369488824, -4.645560301473206},
+				{-44.22871383436573, 33.05332389732731, -4.287580040609645},
+				{-44.07547886503693, 32.40100979273598, -3.970494235166449},
+				{-44.311255294983575, 32.00554220117736, -3.675397084220645},
+				{-44.90276255619271, 31.809859484108436, -3.3807271549777913},
+				{-45.80062793526375, 31.726623807047083, -3.05305114343175},
+				{-46.84041524643151, 31.533175795278968, -2.619397384189921},
+				{-47.18073492294826, 30.305291210391132, -1.827170404499313},
+				{-29.693072288418545, 10.83518567412438, 3.560363324389563},
+			},
+			{
+				{-86.68002502942005, 73.93881726896677, -13.670063982950436},
+				{-66.78092458868049, 56.078900333423064, -9.932614228658037},
+				{-57.058640910160356, 47.22411059909345, -8.032896581020388},
+				{-51.28484327384808, 41.85015886229182, -6.839188171549975},
+				{-47.54197992458751, 38.25300191383421, -6.001070186953709},
+				{-45.02704215294186, 35.71603828053005, -5.370052994563094},
+				{-43.34286911378804, 33.88146523350751, -4.870755889486961},
+				{-42.27233699526626, 32.54959647674882, -4.459636438934923},
+				{-41.68959647412191, 31.599455158612344, -4.108469435970084},
+				{-41.51951885172621, 30.951704840882407, -3.796350976912489},
+				{-41.71564580068578, 30.54723555877927, -3.5046394903451628},
+				{-42.242503218092956, 30.328101124566807, -3.211836332554662},
+				{-43.04526021774021, 30.203696026232954, -2.884190169439228},
+				{-43.946297386930446, 29.941338198002867, -2.44704870231315},
+				{-44.05184971732401, 28.56685939815786, -1.6366787624677164},
+				{-23.87778364346161, 6.369789150234369, 4.423364532108321},
+			},
+			{
+				{-82.62085473455585, 71.29664962983466, -13.251399937807458},
+				{-63.658726983139736, 54.141409432812786, -9.644069595518886},
+				{-54.337161148411134, 45.58473409718296, -7.799003749666499},
+				{-48.785899925390645, 40.3777428845849, -6.636168046330678},
+				{-45.18210694894915, 36.88757759850251, -5.818275686802227},
+				{-42.75903185086543, 34.42454397206526, -5.201748209900738},
+				{-41.13634524136697, 32.643281623277254, -4.71346479490447},
+				{-40.105712253804995, 31.35065680087856, -4.311074909905129},
+				{-39.54623204843134, 30.42949277956591, -3.9670503015220944},
+				{-39.385688761491494, 29.80284173602662, -3.6609293029087064},
+				{-39.57936713645949, 29.41320753715705, -3.37436409985391},
+				{-40.09276184874517, 29.20373168143179, -3.0860212797238993},
+				{-40.87109440000555, 29.084027411841706, -2.7620435212524375},
+				{-41.73370401853454, 28.81792218100857, -2.32656378611198},
+				{-41.756402698487875, 27.396104944950547, -1.5061675662515808},
+				{-18.980746972845957, 2.4289182062096693, 5.2715037871309125},
+			},
+			{
+				{-79.18779653145955, 69.16011198800423, -12.920416008463867},
+				{-60.95374712087799, 52.536440370802595, -9.41060794249216},
+				{-51.95355096025483, 44.21234669599607, -7.60781214111776},
+				{-46.58649916308186, 39.14038541798014, -6.469617353015994},
+				{-43.10281479716834, 35.740872827034, -5.668472999214028},
+				{-40.76429709816957, 33.344605546471186, -5.064494679430776},
+				{-39.2042681686091, 31.615984863986682, -4.586293376994201},
+				{-38.22194466508014, 30.367327343701675, -4.192462167117759},
+				{-37.701226805956026, 29.48501201723918, -3.8560597929746896},
+				{-37.573511112171616, 28.894868448075545, -3.557041836657178},
+				{-37.79744656284947, 28.542056076540778, -3.277397575938919},
+				{-38.34243007274148, 28.372744163118824, -2.996078665123082},
+				{-39.15894567931772, 28.300253144063397, -2.6793681406934065},
+				{-40.07317805124263, 28.09165792842604, -2.250738655480711},
+				{-40.15369271294341, 26.719209270068262, -1.4290349691740383},
+				{-14.730715836867637, -1.189906827588581, 6.14321035680941},
+			},
+			{
+				{-76.22804359508075, 67.42792020595452, -12.661149831778504},
+				{-58.553752399824845, 51.192531123563775, -9.22158690901265},
+				{-49.80992339865126, 43.04610134444774, -7.450606390123096},
+				{-44.59553892264872, 38.08247267599469, -6.3318193704512895},
+				{-41.21665593975976, 34.76032783006079, -5.544573274805192},
+				{-38.95746759696723, 32.425654755838366, -4.951653358641014},
+				{-37.46242390220278, 30.750416455990223, -4.482957479893852},
+				{-36.53736618396382, 29.551521011593273, -4.097816648213762},
+				{-36.071013998810564, 28.71881150393735, -3.7697935417189146},
+				{-35.99919304909416, 28.1814190149866, -3.4792588331837133},
+				{-36.28568232172303, 27.88838092013369, -3.2086096451906596},
+				{-36.90699547640926, 27.79115346589714, -2.937245697636962},
+				{-37.824734802207246, 27.810901101964472, -2.6319160395378036},
+				{-38.88344853677968, 27.726165607384072, -2.21615778886643},
+				{-39.1729667439775, 26.51209871003438, -1.4036479953546905},
+				{-10.933287514419186, -4.654143500824719, 7.081847113919977},
+			},
+			{
+				{-73.61066199638124, 66.01959443298034, -12.461539948808994},
+				{-56.35234679618498, 50.046013718657676, -9.067912984868178},
+				{-47.807676584193175, 42.02795006959532, -7.319368256560342},
+				{-42.71785044224428, 37.148765909331686, -6.215358635583961},
+				{-39.43041354563926, 33.89259763970077, -5.439603920862331},
+				{-37.24679003378316, 31.61597992976091, -4.8566410330306855},
+				{-35.82046346222739, 29.996534458552407, -4.397256917991445},
+				{-34.96320864028299, 28.855086386817465, -4.021342516281628},
+				{-34.568775269910844, 28.085026195232246, -3.7029052092636143},
+				{-34.57848588905576, 27.619551608972024, -3.422758270364518},
+				{-34.96345027124381, 27.413206153170556, -3.163823555483829},
+				{-35.711364199932596, 27.425746318152807, -2.9061918718501296},
+				{-36.80259219487391, 27.591824341800784, -2.6175531086530492},
+				{-38.115674637490564, 27.71298143769178, -2.222542707407254},
+				{-38.801573494018314, 26.797592016257745, -1.4331652047893781},
+				{-7.437468887950743, -8.127006634247632, 8.14525491128921},
+			},
+			{
+				{-71.20037342422965, 64.8590966061743, -12.310968165171},
+				{-54.22210135197661, 49.0245523213593, -8.939676754980491},
+				{-45.819841648181544, 41.08597529277371, -7.204461720641275},
+				{-40.82578113170995, 36.26750216573687, -6.1108400551902085},
+				{-37.61605718590207, 33.06648609925813, -5.344464088176138},
+				{-35.50469747753895, 30.845630457706907, -4.770737017019841},
+				{-34.15237709825583, 29.286464526313942, -4.3209542693570615},
+				{-33.37628356373396, 28.213197276092746, -3.9554037282501326},
+				{-33.07561989259965, 27.523059164555907, -3.6485005487405084},
+				{-33.19866612781183, 27.15445931239781, -3.3815686750935137},
+				{-33.726866263671894, 27.069803809789025, -3.1382478033058785},
+				{-34.66484171842966, 27.241603394627226, -2.899707521134644},
+				{-36.02301274068468, 27.62663910221209, -2.6353370573878157},
+				{-37.73814522571721, 28.067791813407307, -2.2725056819622753},
+				{-39.08581742044994, 27.655187834998323, -1.5266354464061829},
+				{-4.1142951109544805, -11.802041786590934, 9.42358055173241},
+			},
+			{
+				{-68.80190464360044, 63.84114669810219, -12.19535818988481},
+				{-51.95638861910082, 48.01238271734667, -8.821269600358427},
+				{-43.628766755692716, 40.09756822070534, -7.089605847212171},
+				{-38.6923565215166, 35.3112792150315, -6.001680708004746},
+				{-35.54014220186093, 32.15200654340201, -5.242600495963348},
+				{-33.49478401182433, 29.984469018123495, -4.6777529285639865},
+				{-32.22258645079216, 28.492477733689054, -4.238559879197663},
+				{-31.545708700751028, 27.503179560457145, -3.885539751887751},
+				{-31.36931363814982, 26.91811507918254, -3.5934872875518673},
+				{-31.650463727621222, 26.682520023214924, -3.344347886800632},
+				{-32.3850571664395, 26.770148131475334, -3.122785391030703},
+				{-33.60323053156408, 27.173273263624083, -2.9116906770992528},
+				{-35.363729558877495, 27.885298122422352, -2.683467939287265},
+				{-37.70385339307989, 28.82326097338703, -2.371139987082225},
+				{-40.14114628847669, 29.24915182418721, -1.7020367507729535},
+				{-0.8516123336332011, -15.955177949374725, 11.07994594949695},
+			},
+			{
+				{-66.01214871661072, 62.74315877509596, -12.084566046454832},
+				{-49.09887494536678, 46.749210874959246, -8.677292739669882},
+				{-40.72119514050422, 38.768660121170825, -6.935448395220667},
+				{-35.752567380708214, 33.95740363161391, -5.845553144737734},
+				{-32.59764374508721, 30.805903751860583, -5.089941597532236},
+				{-30.587485155165005, 28.678519668675683, -4.533248225817791},
+				{-29.393893901740974, 27.260339994961537, -4.106643107663349},
+				{-28.84326758782394, 26.380783651714278, -3.7706146389143633},
+				{-28.845915424209355, 25.94555984318815, -3.5001770691790357},
+				{-29.368017380141193, 25.907938467459942, -3.277910703966421},
+				{-30.42363792809525, 26.257232916626737, -3.0898486455211334},
+				{-32.08003512967714, 27.01618588539046, -2.9216022908968338},
+				{-34.47472381405108, 28.24054920491519, -2.7509961388832265},
+				{-37.82729601634276, 29.98737175899865, -2.522641790593323},
+				{-42.15562010052532, 31.89216039645644, -1.9933910711856366},
+				{2.3625953830302944, -21.019225978355944, 13.463771236213988},
+			},
+			{
+				{-61.61288819049335, 60.86239089773791, -11.880396120309161},
+				{-43.99776693165071, 44.26703492879834, -8.373939486785755},
+				{-34.90141302873051, 35.81597359096876, -6.568449018438128},
+				{-29.262655991595466, 30.614329983590903, -5.432202759598448},
+				{-25.579982995885572, 27.183617397196702, -4.648404620520299},
+				{-23.248529884196476, 24.914581478940768, -4.083249626836034},
+				{-21.970994565963856, 23.50411428595034, -3.6679732133692164},
+				{-21.58075070958981, 22.782945417526296, -3.362040009595359},
+				{-21.982414360336797, 22.654112682793787, -3.1391208603018863},
+				{-23.1292076492171, 23.067913672511352, -2.98050295288478},
+				{-25.021675786195853, 24.015751166574194, -2.8714817403951507},
+				{-27.736950887800976, 25.54493174619356, -2.7991955826330046},
+				{-31.50412781674064, 27.801502563562092, -2.749227892860329},
+				{-36.87118074027, 31.118073902393956, -2.6902474376113146},
+				{-45.06128684326943, 36.08053551710021, -2.458463217198103},
+				{4.364882717212766, -27.223076746794174, 17.504729653170838},
+			},
+			{
+				{-40.61118895150126, 49.29407840719776, -10.314658627541084},
+				{-4.882007486358297, 22.35980428744376, -5.447445299626364},
+				{15.398463947625292, 8.220503064196496, -3.012657409478594},
+				{27.91319334519976, -0.06852195421838811, -1.6116070976603671},
+				{36.2096755885655, -5.311142851893201, -0.7258545681230559},
+				{42.1634919208512, -8.872174072312623, -0.11740387127334063},
+				{46.889355326774606, -11.507565789969435, 0.33934295685902444},
+				{51.089161166755574, -13.660383474461021, 0.7152948823061956},
+				{55.23007674302157, -15.597968590297631, 1.052148484886973},
+				{59.685089415223715, -17.50294505641176, 1.3777811045394324},
+				{64.84642214831564, -19.532446117725293, 1.715746843123123},
+				{71.2479414632052, -21.862749416874852, 2.0924758650658006},
+				{79.7714395025057, -24.737175216938205, 2.5462560267281473},
+				{92.07880159043408, -28.496001357636047, 3.1426837733234922},
+				{110.67674300297145, -32.709055694416776, 3.9581503717772852},
+				{-1.0964261244345454e-09, 7.288798808672686e-10, 22.738000198290237},
+			},
+		},
+		{
+			{
+				{-145.44288899252618, 115.81528573734545, -20.58221439006721},
+				{-107.54700662596066, 83.93723974924043, -14.273455765918388},
+				{-91.17406424474544, 69.94254195540508, -11.430687691258651},
+				{-82.1095271574019, 62.00176018240526, -9.754730355072116},
+				{-76.59158289963956, 56.97516955998227, -8.632391829201753},
+				{-73.17075803519663, 53.64552534823151, -7.8234970380319675},
+				{-71.18130315436211, 51.44541266869335, -7.213339093265366},
+				{-70.29472434307486, 50.084325526983505, -6.739743042181365},
+				{-70.35794670562085, 49.41178473004485, -6.3657295831967255},
+				{-71.33059523958367, 49.36153238454545, -6.067461648976682},
+				{-73.26622476520973, 49.929576955428495, -5.827853964453196},
+				{-76.32196492854168, 51.17007380016776, -5.631700493705245},
+				{-80.80016819074311, 53.20438799081637, -5.458859276926225},
+				{-87.23520471989548, 56.232134130607875, -5.2659222018962275},
+				{-96.41465437832237, 60.363001717146076, -4.891672283385071},
+				{-104.93523329592158, 60.72976706070631, -2.7180959586426656},
+			},
+			{
+				{-127.62351569670489, 102.84653151351303, -18.421147683512643},
+				{-95.8925417362538, 75.77491296823482, -12.987091634883237},
+				{-81.63727258037986, 63.42110827437487, -10.44335128096269},
+				{-73.56774967578475, 56.26002784015862, -8.913505991620859},
+				{-68.55192805686875, 51.64232607833416, -7.873366900056337},
+				{-65.35174222528008, 48.5149819925692, -7.112676914125747},
+				{-63.38462021368707, 46.37612424016304, -6.52909821753802},
+				{-62.352001883183235, 44.96068800965679, -6.065972470209019},
+				{-62.10094826326527, 44.12215467737613, -5.688518741085092},
+				{-62.56672612959793, 43.7816931703245, -5.372998620425365},
+				{-63.7491267099821, 43.90405076912466, -5.100570988283884},
+				{-65.7055344529482, 44.484073151234995, -4.852057929997876},
+				{-68.55194674534081, 45.532154385697105, -4.5998795977632625},
+				{-72.44338469105688, 47.02209550205458, -4.2858112288651045},
+				{-77.27881153696224, 48.53175843480292, -3.7134389843054794},
+				{-75.93562295992734, 42.5188804981824, -0.8916456598141538},
+			},
+			{
+				{-114.86040762386455, 93.64299993131684, -16.894383825375566},
+				{-87.18591251662396, 69.73994891510404, -12.040790065277612},
+				{-74.40553607555712, 58.53019134362532, -9.70678973791459},
+				{-67.05416216901213, 51.93178112385291, -8.282773731233704},
+				{-62.416684417286696, 47.62080848922665, -7.304029426757055},
+				{-59.39982917731546, 44.656804667496466, -6.580900859630846},
+				{-57.4803945133984, 42.58449701020729, -6.019815734071691},
+				{-56.38367722527111, 41.15852566231855, -5.568217133533506},
+				{-55.961461040464286, 40.23812194081326, -5.1931990750815675},
+				{-56.13943290899805, 39.7403893209975, -4.871581010393373},
+				{-56.892579027746294, 39.61622762591714, -4.58406555895165},
+				{-58.23196945090214, 39.83347647038502, -4.309980965289964},
+				{-60.18980105732427, 40.35304314433679, -4.018751169815574},
+				{-62.75926566452321, 41.05166125183673, -3.6457575512796927},
+				{-65.48158512964329, 41.28313317680074, -2.9761901285289754},
+				{-58.34228942448897, 30.946413356639887, 0.5141606479502142},
+			},
+			{
+				{-105.25476041101557, 86.7946924264416, -15.764139516632842},
+				{-80.426891858853, 65.11460883050384, -11.319792056131686},
+				{-68.72640501156756, 54.74146515202963, -9.139778511528212},
+				{-61.91639371122954, 48.56619336816095, -7.79545617485235},
+				{-57.574608043049565, 44.49351271725845, -6.864117582866106},
+				{-54.712102873335844, 41.66393525308951, -6.170977552205968},
+				{-52.84978807841321, 39.65663557765013, -5.628923902799449},
+				{-51.73199301454686, 38.241664962547326, -5.188519968012052},
+				{-51.21635918579699, 37.283987666520225, -4.818395989912194},
+				{-51.225238378806104, 36.70004672218086, -4.496005983488909},
+				{-51.72138228474637, 36.4342873852345, -4.202047677519299},
+				{-52.69201860636167, 36.44090811542452, -3.9152416929402403},
+				{-54.126221410378996, 36.6553395321766, -3.603512795030728},
+				{-55.9347724359036, 36.903570295453825, -3.1984412040700287},
+				{-57.47029245994849, 36.41092711707467, -2.470652436199596},
+				{-45.938215587956996, 22.400548301064575, 1.7251858605132417},
+			},
+			{
+				{-97.76830911157181, 81.53393749022055, -14.90133395190746},
+				{-75.02654939752921, 61.478314985621736, -10.7570669009914},
+				{-64.1448477146389, 51.73703475453744, -8.693601088510945},
+				{-57.755938167477424, 45.889289141980505, -7.410915763922017},
+				{-53.65178550221936, 42.00661802817317, -6.517069031775241},
+				{-50.92132703679225, 39.28973787704303, -5.84835103753017},
+				{-49.119229103789536, 37.343995157319185, -5.322539002533537},
+				{-48.00517742470829, 35.95166052200125, -4.8926143651114975},
+				{-47.44269339559048, 34.982935373009994, -4.528457555700318},
+				{-47.35375420730092, 34.355108875012604, -4.208123792493954},
+				{-47.695159918204666, 34.00979219834265, -3.912473635607524},
+				{-48.44140190008821, 33.89407040867987, -3.6199889331342123},
+				{-49.5578938664929, 33.92929272776317, -3.297717170724833},
+				{-50.90920371129469, 33.911723381240066, -2.8744933417348197},
+				{-51.730633719853806, 32.9781211363374, -2.107681721885544},
+				{-36.64206169339579, 15.75018486187169, 2.780856383604899},
+			},
+			{
+				{-91.77952855348664, 77.40397559339574, -14.229459833712301},
+				{-70.61183869517346, 58.56652956822966, -10.310636522480335},
+				{-60.36634734313967, 49.31268610541343, -8.33710189451543},
+				{-54.31266412988301, 43.72362069352777, -7.102948918139236},
+				{-50.40379070013667, 39.995531589735535, -6.239276735003674},
+				{-47.788241733966174, 37.37483626182115, -5.590783292898035},
+				{-46.04708101856259, 35.487135600575115, -5.079008558944677},
+				{-44.95255734925374, 34.12451698443724, -4.658832771208153},
+				{-44.37376517364241, 33.1618572543568, -4.301157252563746},
+				{-44.23388217092209, 32.51803692946733, -3.984579449684291},
+				{-44.487329379134636, 32.13386985294743, -3.6901897570245663},
+				{-45.10218757441563, 31.953043959700384, -3.396414606271182},
+				{-46.03113614622217, 31.88926228520708, -3.069752253598544},
+				{-47.11236662265819, 31.720741065204216, -2.6368858282295156},
+				{-47.502110437996585, 30.51769804766969, -1.8428454899850615},
+				{-29.334935986347947, 10.322197458650734, 3.736815323359454},
+			},
+			{
+				{-86.88940831870602, 74.1144176367569, -13.700157191414066},
+				{-66.93158019875169, 56.20313018222085, -9.952736348908},
+				{-57.1887320139248, 47.329916675348564, -8.049294073446866},
+				{-51.40656211662258, 41.94793261890932, -6.85377191556635},
+				{-47.66119774856587, 38.3476405164764, -6.014697534025608},
+				{-45.147457606490434, 35.810524296165966, -5.383205557545154},
+				{-43.46737632987213, 33.978033024356506, -4.88375450107867},
+				{-42.40363364900768, 32.6502347356327, -4.472727535386711},
+				{-41.83055152281116, 31.706190712363593, -4.121865900770842},
+				{-41.673486007071254, 31.066820495184896, -3.8102533987195146},
+				{-41.886819797643966, 30.673490574872147, -3.5192450670615023},
+				{-42.43639994630963, 30.4689930456453, -3.2273309350834594},
+				{-43.2693810036541, 30.363744056757557, -2.9006925444555325},
+				{-44.21064138726359, 30.12588636746261, -2.46433046913459},
+				{-44.36352564282084, 28.775292660701314, -1.6520559038237974},
+				{-23.350731545122557, 5.684583581305768, 4.643577416583402},
+			},
+			{
+				{-82.8257889932761, 71.4704562905872, -13.281291105370208},
+				{-63.80657565785352, 54.2647920540964, -9.664130701841481},
+				{-54.46491818951207, 45.689934477180564, -7.815371063425599},
+				{-48.90549543741824, 40.47502694033452, -6.650735963525906},
+				{-45.299318771383675, 36.98181106316606, -5.831898537982645},
+				{-42.87751845352734, 34.51870584468846, -5.2149081491259315},
+				{-41.25898339761575, 32.73961547180099, -4.726484673157194},
+				{-40.23519618484248, 31.451170450175592, -4.3242042966590635},
+				{-39.68544041367004, 30.536244144738554, -3.980506581102637},
+				{-39.538002724380526, 29.918162488764086, -3.6749195959827987},
+				{-39.74903813397118, 29.53993499076573, -3.3890954031880405},
+				{-40.285415692886104, 29.345492296257692, -3.1016947538900914},
+				{-41.09444413186437, 29.24556107089209, -2.778802339124707},
+				{-41.9981607305169, 29.0049588153796, -2.3442166918563507},
+				{-42.069726520536264, 27.60851338045333, -1.5220253170930305},
+				{-18.266513959165064, 1.5430668080814451, 5.545420902704278},
+			},
+			{
+				{-79.39136099992396, 69.33486624440262, -12.950597217176878},
+				{-61.10059292703196, 52.66059778753556, -9.430887405803318},
+				{-52.080402741630174, 44.31822621764503, -7.624362650688176},
+				{-46.7052739200608, 39.238343081882206, -6.4843560812827326},
+				{-43.21931176856746, 35.83584065236667, -5.682268104122075},
+				{-40.8822098307814, 33.439621012777245, -5.077838628109186},
+				{-39.32652174784475, 31.713352874240595, -4.599518348908533},
+				{-38.35130058735995, 30.46912917044344, -4.205827654669387},
+				{-37.84066099481722, 29.593402275309053, -3.869795128401792},
+				{-37.726551113881214, 29.01231520951887, -3.5713698665890035},
+				{-37.96857356439888, 28.671600242396067, -3.2925474237293586},
+				{-38.53764751722941, 28.518332707026673, -3.0122843788400644},
+				{-39.386621134335165, 28.46715928829725, -2.6968240861093737},
+				{-40.344931328512516, 28.286553297222124, -2.2693353723437255},
+				{-40.479588971861254, 26.943603924095946, -1.4461760521271447},
+				{-13.79026022662869, -2.3274194039748775, 6.486922847763569},
+			},
+			{
+				{-76.4327865024343, 67.6060845477722, -12.692075362418862},
+				{-58.7010398473395, 51.31890825929682, -9.242341865296202},
+				{-49.93698919389695, 43.15379682479173, -7.467535624913347},
+				{-44.71450782711346, 38.18213365264771, -6.3469000320378415},
+				{-41.3334542088462, 34.85704670277401, -5.558703739934337},
+				{-39.07589627088523, 32.52258967114968, -4.965346239914275},
+				{-37.58552222711673, 30.849987994876145, -4.4965619256298766},
+				{-36.668036178041845, 29.65594243628781, -4.111609442414361},
+				{-36.21242194969211, 28.83040686468283, -3.7840243349523415},
+				{-36.15514252995635, 28.302892397173935, -3.4941768793278505},
+				{-36.46107808029921, 28.023123657491578, -3.224481010166183},
+				{-37.10852810926448, 27.943664368906727, -2.954359294153412},
+				{-38.06195839238585, 27.98737864347835, -2.650552369570155},
+				{-39.170194359575184, 27.934951265559313, -2.2363502882887816},
+				{-39.52380882092604, 26.75794066694588, -1.4230321797385117},
+				{-9.696971536256578, -6.129825118872024, 7.5216756078959275},
+			},
+			{
+				{-73.81876197438915, 66.20354017768545, -12.49366318208052},
+				{-56.501183448223095, 50.175941133325445, -9.08939181041197},
+				{-47.935741996069495, 42.138473543306, -7.336860293513999},
+				{-42.837697957379895, 37.251034811802114, -6.23094061709034},
+				{-39.54820730033315, 33.991967293180444, -5.454222395417477},
+				{-37.3665184564932, 31.7157997939264, -4.870839918733596},
+				{-35.94535555599261, 30.099404953334727, -4.411411354129226},
+				{-35.09639355945616, 28.963424163022182, -4.035755625598816},
+				{-34.7137226338968, 28.201416062957897, -3.717857625173004},
+				{-34.73943696325477, 27.747062456245533, -3.438539765936018},
+				{-35.14598286858744, 27.55577585485293, -3.180757475754625},
+				{-35.923270048932885, 27.588746281790147, -2.9246534710120753},
+				{-37.05535681872923, 27.782946290984764, -2.637961497129232},
+				{-38.42682456862116, 27.94334823155668, -2.2451704521627573},
+				{-39.193651708933984, 27.077751676245462, -1.4560999515097799},
+				{-5.781754088567226, -10.091820854166865, 8.726562825597098},
+			},
+			{
+				{-71.41359853611232, 65.05118596662426, -12.344764624571996},
+				{-54.373041976368825, 49.15917426907221, -8.962114177348433},
+				{-45.94908376687936, 41.20009528285308, -7.222676572703124},
+				{-40.94656131725875, 36.3730226851188, -6.127055927613246},
+				{-37.73491142517593, 33.1691511259143, -5.359697617229139},
+				{-35.625902824570716, 30.949070856572725, -4.785577543898329},
+				{-34.27945112737723, 29.393545177237627, -4.335814783489092},
+				{-33.51269781705522, 28.326634831994795, -3.970626002610821},
+				{-33.22530019228443, 27.645825797852456, -3.664411196527407},
+				{-33.36651553792216, 27.290172418313166, -3.3985185453495825},
+				{-33.9194935063196, 27.22323764258808, -3.156648371361129},
+				{-34.89176012033352, 27.419497099624657, -2.9200704480073507},
+				{-36.298817935511074, 27.839101613030916, -2.6583081586783903},
+				{-38.086567476327886, 28.330653372218222, -2.2987679296264507},
+				{-39.543659042678826, 27.989527104039702, -1.5551262736811813},
+				{-1.8052166918960724, -14.541035778911066, 10.231829614404894},
+			},
+			{
+				{-69.0209052639894, 64.04344283902928, -12.231297493864004},
+				{-52.10853279855002, 48.15219358990677, -8.844828300719291},
+				{-43.75775612375359, 40.21527941871078, -7.108611766748307},
+				{-38.81242835963066, 35.41986599363963, -6.018564572567048},
+				{-35.65838997131833, 32.25777292410568, -5.258478467761374},
+				{-33.61592817379811, 30.091459908428, -4.693280151615977},
+				{-32.35058933489297, 28.603951881848516, -4.254205153554433},
+				{-31.684563555549776, 27.622301857866677, -3.901702457401762},
+				{-31.52363448583485, 27.048438627658996, -3.610563254045066},
+				{-31.826153726801355, 26.828494484357517, -3.362781427600672},
+				{-32.590308836480496, 26.93784565100808, -3.1431264570792625},
+				{-33.85028496849631, 27.371628818738145, -2.9346734606459486},
+				{-35.672339175062994, 28.128488309291697, -2.7101271403525384},
+				{-38.10871031234816, 29.135567316598184, -2.402911257402288},
+				{-40.70661309168677, 29.672356981012438, -1.7395546170222018},
+				{2.613766066764459, -20.08359667142914, 12.300217531802206},
+			},
+			{
+				{-66.23348628939789, 62.955846771739864, -12.122904730027262},
+				{-49.246367977119974, 46.89208766387428, -8.70179776375156},
+				{-40.84275122485934, 38.88682944068238, -6.95490778526544},
+				{-35.863850194029915, 34.065352102151564, -5.862688481844878},
+				{-32.706694044950304, 30.910815232961163, -5.10602133343332},
+				{-30.699889048809567, 28.785162461144438, -4.549038686937867},
+				{-29.514507697498654, 27.372685863298106, -4.122712032378785},
+				{-28.977074885497938, 26.502785526943065, -3.787462137139054},
+				{-28.998725231803032, 26.081740712300654, -3.5183150477022327},
+				{-29.547353505548962, 26.064090342610896, -3.2979357714040747},
+				{-30.64020624663759, 26.44152369546, -3.1125377775582463},
+				{-32.35052488953079, 27.24122752671707, -2.9480700076627735},
+				{-34.827855618801244, 28.52779402096803, -2.782990545099422},
+				{-38.318797628048095, 30.377762886454946, -2.5631250693603023},
+				{-42.91100957305851, 32.474562310420076, -2.0469210618720535},
+				{8.335123242379154, -28.205706791595976, 15.602079708044409},
+			},
+			{
+				{-61.812627277327984, 61.074283601876935, -11.919844261082467},
+				{-44.10354365457588, 44.3928196643279, -8.396739061727613},
+				{-34.96254003741974, 35.90495440749315, -6.584452556044083},
+				{-29.295857584386017, 30.68277267250105, -5.4445465458002795},
+				{-25.596666558078983, 27.241292597963653, -4.65879402796268},
+				{-23.259807456856763, 24.96984430751896, -4.092968405325942},
+				{-21.988271226676083, 23.564967638568014, -3.6781234000394436},
+				{-21.615905524138206, 22.857530149002944, -3.373642963131007},
+				{-22.04823633111717, 22.75126837170442, -3.1531974994904544},
+				{-23.24000749090618, 23.197887430178522, -2.9981488337197124},
+				{-25.19460968143424, 24.191503484494568, -2.8939826803557698},
+				{-27.99630866521394, 25.78562037081881, -2.8283308638288305},
+				{-31.89347763656973, 28.141791890199528, -2.788046191594167},
+				{-37.488846689361885, 31.635129741279652, -2.745115229713135},
+				{-46.20133940168522, 36.999286775555255, -2.545613538333659},
+				{18.57841101067821, -44.68611839179584, 22.786032851189393},
+			},
+			{
+				{-39.83322808403718, 48.947181147421944, -10.276757221206106},
+				{-3.257176416230034, 21.535685187879974, -5.345949961626784},
+				{17.386588611868348, 7.233432425815977, -2.8955323972133535},
+				{30.08547279260341, -1.1147045414328396, -1.4926928600495506},
+				{38.50833301509658, -6.386162186185277, -0.6084563196447895},
+				{44.60443664220163, -9.985715335567127, 0.0002488926407789806},
+				{49.54223932883705, -12.695770882432798, 0.46201412156830335},
+				{54.059839530652226, -14.974253700085505, 0.8490217009245832},
+				{58.665059473450334, -17.104075911035007, 1.2041927648281487},
+				{63.803702133062615, -19.297706612912968, 1.558165571060575},
+				{70.01197371106204, -21.773179566496896, 1.9404630854512814},
+				{78.13550021364799, -24.83991253222022, 2.3907745329408683},
+				{89.84390571006844, -29.078474610136308, 2.9810887229253282},
+				{109.3854264591009, -35.93625004607732, 3.8875233589048803},
+				{153.58941431650808, -51.1034826986827, 5.79424506657498},
+				{-19.832273713392127, 12.87830516784968, 75674.04065101262},
+			},
+		},
+	},
+	{
+		{
+			{
+				{-5.952865057324677e+06, 2.0025411190975136e+06, -236789.4857142624},
+				{-1.0948033226371417e+06, -200525.54306706216, -18740.37943785014},
+				{138755.0579235211, -656382.5093955473, -1890.442485967064},
+				{652495.7109152962, -790155.2355637057, -17370.450941872776},
+				{868411.746261772, -868353.4131846321, -15058.312894899665},
+				{619706.5882807064, -708131.8748450951, -32234.325002793314},
+				{701628.6725901174, -766330.6208026034, -18608.82169378228},
+				{402381.6798592082, -561933.0529051216, -44833.752580892615},
+				{812704.6601453245, -822854.6677743408, -6187.176062346429},
+				{475721.6306192031, -594146.8196293085, -36667.39374209464},
+				{759631.2040989939, -769834.1288203547, -10748.555611798543},
+				{762268.0842155978, -772251.5665675638, -6942.088746596651},
+				{750806.0552761502, -738291.4651233335, -14851.790768572482},
+				{730044.8189420297, -752476.0460320747, -3372.043682164589},
+				{677116.8549087099, -712487.6222831551, -7105.703251887398},
+				{555116.486525061, -661755.1557027453, -4964.558729173279},
+			},
+			{
+				{-2.0784758114354662e+06, 484958.67308241583, -96657.99115906263},
+				{-444994.06180076656, -299095.1178918942, -18600.11813478277},
+				{217148.1727762032, -575725.8736800444, -2107.73587677416},
+				{551913.2108818786, -690456.4610731844, -3193.5586948393902},
+				{764832.6657504963, -770981.1421954675, -1496.8600673782507},
+				{756870.1634415165, -760538.305756365, -1330.2989119011609},
+				{737388.2359366147, -745208.0137783383, -1435.429083380336},
+				{738827.457511154, -737994.0662221417, -1745.5873960977594},
+				{624637.2272440555, -684786.2251270826, -1745.6086401348189},
+				{632261.3384267264, -679744.5127913288, -2496.1371060298743},
+				{560897.9817097565, -641736.3093247025, -3543.2186701629535},
+				{633703.9980714703, -661581.686265896, -5094.325522476445},
+				{641005.2168129318, -656457.933192298, -6151.1698181265665},
+				{560278.0924153279, -611631.3585809085, -8490.552933548879},
+				{581862.8462603387, -616362.2010714209, -8222.579375772884},
+				{625663.0560071521, -634602.9569584844, -6071.861427284829},
+			},
+			{
+				{-1.2125264864037256e+06, 208249.75584324752, -76111.54486936246},
+				{-311508.01049003715, -241885.975361715, -29616.31400662186},
+				{195738.90671149612, -488137.887856237, -6864.563719691934},
+				{455781.38489033433, -593931.4353098182, -2320.3701168927373},
+				{620401.2193258229, -654137.4361908536, -1906.4468940589186},
+				{666013.7498821382, -667182.7506250388, -1894.745523904029},
+				{643324.4443278569, -652584.5203548844, -2074.2015664521186},
+				{547586.1094029995, -607064.2703539659, -2850.491201495836},
+				{621775.8422123116, -633609.778033233, -2336.2090292114444},
+				{409423.13134067925, -537844.7159758627, -4224.0880762936695},
+				{587082.7864079757, -609173.5966927921, -2947.0072493293087},
+				{550238.2780131933, -587754.0862465906, -3680.9107249786575},
+				{607008.7137371199, -608133.7488178764, -3332.541431286477},
+				{577444.8487891017, -590611.1250874266, -3950.4713005865833},
+				{542370.9222459287, -572215.0311589614, -3933.5411781802477},
+				{580417.5849474496, -583602.8686573381, -4287.9799461428265},
+			},
+			{
+				{-908644.361102217, 151617.59707265702, -75561.32237451381},
+				{-305533.85391654365, -158648.34314318871, -42319.29517110254},
+				{83672.71081697577, -357199.47583242407, -22030.28471015688},
+				{340898.19781902025, -485527.25989865896, -9834.427346087958},
+				{505595.9967314803, -561787.0452106443, -4068.582875386607},
+				{600503.1193748522, -599466.2801423894, -2712.5390012562466},
+				{582327.8422559529, -586851.1805428503, -3300.4584031230415},
+				{557129.3534208352, -570966.7655213597, -4060.0219821310293},
+				{440571.84685183223, -513216.00957336347, -6636.436510315467},
+				{521881.1013455185, -546030.1278917135, -5517.745664021174},
+				{498657.9412188727, -531443.1406751585, -6095.742910274619},
+				{549718.8881172098, -550300.3176230395, -5716.848882814456},
+				{341879.07663875364, -445804.70322608197, -11791.248091220536},
+				{539377.7675097683, -537115.0074149682, -6848.438499310234},
+				{518351.28562302963, -522162.9882618835, -8164.012636540767},
+				{350353.4359697406, -431291.96965345857, -15350.145533494268},
+			},
+			{
+				{-784778.8572318158, 158329.115524495, -80367.53223927035},
+				{-337799.4653675624, -76748.19128435416, -54403.27982853195},
+				{-26276.745577829308, -240018.35688837373, -36876.270983340204},
+				{196423.0880806381, -355860.1748319712, -24816.529824691992},
+				{358146.38777407934, -438892.5577875946, -16478.9367550819},
+				{476000.61070162855, -498086.3897417362, -10820.653189996998},
+				{530984.584491102, -523253.1261165211, -8613.651434540847},
+				{516366.40634168393, -511287.6118859492, -9837.050601202962},
+				{487054.29812597705, -491436.53579687874, -11776.317764899117},
+				{464673.10293426487, -474877.15646129544, -13524.118658649462},
+				{502293.79034655297, -491860.37940264813, -12038.941158288493},
+				{464968.88694542606, -465898.57068223995, -14792.641079702516},
+				{489136.1061919626, -477114.45904092456, -13716.426179432874},
+				{459643.23120645486, -457056.6092575064, -15771.159469407947},
+				{370221.1107129198, -403440.55113402056, -21360.925751697992},
+				{424068.8335805144, -430008.44565220457, -18843.951057286507},
+			},
+			{
+				{-733816.5959290317, 186284.77120781597, -86695.01646934058},
+				{-381265.28093448735, -2366.746796883727, -65333.7882724999},
+				{-122248.35353066176, -140768.45056924474, -49965.29520236475},
+				{72012.893933872, -244191.15674145293, -38699.63646404711},
+				{219914.10445530002, -322450.6639955358, -30337.830510865235},
+				{333608.5124377633, -382064.5606269203, -24093.34801997524},
+				{421184.8295986317, -427387.9566744254, -19444.60479802962},
+				{464692.53781107394, -448245.5890940278, -17392.65908728865},
+				{452420.63342095073, -437936.75286140846, -18574.074657802932},
+				{440739.1946884699, -427931.45198432024, -19728.240570847935},
+				{431223.9018434467, -419336.96371562994, -20729.834246070488},
+				{417638.2713257936, -407388.4141084301, -22156.46789379447},
+				{427273.7443123696, -410588.0863226844, -21875.226022475377},
+				{430186.0497244401, -408897.04479901696, -22180.02696260992},
+				{382060.26224713156, -378740.158987649, -25480.582581977593},
+				{210598.6849250832, -277100.7685397979, -36512.27912517461},
+			},
+			{
+				{-716424.5249444752, 221062.08416069843, -93265.78599449634},
+				{-426523.681678826, 63740.252959627105, -75081.15277004908},
+				{-205084.42950499663, -56380.19342237638, -61395.3324299777},
+				{-33077.64456338406, -149511.92531628598, -50929.084705290465},
+				{102278.09994568641, -222547.58081827237, -42828.3770723857},
+				{209813.7120288039, -280270.87683358905, -36506.688535936744},
+				{295754.6478178187, -326067.2476551649, -31552.853914682295},
+				{364460.7799348627, -362312.62911029754, -27680.019898050392},
+				{409309.2683152094, -385120.35477227386, -25290.48932931944},
+				{401987.527823354, -378084.57778242417, -26147.811284748863},
+				{391688.2164873048, -369356.9666998829, -27189.862485802052},
+				{368723.444578311, -353364.5432706543, -29031.361914917437},
+				{320788.5854021396, -323129.9956423483, -32442.658399276985},
+				{355262.9968324335, -340291.31539229606, -30641.37295302185},
+				{310677.8829868436, -311218.1043740489, -33977.76867347439},
+				{354885.14401114883, -334977.17630842875, -31402.469299829932},
+			},
+			{
+				{-715663.4374692092, 257073.6907557196, -99611.52916109402},
+				{-470109.5950845594, 122245.68268651227, -83753.5707119447},
+				{-276829.45406065346, 16100.475605575406, -71407.9990742873},
+				{-122563.68429176876, -68545.33362209573, -61665.64257565478},
+				{1946.9221045418326, -136729.1812673523, -53894.843390117734},
+				{103325.07746652795, -192068.32428875513, -47646.18069944414},
+				{186402.44556470163, -237211.3992902146, -42593.44897912823},
+				{254748.5630893538, -274120.9270782353, -38496.8639130488},
+				{310977.35540020914, -304235.79036595486, -35181.657645999636},
+				{356193.3757879311, -328137.466872392, -32573.315037109824},
+				{360202.6352294879, -328137.24140844954, -32646.14494470573},
+				{324193.7390915863, -304811.8065115986, -35338.81375434354},
+				{349613.9298275676, -317330.54596261523, -34004.18821944774},
+				{347648.2173225449, -314028.26068870974, -34440.64608998196},
+				{298626.1814067735, -283048.4191396287, -38005.11833210829},
+				{295752.71444826334, -279239.0921737106, -38505.02126599328},
+			},
+			{
+				{-723434.4577169623, 292047.8377109956, -105564.5933560699},
+				{-510809.1760426494, 174125.47390856402, -91486.43080782917},
+				{-339396.4400300465, 79007.47528912312, -80232.22650507804},
+				{-199578.3134704728, 1440.939330174995, -71130.98440153265},
+				{-84422.26284144382, -62374.76155245165, -63701.117221315624},
+				{11173.063666811508, -115246.0375451957, -57589.88786993518},
+				{91028.12124909548, -159282.03602236256, -52534.21996345718},
+				{158044.06961648588, -196089.00244329683, -48335.26611677413},
+				{214443.31838626135, -226900.3218508671, -44841.39788506128},
+				{261919.11288798554, -252656.69162566288, -41937.61224818208},
+				{301609.22420170036, -273984.8247699769, -39547.02883836278},
+				{321358.46068551554, -283664.25346352207, -38493.98525446512},
+				{317795.7219676915, -279609.487739407, -39015.69273102614},
+				{314777.0428330757, -275919.7209751889, -39493.85557030435},
+				{299532.2918235902, -265005.70720666513, -40805.17322674886},
+				{301040.34250707785, -264083.4385742815, -40961.429979196735},
+			},
+			{
+				{-735575.5397452175, 325086.1514409152, -111078.72843771597},
+				{-548306.7860933604, 220319.38515245955, -98407.41085311075},
+				{-394362.4141643486, 134128.29964692722, -88059.54193676104},
+				{-266536.0167024706, 62548.97328300509, -79524.52932118514},
+				{-159494.65458635573, 2637.9989297867805, -72425.95302901158},
+				{-69221.30357859007, -47828.973539116385, -66481.33067374877},
+				{7356.193790878991, -90558.2398474951, -61475.45487406848},
+				{72621.48840148708, -126877.69613953622, -57241.96106268267},
+				{128441.24242890424, -157829.8691428933, -53651.05595105466},
+				{176288.37401697866, -184238.94460923935, -50600.78202506604},
+				{217322.1948257101, -206754.56616510477, -48011.14637524533},
+				{252411.982780375, -225862.27227101356, -45822.513355073286},
+				{281590.03288366727, -241554.61622415346, -44033.67445595094},
+				{285717.7263029701, -242363.91014963202, -43982.6015834991},
+				{276884.11825686035, -235450.80904761818, -44833.64249894739},
+				{273886.60228140664, -232053.4061361443, -45272.77218754652},
+			},
+			{
+				{-749835.5663817448, 355893.3583022271, -116158.39064674538},
+				{-582664.2491054658, 261650.8210766743, -104628.40967367997},
+				{-442993.7252614405, 182837.72811136802, -95045.75177790287},
+				{-325280.734851851, 116385.72230217975, -87012.222160052},
+				{-225329.64326441812, 59965.04044991102, -80227.38148003703},
+				{-139919.52307005407, 11782.03381225375, -74461.3633150608},
+				{-66539.99872514248, -29565.46877111923, -69535.54362685388},
+				{-3212.523444472052, -65185.48045008743, -65309.632604448096},
+				{51640.91570171439, -95964.16271347848, -61672.059694075244},
+				{99287.37125133803, -122614.53710295263, -58533.547993785905},
+				{140751.50891910895, -145714.3866390776, -55822.140131852175},
+				{176860.7630444074, -165730.86615497404, -53479.92201915722},
+				{208270.68918482965, -183033.7533561205, -51461.21935874241},
+				{235426.37846897103, -197870.82999141235, -49735.32696979045},
+				{255895.47392394475, -208758.66075436253, -48477.23462466062},
+				{250657.5045966983, -204191.79589311808, -49051.46332917326},
+			},
+			{
+				{-764954.6855771069, 384446.1898555291, -120828.67156365779},
+				{-614087.3791690281, 298812.23114719044, -110244.10712237863},
+				{-486299.50535636547, 226202.5614795589, -101316.72383153517},
+				{-377231.26062016614, 164190.03530777505, -93729.36714778584},
+				{-283521.95701480843, 110898.58945468703, -87238.30017260916},
+				{-202546.5752780389, 64858.55857290853, -81653.59107158633},
+				{-132227.10232989857, 24903.746434969533, -76825.40253716058},
+				{-70900.98738594993, -9901.73128184822, -72634.07445966933},
+				{-17226.117689460814, -40314.8649646812, -68983.3675042941},
+				{29891.444795936302, -66954.3388503257, -65794.97716619962},
+				{71348.91550111362, -90328.58175536558, -63004.88885809171},
+				{107886.17494796404, -110857.59519555754, -60560.454911227454},
+				{140114.43451208912, -128888.91116593311, -58418.29042702932},
+				{168534.00799560043, -144706.9504880286, -56543.03972661007},
+				{193537.14564788845, -158534.01158577757, -54907.10740330972},
+				{215328.05005381972, -170480.78130522574, -53496.55343439804},
+			},
+			{
+				{-780215.3495334325, 410842.7283149755, -125121.96363610099},
+				{-642830.6092179753, 332380.93222661864, -115334.12042195392},
+				{-525087.6615311916, 265061.53627239447, -106974.51699476579},
+				{-423496.56316375104, 206931.85634373396, -99786.39556554236},
+				{-335320.80533658294, 156456.58896085105, -93568.89846196579},
+				{-258391.11745747898, 112415.6024366228, -88163.19703410481},
+				{-190966.85260106382, 73827.44056284204, -83442.17451675663},
+				{-131639.1811372895, 39895.70920342982, -79303.14258940396},
+				{-79255.83965120613, 9967.618029816043, -75662.36229403474},
+				{-32867.76000409741, -16495.856099521243, -72450.96791959254},
+				{8312.329199758904, -39942.686236297515, -69611.99282061681},
+				{44942.060730160156, -60747.92800979229, -67097.94120513936},
+				{77572.01369233856, -79226.02794008925, -64869.14868515944},
+				{106665.83205019694, -95642.25695526552, -62892.286891968826},
+				{132611.50562322742, -110218.64978192486, -61139.56431578302},
+				{155729.58930100925, -123138.62705169056, -59588.079540780316},
+			},
+			{
+				{-795206.8881248012, 435230.3692220134, -129071.86927754473},
+				{-669149.830920609, 362835.2200582789, -119964.98991256127},
+				{-560012.3362429684, 300084.05912202474, -112102.67025386366},
+				{-464952.7838878834, 245379.25966439518, -105273.59662719295},
+				{-381713.7222661166, 197448.72084920687, -99310.32109516588},
+				{-308480.9302550079, 155267.57576456602, -94078.52962346026},
+				{-243781.93269874816, 118002.03023644732, -89469.43122425128},
+				{-186410.63944408443, 84967.68201408641, -85394.14078748507},
+				{-135371.0044492177, 55597.855888411475, -81779.34610952274},
+				{-89835.21676450691, 29420.680655671582, -78564.24998439665},
+				{-49109.037380378584, 6039.643050582919, -75698.00449782137},
+				{-12608.8305303572, -14879.296584989279, -73137.90092496425},
+				{20161.55823763316, -33620.77115291053, -70847.70373699299},
+				{49622.040111439004, -50425.84941790598, -68796.82572414562},
+				{76132.2389455387, -65501.500626643596, -66959.09528578544},
+				{99998.47243817935, -79024.12095119717, -65312.27874680149},
+			},
+			{
+				{-809697.6501931837, 457771.06746518327, -132710.5272072924},
+				{-693289.4696445817, 390575.69873797847, -124192.93966283146},
+				{-591604.8926716639, 331809.14540747757, -116769.85578504499},
+				{-502301.4973106462, 280148.84135951404, -110265.40716854023},
+				{-423492.59815264493, 234527.226362715, -104538.2699476983},
+				{-353645.8204614561, 194075.1765996359, -99473.82507877481},
+				{-291503.34989671066, 158077.66325525776, -94978.16901478177},
+				{-236024.9820349891, 125942.25738959436, -90973.8283894751},
+				{-186343.92962921795, 97174.0247641546, -87396.29989885661},
+				{-141733.3370877448, 71357.29171638447, -84191.6392733133},
+				{-101579.18175956538, 48140.02751875048, -81314.31335096707},
+				{-65359.825521529536, 27222.64229190474, -78725.71222273866},
+				{-32630.57725757599, 8349.326478344921, -76392.9916682984},
+				{-3009.473439363475, -8700.17998065869, -74287.94345782348},
+				{23831.48618247646, -24115.139383770147, -72386.4391563719},
+				{48176.339943330124, -38059.87726959419, -70667.55915655471},
+			},
+			{
+				{-823563.7968030203, 478625.5448167982, -136067.5985032988},
+				{-715469.6260259238, 415936.1743264172, -128065.19414053277},
+				{-620303.9517713037, 360677.7398732662, -121033.27347666983},
+				{-536111.290501878, 311741.59376949293, -114823.66958886634},
+				{-461300.0776773297, 268223.7306828308, -109316.04781565099},
+				{-394562.32609526947, 229379.58430216793, -104411.68618782754},
+				{-334815.18575140927, 194591.45715145237, -100028.97926486956},
+				{-281155.33295911073, 163342.9920719291, -96099.94929212812},
+				{-232823.35568017562, 135199.23749544143, -92567.56505263712},
+				{-189177.41290847328, 109791.79030603546, -89383.65371751164},
+				{-149671.81619318394, 86806.87802870311, -86507.34478684622},
+				{-113839.9501989251, 65975.76529037653, -83903.76094354683},
+				{-81280.82297932838, 47067.006551832485, -81542.94733903992},
+				{-51647.77187714811, 29880.08186271985, -79399.01572178918},
+				{-24641.18737526892, 14241.555158152954, -77449.65988412712},
+				{-0.014527250662671009, 0.008048178952171175, -75675.47335582343},
+			},
+		},
+		{
+			{
+				{-1790.3294957441642, 602.21245862815, -71.18365469011322},
+				{-328.9378410842726, -60.54395599772169, -5.583801673216811},
+				{64.4618085412153, -213.80274703635965, 2.1284289553313775},
+				{253.67135008407803, -284.6785550352945, 3.649839690505494},
+				{309.26309480760017, -303.408130237051, 4.002580616377631},
+				{318.68135641754384, -303.2754966105718, 3.9827487867889215},
+				{319.9943713787169, -300.00574835468086, 3.9015746194312477},
+				{319.9490552871091, -296.40341835887733, 3.8143320184659606},
+				{317.83411125624286, -292.13219094383527, 3.7137955903811113},
+				{315.18583036402663, -287.8216510378008, 3.612198881384186},
+				{311.4550820063555, -283.2190840996073, 3.50393846637192},
+				{309.94730259208603, -279.73963222432354, 3.418308643507175},
+				{306.05929188652243, -275.37270744735906, 3.312874209213952},
+				{302.35844045044627, -271.2164984349908, 3.2103051239068137},
+				{300.502841427436, -267.9846669389221, 3.1264889322842846},
+				{297.6228044081099, -264.415749691067, 3.033477802126235},
+			},
+			{
+				{-625.015921997665, 145.7614083813899, -29.036208318733706},
+				{-133.62176842366944, -90.12305206024172, -5.549153568122899},
+				{72.65911236658174, -178.6576398442519, 0.3227513817881967},
+				{185.60120805809942, -223.48970286807352, 1.9912708525555884},
+				{247.06021106261645, -246.9755913252788, 2.6287049449818487},
+				{259.2493618786956, -249.71459382634916, 2.680436426567647},
+				{264.70009979021586, -249.71883655977004, 2.6646012394867253},
+				{266.53859689901924, -248.27357987956327, 2.6119400011457143},
+				{267.04015532586834, -246.34504022647212, 2.545568619403756},
+				{267.24693198311047, -244.37656353244432, 2.476427023440585},
+				{265.65438248317673, -241.6920658287272, 2.384659665944095},
+				{264.2256537153194, -239.15004417466318, 2.293262797669788},
+				{263.527668193439, -237.00019558645036, 2.210235807538748},
+				{261.3327697373384, -234.2389040267612, 2.103551308947405},
+				{259.3297978431849, -231.61407940706422, 1.9958348013894855},
+				{258.385258122302, -229.51824449706007, 1.9018582405363602},
+			},
+			{
+				{-364.5979192341853, 62.55380125032558, -22.858551717214027},
+				{-93.56328819283188, -72.85927628384098, -8.870261875611247},
+				{59.31497733699462, -147.1663164575608, -1.9845938492842414},
+				{141.46732919279572, -182.17331346781998, -0.02390281250797644},
+				{194.93878226644054, -203.82362488285617, 0.8280631910443427},
+				{216.79736308302688, -211.9090956444601, 1.1632238363355472},
+				{225.25869976291077, -214.0213061020558, 1.2301137258125456},
+				{229.56862822599732, -214.31705058954202, 1.2162163157536987},
+				{231.2314976187891, -213.45538553192935, 1.14994479926265},
+				{231.40651150446175, -211.95931794187354, 1.0541725651155684},
+				{232.05152939921757, -210.73575582908708, 0.9714032448343379},
+				{231.11749479915886, -208.82510891105852, 0.8571470640497825},
+				{230.51911900897454, -207.12326354816972, 0.754375248664279},
+				{230.1659794230819, -205.58738259351242, 0.6614378207785986},
+				{228.8283066961775, -203.64091019453377, 0.5525689636996288},
+				{227.62832682487684, -201.80672761651883, 0.45184792328953893},
+			},
+			{
+				{-273.2283637974315, 45.53824404300718, -22.69517851985752},
+				{-91.81047269910235, -47.794703008880276, -12.694912813528452},
+				{25.274959120725637, -107.5319016681754, -6.589219231578949},
+				{102.73443490277941, -146.2059619139096, -2.906552543956281},
+				{153.09154153129498, -169.8342082720139, -1.0377995877721153},
+				{183.88462072171527, -182.80993103017525, -0.3536331799398174},
+				{193.57507315513152, -185.92970311769008, -0.23739289519290857},
+				{199.87325561364668, -187.56111921330378, -0.1881211931721981},
+				{202.99593908808748, -187.78643716172104, -0.19890822984197293},
+				{204.8191227761113, -187.45259694360462, -0.23353232375528052},
+				{205.26917883354392, -186.51543968849305, -0.29538726007294575},
+				{206.09009745285127, -185.77955665285882, -0.3491202557231757},
+				{205.71090813769183, -184.5047881056745, -0.43021496901316725},
+				{205.55383286363332, -183.35488457914113, -0.5082095273417592},
+				{205.48149642093756, -182.26476580372585, -0.5861150321310078},
+				{204.66459309082268, -180.83064675096426, -0.6868371670584973},
+			},
+			{
+				{-235.9977662909268, 47.5727326014393, -24.142684979484994},
+				{-101.54327769953272, -23.14224868926288, -16.331982206621774},
+				{-7.833941575480995, -72.2582616959778, -11.058907880240682},
+				{59.16120731385769, -107.1107645391085, -7.429765329889519},
+				{107.8234319580695, -132.1016241424672, -4.918736231701804},
+				{143.31589265861626, -149.9445819235198, -3.2090146932400234},
+				{164.66511878958661, -160.10489351307396, -2.3038264197458242},
+				{172.45881006991007, -163.0394101601086, -2.078992848210118},
+				{177.75356589543568, -164.6943467767059, -1.9679864928930393},
+				{180.56223143926718, -165.0712371072236, -1.9672046333470592},
+				{182.2209955051098, -164.8700341865023, -2.0157527186232236},
+				{182.75063005352698, -164.09311317813885, -2.1147570503685102},
+				{183.44023977440347, -163.42581161330014, -2.204261601731361},
+				{183.2453799147321, -162.30209216861613, -2.3356237693293496},
+				{182.97141114677888, -161.1544495000658, -2.470234626728107},
+				{182.9475075833017, -160.1631369727472, -2.5910289545738485},
+			},
+			{
+				{-220.6899791596244, 55.994703195050946, -26.04750857015805},
+				{-114.63815063947902, -0.755311843629634, -19.621530844378363},
+				{-36.7225831084817, -42.38968240057405, -14.998120472992861},
+				{21.714748183290613, -73.50267608199765, -11.60872502191886},
+				{66.2077956256513, -97.04720950927309, -9.092650557241864},
+				{100.41284882481793, -114.98424060812422, -7.2132405721848665},
+				{126.76389124545393, -128.62449834803186, -5.813480548896807},
+				{143.89810126150107, -137.1707071704699, -4.960749970296872},
+				{150.84417857289026, -140.05915782668765, -4.695845124806642},
+				{155.9025471268525, -141.92969084769788, -4.534466546033083},
+				{158.79491994452405, -142.61659032408073, -4.493213753218796},
+				{160.3621985350392, -142.5835456435419, -4.524762370413384},
+				{161.3815022215089, -142.26430501653638, -4.584887939149952},
+				{161.9611613301575, -141.7174244905183, -4.667814264074664},
+				{162.27507263836304, -141.03890456144623, -4.763844736149783},
+				{162.08987303852206, -140.09473280775822, -4.8870905950955414},
+			},
+			{
+				{-215.4762376072692, 66.46689254627086, -28.025327996713575},
+				{-128.26845293315665, 19.140603433038663, -22.55490208466644},
+				{-61.655489108837784, -16.994701324053576, -18.437750863194246},
+				{-9.912156974131221, -45.01160540192668, -15.289025596869843},
+				{30.806456042439784, -66.98357555354826, -12.851830723575898},
+				{63.15705378204503, -84.34973970374084, -10.949725166448916},
+				{89.01230452913205, -98.12848037368644, -9.45903021578261},
+				{109.68413035849166, -109.03478722043018, -8.293430785137286},
+				{124.86209784593012, -116.86433560104909, -7.469163826824217},
+				{131.64255733992673, -119.92535300654973, -7.163787745521666},
+				{136.33615693312134, -121.82060462742001, -6.983648744404567},
+				{139.46506028567785, -122.84347288575489, -6.8967757612402645},
+				{141.39671102561584, -123.19744795489125, -6.881379675934723},
+				{142.76065675115507, -123.24214018740982, -6.898687197564102},
+				{143.39574162856886, -122.88192459415566, -6.9592693334204405},
+				{144.0116775915628, -122.52582127160125, -7.018824496030691},
+			},
+			{
+				{-215.2631981660075, 77.30962418249648, -29.935393196827786},
+				{-141.3942248842863, 36.74923156023986, -25.164827369289686},
+				{-83.25043062081308, 4.817332312148302, -21.45083285087545},
+				{-36.84282631171052, -20.647142887024483, -18.519904349300297},
+				{0.6139189051398966, -41.15954538159729, -16.18204196656426},
+				{31.11238491091507, -57.808237007891975, -14.30202829235885},
+				{56.10608319067899, -71.38999476669595, -12.781737958735992},
+				{76.66877170103463, -82.49516663487401, -11.549049744256903},
+				{93.58682443945989, -91.55660838075548, -10.551389192817417},
+				{107.23099278068335, -98.77167725450265, -9.763831318692656},
+				{114.6309016376171, -102.38986901738244, -9.380041739139612},
+				{119.22364994230803, -104.39915716771735, -9.175430857773728},
+				{122.70435585287308, -105.78142123659296, -9.039972482375983},
+				{124.96058883280661, -106.4652957464094, -8.981776904853794},
+				{126.37627085805329, -106.67134824248446, -8.97634868421411},
+				{127.45450989931457, -106.69271864216817, -8.990990224617592},
+			},
+			{
+				{-217.61552626358358, 87.83982112525638, -31.727292872533067},
+				{-153.6500942345873, 52.36400008271939, -27.492027381095937},
+				{-102.08334034469524, 23.748860690942113, -24.106317135564545},
+				{-60.021104534994116, 0.41370651255829877, -21.36825558545573},
+				{-25.37763761317894, -18.78490966094493, -19.13296713613311},
+				{3.3817575949981147, -34.69134943561138, -17.29432295930725},
+				{27.406320114999275, -47.94001259112123, -15.773203203219117},
+				{47.568875832205634, -59.01415539492675, -14.509786356593464},
+				{64.53815180683516, -68.28492164058956, -13.458446049368584},
+				{78.82334213624368, -76.03518636547484, -12.584601000152743},
+				{90.7689590813758, -82.45480356944067, -11.864976872087526},
+				{99.34087459979088, -86.92141896883918, -11.36982133336803},
+				{104.02396963849543, -89.11738250688765, -11.134344193368554},
+				{107.60877242469712, -90.6825562558846, -10.970334566843148},
+				{110.23427576744517, -91.69626044174751, -10.868700032893548},
+				{112.06216517204993, -92.24996758838455, -10.819122020164578},
+			},
+			{
+				{-221.28133377220115, 97.78727584365343, -33.38712634502652},
+				{-164.94255231172352, 66.26841650235237, -29.575019332014094},
+				{-118.62952446245015, 40.338144087045436, -26.461902759812645},
+				{-80.1736112852439, 18.803572533655778, -23.894137777331316},
+				{-47.970440467115495, 0.7792092366710952, -21.758485194849055},
+				{-20.81156731762085, -14.404093791445078, -19.969971523650454},
+				{2.2275282959005436, -27.259855879427267, -18.463832800572977},
+				{21.863637336321098, -38.187364291025396, -17.19004649310777},
+				{38.65850469185622, -47.50034870094938, -16.1095594640294},
+				{53.055357069953885, -55.44686857575999, -15.191677988921912},
+				{65.4029062952618, -62.222292280324, -14.412353726545316},
+				{75.96307589188473, -67.97293979922247, -13.753619043088944},
+				{84.78647333213256, -72.72099557936946, -13.212254340680536},
+				{90.33480998438259, -75.53908305105382, -12.896030879529073},
+				{93.99812134432356, -77.24950478302743, -12.708412223143444},
+				{96.93167023529605, -78.53733640585911, -12.569477338860006},
+			},
+			{
+				{-225.5837338225347, 107.06329795835848, -34.91624770117145},
+				{-175.28983001410415, 78.70990510307591, -31.44742515175696},
+				{-133.26973546380162, 54.998607477932545, -28.564452672869177},
+				{-97.85517479928565, 35.00593812546026, -26.147494709420254},
+				{-67.78436913938877, 18.031291101730307, -24.106206944594785},
+				{-42.087896544411066, 3.53477728884292, -22.3714014095672},
+				{-20.01058543596919, -8.905387172726916, -20.88935400901658},
+				{-0.9569750675382634, -19.622696700571055, -19.6178379089696},
+				{15.547412532185733, -28.883569786630293, -18.523312929735052},
+				{29.883907642363823, -36.90261951102722, -17.578909832163255},
+				{42.360735117157134, -43.85365172805672, -16.762981960036637},
+				{53.22696213869308, -49.87728359094271, -16.058099112663378},
+				{62.679961826718, -55.084858900433424, -15.450509113623214},
+				{70.85497912733481, -59.55171805165094, -14.930881243714055},
+				{77.50709788269428, -63.12503451191314, -14.517050549238908},
+				{81.6537706715634, -65.20585544516975, -14.279762247938468},
+			},
+			{
+				{-230.14416705424247, 115.6610503177404, -36.322219130965664},
+				{-184.75411679831112, 89.89691679189266, -33.137751562121075},
+				{-146.30766267405124, 68.05124290769692, -30.451841419702024},
+				{-113.49310599470748, 49.39381439362645, -28.169079597786194},
+				{-85.29942041175117, 33.3602074722706, -26.21613332664448},
+				{-60.93648589493499, 19.5081003168524, -24.5358522721853},
+				{-39.77917294675064, 7.4865925137455465, -23.0831444636521},
+				{-21.32742773655393, -2.985736988929838, -21.822037990100526},
+				{-5.177326876193857, -12.136771583186269, -20.72355439313645},
+				{9.000192166860712, -20.152591928087936, -19.76414909392224},
+				{21.47511150634262, -27.186194511101995, -18.924556629956992},
+				{32.47006708117956, -33.36397722100081, -18.188933740905405},
+				{42.16890700092924, -38.79045652582442, -17.544235766724007},
+				{50.72226243829069, -43.55128574776949, -16.979811993025347},
+				{58.24835969838898, -47.71346543349108, -16.487348918025567},
+				{64.81214723780926, -51.31243173360445, -16.062397312524762},
+			},
+			{
+				{-234.7468439125848, 123.61006248270846, -37.61478670433511},
+				{-193.41182869160605, 100.00300550272804, -34.66993116758751},
+				{-157.98664310614964, 79.7485311410705, -32.15479631625053},
+				{-127.4207545893218, 62.25880191560243, -29.992091750658258},
+				{-100.89106500621376, 47.07204187162796, -28.121409468379245},
+				{-77.74470995710368, 33.82103741873565, -26.494951495621937},
+				{-57.45811180670154, 22.21055265711273, -25.074476769104983},
+				{-39.607185697791785, 12.000868394890873, -23.829083540392816},
+				{-23.845345246035865, 2.9956093160772848, -22.733575257866015},
+				{-9.887058595009433, -4.967372635701973, -21.767241038251427},
+				{2.5045790260725105, -12.022899153683808, -20.912935694644922},
+				{13.527313528104019, -18.283730064480437, -20.156380127818355},
+				{23.34696740017609, -23.844588606476435, -19.485627738707898},
+				{32.10291571841017, -28.785203557741546, -18.890661493425707},
+				{39.912020637843106, -33.17247733657035, -18.363105367647385},
+				{46.87076889810472, -37.06160581266836, -17.896069175743794},
+			},
+			{
+				{-239.26829487434776, 130.95468694159916, -38.80406261110156},
+				{-201.34048651974288, 109.17239680396379, -36.0640279713386},
+				{-168.50320287086322, 90.29167318410157, -33.69842423987312},
+				{-139.90169481260733, 73.83198236685043, -31.643702567803814},
+				{-114.85659200569458, 59.41046311708959, -29.849463830280484},
+				{-92.82196029888924, 46.71871519113898, -28.27529464727707},
+				{-73.35471841031334, 35.505849977620386, -26.88846404794356},
+				{-56.09200679315633, 25.56592909392652, -25.662221855194037},
+				{-40.73421869149345, 16.728530803670175, -24.57452492544272},
+				{-27.032125435941133, 8.85158100926588, -23.6070723262501},
+				{-14.777004998166992, 1.8158478251062522, -22.74456680582991},
+				{-3.793004496501476, -4.479324272776914, -21.974144348706336},
+				{6.068810774762512, -10.119342450131056, -21.284930453702206},
+				{14.935111168725165, -15.176969979541871, -20.66769375468022},
+				{22.913928428022746, -19.71435430055586, -20.114576717452703},
+				{30.0974137888242, -23.784563775378164, -19.618891366792752},
+			},
+			{
+				{-243.63897962391823, 137.7437200715688, -39.89972167930313},
+				{-208.61313729288855, 117.52526349920504, -37.33690583078589},
+				{-178.01749732729147, 99.84300281767388, -35.10340954405958},
+				{-151.1470415292804, 84.29886941353308, -33.1463065270822},
+				{-127.4342697202665, 70.57171853381351, -31.42307766831166},
+				{-106.41784205363714, 58.39989620707645, -29.89922369347049},
+				{-87.71933648444421, 47.56830114125234, -28.546498386415365},
+				{-71.0257899405418, 37.898652741806345, -27.341583521009554},
+				{-56.076425060030175, 29.242081543572073, -26.265083026977244},
+				{-42.652448299099724, 21.47342568999582, -25.30075109287333},
+				{-30.56913082508159, 14.486796986541094, -24.434893905850007},
+				{-19.669606621224318, 8.19210330678848, -23.655901832939957},
+				{-9.819977612772217, 2.5123000661801576, -22.953880755073364},
+				{-0.9054259050809652, -2.618795444425486, -22.320359707233884},
+				{7.1728865415617795, -7.258248506982134, -21.74805811445183},
+				{14.500289420160918, -11.455397706219992, -21.23070057954622},
+			},
+			{
+				{-247.82154396524842, 144.0254381895247, -40.91067710548648},
+				{-215.296275932027, 125.16221840437193, -38.502802222166174},
+				{-186.66124031676281, 108.53500617300027, -36.38693746883881},
+				{-161.32793491916863, 93.81019368745369, -34.518505274958585},
+				{-138.81728126903786, 80.71563128927768, -32.861280921896046},
+				{-118.73577639558303, 69.02731298073962, -31.385561311429317},
+				{-100.75755556884154, 58.55935046632545, -30.06678965678203},
+				{-84.61073612769074, 49.15633055525851, -28.88450996246296},
+				{-70.06690529840067, 40.68742430928403, -27.821563384582067},
+				{-56.93294629840108, 33.04180024540174, -26.863464250642526},
+				{-45.044623815056724, 26.125019775028974, -25.997911082999938},
+				{-34.26150765699779, 19.856180603576885, -25.2144001735138},
+				{-24.462924679057515, 14.165635621447505, -24.503917861149674},
+				{-15.544708771714834, 8.993159099382924, -23.858693818582378},
+				{-7.416576637259732, 4.286464230890516, -23.272002123403084},
+				{-3.9406137620057594e-12, 2.3219589235637016e-12, -22.73800019837411},
+			},
+		},
+		{
+			{
+				{-475.5596687611213, 159.8065319954331, -18.817574218958242},
+				{-86.42039651803498, -16.774463707901436, -1.3295881035256147},
+				{31.17583256097254, -66.93193808857963, 2.2587012776653497},
+				{85.15117796863123, -90.05226428295596, 3.6828545836256463},
+				{102.2419631205344, -96.73555972431663, 4.07385586788562},
+				{106.93488884333352, -97.69310564073557, 4.112357321766943},
+				{108.97245871276046, -97.47632329782581, 4.0795758485072495},
+				{109.7061793941856, -96.71094367438651, 4.013622234940619},
+				{109.71785040657643, -95.6648679399905, 3.930763640215308},
+				{109.32041035103403, -94.48111152198214, 3.8397111976970377},
+				{108.74462732202691, -93.26606575025079, 3.7470566627943516},
+				{108.0124348893294, -92.02440902247578, 3.6529954790831285},
+				{107.22127731949277, -90.79967824349177, 3.5602573314336228},
+				{106.36438086265126, -89.58409538579427, 3.4682871080183983},
+				{105.46375982510688, -88.38487486474735, 3.3775031878130646},
+				{104.55486299101864, -87.21662020715009, 3.2888240626975094},
+			},
+			{
+				{-165.76055525604374, 38.45076837781596, -7.613674715909929},
+				{-34.878423362455514, -24.436276844259304, -1.3428640005982913},
+				{25.42660856243494, -52.073970958957126, 0.9189437214616476},
+				{58.54239183940774, -66.83260923430961, 1.9492433814279204},
+				{76.23919831882367, -74.52479048479974, 2.4575536005055434},
+				{83.08629898806582, -77.08494450837435, 2.6146273367724846},
+				{86.26262038715936, -77.90789758363493, 2.6555995236187853},
+				{88.0628283387065, -78.09690979970792, 2.6547619485798455},
+				{89.05503438668916, -77.92211512514423, 2.6299902919642504},
+				{89.53224645357669, -77.52260456057319, 2.5904135795853565},
+				{89.6950624976584, -76.99426885206579, 2.5423525593268477},
+				{89.66781088893445, -76.39642561281603, 2.4897327768302495},
+				{89.50446507660405, -75.75376460153217, 2.4341570239755463},
+				{89.24542984120373, -75.08466198175213, 2.3768292821593264},
+				{88.91881684365983, -74.40164592277578, 2.3185749002110025},
+				{88.53288444113096, -73.70732847646607, 2.2595360913313214},
+			},
+			{
+				{-96.63905762965484, 16.387571769933107, -5.977861159041073},
+				{-24.490630418676, -19.682810043759407, -2.248043889259451},
+				{16.68196506499807, -39.851772410084315, -0.3437586316422716},
+				{41.42039588855853, -51.5202569996652, 0.6197048150782666},
+				{57.22228370987715, -58.78670327082336, 1.1710976486327829},
+				{65.91768855185003, -62.588032649614135, 1.4449330109247869},
+				{70.21290721428778, -64.2280991572877, 1.5551253475944533},
+				{72.68944903429147, -64.98132513799239, 1.5996912036756477},
+				{74.28284167614709, -65.31144980485192, 1.6133622762050732},
+				{75.31150903528683, -65.374839945701, 1.6076492009208456},
+				{75.95057250148544, -65.25724308304227, 1.588788360826181},
+				{76.3328199645163, -65.0242601099281, 1.5615583839194411},
+				{76.53887382244142, -64.71556715814265, 1.5288388860373137},
+				{76.62373337887416, -64.35818526397831, 1.4925950402160688},
+				{76.61456243242247, -63.965011925899134, 1.453743810609567},
+				{76.53508607515384, -63.54740301104379, 1.4131045232738648},
+			},
+			{
+				{-72.43906263140364, 11.917619708284837, -5.9407755127634605},
+				{-24.15453093976143, -12.934302886558509, -3.2763982136363934},
+				{7.036627250253044, -28.86770836245519, -1.6437614388547606},
+				{27.822360285413893, -39.309711351592874, -0.6341271960118534},
+				{41.961633964401514, -46.230916372551896, -0.011794042006050429},
+				{51.489261587991685, -50.75440561531018, 0.36994288412130555},
+				{57.16549919660108, -53.30154355592771, 0.5740865631027056},
+				{60.43829897493602, -54.62060357222386, 0.6737212458834813},
+				{62.537600612420476, -55.34582584721929, 0.7241299652343396},
+				{63.9911430358696, -55.74879674112757, 0.7482531479807683},
+				{65.01442342374266, -55.93949140131157, 0.7552098205280197},
+				{65.72634115096947, -55.978225448234596, 0.749919783840376},
+				{66.21624259770928, -55.91052775201578, 0.7360717782648106},
+				{66.54768247506648, -55.768835687568384, 0.7162845584351515},
+				{66.76396602841403, -55.57520754281706, 0.6923336155002265},
+				{66.8929499893072, -55.34370955464318, 0.6653436483501299},
+			},
+			{
+				{-62.61295118867454, 12.504267272695445, -6.33116785811774},
+				{-26.824908733119273, -6.324302885406191, -4.250763834487448},
+				{-1.8781603487698297, -19.407275220207804, -2.844770074048856},
+				{15.969339508616265, -28.70244555244483, -1.8745293021920977},
+				{28.959475689892923, -35.391032051091116, -1.19823710552015},
+				{38.48825867021583, -40.2154560883287, -0.7273502716591221},
+				{45.22813193249158, -43.53784886972741, -0.41513569193321076},
+				{49.6031568213697, -45.59097166851774, -0.22980262292691203},
+				{52.38611765444571, -46.794205741089215, -0.1260807593766458},
+				{54.28172074885388, -47.5281588470923, -0.06629450026626198},
+				{55.655138504848075, -47.9893378248843, -0.03159290870199405},
+				{56.67416955645246, -48.26737415744338, -0.013529681297303888},
+				{57.43075957385626, -48.41100396215119, -0.007589187034347215},
+				{57.989944891787815, -48.45464643105715, -0.010626420344581108},
+				{58.40237374507428, -48.42516832580955, -0.02020446415379156},
+				{58.70413822488195, -48.34168640238051, -0.034599934500825075},
+			},
+			{
+				{-58.6027464951606, 14.783169790330163, -6.842493038333109},
+				{-30.369091816719003, -0.32893691018663457, -5.1309743974272335},
+				{-9.625642225864866, -11.417345752772992, -3.8989863286825885},
+				{5.9351811296472325, -19.706675357536646, -2.995051627152133},
+				{17.787852813185317, -25.983918373418867, -2.323056202200866},
+				{26.906554935541138, -30.771853978043058, -1.8198809339668969},
+				{33.94039894160567, -34.420372282564195, -1.4435672716269796},
+				{39.18972187800334, -37.089074449693875, -1.1737807095806951},
+				{42.8678794012618, -38.891728884498406, -0.9957871493709127},
+				{45.39419302910446, -40.05955522811183, -0.8837675246062222},
+				{47.18632787020819, -40.82449130229246, -0.8129985323008081},
+				{48.524288991158485, -41.342376387751735, -0.7672112195007618},
+				{49.551049671734695, -41.69248874917857, -0.7382181633619628},
+				{50.34650331861864, -41.91889200859123, -0.7215151221935823},
+				{50.96292105387552, -42.05030080552313, -0.7141976858717305},
+				{51.44037734998345, -42.10873555329499, -0.7140565818501403},
+			},
+			{
+				{-57.268401874251914, 17.60325228728972, -7.372785840606424},
+				{-34.04552894901877, 4.997998250826996, -5.915623954208966},
+				{-16.30718629737568, -4.627022957880089, -4.818662811004525},
+				{-2.527145452157532, -12.090929316046624, -3.9793709941440407},
+				{8.319098846150718, -17.94623517852812, -3.329334596805984},
+				{16.939208990230732, -22.57630024066862, -2.8215790916747356},
+				{23.831935362968217, -26.25236457650794, -2.4231831951466503},
+				{29.347303475035854, -29.165195906594924, -2.1111417378717863},
+				{33.66748159267145, -31.412264976756713, -1.8733307609529442},
+				{36.883843392457564, -33.0405223881624, -1.70350494658528},
+				{39.24339213965976, -34.18753497485977, -1.5859598859023982},
+				{40.97959145870005, -34.98449026342853, -1.5061077761947885},
+				{42.30441557456859, -35.55158458411946, -1.450810791922766},
+				{43.34479722651485, -35.96080653064587, -1.412258623282573},
+				{44.17425187023541, -36.253726483365334, -1.385968661830934},
+				{44.8393345470343, -36.45658152683593, -1.369129202643066},
+			},
+			{
+				{-57.25861036212762, 20.51929369969605, -7.884781482120779},
+				{-37.58210859141493, 9.71337605974236, -6.61381390471328},
+				{-22.094654183641193, 1.2060934952275941, -5.624187924227857},
+				{-9.732498130494344, -5.5788680673032935, -4.843013040509145},
+				{0.24679040157024051, -11.045438673786533, -4.219675728238094},
+				{8.374153171040776, -15.483631106810883, -3.7181679353935357},
+				{15.036812893399452, -19.10571579319443, -3.3123650615086517},
+				{20.520854890508655, -22.068984068687417, -2.983065371612401},
+				{25.036284886592252, -24.489043751102322, -2.716242599672009},
+				{28.713627327773708, -26.43695181828106, -2.503200390564325},
+				{31.593194635483467, -27.932174575949446, -2.341226166978613},
+				{33.81867927070651, -29.05520870967255, -2.220920772494049},
+				{35.52192279855549, -29.880491639302555, -2.1337484415441885},
+				{36.84694813206109, -30.490496593523027, -2.0704018437776646},
+				{37.90421686915697, -30.94879291883598, -2.0237557543880067},
+				{38.76316925887987, -31.295322013622307, -1.9893532782616279},
+			},
+			{
+				{-57.92756440852523, 23.35051662647472, -8.365177748848975},
+				{-40.883871707854404, 13.89655092017389, -7.236569104114204},
+				{-27.144103203008807, 6.270882585514945, -6.3342598959861425},
+				{-15.936185980109952, 0.05180854872152134, -5.604419891432642},
+				{-6.703948303247709, -5.065590253815073, -5.00844021934666},
+				{0.9617037720492422, -9.306400703735266, -4.518047098044115},
+				{7.367044240345564, -12.839699827840834, -4.11216693920698},
+				{12.744664944288523, -15.794273583018184, -3.7748675415044013},
+				{17.272823196101815, -18.269062507670892, -3.4939939156082414},
+				{21.087553045457362, -20.33965368437917, -3.260313751320846},
+				{24.285036088900835, -22.059219751973245, -3.0673352470181525},
+				{26.903278927303546, -23.447303214655065, -2.912537106059306},
+				{29.00352705153236, -24.537597225687673, -2.7918465423537686},
+				{30.678375761697215, -25.382833822164308, -2.6990925794551854},
+				{32.0118804765125, -26.031227267380473, -2.62869183044298},
+				{33.08991228193922, -26.532605338092456, -2.5749197514648414},
+			},
+			{
+				{-58.943432131113184, 26.02559347634518, -8.810341765575043},
+				{-43.92714522793022, 17.623622080947786, -7.7942364396230746},
+				{-31.58329245756704, 10.71143732594912, -6.964388140882119},
+				{-21.33312925597065, 4.970670079247688, -6.279818363258817},
+				{-12.748676696710245, 0.1650910156239644, -5.7103362368418775},
+				{-5.507680770987132, -3.8837535634774865, -5.233294047691764},
+				{0.6363372100663464, -7.312779258449714, -4.831434437092714},
+				{5.874446935543942, -10.228430033945235, -4.491429812596177},
+				{10.356391275479018, -12.714344322286465, -4.20287478103218},
+				{14.200367198001858, -14.836682249771904, -3.9575878205925195},
+				{17.49953978971714, -16.647654219314894, -3.749146701244888},
+				{20.324917457812752, -18.18697766829957, -3.572681600768077},
+				{22.719499191645564, -19.478177634461293, -3.425280443241465},
+				{24.704974688011657, -20.53237240176138, -3.3055235393284765},
+				{26.34088285300915, -21.3835589673844, -3.2093564892700712},
+				{27.68176039737736, -22.063077815779906, -3.1330754803040834},
+			},
+			{
+				{-60.12687713438533, 28.52126642658557, -9.220672846930492},
+				{-46.71746823857716, 20.9607879763858, -8.295815006868457},
+				{-35.514193917688125, 14.638190259650665, -7.527119090600308},
+				{-26.071671291672782, 9.306909455306826, -6.882609220111221},
+				{-18.053178285981303, 4.779961838722326, -6.33818815756437},
+				{-11.200098612171848, 0.9133052901773542, -5.8754074254004385},
+				{-5.3110252957421356, -2.4055626472147087, -5.479945301491347},
+				{-0.22717622847646068, -5.265579070623644, -5.140546772549065},
+				{4.177959306634, -7.737787875308933, -4.8482726284136834},
+				{8.006063594527777, -9.879423965080063, -4.59596081663975},
+				{11.339359747876564, -11.736860958693514, -4.377838695840366},
+				{14.244387094095341, -13.347676553267569, -4.189250929645513},
+				{16.774231288591338, -14.741840915018813, -4.02649897237897},
+				{18.968088945513575, -15.941334415915332, -3.886872038705816},
+				{20.846801425039512, -16.957344184747182, -3.7689682324516625},
+				{22.433202628824823, -17.802425513192592, -3.671243322820667},
+			},
+			{
+				{-61.3776565369533, 30.8359258288201, -9.598206319210382},
+				{-49.27180057439115, 23.96372093335057, -8.7489190939661},
+				{-39.01797802238576, 18.13677456251308, -8.032570328487168},
+				{-30.265866906682266, 13.16004141968097, -7.4236911882536845},
+				{-22.745555160710964, 8.882820398113273, -6.90271345389153},
+				{-16.24617615106283, 5.187051006317388, -6.454391823308301},
+				{-10.600941181948409, 1.9790993946556439, -6.066700636715381},
+				{-5.676460490725384, -0.816113145728225, -5.730048218204607},
+				{-1.3650020583069629, -3.259374449950149, -5.436708469368173},
+				{2.4211958154124678, -5.4003200074306985, -5.180404092131474},
+				{5.754145468247761, -7.279765639367017, -4.955997895692786},
+				{8.69325408592105, -8.931446149275523, -4.759263322475621},
+				{11.28763427973035, -10.38329049119104, -4.586716192806989},
+				{13.577674508904492, -11.658278174218724, -4.435500701346996},
+				{15.595679073510118, -12.77475227676367, -4.303343517307201},
+				{17.364712529542576, -13.745654277095815, -4.188639389829837},
+			},
+			{
+				{-62.63866816296583, 32.97756090952286, -9.945549129673886},
+				{-51.610732690349394, 26.678722424761887, -9.159932873888549},
+				{-42.15960382330828, 21.2744912515388, -8.488938837281896},
+				{-34.00460163155224, 16.607787586907058, -7.9119261755856325},
+				{-26.92589798563532, 12.55526585444859, -7.412768627776134},
+				{-20.74918786683549, 9.018871861143184, -6.978710242490832},
+				{-15.334722851092286, 5.919792930358001, -6.599548146173845},
+				{-10.569335713895919, 3.194050687613157, -6.267039262931109},
+				{-6.360555402185676, 0.78924332476566, -5.974463319916622},
+				{-2.632208473412243, -1.337893493625302, -5.716296821049126},
+				{0.6789047553536234, -3.22333278006307, -5.487967127335189},
+				{3.6255434454679705, -4.897165172315758, -5.285665377556882},
+				{6.25196502509336, -6.384678056951147, -5.106203585015591},
+				{8.595400399475968, -7.707175969762281, -4.946906140616409},
+				{10.687137428683785, -8.882579753809107, -4.805530368536542},
+				{12.553203159483422, -9.925793816290822, -4.6802168563499},
+			},
+			{
+				{-63.87727067528986, 34.95805796866508, -10.265399916405544},
+				{-53.75505811300038, 29.144241563785762, -9.534203778277247},
+				{-44.99149111905977, 24.104942372031154, -8.90291602151404},
+				{-37.358109869143846, 19.711701149074273, -8.354558421605729},
+				{-30.67340027159757, 15.862208673709764, -7.875670901297306},
+				{-24.791548190751655, 12.474080849702721, -7.455463648656493},
+				{-19.594244139072714, 9.480315856060981, -7.085200232528531},
+				{-14.984622026886953, 6.825929123699344, -6.757742108045332},
+				{-10.882716441304508, 4.465427342164466, -6.467207803938226},
+				{-7.222017250646556, 2.3608895393701603, -6.208714864131384},
+				{-3.946828582426509, 0.4804938082223579, -5.97818236385825},
+				{-1.0102256081872683, -1.202624208708004, -5.772178380458824},
+				{1.6275379953498692, -2.7112622139101887, -5.587801308068123},
+				{4.0002769904848385, -4.0648494268465205, -5.422587092134404},
+				{6.1368497138973455, -5.279992509342854, -5.274436829219951},
+				{8.061908991485456, -6.370894886835966, -5.141561159852375},
+			},
+			{
+				{-65.075106660504, 36.79045658286067, -10.560337444718423},
+				{-55.72432457692011, 31.392298936258857, -9.876223470203826},
+				{-47.55633405904629, 26.67135817422768, -9.280010952875601},
+				{-40.38262203461066, 22.521176311309425, -8.757553728701426},
+				{-34.051503676215894, 18.85590037391726, -8.297491398536836},
+				{-28.439710203369117, 15.605604581594426, -7.890609213924866},
+				{-23.446157295709284, 12.7128206674123, -7.5293646270964025},
+				{-18.98726660336387, 10.129929352500943, -7.207532391041703},
+				{-14.993410505781831, 7.817175222939979, -6.919935284330496},
+				{-11.406180618700029, 5.741139282454077, -6.662237544768757},
+				{-8.176268183988842, 3.8735518824573347, -6.430784820066914},
+				{-5.261804445745024, 2.1903618809940126, -6.22247904084242},
+				{-2.627050847458643, 0.6710009655461331, -6.034679817287057},
+				{-0.24135843487513906, -0.7022015607230443, -5.8651262216561255},
+				{1.9216627743412336, -1.9444863638555592, -5.711874452674795},
+				{3.8848076908017695, -3.0690379424057936, -5.5732481001972465},
+			},
+			{
+				{-66.22234017621038, 38.487639499416865, -10.832735673252348},
+				{-57.536301594510654, 33.44969344132889, -10.189782282551718},
+				{-49.889230448910546, 29.00901009932598, -9.624798505032897},
+				{-43.123706816480976, 25.076333846046477, -9.125864617172457},
+				{-37.11162885454257, 21.578879421226894, -8.683297865115826},
+				{-31.747811720097342, 18.45675727743904, -8.289161187361044},
+				{-26.945175784368594, 15.660283355323543, -7.936894460385062},
+				{-22.631085163988025, 13.147929433728965, -7.621034312489993},
+				{-18.744530519854568, 10.884744008958268, -7.336998857451114},
+				{-15.233939403417613, 8.841123248420848, -7.080920620309305},
+				{-12.055458520767088, 6.99184547823095, -6.849515656674923},
+				{-9.17159473420106, 5.315306233598149, -6.6399801498824536},
+				{-6.550131554397186, 3.7929075267985457, -6.449908083094256},
+				{-4.163259282868683, 2.408566884595577, -6.27722523573792},
+				{-1.9868724939296438, 1.1483203465851286, -6.120135951136853},
+				{-8.126432394075006e-14, 4.862587858656952e-14, -5.977080004642534},
+			},
+		},
+		{
+			{
+				{-227.22068949145793, 76.07174311026176, -8.830130265074972},
+				{-39.587054540196455, -9.255587949825259, -0.36133687926903596},
+				{21.137272491824454, -36.5845626544384, 1.9099397779649396},
+				{47.40364438695801, -48.592420791758435, 2.862990676129023},
+				{56.328571325363725, -52.3476534995818, 3.1493260224303836},
+				{59.61790439682582, -53.34733851470908, 3.214265424856663},
+				{61.10884485799985, -53.48468682006594, 3.210485955767093},
+				{61.83559164705488, -53.27492129317415, 3.1794635266283477},
+				{62.139791753637496, -52.88614942407109, 3.134648891267309},
+				{62.18056867692909, -52.395146381359254, 3.082143900875028},
+				{62.04834308838407, -51.84460315213817, 3.0253223702506675},
+				{61.800532099495186, -51.261026222544615, 2.966270328450328},
+				{61.47515326324289, -50.66147254416457, 2.906323485344721},
+				{61.098211653625825, -50.05720492932596, 2.8463586213660705},
+				{60.687376036439744, -49.45547459015422, 2.786933787685078},
+				{60.2548212142003, -48.86092615256157, 2.7284002492973425},
+			},
+			{
+				{-78.7272490745781, 17.888196215515823, -3.461456768973475},
+				{-15.581868754437957, -12.554964008176952, -0.4100647315792194},
+				{14.892231297166841, -27.030954545149655, 0.8904783276549055},
+				{31.62174173954469, -34.94249461908215, 1.563008877790377},
+				{40.458697524114065, -39.0240122737542, 1.9004943944446708},
+				{44.639278904675265, -40.78220721681227, 2.0397340959539023},
+				{46.88668715541828, -41.5725103705436, 2.0972038134738753},
+				{48.21390717230213, -41.90701758225117, 2.116446861748527},
+				{49.05234913495603, -42.005472109759815, 2.11607187165801},
+				{49.58979144705355, -41.96314158835437, 2.1041052687666935},
+				{49.92376630694164, -41.82909136970304, 2.084658587115911},
+				{50.11320703809747, -41.63269914826397, 2.0601831330535854},
+				{50.197070712581414, -41.393198656644095, 2.0322788023513234},
+				{50.20258054285769, -41.12389117399449, 2.002049594070686},
+				{50.14932171430399, -40.83422528372883, 1.9702781070965607},
+				{50.051645591476735, -40.53101506844313, 1.9375273559670867},
+			},
+			{
+				{-45.795502136897994, 7.4165321305708405, -2.689093247979083},
+				{-11.047778698021943, -9.998610838610414, -0.881533372029776},
+				{9.075356073101105, -19.982548522371264, 0.08907482504706134},
+				{21.526158457373572, -26.08856896932015, 0.6515364389853312},
+				{29.430119911333485, -29.9109477935533, 0.9924456467424352},
+				{34.12787171531025, -32.10295747187447, 1.1827557113574048},
+				{36.8906306537648, -33.30127911380379, 1.2832179400161292},
+				{38.64540273593245, -33.98255695806278, 1.3374728017709958},
+				{39.823197367102196, -34.369905751678964, 1.365670572820886},
+				{40.6519126986023, -34.581965503920046, 1.3784493745978323},
+				{41.25087189792248, -34.68081365730774, 1.3813442133989253},
+				{41.68731619622436, -34.701272553378224, 1.3774425114461377},
+				{42.00348426274299, -34.66510002011188, 1.368664111012465},
+				{42.228067701476775, -34.58699485997985, 1.3563011329495456},
+				{42.38159552828506, -34.477414257574566, 1.3412702673731158},
+				{42.47926861185766, -34.34405272786351, 1.3242446173111138},
+			},
+			{
+				{-34.3592474207934, 5.37060434026411, -2.6827690638106856},
+				{-11.118544022728624, -6.6118744106658305, -1.3951773274880388},
+				{3.934652820548752, -14.333951251101148, -0.5971797918615123},
+				{14.065610733438632, -19.483190229011292, -0.08510168725647652},
+				{21.06127577955678, -22.9961621354304, 0.25324491564352375},
+				{25.86493668221332, -25.364861549066475, 0.47576816819075796},
+				{29.03115173802973, -26.873349935727532, 0.6141704614035127},
+				{31.13845552162479, -27.82375457502991, 0.6990400372486798},
+				{32.60999248483487, -28.439877698921517, 0.7522363795615518},
+				{33.67617195243237, -28.84395443869015, 0.7855188483932448},
+				{34.474433583519, -29.109155773106274, 0.8058587014364296},
+				{35.087202977347026, -29.27940127651393, 0.8174123262885551},
+				{35.564648153812094, -29.381357967601033, 0.8226884722427078},
+				{35.93934482364919, -29.432278801451027, 0.8233015469858294},
+				{36.23384296058557, -29.444060755803857, 0.8203596986698233},
+				{36.46452362222291, -29.425312379513517, 0.8146617838867145},
+			},
+			{
+				{-29.77828600573099, 5.734087065298237, -2.879927122429455},
+				{-12.547529456052645, -3.342581485921977, -1.8756666758022789},
+				{-0.5302850225763106, -9.658448961512658, -1.1943793037073147},
+				{8.084507961181107, -14.162443579520186, -0.7203718088586293},
+				{14.380679113079987, -17.427280117497403, -0.38467234858413674},
+				{19.027571219260214, -19.808953412958015, -0.144858149644086},
+				{22.414898712496928, -21.51412750129907, 0.023603051257614905},
+				{24.830618049144018, -22.695400317912522, 0.13810450800744464},
+				{26.568416312074376, -23.51070018389186, 0.21551868104912675},
+				{27.858383680639694, -24.08482594613117, 0.2687764407668599},
+				{28.84210356562037, -24.494710901926705, 0.30573175421938276},
+				{29.61015943933254, -24.78966052676846, 0.33136510312870116},
+				{30.222284611429192, -25.002228681591795, 0.34893966657023556},
+				{30.71771336256937, -25.15377832897162, 0.36058372927038684},
+				{31.122900583034586, -25.25867974718704, 0.3677210639890546},
+				{31.45648862386312, -25.327029717878556, 0.37134708036237796},
+			},
+			{
+				{-27.962828681049608, 6.898080114698425, -3.133871814601229},
+				{-14.35936437758672, -0.39018581297969385, -2.30781494253759},
+				{-4.36428368952489, -5.740485409378057, -1.712184457726115},
+				{3.1385956809283635, -9.745310867682818, -1.2738416973842925},
+				{8.861186394597429, -12.784794706923746, -0.9464374337019448},
+				{13.273018876186264, -15.110967813527646, -0.6995959375942279},
+				{16.6871230669896, -16.892405853197566, -0.5131962749117918},
+				{19.30168788860686, -18.23552737619015, -0.3745697082976811},
+				{21.27863295320965, -19.227809512504002, -0.27359321442277834},
+				{22.780323033987305, -19.95825948805492, -0.20037813765713705},
+				{23.944203216909948, -20.502894197914653, -0.14667585910419842},
+				{24.865049070273237, -20.91427540730348, -0.10685447954015753},
+				{25.60670208765759, -21.22784808412262, -0.07715101595608369},
+				{26.213800096634717, -21.468425358687515, -0.054950647912303204},
+				{26.71769226117283, -21.653436940442212, -0.03842747276648804},
+				{27.14049742854094, -21.7951665776092, -0.026301257608620864},
+			},
+			{
+				{-27.416238701553745, 8.314508851882008, -3.396112083986691},
+				{-16.21644051459439, 2.230633651613213, -2.6925700578573246},
+				{-7.6622143076238425, -2.415651126236679, -2.16245017155293},
+				{-1.0145822619092453, -6.020978193403973, -1.7562249954815736},
+				{4.221618146441968, -8.852405642284385, -1.440905088145031},
+				{8.387951005007066, -11.094968725762607, -1.1938731209304507},
+				{11.724997099421289, -12.879480091146096, -0.9992988012309598},
+				{14.402638121571902, -14.29849212851255, -0.8460758344722625},
+				{16.536584634363923, -15.414890011265886, -0.7266890133577684},
+				{18.223005258975842, -16.281268839277306, -0.634968091476704},
+				{19.557990613252418, -16.950956109768917, -0.5648253429359616},
+				{20.627470835655856, -17.472104132655453, -0.5108597486922363},
+				{21.49764833376426, -17.88198534229149, -0.4689341557217453},
+				{22.215737325778402, -18.20722592487327, -0.4361144776549535},
+				{22.815972187501856, -18.467156569627083, -0.4102834135651073},
+				{23.32358677746039, -18.676038811998296, -0.389886804038632},
+			},
+			{
+				{-27.495832843933464, 9.7723962396139, -3.649070699674432},
+				{-17.996242028539132, 4.552080166489136, -3.035027679100277},
+				{-10.519700601831378, 0.44201137572262367, -2.556641612938562},
+				{-4.550484419509868, -2.837246562344151, -2.178657318039826},
+				{0.270742528677095, -5.4812133535814045, -1.8766352683653935},
+				{4.200630893503091, -7.6300874381932084, -1.6332108663451113},
+				{7.426221761947554, -9.386406037040683, -1.435796711354054},
+				{10.08577334150786, -10.826185300374602, -1.2751395278665543},
+				{12.281609347714134, -12.005772955947457, -1.1444304281189726},
+				{14.087801990001282, -12.965901163685045, -1.0387721409870032},
+				{15.564746315370574, -13.739908281170672, -0.9542019080793902},
+				{16.772537202492487, -14.361432732818542, -0.8867987446972355},
+				{17.766589071955153, -14.86179841778341, -0.8329605654004444},
+				{18.59369768326067, -15.26762936550232, -0.7896532169143665},
+				{19.28973031661463, -15.599391619141217, -0.7545583478257047},
+				{19.881602694279927, -15.872467233217728, -0.725941922891338},
+			},
+			{
+				{-27.895021627969534, 11.186593621379764, -3.8865562353568643},
+				{-19.657207230943165, 6.614652990697973, -3.340839461319534},
+				{-13.016828696737052, 2.9268771613113658, -2.9043853264215937},
+				{-7.599051667602263, -0.08150444341332212, -2.5511184494789134},
+				{-3.1342946187678913, -2.558314779754777, -2.2623660756571913},
+				{0.5754881230350959, -4.612538991278139, -2.0244703340562324},
+				{3.678442460726523, -6.3259747850102315, -1.8272597280589506},
+				{6.2870482141508965, -7.760914821253466, -1.663046149959564},
+				{8.487615467688048, -8.965279381940075, -1.5259573235545343},
+				{10.346529428276222, -9.975984167757675, -1.4114985146756964},
+				{11.914282077068899, -10.821097860739076, -1.3162699987859205},
+				{13.231322431203294, -11.523138510924117, -1.2375640048539773},
+				{14.336694972507914, -12.104094337423101, -1.1727727416754572},
+				{15.267442986488952, -12.585036836315734, -1.1194246798038336},
+				{16.056600654711726, -12.984894291617165, -1.0753169377080751},
+				{16.731528934032518, -13.319414447527413, -1.038626692997162},
+			},
+			{
+				{-28.456537884489574, 12.523841743402109, -4.106943581972153},
+				{-21.190146478398905, 8.456162658790351, -3.615162875021299},
+				{-15.217370800904376, 5.109826652790106, -3.213426664355591},
+				{-10.25683586567685, 2.3300066304897755, -2.8818539779041794},
+				{-6.100789808692986, 0.0020004045897865203, -2.605819515663138},
+				{-2.5929954897433203, -1.9607342675482233, -2.3743649017468176},
+				{0.385937777968562, -3.6245360337557537, -2.1791469048411183},
+				{2.928513997731329, -5.040954595157469, -2.0137249402897033},
+				{5.10723820884957, -6.250502887568127, -1.8730695073682344},
+				{6.979430699911814, -7.285282956978099, -1.7532194061292863},
+				{8.590572598533585, -8.170808757048487, -1.6510440449694832},
+				{9.97661577951396, -8.927259310452621, -1.5640797700556919},
+				{11.166451909234075, -9.57085914849661, -1.4903561842568636},
+				{12.186395208432653, -10.116493738868119, -1.4280817825549976},
+				{13.062007667195381, -10.578773047170262, -1.3755146853400524},
+				{13.816778977392286, -10.971222656016169, -1.331053220064563},
+			},
+			{
+				{-29.095537689106862, 13.773579959354544, -4.310497313584471},
+				{-22.598973987569718, 10.109110411334326, -3.8624239965954557},
+				{-17.17156624623984, 7.044750101160642, -3.489941501294502},
+				{-12.596465101310203, 4.460408444721673, -3.177512046907715},
+				{-8.709974643900836, 2.26515204034535, -2.9134432623102637},
+				{-5.3865249471038394, 0.38901694737520043, -2.6887940011858795},
+				{-2.5284178440434677, -1.222589545557416, -2.4966292088809596},
+				{-0.058682741793446555, -2.612797366568294, -2.331501876797275},
+				{2.083989966855906, -3.8160511759017304, -2.189086442147449},
+				{3.948894105545281, -4.860112350380357, -2.0659156093115922},
+				{5.575954937854698, -5.767514690623064, -1.9591896453254098},
+				{6.997653258843954, -6.556622343356387, -1.8666380901356787},
+				{8.240426876005314, -7.242399073920397, -1.7864193110760809},
+				{9.32590506488339, -7.837094934422808, -1.7170323404068992},
+				{10.27285700751474, -8.35137795888709, -1.6571776824904383},
+				{11.09924156097929, -8.795545493654874, -1.6056103183477648},
+			},
+			{
+				{-29.764693727643497, 14.935417758947505, -4.498239531438463},
+				{-23.892594841940177, 11.600649889509574, -4.086339159439276},
+				{-18.91911346045513, 8.773238962672302, -3.7388696370354624},
+				{-14.673435221219183, 6.358040425073934, -3.4434354712286797},
+				{-11.024148250643156, 4.281650472292777, -3.190526457370059},
+				{-7.868714876336484, 2.4866348473231263, -2.9727419003102047},
+				{-5.126112201617122, 0.9274874907473077, -2.7842493436840536},
+				{-2.7315911396193933, -0.432252887348612, -2.620399849120123},
+				{-0.6328826017126408, -1.6220940473814025, -2.477450050644725},
+				{1.2125880588691251, -2.666128558303125, -2.352358479226951},
+				{2.839770721145775, -3.584178735092003, -2.2426345324627133},
+				{4.277541805493959, -4.392655950397706, -2.1462255013369664},
+				{5.549872474368031, -5.105208099485513, -2.0614316817269147},
+				{6.6767141541441175, -5.733210336951356, -1.9868421881544114},
+				{7.6747250570252685, -6.286169964507771, -1.9212824217389328},
+				{8.558128307349405, -6.7722193160681075, -1.8637519304199905},
+			},
+			{
+				{-30.437117460547377, 16.013438661419098, -4.671449728660668},
+				{-25.081378600772037, 12.953271733972171, -4.290009043750743},
+				{-20.49163697710895, 10.327881064685068, -3.9641925733163137},
+				{-16.53083412215782, 8.060523141813931, -3.6839385966098006},
+				{-13.091782527072597, 6.091023898733242, -3.441397044740085},
+				{-10.089596596344286, 4.371606730906869, -3.2303656623713404},
+				{-7.456289558851283, 2.8639096308202223, -3.0458882177442885},
+				{-5.136851611725721, 1.5368164011533978, -2.8839633789680175},
+				{-3.0863605811151094, 0.36485605240151403, -2.7413306185151756},
+				{-1.2678248444730877, -0.6729942611836657, -2.6153106631215723},
+				{0.34944608694036206, -1.5942150452454982, -2.5036851880933217},
+				{1.7910849411193823, -2.413436779055624, -2.4046051796990167},
+				{3.0786233613890683, -3.1429733294634765, -2.31652055688145},
+				{4.230232713826103, -3.7932354138591218, -2.238125779065036},
+				{5.261302440978584, -4.373053635144942, -2.1683174714739257},
+				{6.184908667962734, -4.889941016836446, -2.1061601592092},
+			},
+			{
+				{-31.09749499345372, 17.013514164131625, -4.831440876326732},
+				{-26.17562715738787, 14.185601131650694, -4.4760220671409305},
+				{-21.914578563279726, 11.73457651806727, -4.169149135931548},
+				{-18.202625272831135, 9.597581977088659, -3.9025325773231634},
+				{-14.951128782054674, 7.72462721448136, -3.669609615515253},
+				{-12.088971822807626, 6.075507524305148, -3.4651255308603504},
+				{-9.558512362930928, 4.617559188978586, -3.284829720519077},
+				{-7.312596976810902, 3.32400006245704, -3.125251710284988},
+				{-5.312326198503484, 2.1726859886489414, -2.983533839158692},
+				{-3.5253619802195404, 1.1451673637520994, -2.857304740826927},
+				{-1.9246315178377438, 0.22596545122999323, -2.744582611319126},
+				{-0.48732470902393804, -0.5979882643670635, -2.6437005110301475},
+				{0.8058881580778764, -1.3377902388079423, -2.5532481699265865},
+				{1.9714715971788601, -2.0029099135065866, -2.4720262992883293},
+				{3.023512041806335, -2.6014632625784078, -2.399010476205802},
+				{3.974106745794635, -3.1404314417854735, -2.3333223607568767},
+			},
+			{
+				{-31.737262337021637, 17.942016934189688, -4.979461786446069},
+				{-27.184942525540656, 15.313119327021658, -4.646547146618628},
+				{-23.208615154214943, 13.014182419758022, -4.356399187438407},
+				{-19.715954320040396, 10.993032098697634, -4.10210010969808},
+				{-16.63277643640363, 9.207652528001143, -3.878101950320098},
+				{-13.898869572077706, 7.623864880539479, -3.679909832503859},
+				{-11.464910604214316, 6.213609319408143, -3.503848195031983},
+				{-9.290155197379041, 4.953655891094558, -3.3468858955987355},
+				{-7.340685399658237, 3.824625180339231, -3.2065037361416358},
+				{-5.588065256982147, 2.810235863498226, -3.0805929867454314},
+				{-4.008298828197835, 1.8967206237948278, -2.9673768526680573},
+				{-2.5810149813777574, 1.0723684880528255, -2.865349124021893},
+				{-1.2888241344234772, 0.3271631468564493, -2.7732258360027786},
+				{-0.11680670274398386, -0.347505095522665, -2.689906882212441},
+				{0.9478966149923543, -0.9590703515746182, -2.6144453143737545},
+				{1.9164152053754844, -1.5139776877190456, -2.5460226237366888},
+			},
+			{
+				{-32.35187598692295, 18.805210371540202, -5.116658755182108},
+				{-28.118008183377537, 16.348764880888424, -4.803411238884259},
+				{-24.3907183309544, 14.183697172751035, -4.528147435362},
+				{-21.092793357092855, 12.266195118566701, -4.285029000280545},
+				{-18.161488570902556, 10.560581621486579, -4.069319635748726},
+				{-15.545347604958092, 9.037537008379509, -3.8771414734237704},
+				{-13.201817042987267, 7.67276410079124, -3.7052926058759525},
+				{-11.095434815121529, 6.445973120470759, -3.551108710539363},
+				{-9.196438737284598, 5.3401009855368216, -3.4123569185727995},
+				{-7.4796866685912935, 4.3407046135995735, -3.2871535682870223},
+				{-5.923810517948716, 3.435484939817585, -3.173899859114483},
+				{-4.510547642613194, 2.6139102022210836, -3.0712310670591223},
+				{-3.224208162677076, 1.8669153750019958, -2.9779761377445437},
+				{-2.0512473842222314, 1.1866605636717216, -2.8931252949791753},
+				{-0.979920209646224, 0.5663354520605365, -2.8158038941565735},
+				{-7.648200162022673e-14, 5.298123391095859e-14, -2.74525117920247},
+			},
+		},
+		{
+			{
+				{-139.13714096829892, 46.17173307795059, -5.183112645516713},
+				{-21.796337905627954, -7.460358928992409, 0.16826188437319273},
+				{17.462654770322853, -25.849188930557528, 1.846823277222056},
+				{33.38486668202726, -33.42927533251229, 2.5302987282109624},
+				{39.016444626684404, -35.89326578054343, 2.744231775810181},
+				{41.421809847937254, -36.72250985371973, 2.8080349399421296},
+				{42.67577873306714, -36.98033354353348, 2.819814450257834},
+				{43.36763881711985, -36.96976113358708, 2.807484596878274},
+				{43.74329286682039, -36.81657452969797, 2.7826000830220634},
+				{43.92805019276997, -36.58421861855428, 2.750969887726355},
+				{43.991301526350696, -36.307176800972734, 2.715733396910536},
+				{43.97404591486773, -36.0052692781325, 2.6786797434638716},
+				{43.90201722431512, -35.6905010567232, 2.6408804657008016},
+				{43.792209467343305, -35.37046296089332, 2.6030035573961054},
+				{43.65628871147952, -35.05010017403305, 2.565476425620937},
+				{43.50249641569058, -34.73269068687983, 2.5285757733808425},
+			},
+			{
+				{-47.51078072454509, 10.246019641854604, -1.8718345060488242},
+				{-8.016556911668962, -8.935497025867482, 0.07258597015323784},
+				{11.445408044141702, -18.446317052967867, 0.9851583233116791},
+				{21.959392719086484, -23.603829351330678, 1.4705123287326618},
+				{27.44583645726886, -26.229659068218105, 1.7134770011187603},
+				{30.316101725199484, -27.50605650568721, 1.8278133529345562},
+				{32.00795787872023, -28.17301272667087, 1.8843013212341504},
+				{33.106196204065064, -28.536345103297684, 1.9121517671621944},
+				{33.858659482804256, -28.72608584087512, 1.9237564731919417},
+				{34.39053701387318, -28.807851808323804, 1.92535337262516},
+				{34.77393256026714, -28.819327559643213, 1.9205173883889248},
+				{35.05334081668056, -28.783632535821063, 1.9114361479977118},
+				{35.25752828555685, -28.71557516569414, 1.899506124271452},
+				{35.40586425908933, -28.625001675531752, 1.8856518933210515},
+				{35.511881553258036, -28.518685875918276, 1.8705063295221571},
+				{35.58534420789664, -28.401427654671195, 1.8545153043054834},
+			},
+			{
+				{-27.480802089034114, 3.934913957373014, -1.412163908050353},
+				{-5.813358396167811, -6.987026323140156, -0.2685103286240044},
+				{6.8662359087670275, -13.37985757183459, 0.3750854207777521},
+				{14.743640257185989, -17.34552971877874, 0.7653547382861664},
+				{19.702129509220903, -19.815752250607964, 1.0048669152018033},
+				{22.752495112945557, -21.290172469624263, 1.145443250335183},
+				{24.69883703037973, -22.182999381865304, 1.2286265235085696},
+				{26.02671092195756, -22.75006258705146, 1.2798367001669222},
+				{26.986048590849478, -23.12432677701471, 1.3122319932780788},
+				{27.707675306281057, -23.375619915506245, 1.3327009449397424},
+				{28.26610215732359, -23.543721490805986, 1.34515654566319},
+				{28.707677470163073, -23.65335273189192, 1.3520246817426966},
+				{29.062946085926082, -23.720768850473814, 1.3548968976675804},
+				{29.3528347926605, -23.75706272457676, 1.3548567026334912},
+				{29.59213049065361, -23.770028041326828, 1.3526633078174854},
+				{29.791572404630152, -23.765283738256226, 1.34886242370586},
+			},
+			{
+				{-20.66096882247971, 2.8124783190378784, -1.4249358122913502},
+				{-6.185478327696514, -4.681300065304872, -0.6151265202955849},
+				{3.2219880669370493, -9.547746499775492, -0.10383922813793924},
+				{9.588675287102182, -12.831227717354105, 0.23386384166902663},
+				{13.995091482557553, -15.088344728017127, 0.4624794005869131},
+				{17.039354885817765, -16.62513091603008, 0.6161228439276404},
+				{19.137791321283228, -17.656806660565508, 0.7178179130587422},
+				{20.630046626505276, -18.36340134345415, 0.786328477661474},
+				{21.736614597067167, -18.863331869392688, 0.8338676866181159},
+				{22.588859993999765, -19.227624435982584, 0.8677196075460737},
+				{23.26538802838013, -19.498911430924768, 0.892238982771284},
+				{23.81510326601258, -19.70373658751585, 0.9101280050489502},
+				{24.270198693367455, -19.85956829855418, 0.9231598238493961},
+				{24.652874522619424, -19.97844026303933, 0.9325528270199571},
+				{24.978966841919082, -20.068919953684308, 0.9391723916247253},
+				{25.26007016712728, -20.137261744388972, 0.943648757586422},
+			},
+			{
+				{-18.020328696332157, 3.155313263607311, -1.5611210744811437},
+				{-7.2814082728375755, -2.5185598114388568, -0.9311885067965161},
+				{0.21410618403091056, -6.477256682903021, -0.5005240277563381},
+				{5.601232964281653, -9.31520254091015, -0.1970718040830699},
+				{9.551905071533456, -11.386013505461985, 0.021257042801599103},
+				{12.47792377175556, -12.906576006620826, 0.17970496194870494},
+				{14.641778564574766, -14.014979102371454, 0.2939537456315493},
+				{16.25066135521646, -14.821561372578769, 0.3761591263467343},
+				{17.474363172762647, -15.418219318002004, 0.4362388337787125},
+				{18.431953610071616, -15.869988679443269, 0.48113868776120844},
+				{19.201674253498872, -16.219822137064902, 0.5154168337184754},
+				{19.834850727037935, -16.495976233738467, 0.5420581486817744},
+				{20.365729350322706, -16.717324867237707, 0.5630489346538184},
+				{20.81793360871011, -16.89688831206002, 0.5797550200873937},
+				{21.208335797072582, -17.04396093825219, 0.5931486027108636},
+				{21.549338537926477, -17.165367572256883, 0.6039417217396599},
+			},
+			{
+				{-17.053719726952906, 3.9764223670527077, -1.7305694881053255},
+				{-8.561868463107405, -0.5836596141239935, -1.2126964248483836},
+				{-2.322355816998888, -3.9345336014256995, -0.8378743029431958},
+				{2.3669108942708705, -6.448790677122468, -0.5603800952769818},
+				{5.951266025390719, -8.363979821484982, -0.35145544975517395},
+				{8.723073691201872, -9.836629589826963, -0.19242693879027142},
+				{10.8784156705476, -10.971901378183187, -0.07093998139531156},
+				{12.555617359988403, -11.844144086166043, 0.021590581935667295},
+				{13.86946735469242, -12.515751913001694, 0.09221436336100725},
+				{14.915602843585994, -13.039338015274543, 0.14678123419847106},
+				{15.765670464416385, -13.45461029774673, 0.18966427333333846},
+				{16.47030091534268, -13.78974914363812, 0.22394747219242356},
+				{17.064995112494284, -14.064565876721517, 0.2517887122255305},
+				{17.57481784990384, -14.293066367007437, 0.2747076886544422},
+				{18.017786292524647, -14.48532222425195, 0.2937935825745864},
+				{18.407162450573445, -14.648745442084385, 0.30984557620401454},
+			},
+			{
+				{-16.85031079200335, 4.943228848536192, -1.9039565159662055},
+				{-9.844109217519875, 1.130380019307063, -1.4625944838334037},
+				{-4.493851367465716, -1.7825688751880406, -1.1293308389117318},
+				{-0.33322281515459307, -4.045883700988553, -0.8730989971731923},
+				{2.9489089037945955, -5.827320447287335, -0.6733041171298721},
+				{5.5664964197758735, -7.242707262590152, -0.5158858938176698},
+				{7.670144337229175, -8.373843937240132, -0.3910270915485517},
+				{9.367730833577607, -9.279458301015161, -0.29175859078286887},
+				{10.740506966976792, -10.00394259504397, -0.21287922222366168},
+				{11.857311263243743, -10.58527358209876, -0.15000819834301896},
+				{12.776705178871559, -11.056081599332103, -0.09942793831109477},
+				{13.544977749896713, -11.44233183958093, -0.05820447293812077},
+				{14.196819459261334, -11.763562463967462, -0.024142746068926923},
+				{14.757838468526218, -12.034233631269585, 0.004374583679965207},
+				{15.246961240502456, -12.265049097903775, 0.02854032735976477},
+				{15.678301315969826, -12.46399646126456, 0.04924178123062902},
+			},
+			{
+				{-17.021032241717776, 5.929244734294533, -2.0708781995456778},
+				{-11.064147374535963, 2.6507724069896135, -1.6851306595686368},
+				{-6.376895347103211, 0.06930260422898826, -1.3842189141354875},
+				{-2.6327853353696815, -1.9920928232394168, -1.1459495185725408},
+				{0.3947978378954913, -3.6567272738019656, -0.9549976728690043},
+				{2.8672597742932946, -5.012769796313446, -0.800507378417201},
+				{4.902067482857958, -6.124612249987829, -0.6746227300598585},
+				{6.586222079520448, -7.040090808419958, -0.5715599187691964},
+				{7.985212463511957, -7.795307777120589, -0.4869922312570979},
+				{9.150439421718293, -8.418710119696932, -0.41754333466964216},
+				{10.125851691588386, -8.934819170321829, -0.36033569842142466},
+				{10.949558331998107, -9.365083791759032, -0.3128767466737502},
+				{11.652981250484531, -9.727301782101968, -0.2731125836025788},
+				{12.26085864318837, -10.035543362479176, -0.2394277222990806},
+				{12.792251518933956, -10.300679067367525, -0.21057903054879787},
+				{13.26178764564112, -10.531065929856409, -0.18561399959538114},
+			},
+			{
+				{-17.381107392253742, 6.884209835085924, -2.227801270762293},
+				{-12.202188084500557, 4.0062063943741535, -1.8843534788208052},
+				{-8.028441412073457, 1.6848333831582467, -1.6094408288221818},
+				{-4.621785970365704, -0.21001941129724028, -1.386588301492988},
+				{-1.8116143094951822, -1.7719353533737743, -1.2040399766613945},
+				{0.5270662264275283, -3.0697217071858254, -1.05322002743472},
+				{2.487594205461665, -4.154933966374543, -0.9277522181068497},
+				{4.1408254109932265, -5.066832228498327, -0.822817385144738},
+				{5.541317732853331, -5.835733217323433, -0.734721231663442},
+				{6.7317110262374165, -6.485396262820655, -0.6605891487625923},
+				{7.746412099011243, -7.03506519201006, -0.598110429585703},
+				{8.614956257444463, -7.501369844328332, -0.5453051715287751},
+				{9.363322282422178, -7.899050696520286, -0.5004320334070582},
+				{10.013643805516264, -8.240743503969659, -0.4620071725156398},
+				{10.584074473728696, -8.53685663727226, -0.42881378878133297},
+				{11.089139210088154, -8.795742281780798, -0.39987893145394743},
+			},
+			{
+				{-17.836151399634918, 7.789013210922762, -2.3739046396328294},
+				{-13.255764295331497, 5.222028911199691, -2.063751124347163},
+				{-9.491556758639058, 3.1104487387086595, -1.810243358798794},
+				{-6.364209029716699, 1.3555444491401487, -1.6007734649463352},
+				{-3.741786011194989, -0.1155687152617331, -1.4260978770847041},
+				{-1.5253394751549059, -1.3577322030326575, -1.2793084441451372},
+				{0.36059634057971485, -2.4129049854509006, -1.1551547517765082},
+				{1.9744368523029396, -3.3136645419382944, -1.0495865639129258},
+				{3.3619976018808324, -4.085635287930558, -0.959437971703983},
+				{4.5596461846840555, -4.749213348501207, -0.8822042316116111},
+				{5.5966355875131475, -5.32084997133681, -0.8158769666999397},
+				{6.497060009496816, -5.814142121664757, -0.7588063114674636},
+				{7.2816508791993355, -6.240852651016845, -0.7095742869007721},
+				{7.968789037819534, -6.611481083859943, -0.666923168838151},
+				{8.574532156570239, -6.9352540241759675, -0.6297533792667804},
+				{9.112494291842197, -7.220028742058517, -0.597132269946675},
+			},
+			{
+				{-18.33547088084855, 8.638031879692642, -2.5094669727674996},
+				{-14.229165577150466, 6.319465021286845, -2.2262305340658344},
+				{-10.799264858231595, 4.380844477263277, -1.9906859539214308},
+				{-7.9070996170076295, 2.745315222654631, -1.7929428612392446},
+				{-5.448350184871369, 1.3548819388933562, -1.6255830191897511},
+				{-3.343214486773786, 0.16504545511386887, -1.482948263598873},
+				{-1.5297335885440397, -0.858845632091917, -1.36065810962453},
+				{0.040839874496546386, -1.7441320443430035, -1.2552757124191243},
+				{1.4073191686206352, -2.512643147378953, -1.1640719897007226},
+				{2.6009457529930478, -3.181996902472227, -1.0848559690934483},
+				{3.6471278018314583, -3.766559269005623, -1.0158501942837308},
+				{4.566767977568993, -4.2781804597594215, -0.9555957518472753},
+				{5.377368622329627, -4.726814349212332, -0.9028733017371603},
+				{6.094035198649594, -5.121089778787504, -0.8566313087834526},
+				{6.7301940906979745, -5.468720358325951, -0.8159346027967539},
+				{7.297783100285082, -5.776602780710658, -0.7799507814693797},
+			},
+			{
+				{-18.850936490663774, 9.431606363562354, -2.635193274301389},
+				{-15.129044464960307, 7.315984992945272, -2.3741903196506895},
+				{-11.977247217803635, 5.522516254389071, -2.1539576501961823},
+				{-9.285935815899238, 3.990087128500294, -1.9665754209466264},
+				{-6.971069944217419, 2.6717083356934066, -1.8059868129471452},
+				{-4.96725702780367, 1.5307150930820101, -1.6674906754845435},
+				{-3.2229393731572964, 0.5381204130293084, -1.5473893746519471},
+				{-1.6969813937133889, -0.3292652043670087, -1.442739564937728},
+				{-0.3562070315877509, -1.0901932482699066, -1.3511726975855403},
+				{0.8264058841228059, -1.7599760796359984, -1.2707636623123955},
+				{1.8730705261756502, -2.3512325964472827, -1.199933131353403},
+				{2.8022112895394553, -2.8744554397355593, -1.1373736109640546},
+				{3.629261063779221, -3.3384561584321433, -1.0819917396039556},
+				{4.367322036671177, -3.7507372277422415, -1.0328604907729553},
+				{5.027753810245191, -4.117827370391124, -0.9891765841933692},
+				{5.620652234432695, -4.445556593896645, -0.9502257474544695},
+			},
+			{
+				{-19.36667549206383, 10.172645974296561, -2.7519216945326317},
+				{-15.962547836667436, 8.225923109769859, -2.5096091362109347},
+				{-13.045753700426287, 6.556079100147978, -2.3025989055226854},
+				{-10.528036429676385, 5.1136378585556, -2.1244379444859973},
+				{-8.34060431781145, 3.859935007886171, -1.970107606080295},
+				{-6.429131008371198, 2.764357619591149, -1.8356530468085759},
+				{-4.7502049296033055, 1.8023808795084577, -1.7179204964565833},
+				{-3.2687634786240385, 0.954146892624094, -1.6143677316767548},
+				{-1.9562095455853559, 0.20341957975222966, -1.522925195330195},
+				{-0.7890089735333373, -0.4631950087128728, -1.4418927570796671},
+				{0.25236801694587846, -1.0568383149780827, -1.3698619246551864},
+				{1.184256686377378, -1.5868513265420923, -1.3056564290154333},
+				{2.020427220230935, -2.061126836424896, -1.248286115155799},
+				{2.7725855351152413, -2.4863907734886324, -1.196910299785803},
+				{3.4507882045206806, -2.868436784487002, -1.150807424562364},
+				{4.06380497513462, -3.212333207972662, -1.1093485154023845},
+			},
+			{
+				{-19.873734995747405, 10.865042308301089, -2.8604947656063717},
+				{-16.736535718178164, 9.061074660044369, -2.6341258090530526},
+				{-14.020962214735247, 7.497837782786011, -2.4386596604353663},
+				{-11.654816200636942, 6.134655738384356, -2.2687595983288253},
+				{-9.581006606977438, 4.939286553722693, -2.1202138417049676},
+				{-7.753856253916401, 3.8858724135678693, -1.9896589532181062},
+				{-6.136429992206695, 2.9534546870672824, -1.8743800703119549},
+				{-4.698571260564866, 2.124881028971168, -1.7721643430181457},
+				{-3.415438638737166, 1.3859894880696804, -1.681191870406395},
+				{-2.2664008516716136, 0.7249914275286032, -1.599953480936649},
+				{-1.2341923268027486, 0.13199912775876566, -1.5271879967970476},
+				{-0.30426038960626284, -0.401340077335445, -1.4618338078713882},
+				{0.5357453499421192, -0.8821305527451461, -1.402991047253483},
+				{1.2963778091417109, -1.3164562485617641, -1.349891642391022},
+				{1.9867193284064344, -1.7095653312424495, -1.3018751541613114},
+				{2.6146532598288044, -2.0660243524805777, -1.2583687104329797},
+			},
+			{
+				{-20.36718386518637, 11.512918054524281, -2.9617049505189783},
+				{-17.457283578444112, 9.831200004863645, -2.7491057887489267},
+				{-14.915956366723831, 8.360876464904772, -2.5638136327313523},
+				{-12.683325034295482, 7.068057757686874, -2.4013577424955965},
+				{-10.711435823118487, 5.9255421236122565, -2.258163858035704},
+				{-8.96147633869096, 4.911264137696613, -2.1313438574133903},
+				{-7.401729574598762, 4.0071522111763835, -2.018541306935759},
+				{-6.0060477582110146, 3.1982752053097254, -1.9178164278108156},
+				{-4.752699180255952, 2.472196676372364, -1.827559306216777},
+				{-3.623486509530276, 1.8184804007235338, -1.746423632754707},
+				{-2.603065274009152, 1.2283075574653097, -1.6732755575490448},
+				{-1.6784116216306137, 0.6941772725619338, -1.6071538029412864},
+				{-0.8384025016709711, 0.209670004212638, -1.547238242588077},
+				{-0.07348112973727126, -0.23074136432991332, -1.4928248942895792},
+				{0.6246126574260448, -0.6318441297853126, -1.4433057853656082},
+				{1.2630629410732868, -0.997810942057867, -1.3981524983630877},
+			},
+			{
+				{-20.84447694786416, 12.120276819947968, -3.0562746054204384},
+				{-18.130401708030657, 10.544430029860443, -2.855694402900399},
+				{-15.741437064568702, 9.155831291621693, -2.6794421426163493},
+				{-13.627325222282426, 7.925913852466284, -2.5237300845646757},
+				{-11.747358531904542, 6.831491178893086, -2.385495017597205},
+				{-10.068247560899774, 5.8535625709031, -2.262235637882611},
+				{-8.562530020334687, 4.976420764409102, -2.151891359572892},
+				{-7.2073678828557055, 4.186976427819361, -2.0527508239793746},
+				{-5.983627201217802, 3.474240737161694, -1.9633820084041127},
+				{-4.8751665321666575, 2.8289247012364487, -1.8825782526459403},
+				{-3.8682811397472827, 2.243125755374093, -1.8093161569634724},
+				{-2.9512647922781827, 1.7100802977759162, -1.7427224300632134},
+				{-2.114061186494858, 1.223966535772348, -1.6820475499773313},
+				{-1.3479842497163887, 0.779746030265929, -1.6266446539859454},
+				{-0.645491710690022, 0.373035188958538, -1.5759524672875715},
+				{-3.8483529761584486e-14, 2.809527348461678e-14, -1.5294813607531654},
+			},
+		},
+		{
+			{
+				{-98.10922175810335, 32.001058487393244, -3.3730067878830106},
+				{-12.089365495241127, -7.695526493324217, 0.6316217080037744},
+				{16.660112469103186, -21.650342899818472, 2.0045103013916883},
+				{27.42298803649059, -26.924098680480697, 2.5231250913893257},
+				{31.391467127264193, -28.69904800479582, 2.690717777258306},
+				{33.308029159390095, -29.40798794046122, 2.7511791052864547},
+				{34.410666692494736, -29.7037289038921, 2.7705132847528624},
+				{35.10683749604706, -29.80176030429994, 2.770514297238034},
+				{35.57268046697907, -29.79463300548919, 2.7605175790298637},
+				{35.89642278679557, -29.728009131773643, 2.7451027471295903},
+				{36.12760385552423, -29.626977421392034, 2.7267656524991684},
+				{36.29646619665738, -29.506438940044024, 2.7069732484001343},
+				{36.42261029098974, -29.37573370120729, 2.686633006606792},
+				{36.519316705176934, -29.240942577595657, 2.6663260916367455},
+				{36.59589819173287, -29.106133451779044, 2.6464333326655174},
+				{36.65906643325092, -28.974080348391478, 2.6272077349912557},
+			},
+			{
+				{-32.52452082121063, 6.24686474376494, -1.0021999722878647},
+				{-3.6394303724663564, -7.963702543690037, 0.4673274992857646},
+				{10.573748122802929, -15.100545106305011, 1.193044598840192},
+				{18.026730796538303, -18.861119109220247, 1.5736946496515818},
+				{21.89176402110094, -20.75762301008567, 1.7631262572975424},
+				{24.069479776383748, -21.76129398046758, 1.8606994510944792},
+				{25.46372650404659, -22.350907253081562, 1.9157802265782533},
+				{26.43958092993873, -22.72212078656624, 1.9485847442225543},
+				{27.16566610501522, -22.965609873788186, 1.9684813514891903},
+				{27.731073046781752, -23.129244507262566, 1.980410416268038},
+				{28.187200933641236, -23.240514279701546, 1.9872025151094757},
+				{28.565878381777548, -23.316309533424608, 1.9905911012245292},
+				{28.88800686050266, -23.367607947572868, 1.9916981675016405},
+				{29.167974712222076, -23.40187246944219, 1.9912827598042173},
+				{29.416066800807283, -23.42436075634103, 1.9898766233775236},
+				{29.63986268339773, -23.438884454144585, 1.9878628009223425},
+			},
+			{
+				{-18.58362512626317, 1.9341750939553197, -0.6960725178176395},
+				{-2.8028531978745543, -6.104026349228534, 0.15958893472006838},
+				{6.44276800149446, -10.855848904575325, 0.6573319932208896},
+				{12.138358875963393, -13.790351777796921, 0.9624705845819338},
+				{15.686825125668603, -15.59957528568186, 1.1491761028328935},
+				{17.936660885654483, -16.715674521047838, 1.2629631407157784},
+				{19.4619808057086, -17.442522660399078, 1.3358523975218104},
+				{20.571571535113907, -17.946509212345614, 1.3854018260241132},
+				{21.42428351394893, -18.313893463199328, 1.4207150842244607},
+				{22.107513698438353, -18.59228585511633, 1.446813948320947},
+				{22.673268625843452, -18.81003857214017, 1.4666859624279474},
+				{23.154393356760526, -18.985032501793302, 1.4822125168251394},
+				{23.57266236226481, -19.12908277285436, 1.4946343274460243},
+				{23.94313002442434, -19.250318662539748, 1.5048030037591986},
+				{24.276587827987132, -19.354531948918375, 1.5133235995077756},
+				{24.58101280470359, -19.44597312833286, 1.5206387769375567},
+			},
+			{
+				{-14.022776522581864, 1.320408669811615, -0.7280715275714483},
+				{-3.494553677641167, -4.172235415495016, -0.127778528830659},
+				{3.353947242679407, -7.760638361496778, 0.2586918877332798},
+				{7.985832029141621, -10.189938554712048, 0.5180322588159088},
+				{11.182448480467448, -11.858366260615767, 0.6949523005628991},
+				{13.407033365332891, -13.004188901754835, 0.8155526518241398},
+				{14.996691588977885, -13.805500606791721, 0.899120648629228},
+				{16.1854956584026, -14.388721846419683, 0.9593091014729239},
+				{17.11530814683153, -14.83134715430225, 1.0044781189636418},
+				{17.870332250167138, -15.179644582888201, 1.0396142737922782},
+				{18.502307680776376, -15.462127490423683, 1.0677872454647002},
+				{19.044605013209043, -15.697208541726344, 1.0909769312117878},
+				{19.51968018308786, -15.897276200379157, 1.1105136479438649},
+				{19.94319436311235, -16.070958519119216, 1.1273224494673717},
+				{20.326418651204015, -16.224449911961944, 1.142066273980704},
+				{20.67769522422397, -16.362319967679817, 1.1552331887105023},
+			},
+			{
+				{-12.381813269874552, 1.7243685231373742, -0.8452133721786633},
+				{-4.5621601950628135, -2.431022522824522, -0.3804995050483445},
+				{0.8963002784127392, -5.338813789663812, -0.0593589761282459},
+				{4.823755859946525, -7.4315542621536155, 0.16970876594126383},
+				{7.707624447252729, -8.963865317184533, 0.33630523433803367},
+				{9.850228132930804, -10.094270249435604, 0.45846993280575155},
+				{11.45987101837598, -10.933196641908658, 0.5485650928389615},
+				{12.698151340752451, -11.567997452690319, 0.616277596737755},
+				{13.681576043310399, -12.062562351204122, 0.6686631716414154},
+				{14.487397493828594, -12.459604717911146, 0.7104282219027199},
+				{15.166008349477858, -12.787119915876165, 0.7446522165344339},
+				{15.750864466149158, -13.063743880041898, 0.773381986927479},
+				{16.264862938921464, -13.302248398255577, 0.798018259075095},
+				{16.724161986190627, -13.511649831800051, 0.8195484579399636},
+				{17.140480682810768, -13.698484435043946, 0.8386873224889033},
+				{17.522529325447284, -13.867604449915122, 0.8559645968545191},
+			},
+			{
+				{-11.894538271831031, 2.448628875776361, -0.9835630595843684},
+				{-5.6941537255246635, -0.8957939777206114, -0.6020460397019978},
+				{-1.1399059453575555, -3.3565400871068194, -0.324240501371148},
+				{2.286576534939234, -5.208100454269274, -0.11691484047629785},
+				{4.91141045480123, -6.623832982490509, 0.040580962045428305},
+				{6.948375910852743, -7.717794556570478, 0.16161578767358017},
+				{8.544018569092762, -8.568553345561172, 0.25526686403664894},
+				{9.808627686330391, -9.23586739024725, 0.3283547409263272},
+				{10.83028379148269, -9.768145169510975, 0.3863600539869516},
+				{11.67517616753169, -10.202145859487464, 0.4334258607483477},
+				{12.390239683440546, -10.564104924552852, 0.4725007435247643},
+				{13.00822504190796, -10.872403226394983, 0.5056461247737694},
+				{13.552167110085978, -11.140000631827494, 0.5343128351727453},
+				{14.03857666154319, -11.376199541662672, 0.5595405443097451},
+				{14.47955089700996, -11.587820071105877, 0.5820902988709121},
+				{14.884143056629561, -11.779966266295366, 0.6025307741347541},
+			},
+			{
+				{-11.927181045072828, 3.2635442310648366, -1.1230519485382953},
+				{-6.792867409712215, 0.4594452019496154, -0.7976464936778727},
+				{-2.8740077925115015, -1.683878075769339, -0.5510523085007436},
+				{0.17615450923807738, -3.35236850274279, -0.36045655038797086},
+				{2.5873343811180582, -4.669721779823074, -0.21086357107838744},
+				{4.516905406309931, -5.721010765926121, -0.09208316077739531},
+				{6.076007319198295, -6.566555819425911, 0.0030277351424538635},
+				{7.346397169347033, -7.250967599267477, 0.07969637219826065},
+				{8.392736464794316, -7.809858265327151, 0.14205663195376247},
+				{9.267807925305423, -8.272617081784226, 0.19349669745139106},
+				{10.01280351167366, -8.6623699684533, 0.2366709941470394},
+				{10.6584846166352, -8.996481369294848, 0.2735666527524128},
+				{11.22744196350754, -9.287749100337166, 0.305644825347937},
+				{11.736295509878884, -9.545610333555947, 0.3339808670025336},
+				{12.197411875228054, -9.777097220198627, 0.35937467614739305},
+				{12.62013601396507, -9.98752790922767, 0.38243019243033444},
+			},
+			{
+				{-12.211931179703706, 4.084043741266936, -1.2569094977536366},
+				{-7.8282969995811005, 1.6643509870505602, -0.9719016245205344},
+				{-4.380763815765251, -0.24108086321936412, -0.7490696164176804},
+				{-1.6249848876590927, -1.7646536606358365, -0.5719847503757057},
+				{0.6075310551197753, -2.998032403573351, -0.4293798925992957},
+				{2.4362843804111196, -4.006480564240164, -0.3133079512775039},
+				{3.9481703455638724, -4.837636081836349, -0.21802054213450842},
+				{5.207971522509085, -5.527159047018696, -0.1392514706378879},
+				{6.265746952716689, -6.102751914431212, -0.07371328150988606},
+				{7.162304139491081, -6.587177883005807, -0.01872455940849299},
+				{7.931603066351808, -6.999548375593832, 0.027952928387278038},
+				{8.601019910314623, -7.355377240681993, 0.06812870801644845},
+				{9.191859833800864, -7.666796408648939, 0.10321366628490758},
+				{9.720418236954691, -7.943115670103138, 0.13428796386689698},
+				{10.199127551935565, -8.191453734556731, 0.1621759053766703},
+				{10.637530671515222, -8.417282859016032, 0.18751001851575494},
+			},
+			{
+				{-12.622794662617212, 4.877524044108881, -1.3830480638714457},
+				{-8.794427166746773, 2.7447446499016794, -1.128532731730786},
+				{-5.710651901679999, 1.02468416635156, -0.9245049531431491},
+				{-3.1921636406533724, -0.3806911277647776, -0.7586794541660757},
+				{-1.1112765659158574, -1.5414514163068116, -0.6223435036649081},
+				{0.6251421830851356, -2.50889142148863, -0.509167671411778},
+				{2.0864838963876564, -3.3213675541801515, -0.4144540754829727},
+				{3.3254874611809955, -4.00813845289405, -0.33464451637235626},
+				{4.383099674579923, -4.592011608347339, -0.2669839783997163},
+				{5.292078715345519, -5.091325509992868, -0.20927143956191388},
+				{6.079688170062347, -5.521446879845778, -0.15967348412613727},
+				{6.769001045737628, -5.895477022583631, -0.11663464715768507},
+				{7.379130866641777, -6.224335443735628, -0.07886327230966031},
+				{7.925462535237205, -6.516850630965254, -0.04531796418166073},
+				{8.420164382082675, -6.780027203185504, -0.01517433062316702},
+				{8.872806112448691, -7.019386047325472, 0.012216139181719191},
+			},
+			{
+				{-13.09600704120902, 5.632406966008875, -1.5011631492091886},
+				{-9.694027325908753, 3.721711381188781, -1.2704978225577863},
+				{-6.899587103278919, 2.150396435749953, -1.0817778147072168},
+				{-4.576775755797243, 0.8435662326275146, -0.9255344022939666},
+				{-2.6262069193281214, -0.2537336749967195, -0.7948658989983135},
+				{-0.973683852250229, -1.182667651151229, -0.6846358879660338},
+				{0.43721638320867606, -1.9746440341938352, -0.5909497922981808},
+				{1.650133617569907, -2.6540372415387217, -0.5108033706915507},
+				{2.699381983550639, -3.2400798179597627, -0.4418397091146956},
+				{3.6124416424010692, -3.748233005480372, -0.3821743856806338},
+				{4.411863476781404, -4.1912436744298125, -0.33026188902435955},
+				{5.116712463032533, -4.57995156012179, -0.28479433549209926},
+				{5.7433528681388095, -4.923720098982104, -0.24464670001355024},
+				{6.305650270762553, -5.230526749913502, -0.20886366425646313},
+				{6.81508279600516, -5.507003053144292, -0.1766531567838706},
+				{7.280989829397864, -5.758561381291713, -0.1473701069896079},
+			},
+			{
+				{-13.597673784656825, 6.3460153975536855, -1.611638375768112},
+				{-10.532860500355381, 4.612186290825383, -1.4001504051096925},
+				{-7.974080830484269, 3.1629896318741637, -1.2241643172652728},
+				{-5.815551502597702, 1.9397202705127863, -1.0761993372479361},
+				{-3.978171893010842, 0.898344670952991, -0.9506764719339578},
+				{-2.4017156870469822, 0.005232382070500796, -0.8433585831605056},
+				{-1.0395788939442843, -0.7657207723737205, -0.7509745443526018},
+				{0.14483924889217945, -1.435079503359626, -0.6709607709738639},
+				{1.1806880807726092, -2.0192735292586868, -0.6012793287183028},
+				{2.0915102533454344, -2.531616180635128, -0.5402866734877231},
+				{2.896620633669305, -2.9830694753614417, -0.4866356605246084},
+				{3.6121817429258076, -3.3828452363748625, -0.43919913040971603},
+				{4.252032324564418, -3.738871081109124, -0.3970110126870665},
+				{4.8281902726320425, -4.05806968051774, -0.3592307549821107},
+				{5.351027626211902, -4.346445683968235, -0.32513145583271436},
+				{5.829335135312945, -4.609108653051367, -0.29409607167942337},
+			},
+			{
+				{-14.109229777876317, 7.019488694457024, -1.7151010875353816},
+				{-11.317380854264314, 5.429765117663665, -1.5193766790720749},
+				{-8.95425065884854, 4.082683217467011, -1.3541663382796414},
+				{-6.935668710214959, 2.931234163351701, -1.2134366259012994},
+				{-5.197484867415521, 1.9394887165745431, -1.0925994147705664},
+				{-3.6900224403272412, 1.0795447765707542, -0.9881091287685693},
+				{-2.3742568699933924, 0.329420849169324, -0.8971858010919965},
+				{-1.2191266513529155, -0.32842917591382387, -0.8176205507919959},
+				{-0.19960356805101184, -0.9081841368465645, -0.7476362366886358},
+				{0.7047191621209424, -1.4214389744210068, -0.6857856800347892},
+				{1.510682244659122, -1.8777921282190542, -0.6308756958349903},
+				{2.232371043296098, -2.285298771410821, -0.5819087867843727},
+				{2.881757578789753, -2.650831909550718, -0.538036974274812},
+				{3.46920363080384, -2.980366772176476, -0.49852561000760404},
+				{4.003795070759046, -3.2791683234184745, -0.46272941991309163},
+				{4.49348986893473, -3.5518691843698877, -0.43008217327295784},
+			},
+			{
+				{-14.620386208169855, 7.655527488676088, -1.812235467704125},
+				{-12.053823559292171, 6.185426475539211, -1.629702779330414},
+				{-9.85570275133343, 4.92502760756064, -1.4737388946708954},
+				{-7.957788911737127, 3.8360002479728093, -1.3393918759755055},
+				{-6.307213035717121, 2.8885680879037463, -1.222832211821531},
+				{-4.8624270116866395, 2.059269576025338, -1.1210557144899271},
+				{-3.590358060869951, 1.3293800079268592, -1.0316748787225496},
+				{-2.4643687297972847, 0.6837784030964491, -0.9527695597747309},
+				{-1.4627689040998955, 0.11011948330137372, -0.8827782366987172},
+				{-0.5677125678327797, -0.4017814993846097, -0.8204175170413707},
+				{0.2356337818646376, -0.8604135517545134, -0.7646215482353412},
+				{0.9597295531862884, -1.2729278137247806, -0.7144955570583311},
+				{1.6151610751782655, -1.6454195154295486, -0.6692794139904022},
+				{2.2110434751192143, -1.9831552837020658, -0.6283184085741211},
+				{2.7553406516795738, -2.2907548740181243, -0.5910399793102409},
+				{3.255093251993186, -2.572320078263307, -0.5569371815533971},
+			},
+			{
+				{-15.125497578690219, 8.257361181725333, -1.903704399671986},
+				{-12.747875480323406, 6.88810965879377, -1.7323760962132373},
+				{-10.690768733569778, 5.702224618599648, -1.5844385089097015},
+				{-8.897968958274005, 4.66796211443553, -1.4557658946192271},
+				{-7.325293496757016, 3.7603098990353514, -1.343120561237184},
+				{-5.937568189980487, 2.9593074167118507, -1.2439288818549767},
+				{-4.70646530160528, 2.2488403034103395, -1.1561210498831784},
+				{-3.6089276548939817, 1.6157604265383898, -1.0780144650707466},
+				{-2.6260021038105297, 1.0492334427386736, -1.0082275407094967},
+				{-1.7419634574560605, 0.5402479460497934, -0.9456150650660495},
+				{-0.9436473092861137, 0.08124093494444215, -0.8892190421700464},
+				{-0.2199346841860461, -0.33419214299390293, -0.8382307641994303},
+				{0.43865229159074287, -0.7115255037564527, -0.7919610827986554},
+				{1.0402732554853207, -1.055485813025401, -0.7498166834838438},
+				{1.5920316044978506, -1.3702011343711706, -0.7112808347433368},
+				{2.1001860954349465, -1.6593216477365693, -0.6758978410655929},
+			},
+			{
+				{-15.621598514600914, 8.828272583826665, -1.9901191735285773},
+				{-13.40459203271101, 7.54516051095673, -1.82842649767753},
+				{-11.469348571935459, 6.4240135869550485, -1.6875241530571494},
+				{-9.768919593914148, 5.438185704997534, -1.5639296665336841},
+				{-8.26592925657139, 4.566416791839214, -1.4548746582383023},
+				{-6.930278906597169, 3.7915479007934083, -1.3581327360965285},
+				{-5.737474993031587, 3.099584118375661, -1.2718943182217908},
+				{-4.667392173841758, 2.4790004410869777, -1.194674169201007},
+				{-3.7033454003806874, 1.9202202944422366, -1.1252421306371994},
+				{-2.8313846840465935, 1.4152185833237667, -1.0625705450923206},
+				{-2.0397524713970356, 0.9572157560301546, -1.0057938533582576},
+				{-1.3184610845208076, 0.5404391201567567, -0.954177167692429},
+				{-0.6589595862736584, 0.15993427135852417, -0.9070915206252308},
+				{-0.05386765070233539, -0.18858592985662975, -0.8639941076237913},
+				{0.5032400813489193, -0.50886410782771, -0.8244122853958324},
+				{1.0180108765480094, -0.804199408025285, -0.7879304490279061},
+			},
+			{
+				{-16.10730115574319, 9.371385936732402, -2.0720306163800792},
+				{-14.02841616678227, 8.16267175424277, -1.9187125312235749},
+				{-12.199501577629992, 7.098284644427576, -1.7840281471767563},
+				{-10.580863603631688, 6.155591587481488, -1.6650041824253126},
+				{-9.14054297140702, 5.316332249572427, -1.559249805325722},
+				{-7.8525378174686224, 4.565619323728928, -1.4648221557132328},
+				{-6.695490106798453, 3.891198795651648, -1.3801270799817211},
+				{-5.651700918390816, 3.282894740936032, -1.3038448179131201},
+				{-4.706382719367807, 2.732187195034979, -1.2348736471447161},
+				{-3.8470848162487967, 2.231887341193714, -1.1722866333239248},
+				{-3.0632469380133647, 1.7758847614092228, -1.1152980648082458},
+				{-2.3458486652436648, 1.3589486348573598, -1.0632371225776254},
+				{-1.6871312263229514, 0.9765696959780333, -1.0155270060217338},
+				{-1.0803743465551057, 0.6248332141156934, -0.9716682037359077},
+				{-0.5197152289703784, 0.3003157159985387, -0.9312249318952578},
+				{-3.378253662222741e-14, 2.1264716173306896e-14, -0.893814009603434},
+			},
+		},
+		{
+			{
+				{-75.70609135423017, 23.937467461225268, -2.260641565790164},
+				{-4.965815907410442, -9.243685811722512, 1.155800093520318},
+				{17.645703514002925, -20.605404305106685, 2.352063775596127},
+				{25.31987754993088, -24.442149356551884, 2.756046026091145},
+				{28.35646907325306, -25.81651231539337, 2.8941376271868555},
+				{29.9974561887106, -26.452647364801855, 2.952627663761921},
+				{31.052492654366468, -26.788763685947124, 2.979238710106526},
+				{31.806486214554344, -26.97886150621368, 2.99073580662959},
+				{32.38632445315185, -27.090752093389153, 2.9944669975763434},
+				{32.85845183277363, -27.159312741326254, 2.994183419140991},
+				{33.26168573295806, -27.204452214412793, 2.9920052136682025},
+				{33.620515438373, -27.238388308852866, 2.9892177329316687},
+				{33.95130582639078, -27.269033994100838, 2.986641610596513},
+				{34.265501721382215, -27.301738171676178, 2.98482264481777},
+				{34.57141368984214, -27.340248925197944, 2.9841365641971374},
+				{34.87527427282651, -27.387277859613384, 2.9848501653562893},
+			},
+			{
+				{-23.706525077466438, 3.4495283533036107, -0.3739500627690981},
+				{-0.15942065195899985, -8.364990260543802, 0.886312333745879},
+				{11.119289077621268, -14.187053023945056, 1.5124202181042756},
+				{16.78790494795131, -17.11701142110857, 1.8278775186552263},
+				{19.759516388208134, -18.60434824823239, 1.9859674303035493},
+				{21.56548120625103, -19.461567471027525, 2.0750350204537646},
+				{22.816188942210456, -20.020722101065576, 2.1315682903101045},
+				{23.76253452973593, -20.419420944688742, 2.1707380054475225},
+				{24.524706146276106, -20.723677725138472, 2.1998253318156586},
+				{25.16755562362886, -20.969003024500505, 2.2227416419482653},
+				{25.72956227090388, -21.176337235490806, 2.241787424820825},
+				{26.235327330938507, -21.358957195916123, 2.2584161929080246},
+				{26.701525352898006, -21.52577347169312, 2.2735994702077305},
+				{27.139999873667296, -21.68304688633458, 2.2880168391408358},
+				{27.559495363568406, -21.835348609160125, 2.302162212391601},
+				{27.966685388615335, -21.98612937591529, 2.3164067993854203},
+			},
+			{
+				{-13.202805279303718, 0.31506446141821687, -0.16319336595941752},
+				{-0.40668278635217014, -6.310153592738093, 0.5609152703367347},
+				{6.997980370243523, -10.200572827564292, 0.9866576267970022},
+				{11.477333907701295, -12.560658973764776, 1.2450545468023804},
+				{14.251167858598862, -14.004371284161241, 1.4024135061672174},
+				{16.080446800898695, -14.933234431809534, 1.502733425806316},
+				{17.39730108093761, -15.582231711694922, 1.5720506028823986},
+				{18.416578074145885, -16.069726314792813, 1.6235413656124023},
+				{19.24978291250763, -16.45750635514514, 1.664097224727778},
+				{19.95974938113236, -16.780464356542662, 1.6976119578639295},
+				{20.584544365953153, -17.05973061528268, 1.726442513700093},
+				{21.14871351607797, -17.3088995926037, 1.7521035491173365},
+				{21.66895065891052, -17.53718500890771, 1.77562000893173},
+				{22.157133057497873, -17.75111436586965, 1.7977166848020072},
+				{22.622041368997184, -17.955491004026676, 1.818925894834792},
+				{23.070385878344783, -18.15396873611994, 1.8396518136372362},
+			},
+			{
+				{-10.019860650859949, 0.0859093888150103, -0.2203420164898544},
+				{-1.4868695778485301, -4.422833132207291, 0.28218471683258306},
+				{4.033038942139083, -7.364896105255487, 0.6094730377912021},
+				{7.739875787103773, -9.346002594548303, 0.8297121799912226},
+				{10.28619789128639, -10.700359385897636, 0.9799633912261343},
+				{12.085615880883916, -11.645617694642173, 1.084383301572782},
+				{13.424328680242294, -12.336977187193446, 1.1603309092067315},
+				{14.477021375175001, -12.870806778901708, 1.218639590247214},
+				{15.344591745759026, -13.303260263027546, 1.26563762282159},
+				{16.08695961723402, -13.667841693446674, 1.3051051177234894},
+				{16.741529442052947, -13.985514365347276, 1.3394081684670955},
+				{17.332813227436816, -14.270025463187793, 1.3700981295282852},
+				{17.877601239679645, -14.530786954177199, 1.3982365239367025},
+				{18.387863334561956, -14.774500108613982, 1.4245785262823136},
+				{18.87244328146241, -15.006107490906713, 1.4496805913075514},
+				{19.338087074583438, -15.229372129851432, 1.4739659723480325},
+			},
+			{
+				{-9.048322762731694, 0.6160977629728787, -0.3398422436680674},
+				{-2.695266992764131, -2.7934047496494427, 0.04673150259579375},
+				{1.727117401102447, -5.180081594172336, 0.3164299780081768},
+				{4.902041054698151, -6.897394836957387, 0.5101609816679233},
+				{7.231594653248878, -8.155088122345578, 0.6518123334078858},
+				{8.972867227125684, -9.08915911992325, 0.7567666327544975},
+				{10.311330335787709, -9.79991276137594, 0.8363801615238933},
+				{11.380260093614929, -10.360845452767755, 0.8990039864273625},
+				{12.267253097105119, -10.820824660308576, 0.9502041922856533},
+				{13.028222647480904, -11.21125138925685, 0.9935620768701486},
+				{13.699455129957574, -11.552585274424473, 1.0314125063480402},
+				{14.30527515339101, -11.858573488295331, 1.0653245893667564},
+				{14.862562583772903, -12.138767249275675, 1.0963883482691508},
+				{15.383426852757848, -12.400021239703948, 1.1253857717461468},
+				{15.876831128266968, -12.647408521327128, 1.1528952980193958},
+				{16.349606160757567, -12.884793657111906, 1.1793573150820111},
+			},
+			{
+				{-8.92976083881505, 1.3684874994944998, -0.4721769161330004},
+				{-3.8708358077096916, -1.3814960674161954, -0.15557501750860608},
+				{-0.16146397537399254, -3.405713366880856, 0.07656832612857975},
+				{2.6286651966005308, -4.931022121714473, 0.25108137292476185},
+				{4.768957385147473, -6.100268227821988, 0.3846217259964216},
+				{6.437395733195247, -7.008612466119259, 0.48818374283705773},
+				{7.760633933171367, -7.7246467158410645, 0.5696577724271412},
+				{8.83572624309594, -8.301832974686134, 0.6351938102045905},
+				{9.734772451424458, -8.780433920255582, 0.6894300181804212},
+				{10.508173900448353, -9.188839096038743, 0.7356402289495019},
+				{11.190457973306163, -9.54660219798338, 0.7760806007631469},
+				{11.80549973619446, -9.867291550243596, 0.8123170044089175},
+				{12.370174505075942, -10.160519357861206, 0.8454587021579173},
+				{12.896719501172576, -10.43326609188322, 0.8763110693492699},
+				{13.394242164967794, -10.690731483655664, 0.9054738133047306},
+				{13.869694994737886, -10.936886996188871, 0.9334047789813736},
+			},
+			{
+				{-9.188453800042746, 2.174128286827112, -0.6029351266774612},
+				{-4.976432538551448, -0.1406601182793063, -0.33276039200331126},
+				{-1.7658147507713657, -1.9101964123309174, -0.1270307428146952},
+				{0.7341592339495719, -3.290040744756029, 0.03296115201763024},
+				{2.714636381937835, -4.382946887301528, 0.15943332210391423},
+				{4.306513870150786, -5.259699898383439, 0.2607265611872738},
+				{5.603460308729417, -5.9713522619361665, 0.342818646873609},
+				{6.676794984129984, -6.557216184183926, 0.41029736120264054},
+				{7.5830647558992865, -7.048871401608564, 0.4668453764354247},
+				{8.365673655887607, -7.470809418250334, 0.515319449192659},
+				{9.056498646453205, -7.841141862368376, 0.5578326706532786},
+				{9.678562427713057, -8.17300974709862, 0.595918290651082},
+				{10.24856845004335, -8.475977128299833, 0.6306920825149896},
+				{10.778820774897675, -8.757104580428166, 0.662977550166256},
+				{11.278561405232121, -9.02170386589727, 0.6933940076223236},
+				{11.754879260491995, -9.273853938947301, 0.7224168270679382},
+			},
+			{
+				{-9.62758065295947, 2.9739357883170143, -0.7278264746893238},
+				{-6.008780080052345, 0.9661266217649832, -0.49058440596828207},
+				{-3.1660518890760265, -0.614668286660707, -0.30448763760026826},
+				{-0.8923997947012385, -1.8805213703848376, -0.15587736143535294},
+				{0.9536971904593368, -2.90838293620957, -0.03545916527990391},
+				{2.4722357648994855, -3.752887303969271, 0.06331390440363792},
+				{3.7361593239151683, -4.454115779885079, 0.1452141006607479},
+				{4.800737707192842, -5.0426603858544965, 0.21386667367554002},
+				{5.709911574040003, -5.543078474427382, 0.27217352315452953},
+				{6.499405563129424, -5.975543765226839, 0.3225157365972505},
+				{7.197479494282784, -6.356147150682058, 0.3667917194945556},
+				{7.8257343102534, -6.697259384424071, 0.40646059131623047},
+				{8.400430820662493, -7.008230474421816, 0.44262485000046436},
+				{8.933824150643067, -7.296127417570313, 0.476117488014068},
+				{9.435248461633572, -7.566344213298457, 0.5075740212461864},
+				{9.911921952744516, -7.823058805449017, 0.5374864433671492},
+			},
+			{
+				{-10.156403583124964, 3.7472594912321955, -0.8459070213622286},
+				{-6.9742895938296, 1.966812630500911, -0.63319334290736},
+				{-4.4138505285365675, 0.5315393165153312, -0.46230080247471494},
+				{-2.321609481536764, -0.6424924924068016, -0.32288638084427534},
+				{-0.5892316733147273, -1.614758788085412, -0.20767392438679014},
+				{0.8618873095700004, -2.428593909552371, -0.11139735401733918},
+				{2.090264114230976, -3.1164074617609816, -0.030141207828809833},
+				{3.1406381293605414, -3.7031120187423228, 0.03908961934838703},
+				{4.04834366358807, -4.208514519874547, 0.09866748580446255},
+				{4.842321377175848, -4.648972224256485, 0.15054665592395317},
+				{5.546603171214723, -5.038183944083846, 0.19636149258114638},
+				{6.1807161859519075, -5.387358031349477, 0.23744817851561836},
+				{6.760088795168499, -5.70539487813438, 0.2748671417150628},
+				{7.296733815621387, -5.999249601161153, 0.30944659043054196},
+				{7.7999922373648936, -6.274344817892283, 0.3418318771157964},
+				{8.277179994169009, -6.534936173992676, 0.3725291611158811},
+			},
+			{
+				{-10.730020618536692, 4.488241008052611, -0.957405594280237},
+				{-7.881670590955604, 2.8825085980358875, -0.7636432305484531},
+				{-5.544470300061834, 1.562856657021522, -0.6048937848145505},
+				{-3.6008471173302063, 0.4644267707388071, -0.4730886094374306},
+				{-1.9655875813654433, -0.45995540773411514, -0.36239440763374897},
+				{-0.57545870988353, -1.2454260524049763, -0.2684910019789538},
+				{0.6174907287509127, -1.918735961316602, -0.18810617493369644},
+				{1.6504033767511634, -2.5006970101589845, -0.11870574416289074},
+				{2.5527176489302223, -3.0078873598733002, -0.058278855597794055},
+				{3.348412340850303, -3.4538923390660408, -0.005182793575972888},
+				{4.057572609985754, -3.850172383697277, 0.04196507460453313},
+				{4.69718942476517, -4.206492284959485, 0.08434105313242754},
+				{5.2813992740909095, -4.531025197623473, 0.1229287248710145},
+				{5.821689697461047, -4.8304427035899975, 0.15853033370118247},
+				{6.327262872993788, -5.110106866155172, 0.19179018553141777},
+				{6.80546629006524, -5.374309708117826, 0.22322362961787176},
+			},
+			{
+				{-11.325242613934016, 5.196979469436169, -1.0629470465995137},
+				{-8.73954999462577, 3.729393503741442, -0.8842462959003726},
+				{-6.583099637213589, 2.5037692649119667, -0.7354254687229779},
+				{-4.763372540689061, 1.4686729495212487, -0.6100325938060657},
+				{-3.211806591791064, 0.5858662539152883, -0.5032956046820565},
+				{-1.876539326645982, -0.17367223644763174, -0.41161027925980315},
+				{-0.7175956041632797, -0.8323906226141639, -0.33220133715713474},
+				{0.29639490694620463, -1.407971218750338, -0.26289170103550574},
+				{1.190501732754697, -1.9146031669763668, -0.2019408021958654},
+				{1.9851635923846056, -2.3639088690237453, -0.14792748569932868},
+				{2.6974313266801713, -2.7656372643296008, -0.09966285383744465},
+				{3.341848017453247, -3.1281550653245027, -0.05612869897352512},
+				{3.9309211939670012, -3.4587027353377175, -0.01644522216450303},
+				{4.475276560904216, -3.763464118965552, 0.020138129230789475},
+				{4.98376188135941, -4.047609494910719, 0.054248295803785096},
+				{5.4636413278514135, -4.315396835079906, 0.08640113786838859},
+			},
+			{
+				{-11.92989496570573, 5.875948622339019, -1.1632575391572544},
+				{-9.555749775687637, 4.520089784121982, -0.9967952199166282},
+				{-7.548418514996953, 3.3723485930409223, -0.8562411765720298},
+				{-5.8334410196322795, 2.3910398755647937, -0.7363274088218884},
+				{-4.354582058525551, 1.5445892784768154, -0.6330814686694095},
+				{-3.068593617586874, 0.8086409430761852, -0.5434520812177531},
+				{-1.9416591049673946, 0.16409057356548593, -0.4650554288092706},
+				{-0.9469195318164124, -0.4042857329783716, -0.39599892806217374},
+				{-0.06271155578516231, -0.9088104479012474, -0.3347559010654549},
+				{0.7287184561351313, -1.3596213008836553, -0.28007420599607297},
+				{1.4421776554533132, -1.7652103150554643, -0.23090782515173103},
+				{2.090259320839655, -2.1328356095373993, -0.18636482803877827},
+				{2.6838708092014185, -2.4688180241390216, -0.14566997117728417},
+				{3.2325326217352623, -2.778704924599209, -0.10814392556113896},
+				{3.744483805969777, -3.067319987718656, -0.07319671527626555},
+				{4.226734317670288, -3.3387828324731235, -0.04032501609834014},
+			},
+			{
+				{-12.537688734814022, 6.528468990618968, -1.2590516565431271},
+				{-10.33714462479957, 5.2646247950093725, -1.1027107494579282},
+				{-8.454716005519515, 4.182347981253187, -0.9691385630674485},
+				{-6.82931856023509, 3.247221192134341, -0.8539563376885516},
+				{-5.4140580709077994, 2.4327520976846544, -0.7538068181037638},
+				{-4.172346581207972, 1.7182141060311398, -0.6660732740712185},
+				{-3.075210897020892, 1.087147747138175, -0.5886850922993245},
+				{-2.099386392727803, 0.5262974914223014, -0.5199802974014357},
+				{-1.2259392704935157, 0.02484177965963372, -0.45860632038203836},
+				{-0.4392506080043138, -0.4261761216597685, -0.4034469064222271},
+				{0.2737485994375253, -0.8342779489933865, -0.3535671199405903},
+				{0.9241653772507304, -1.2058820381335966, -0.3081710838096222},
+				{1.521600026352952, -1.546561007290598, -0.26656925793228675},
+				{2.0744805147328327, -1.8612308299842348, -0.228154471481881},
+				{2.590262215852597, -2.154261365979519, -0.1923878421307088},
+				{3.0755043216210454, -2.4295138905613674, -0.15879383465682298},
+			},
+			{
+				{-13.145608334285242, 7.158043981077252, -1.3509922163759216},
+				{-11.089720517160561, 5.971104962843125, -1.2031414518813468},
+				{-9.313214050232833, 4.944510333099164, -1.075532716293923},
+				{-7.765143266297642, 4.049383627669495, -0.9644696740078269},
+				{-6.4058353989602015, 3.2631835258006254, -0.8670770266524297},
+				{-5.203942296599636, 2.568054209382518, -0.7810847944705531},
+				{-4.134359874707212, 1.9496597278290777, -0.7046763100359024},
+				{-3.176730885433198, 1.396343920292192, -0.6363789792447225},
+				{-2.3143477673322703, 0.8985137706115635, -0.5749843324466631},
+				{-1.533334578089572, 0.4481787089930222, -0.5194884767001143},
+				{-0.8220263045856392, 0.03860024023856127, -0.4690469529563242},
+				{-0.1704894472218601, -0.3359794612052451, -0.4229398850073894},
+				{0.42985429011193504, -0.6805513011665442, -0.38054462687344875},
+				{0.9865240464009299, -0.9995079109820135, -0.3413142499292913},
+				{1.5062015013715828, -1.2967697753279943, -0.3047614777079296},
+				{1.9948699419597562, -1.5758629425042303, -0.2704487480974433},
+			},
+			{
+				{-13.752516333532668, 7.768078013683093, -1.4396799653082617},
+				{-11.818693831840905, 6.646194897916391, -1.2990329724285743},
+				{-10.132931789270092, 5.667419581303337, -1.1765634476496472},
+				{-8.652123200305992, 4.8071818739310945, -1.0691085651867527},
+				{-7.342275614469095, 4.046063915796731, -0.9741766200495816},
+				{-6.176226455162898, 3.3685141047219886, -0.8897780817806827},
+				{-5.132004643550821, 2.761923778256252, -0.8143046027615994},
+				{-4.191634562508758, 2.215952299452501, -0.7464408037468679},
+				{-3.3402484776168455, 1.7220254628205545, -0.6850988857021953},
+				{-2.5654175524262492, 1.2729567970844546, -0.6293693963822624},
+				{-1.8566399020118276, 0.8626571979010573, -0.5784835865103919},
+				{-1.204942766023389, 0.48590876638577374, -0.5317841758298802},
+				{-0.6025685803361976, 0.13818585665812125, -0.48870229262816667},
+				{-0.04272410437307795, -0.1844883610760646, -0.44873904793314456},
+				{0.480619857193159, -0.48565668049615257, -0.4114508327221781},
+				{0.9728812922687032, -0.7685153320475153, -0.3764381291574149},
+			},
+			{
+				{-14.358380318391532, 8.361769002496809, -1.5256554491090424},
+				{-12.528638645427792, 7.295463655304654, -1.3911773469373934},
+				{-10.921271186666102, 6.358075654438495, -1.273167780441995},
+				{-9.499336179670054, 5.528437725210904, -1.168887772074895},
+				{-8.23337715969937, 4.7896375175429675, -1.0761551788001424},
+				{-7.099619844902758, 4.127996772976438, -0.9932100163095315},
+				{-6.078660441212754, 3.5323279543062425, -0.9186166082077746},
+				{-5.154495684127631, 2.9933839121013683, -0.8511913123319498},
+				{-4.313794698157138, 2.5034442173801086, -0.7899483239334963},
+				{-3.5453445961271792, 2.055999738076853, -0.7340584510286476},
+				{-2.8396225602367338, 1.6455087717362278, -0.6828173220279795},
+				{-2.188461041029065, 1.2672058789451974, -0.6356205290209291},
+				{-1.5847821976689238, 0.9169499133206763, -0.5919439218578029},
+				{-1.022384429975045, 0.5911015483663434, -0.5513277727628733},
+				{-0.4957690550014236, 0.28642356929115137, -0.5133639221350429},
+				{-4.016108112089492e-14, 2.7776354757144402e-14, -0.4776853802928018},
+			},
+		},
+		{
+			{
+				{-61.75588244094343, 18.442799450047914, -1.421865171410031},
+				{1.812517581098771, -12.131232854201004, 1.8351866627893887},
+				{20.04116311243299, -21.59693545307449, 2.897061253639565},
+				{25.657750801628133, -24.42965858861166, 3.211843279025528},
+				{28.15307284368852, -25.564027459753213, 3.3313484386318626},
+				{29.66907054673451, -26.17630086580589, 3.3913741124760217},
+				{30.754949864760327, -26.570402049289097, 3.427175317100911},
+				{31.61601586204354, -26.859077817031764, 3.4517915090801496},
+				{32.349777383138324, -27.09506735214483, 3.471281319833505},
+				{33.010160643049396, -27.306970397234142, 3.4889010609777147},
+				{33.63026996740657, -27.51196785019656, 3.5065874238130417},
+				{34.232082986848546, -27.721226406402412, 3.5255868388718348},
+				{34.83114789562439, -27.94250299632592, 3.546758063613631},
+				{35.43909991160474, -28.181530150334662, 3.5707326427797},
+				{36.06513007623248, -28.442819067774813, 3.598007662845548},
+				{36.7169087206234, -28.73016222788575, 3.6290037196885936},
+			},
+			{
+				{-17.26260243762784, 0.7870405323348905, 0.21455479491444948},
+				{3.422566183090876, -9.872010353381867, 1.401066128396882},
+				{12.786501332511063, -14.842299866391286, 1.9658933560872769},
+				{17.264793971411397, -17.2036666381696, 2.2345131925437323},
+				{19.717613395624333, -18.452370177293417, 2.374659699546395},
+				{21.338233760866277, -19.243685325959405, 2.461935778759035},
+				{22.554674639552307, -19.816439568522252, 2.5241473923534485},
+				{23.547235125699903, -20.271674916648585, 2.5730909602483036},
+				{24.40532366282554, -20.659434088913972, 2.614608426103516},
+				{25.1793608593291, -21.007818208599105, 2.6519754715705814},
+				{25.900731419333912, -21.334221401764744, 2.6872156411000354},
+				{26.590542299787973, -21.65027605311149, 2.7216797016244523},
+				{27.263920170531613, -21.964281979961072, 2.756330436643404},
+				{27.932319046323066, -22.282510775834425, 2.791895596626342},
+				{28.604857371055058, -22.609961178044987, 2.828956480218422},
+				{29.289147395809582, -22.95082761669605, 2.8680029066830652},
+			},
+			{
+				{-9.085131397222108, -1.4643349555747522, 0.3449817828131288},
+				{2.0959327245735806, -7.384449576285828, 1.0163387977670422},
+				{8.383871194378717, -10.769078613554914, 1.4045808889627078},
+				{12.088885457662498, -12.763834251931288, 1.6342038533109222},
+				{14.405751336022993, -13.993332431699535, 1.7752596713016326},
+				{16.01883941758886, -14.831903374227862, 1.8708660460804183},
+				{17.25708650704597, -15.463252090766888, 1.9424333859161977},
+				{18.278042953856502, -15.976156863180881, 2.0003608743680887},
+				{19.164586846077395, -16.417496362101506, 2.0501524874142976},
+				{19.964615716224156, -16.814389741798365, 2.0949952488184374},
+				{20.708200769395244, -17.18385536895218, 2.136889792063722},
+				{21.415610278162717, -17.53735394273425, 2.1771837672082257},
+				{22.101365676579185, -17.883093180266577, 2.216842948624657},
+				{22.776447011136266, -18.2272838086501, 2.256599172735348},
+				{23.449573492562163, -18.574870054660884, 2.297036468996294},
+				{24.12799306374661, -18.929980810567685, 2.3386443175836455},
+			},
+			{
+				{-6.9579371023435, -1.300205702250783, 0.2497976434171172},
+				{0.5189796075589207, -5.324666929496917, 0.7119174109981864},
+				{5.282272463146451, -7.916713767892091, 1.0117235017221209},
+				{8.438469897679227, -9.63835851335333, 1.2116169748013754},
+				{10.60459309876115, -10.813009855824298, 1.348037878526426},
+				{12.181539396152248, -11.658742558896625, 1.4460719573070182},
+				{13.413483838986489, -12.311692198224183, 1.5215939108292627},
+				{14.435686097749267, -12.848203333625833, 1.58356610566992},
+				{15.32452282866813, -13.311666987750183, 1.6371007547993968},
+				{16.125750357705503, -13.72817294135508, 1.6852782817391068},
+				{16.868563134808472, -14.114401954912076, 1.7300709791470648},
+				{17.572737304540347, -14.481668925089252, 1.7728182736815838},
+				{18.252431471388327, -14.838085774113564, 1.8144817086777856},
+				{18.918312676991594, -15.189775865346059, 1.8557884042237194},
+				{19.578807717283603, -15.541590861506315, 1.897315876907181},
+				{20.24087756566722, -15.897555526068665, 1.939544749853591},
+			},
+			{
+				{-6.565618273114024, -0.5643642978823061, 0.11040264050296107},
+				{-0.9679870897136472, -3.6144632817074323, 0.4642916254115135},
+				{2.8952754073545326, -5.736200895149232, 0.7116422995645114},
+				{5.6505780279500915, -7.253894628799456, 0.8891699992246014},
+				{7.669734128424434, -8.363879361652227, 1.0192105414254073},
+				{9.200627187185406, -9.200468037048225, 1.1172311974198912},
+				{10.417662345749319, -9.860572331400633, 1.194539296174945},
+				{11.433372494696588, -10.407700473061306, 1.2586050085067995},
+				{12.317121434215492, -10.881342612192304, 1.3140947267064413},
+				{13.112425574239872, -11.306406887931159, 1.3639602260845185},
+				{13.847733658817829, -11.699235462022214, 1.4101421075127016},
+				{14.542532117314298, -12.071052076654079, 1.4539753959560267},
+				{15.21081230689765, -12.429934506676883, 1.4964224914435715},
+				{15.86309405018662, -12.78197176531163, 1.5382102115016103},
+				{16.507647852744334, -13.131965834628406, 1.5799130687549054},
+				{17.15126173754426, -13.483873402279311, 1.622005789989574},
+			},
+			{
+				{-6.816008543020772, 0.3142433969685103, -0.033605518820216666},
+				{-2.3278755983875676, -2.1561366032990628, 0.25571434622730693},
+				{0.9461719686235137, -3.9689881940036065, 0.4686813631855442},
+				{3.4014321610646805, -5.332371281389406, 0.6292766892459368},
+				{5.285601932797353, -6.378371019351537, 0.7527147777147338},
+				{6.765721721317842, -7.197571483488737, 0.8494841267965387},
+				{7.964586640377355, -7.85796024641216, 0.9275301912145787},
+				{8.972004967705562, -8.410143781373282, 0.9928176584215296},
+				{9.849368176964791, -8.889101621123581, 1.0494918804334075},
+				{10.637582195863187, -9.31830326512193, 1.1003438055647417},
+				{11.364285943782566, -9.713681448982406, 1.1472727391849082},
+				{12.048744497773784, -10.08638128561296, 1.1916098514735078},
+				{12.70489693039235, -10.444491023209181, 1.2343229273854643},
+				{13.34323669023906, -10.79411729076722, 1.2761440082820057},
+				{13.971989681228386, -11.140062199405756, 1.3176499769007937},
+				{14.597870837138153, -11.486259964825758, 1.3593146323548242},
+			},
+			{
+				{-7.342362670671493, 1.2121784305141583, -0.17243019715492966},
+				{-3.5760787856441003, -0.8791233331268058, 0.07462800218456139},
+				{-0.7149420308777302, -2.475110648724276, 0.2635275527366397},
+				{1.5102474931260168, -3.71953089372227, 0.4111078388313392},
+				{3.2757446747767838, -4.707353890950904, 0.5284552071289792},
+				{4.703082180951571, -5.504819063595413, 0.6233090033788883},
+				{5.881612808173313, -6.161342749651885, 0.7014692992263667},
+				{6.880577337500967, -6.7158513977793834, 0.7675359904887501},
+				{7.752312227681656, -7.198155273440178, 0.8250523377344244},
+				{8.534448912770756, -7.629875068478091, 0.8765983945147592},
+				{9.253588297672152, -8.026383541023012, 0.9240136764968259},
+				{9.9287428054671, -8.398715360316507, 0.9686213905178014},
+				{10.573848228131197, -8.754987065390813, 1.0113966061468582},
+				{11.199449696622802, -9.101359787421696, 1.053080808727868},
+				{11.813812540877873, -9.442677810089352, 1.0942581184180156},
+				{12.423659490102455, -9.782894468953794, 1.1354062490792047},
+			},
+			{
+				{-7.999225999948326, 2.091974948814782, -0.3041467685851565},
+				{-4.735052509698851, 0.2652683949531244, -0.08658859729042141},
+				{-2.177496487609554, -1.17113294731658, 0.08466415484077225},
+				{-0.13265464419861334, -2.322084139868084, 0.22206989850099074},
+				{1.5310568657325805, -3.2592118535736945, 0.3341000739655982},
+				{2.906650443649694, -4.03356683775874, 0.4267832869011977},
+				{4.062835958649292, -4.683247227640451, 0.5046239637770928},
+				{5.053126423884115, -5.238300213207127, 0.5711877846689136},
+				{5.920439747286649, -5.723141418613814, 0.6293864341366565},
+				{6.698252810252295, -6.1570127928649665, 0.6815242833541137},
+				{7.411694631861971, -6.554461613302973, 0.729348757832043},
+				{8.07938448497548, -6.926321762288251, 0.774164146814608},
+				{8.715244364467695, -7.280726056536612, 0.8169513563089327},
+				{9.32991265923076, -7.623910062842077, 0.8584637265840467},
+				{9.931754373582391, -7.960792137826023, 0.899296464950311},
+				{10.527564543492028, -8.29537993911285, 0.9399354454195232},
+			},
+			{
+				{-8.72282261729821, 2.944307323533298, -0.42912677117971665},
+				{-5.825170532231725, 1.311204361989654, -0.23314446053594473},
+				{-3.4988418209773453, -0.0036232238732143876, -0.07528338590421357},
+				{-1.5980283615368633, -1.0798983314500907, 0.054043977655163715},
+				{-0.020788637929576744, -1.9736478228671326, 0.1615442839364644},
+				{1.3065969083407738, -2.7256420252654974, 0.25208480182837817},
+				{2.439266928707081, -3.36660486740493, 0.3293307229985093},
+				{3.4200894104117854, -3.9206415836709527, 0.39616100043994973},
+				{4.283820708845959, -4.407507890082284, 0.4549419056821961},
+				{5.0591087106680686, -4.843662754965892, 0.5076519809454196},
+				{5.768973858185699, -5.242444782983494, 0.5558997016062023},
+				{6.431349373381995, -5.614308596776573, 0.6009487482749355},
+				{7.060054739262552, -5.96734718449147, 0.6437791357143458},
+				{7.665823691613766, -6.3078692474835965, 0.6851560017098101},
+				{8.25715907378188, -6.640887968850243, 0.7256882478517618},
+				{8.840978940301698, -6.9704940167566996, 0.7658734614872286},
+			},
+			{
+				{-9.483746466815631, 3.769809494554703, -0.5483893071676134},
+				{-6.8634050803747995, 2.2834073206486813, -0.36874299372100194},
+				{-4.717952481982192, 1.0636392878913947, -0.22131168360597883},
+				{-2.9339246883089842, 0.047859069963961914, -0.09848361143163471},
+				{-1.429972220922284, -0.8090408948666965, 0.005198727465556808},
+				{-0.1460715815740151, -1.540509070930463, 0.09376989093632355},
+				{0.9633126028999012, -2.1720681962748767, 0.17030310186853914},
+				{1.9337600871193845, -2.723798031193398, 0.23721527156969358},
+				{2.794034312894722, -3.212064535410035, 0.29647825001179723},
+				{3.568179629974242, -3.650676055443667, 0.3497593759933685},
+				{4.276502713160569, -4.051399645328285, 0.39848331627403405},
+				{4.93578400843048, -4.42402935763812, 0.4438386716856011},
+				{5.559532560574553, -4.776491935169831, 0.4867891958083082},
+				{6.15851994811153, -5.115133305517313, 0.528107564870128},
+				{6.741396817573023, -5.4450663883228065, 0.5684171012681826},
+				{7.3152407560107235, -5.77048598831731, 0.6082297944932512},
+			},
+			{
+				{-10.268521145002845, 4.5726856435753245, -0.6630773561682134},
+				{-7.863744719814518, 3.2003945241578218, -0.49612252186803896},
+				{-5.862668993051028, 2.0564205933904, -0.35697284759643244},
+				{-4.174476316235265, 1.0901808395532122, -0.23942781769913365},
+				{-2.7326458740576114, 0.2644888228845877, -0.13894613990868146},
+				{-1.4872223941522924, -0.4486969186209374, -0.05211359963692543},
+				{-0.39978770427693694, -1.0710431423991793, 0.023702218510474556},
+				{0.5599290564725921, -1.6197141625398719, 0.0905841918788887},
+				{1.4164243862418333, -2.108687710957009, 0.15022813433223486},
+				{2.190128941366879, -2.5497071499715536, 0.20405989154312998},
+				{2.8985596008297048, -2.952922332216045, 0.2533138322922987},
+				{3.556851260141544, -3.327170427170978, 0.2990668031023403},
+				{4.1778464368012305, -3.679996878570954, 0.3422398725989696},
+				{4.772198879504061, -4.017690307118446, 0.3836017474143132},
+				{5.348669021417407, -4.345439901216191, 0.42378742128368563},
+				{5.91450923100965, -4.667553443154354, 0.4633244975462467},
+			},
+			{
+				{-11.071642249651498, 5.358321286296258, -0.7742945417668164},
+				{-8.837888139125686, 4.076536301054382, -0.6173872241512481},
+				{-6.953804201824324, 2.993860058963159, -0.4849042515395427},
+				{-5.344949726062366, 2.0684993324671783, -0.3716830319415867},
+				{-3.9557920527220594, 1.2691763824070916, -0.2738725759454362},
+				{-2.7439792191014014, 0.5719530374919121, -0.18853378967489418},
+				{-1.6765295717518285, -0.041885763243959095, -0.11337397677336943},
+				{-0.7272178367156557, -0.5872870113174236, -0.0465649614417819},
+				{0.12527453849435963, -1.0764623529514088, 0.013385521562810818},
+				{0.8987101834231885, -1.5196454681148683, 0.06772781066997023},
+				{1.6082970577899227, -1.925655867622882, 0.11754006046419231},
+				{2.267371085136995, -2.3022818625990693, 0.16377546097991555},
+				{2.8877103180701256, -2.6564475756083357, 0.20728261881588203},
+				{3.4795664655433787, -2.9942123305195807, 0.24880507773865318},
+				{4.051686927648292, -3.320767058167785, 0.2889804147579372},
+				{4.611473510398179, -3.6405170717465167, 0.3283500674414747},
+			},
+			{
+				{-11.891877247615723, 6.13238368473049, -0.8830651677212},
+				{-9.795870947450647, 4.923392544019002, -0.7342165011312389},
+				{-8.007606638161402, 3.890764392226488, -0.6071461963919916},
+				{-6.464803392511455, 2.999273816214092, -0.49747327304758676},
+				{-5.12026127778909, 2.222149582690716, -0.4018777838707275},
+				{-3.937493581246873, 1.5386334406113276, -0.3177920228580502},
+				{-2.8877631236243535, 0.9323212606814427, -0.24319125990776244},
+				{-1.948015791326141, 0.39000422167697896, -0.1764479770922417},
+				{-1.0994005994790736, -0.09916312592110395, -0.11622753612020487},
+				{-0.32618001934376906, -0.5442774355228084, -0.06141133800669302},
+				{0.3850938342303757, -0.953153142907049, -0.01103865467353729},
+				{1.0462050766393654, -1.332678195637281, 0.03573760543175726},
+				{1.667707125157786, -1.6890590635952056, 0.07968128117212163},
+				{2.259123368713423, -2.0279284211480513, 0.12148620888363797},
+				{2.828951211167574, -2.354335284525932, 0.161774799434339},
+				{3.3846399966984153, -2.6727207083061706, 0.20109477720348806},
+			},
+			{
+				{-12.730454124431354, 6.900514563168322, -0.990337333241414},
+				{-10.746571669833067, 5.750607468684387, -0.8480023640090103},
+				{-9.037312541923049, 4.759049831773378, -0.7253414708969407},
+				{-7.5496230615165505, 3.895626314137334, -0.6185737852996315},
+				{-6.2427429317318435, 3.137064975412266, -0.5247947092571645},
+				{-5.08478761842036, 2.4651126160304253, -0.441731319880043},
+				{-4.050389150696584, 1.8652045115452975, -0.3675737044528321},
+				{-3.119026801061106, 1.3255216520376327, -0.30085571884404144},
+				{-2.273817331728477, 0.8363061358597172, -0.24036861720993716},
+				{-1.5006165726014133, 0.3893513962613789, -0.18509690601440038},
+				{-0.7873349203320436, -0.02238753014024454, -0.13416943094044967},
+				{-0.12340388888375702, -0.4050989576517132, -0.08682119974594048},
+				{0.5006388640988882, -0.7643524728739918, -0.04236366630599673},
+				{1.0934423616819462, -1.1052659942335699, -0.0001639161331578439},
+				{1.6629805981435763, -1.4325808548332464, 0.040364632607563296},
+				{2.216544841349297, -1.7506490170073814, 0.07976053271560489},
+			},
+			{
+				{-13.59015182441936, 7.668270031897809, -1.0970006461103106},
+				{-11.698114172736252, 6.566531755131725, -0.9599433731641509},
+				{-10.054171395121905, 5.608690924452933, -0.84086640265526},
+				{-8.612398661663637, 4.768419321545243, -0.7364569782579407},
+				{-7.337089953040516, 4.025201902638929, -0.6441396934854683},
+				{-6.2000215412339985, 3.362784399827498, -0.5618783435322634},
+				{-5.1785342797571, 2.768084268294528, -0.48803720042972304},
+				{-4.2541587642916046, 2.23040887669719, -0.42128163673752456},
+				{-3.4116079134392128, 1.7408816580789457, -0.3605053446355918},
+				{-2.6380222630683337, 1.292011274160104, -0.30477581879855464},
+				{-1.9223911623917198, 0.877360267927057, -0.25329252771486543},
+				{-1.2550978305125764, 0.4912837122357951, -0.2053540009227599},
+				{-0.6275544310043736, 0.12871877205031015, -0.1603313918128126},
+				{-0.03191099485474978, -0.21498355306471445, -0.11764738931614079},
+				{0.5391552759968353, -0.5441796233221123, -0.0767610667098093},
+				{1.0925431210866479, -0.8629898914629596, -0.03716082768016789},
+			},
+			{
+				{-14.47485095886368, 8.44116846300265, -1.2039083885918886},
+				{-12.658195916978597, 7.378675272441575, -1.0711119895366754},
+				{-11.068159128259037, 6.448374943985901, -0.954921055906621},
+				{-9.664404643727854, 5.626996510205148, -0.8523926200620462},
+				{-8.415243222400056, 4.896221988245594, -0.7612148850408289},
+				{-7.2953947256729865, 4.2414079587841, -0.6795433826224025},
+				{-6.284399149220921, 3.650676923256715, -0.6058847303329005},
+				{-5.365464103506427, 3.1142574963053815, -0.5390123807447951},
+				{-4.524611742485541, 2.6239953338008126, -0.477904295069369},
+				{-3.750034327411881, 2.1729830055269894, -0.4216958725200171},
+				{-3.031596760707949, 1.7552736214468623, -0.36964361062389356},
+				{-2.3604433914317964, 1.3656538278928854, -0.32109636615938486},
+				{-1.728679445879537, 0.9994592533547346, -0.27547204764361544},
+				{-1.1291078185097387, 0.6524214922213099, -0.23223834041139446},
+				{-0.5550130888804201, 0.3205423059850789, -0.19089690255401676},
+				{-3.572492351305297e-14, 2.4045450227834422e-14, -0.15097163922833132},
+			},
+		},
+		{
+			{
+				{-51.27958557241513, 13.595462437918325, -0.6152870252848371},
+				{9.510145458940316, -16.677028404199067, 2.773301302960156},
+				{23.786175525265392, -24.29364618164472, 3.6779847648949273},
+				{28.052713110690064, -26.433503411484672, 3.9251436154055535},
+				{30.251267414837677, -27.432461078259013, 4.03425090665119},
+				{31.751817842445305, -28.063038669928247, 4.099839439744656},
+				{32.93716005957511, -28.540551007742913, 4.1482555326657735},
+				{33.96461294424569, -28.952339208369203, 4.190123906953352},
+				{34.91505223632147, -29.342564567733756, 4.23077530239471},
+				{35.836585244470314, -29.737090570265345, 4.273310208125774},
+				{36.76114005394956, -30.15290801621297, 4.319758303221703},
+				{37.712019219761736, -30.602408060894746, 4.371603247644047},
+				{38.70787792687139, -31.095628270081598, 4.430058526700893},
+				{39.76508440590198, -31.641590198656523, 4.4962323075176},
+				{40.899269823248794, -32.24918716195915, 4.571237740078148},
+				{42.12645537460288, -32.927841964811556, 4.65627572763432},
+			},
+			{
+				{-11.13967889648901, -2.529992859854096, 0.9071616529260074},
+				{7.7551201527951825, -12.581523891635422, 2.084727786948437},
+				{15.5776495107337, -16.8429963886148, 2.59548234208718},
+				{19.21006080222454, -18.786617969386537, 2.8276803942070203},
+				{21.373595003577467, -19.905827189620993, 2.9597003794616032},
+				{22.937998067650966, -20.69306730554186, 3.0516244155665104},
+				{24.208464121527715, -21.322645948264356, 3.1248526160175056},
+				{25.319670769709976, -21.871546501727515, 3.1888514185721393},
+				{26.342830953709097, -22.38039274505402, 3.248616975223796},
+				{27.321075153709558, -22.873739621073895, 3.3071706112765065},
+				{28.283471515513767, -23.368139762009843, 3.366553211799582},
+				{29.251472306999883, -23.875859082311923, 3.428283977306394},
+				{30.242271289674715, -24.40681599075716, 3.4936003404453895},
+				{31.270761736790902, -24.96971772596147, 3.563599112635106},
+				{32.35080886091904, -25.572805796706724, 3.639329747585559},
+				{33.49617122663612, -26.224403889450237, 3.7218636400031757},
+			},
+			{
+				{-4.9928777381081115, -3.862966178533119, 0.9376016964453745},
+				{5.210194279312848, -9.414037915211319, 1.5962828840080392},
+				{10.691566139497397, -12.439095511670903, 1.9606855304037483},
+				{13.841057391900987, -14.168884911805415, 2.169753707831124},
+				{15.892114459825391, -15.278549227007701, 2.303542258334176},
+				{17.421423211430536, -16.09426930338512, 2.4016459034885886},
+				{18.67742104824732, -16.75842973910695, 2.4815093696498005},
+				{19.779339848449524, -17.33976043786861, 2.551590904374349},
+				{20.79222076183185, -17.87585753024978, 2.6165300202609583},
+				{21.755846362146467, -18.38976543286373, 2.679177941687296},
+				{22.697082069274607, -18.89709429587867, 2.741472843718423},
+				{23.635726195030106, -19.409407611828605, 2.8048576660146356},
+				{24.587576818572938, -19.936004687946443, 2.8705005963635726},
+				{25.5662042870998, -20.484955876634345, 2.939423644391402},
+				{26.58408350068417, -21.063769104105315, 3.0125859586705728},
+				{27.653398694209315, -21.679869326060448, 3.0909443367623597},
+			},
+			{
+				{-3.88866426474446, -3.201786065023407, 0.781883519221273},
+				{2.998614275619945, -6.997962135461441, 1.2353314700205715},
+				{7.272122012215173, -9.378512529100588, 1.523080320727075},
+				{10.05508259553279, -10.92907448970725, 1.7115387921182947},
+				{11.994334565504523, -12.002136106601816, 1.8421660621346394},
+				{13.47529470887021, -12.814963405234494, 1.9411726272246521},
+				{14.700082052625206, -13.483422196816516, 2.0227055511701026},
+				{15.775234783844388, -14.069113020177204, 2.0943339083675614},
+				{16.76140057663764, -14.607286332882106, 2.1604073779725934},
+				{17.69627460346615, -15.119940793376314, 2.223650500237194},
+				{18.605387386333586, -15.622039658841258, 2.2859241406081687},
+				{19.507494665611645, -16.124635314891812, 2.3486098722334},
+				{20.417471245107567, -16.63655492460879, 2.41281811181965},
+				{21.3479919549586, -17.165384604870226, 2.4795101598768556},
+				{22.310596807229732, -17.71809737594572, 2.5495765372565855},
+				{23.316435918360547, -18.301496676464822, 2.6238927943918924},
+			},
+			{
+				{-4.115134246582293, -2.1531990979821645, 0.6027970734216946},
+				{1.100072932504327, -5.054869536515585, 0.9509759367094258},
+				{4.641036437382017, -7.04182071898427, 1.191774358836943},
+				{7.139514707364536, -8.44691393769763, 1.3631030985333088},
+				{8.977834017871876, -9.477948635222285, 1.4892505964394875},
+				{10.413383999670277, -10.279292271629004, 1.5875084119365832},
+				{11.60776964930138, -10.943441427856248, 1.6691219798271635},
+				{12.655890390259604, -11.525318454057203, 1.7408233280273546},
+				{13.614725087792952, -12.058128958721221, 1.8067045001075746},
+				{14.520564906918917, -12.563133831135177, 1.86939720754995},
+				{15.39818753466136, -13.054920389791421, 1.9307152369094287},
+				{16.265777820439073, -13.544252747840334, 1.9920039039162194},
+				{17.137678289402327, -14.039674807790725, 2.0543374967217165},
+				{18.026017090629683, -14.548464341567373, 2.11863734133777},
+				{18.941742679989403, -15.077243296829751, 2.185747769495068},
+				{19.895341334336443, -15.632404595200551, 2.2564896719023144},
+			},
+			{
+				{-4.8024223183337424, -1.0456678113104745, 0.42965865972897044},
+				{-0.571713766792742, -3.4173604293162367, 0.7152642174471818},
+				{2.4817625065489093, -5.141192998587365, 0.9246220515234653},
+				{4.756792076706375, -6.429336577812757, 1.0820215818536196},
+				{6.504558300084547, -7.418447793101407, 1.2033921107475398},
+				{7.900241863572906, -8.206278770351975, 1.3003589025093034},
+				{9.069195923802019, -8.864347121575943, 1.3815714035158424},
+				{10.09458937240735, -9.440765336769866, 1.4529087479946887},
+				{11.029873794388788, -9.966721655163683, 1.518207143014704},
+				{11.910260502077783, -10.462889642500611, 1.5800224730189927},
+				{12.760138791133748, -10.9436659722804, 1.640143156569724},
+				{13.597461411479383, -11.419704818393148, 1.6998995080364012},
+				{14.436337857225391, -11.899428222406135, 1.7603492072049778},
+				{15.288619365989614, -12.389955120855152, 1.8223919918398566},
+				{16.1649208623809, -12.897704180543553, 1.8868445258119844},
+				{17.07533009051655, -13.428815243363648, 1.9544931407341302},
+			},
+			{
+				{-5.678400251428471, 0.04275762514523029, 0.2670631844171581},
+				{-2.0858644131403046, -1.9841339883582794, 0.5118949871447821},
+				{0.6232648223529413, -3.5216226868549083, 0.6989694197055842},
+				{2.7218908929397445, -4.716292774520338, 0.8451711623365412},
+				{4.3885851169779535, -5.665664418856758, 0.9618723177093249},
+				{5.748946499852844, -6.4396347460845, 1.0573448830264334},
+				{6.897870312617262, -7.092109627373022, 1.1380699637657212},
+				{7.905952653797936, -7.663853484215184, 1.2090095137582135},
+				{8.82269286199687, -8.183753919830457, 1.2737083604292878},
+				{9.682272653350049, -8.671905183071127, 1.3346467858004414},
+				{10.508904157405105, -9.14262363068791, 1.3936009218175276},
+				{11.320552688375088, -9.606588541177052, 1.4519024695717602},
+				{12.131329778730231, -10.072233134587906, 1.51060886378216},
+				{12.953026957706276, -10.546642998300383, 1.5706138831907084},
+				{13.796130919473232, -11.036154745243685, 1.6327218242555794},
+				{14.670535310269813, -11.54677869060277, 1.6977002639747463},
+			},
+			{
+				{-6.644587270062525, 1.0981322711639137, 0.11402483122738197},
+				{-3.492868241185352, -0.6901877771087699, 0.3306363774717619},
+				{-1.0368971359768706, -2.090572834104918, 0.5013194661960887},
+				{0.9218021077536149, -3.2106531071771554, 0.6385611802394978},
+				{2.517392399888497, -4.124092431446718, 0.7509747996838926},
+				{3.8454928683656306, -4.884140928831048, 0.8448518324935806},
+				{4.978751914336705, -5.531924124473139, 0.9251122549489971},
+				{5.974848805166673, -6.100642323941912, 0.9957784843661045},
+				{6.8782996980154785, -6.616249923000162, 1.060025868804},
+				{7.721960513795306, -7.098071127339013, 1.1202349746601972},
+				{8.529926830793254, -7.560369079182421, 1.1781726160566315},
+				{9.320347408437542, -8.01394457677397, 1.2351836237764913},
+				{10.10752756216321, -8.467353360074188, 1.2923389456295646},
+				{10.903384611383297, -8.927757196102375, 1.3505400758261392},
+				{11.718452496255441, -9.401515855958252, 1.4105922124055563},
+				{12.562601485984924, -9.894614125049989, 1.4732574293526934},
+			},
+			{
+				{-7.664043419579849, 2.1251682644374212, -0.03166328730391207},
+				{-4.830239193030214, 0.5088763148666896, 0.16461664811691412},
+				{-2.5654040415163055, -0.7881183148797621, 0.3229556375442332},
+				{-0.7181067251524204, -1.8487274174605266, 0.45304943303918843},
+				{0.8167837574514287, -2.7310479843360698, 0.561721353223999},
+				{2.115457625638843, -3.4776423009490736, 0.6540052354722288},
+				{3.2358493313255448, -4.121282024675286, 0.7338121797480908},
+				{4.224488263228213, -4.688664555744843, 0.8043625192445821},
+				{5.119758347622708, -5.202118775658639, 0.8683778579968426},
+				{5.952411123087163, -5.679722982567548, 0.9280798014171165},
+				{6.746250261706781, -6.135575340195261, 0.9852116131243926},
+				{7.519688320506605, -6.580642674657252, 1.0411365639321581},
+				{8.287379822467795, -7.023694777562511, 1.0969504072063672},
+				{9.061534970051296, -7.472069495695621, 1.1535747979421074},
+				{9.852896244532651, -7.932247247263303, 1.211828076269504},
+				{10.671465309787079, -8.410281823889742, 1.2724787753956155},
+			},
+			{
+				{-8.724943982073132, 3.1341759259567237, -0.17232864681176657},
+				{-6.1271307370419255, 1.6453038996919962, 0.008922246943977905},
+				{-4.0089706048335945, 0.4273668796117218, 0.15784763421852394},
+				{-2.250348580817565, -0.5860315722218081, 0.28227550066741414},
+				{-0.7660069279985533, -1.4423466677338703, 0.38781118236773704},
+				{0.5068385525547442, -2.1768153636072087, 0.478634586500215},
+				{1.616233619747, -2.816664391151332, 0.5579956087678791},
+				{2.6006321608305742, -3.383930171287214, 0.6285456611479844},
+				{3.4922932829027777, -3.897329203823067, 0.6925575062684919},
+				{4.3187789326235855, -4.37303868810704, 0.7520129812973297},
+				{5.10303066634524, -4.824643516854939, 0.8085882326629269},
+				{5.8636308805897634, -5.263216867089436, 0.8636572795201086},
+				{6.6156819750960985, -5.697800811139979, 0.918347782095147},
+				{7.3718390005485555, -6.136000803698089, 0.9736129057010482},
+				{8.143210574436322, -6.584513592421883, 1.0302960766153388},
+				{8.940081515037551, -7.049554710890603, 1.0891839271643904},
+			},
+			{
+				{-9.827055887782416, 4.13707349570674, -0.31016945595430173},
+				{-7.407514443651792, 2.744007420763647, -0.1401810057767687},
+				{-5.40269806896798, 1.5867540280255743, 0.0015500120789889318},
+				{-3.714202729527544, 0.610408783871868, 0.12154793869860796},
+				{-2.2708587903322868, -0.22494630053764175, 0.22455800862399958},
+				{-1.0195033008661765, -0.9493192972642246, 0.31415546174541104},
+				{0.08089559906738018, -1.5860446920333247, 0.3931315584152689},
+				{1.0631827488599042, -2.153964686380441, 0.46375287079313887},
+				{1.9547973727579477, -2.6689788053492345, 0.527945568627124},
+				{2.7796487673033337, -3.1450905850497364, 0.5874197273561913},
+				{3.5588934987193626, -3.594812907282894, 0.6437150663854642},
+				{4.3108490428704, -4.029059811074692, 0.6981830314669798},
+				{5.051026147476273, -4.457120480635476, 0.751979828453687},
+				{5.792641607039875, -4.886938640479684, 0.8060988117600741},
+				{6.547327221495992, -5.325522993856578, 0.8614203568783085},
+				{7.325809766831097, -5.779347851702763, 0.918761281827405},
+			},
+			{
+				{-10.976323817856493, 5.14629632822204, -0.447248105868251},
+				{-8.692382065449786, 3.8255092917969185, -0.2857133222921834},
+				{-6.774886294108727, 2.714480745015611, -0.14942628191856416},
+				{-5.140962919170938, 1.7665540380868732, -0.03280234327604198},
+				{-3.729703183353039, 0.9472977311227265, 0.06827816724545752},
+				{-2.495032712526302, 0.23053805301281471, 0.15694983646677574},
+				{-1.4010816231309688, -0.4042204915753951, 0.23567292928442535},
+				{-0.419042400700593, -0.97355046356137, 0.3064442033840043},
+				{0.47506896139242033, -1.4913588302892717, 0.3709477690600476},
+				{1.3020726864870493, -1.969825740654019, 0.43066790554728185},
+				{2.0807761644926814, -2.420055526512833, 0.48696720349095246},
+				{2.8284377795811912, -2.852321150949887, 0.5411145511727439},
+				{3.5606241015805757, -3.2759437468921364, 0.5942676167871556},
+				{4.291113670889043, -3.6992050298740886, 0.6474596878098033},
+				{5.032199723183535, -4.129510044882458, 0.7016184661101188},
+				{5.795221768107586, -4.573696276415443, 0.757603871495524},
+			},
+			{
+				{-12.182716149290354, 6.1747388021764715, -0.5855575716073457},
+				{-10.001376974546249, 4.907894049884715, -0.43028276997924825},
+				{-8.15006737281796, 3.83124279735117, -0.2980029166424707},
+				{-6.557276605713704, 2.904176534861705, -0.18382566737331688},
+				{-5.169716796260081, 2.096335393610102, -0.08409600347532167},
+				{-3.9466301495904244, 1.3844084294086734, 0.0039929767348328765},
+				{-2.856027062975805, 0.7500163704411035, 0.08265698305068757},
+				{-1.8721015789416062, 0.17825372105928308, 0.15369705732768763},
+				{-0.9733777003681849, -0.34336091768318316, 0.21862652014220832},
+				{-0.1413139448740158, -0.8256798070009493, 0.2787671818848887},
+				{0.6407849439937727, -1.2785503242279677, 0.3353250811836895},
+				{1.3885564586011656, -1.7112671067814256, 0.38944534299253675},
+				{2.116910215337477, -2.1327499403632237, 0.44223305093667425},
+				{2.839879972850177, -2.551431598472252, 0.49473782996910964},
+				{3.570453658537298, -2.975133964283801, 0.5479370134910544},
+				{4.3207503694820435, -3.4111598424391953, 0.6027461601147046},
+			},
+			{
+				{-13.459562359978042, 7.236124795068444, -0.7271126564815636},
+				{-11.354139442707472, 6.0082460706218095, -0.5763016127763733},
+				{-9.551137977298747, 4.955845099086005, -0.44679892554577977},
+				{-7.987474658767811, 4.04279934557456, -0.33422771314021144},
+				{-6.6155851596764546, 3.2417997828911225, -0.23528164089413842},
+				{-5.398737557860578, 2.5316916156163667, -0.14740001302596917},
+				{-4.307888904444352, 1.8956884218289682, -0.06854991345599964},
+				{-3.3195005744793407, 1.3201286245310606, 0.002925277152289507},
+				{-2.4139600384208255, 0.7935744208242608, 0.06841554883307736},
+				{-1.5743873458997866, 0.306127179497241, 0.1291276041293428},
+				{-0.7856842046398882, -0.1511214878716225, 0.18615180892501598},
+				{-0.033747744617388366, -0.5865465005693002, 0.2405180757151823},
+				{0.6951376418962291, -1.0083425775906965, 0.29323914302781795},
+				{1.4145881855452718, -1.424684109461774, 0.3453298291635003},
+				{2.138000064377277, -1.8436353224265933, 0.3977950096229881},
+				{2.878339210558534, -2.2730058748624518, 0.4516107407342212},
+			},
+			{
+				{-14.823709112382835, 8.34562589651084, -0.8740566477344287},
+				{-12.77157419778877, 7.143857076742535, -0.7261620924112477},
+				{-11.001077647020432, 6.106643381147436, -0.5983337495403644},
+				{-9.45540640558107, 5.20118739336016, -0.48657750543789496},
+				{-8.091297501230468, 4.40245956848093, -0.3878476287858492},
+				{-6.875064429011326, 3.690922506652746, -0.2997676538277147},
+				{-5.779898879886969, 3.050984078074607, -0.2204408693762801},
+				{-4.783976758309064, 2.4699099514246194, -0.1483172253172497},
+				{-3.869080454126836, 1.937030662482209, -0.08209635252388507},
+				{-3.0195532424409497, 1.4431376837038004, -0.020653696309225516},
+				{-2.2214628870648996, 0.979997893794765, 0.03701893715321472},
+				{-1.461891241345657, 0.5399386624500871, 0.09186431203729867},
+				{-0.7283039133290988, 0.1154777416662732, 0.144805338153554},
+				{-0.008018039318039008, -0.3009890452989362, 0.1967836057244473},
+				{0.712102660510367, -0.7173613714297051, 0.2487806626108578},
+				{1.4455842555072054, -1.1417813157448986, 0.3018109142011328},
+			},
+			{
+				{-16.296272281059036, 9.520715513634235, -1.0287888595875443},
+				{-14.277222441621824, 8.333404881650088, -0.8824019757938721},
+				{-12.524570855551097, 7.3028490499937515, -0.7552073522584584},
+				{-10.98611716553701, 6.398670821132391, -0.6434889561162941},
+				{-9.62178448960178, 5.597507428511878, -0.5443909866818945},
+				{-8.400151671843197, 4.881006091023743, -0.45567116878998043},
+				{-7.296074839991382, 4.234448202662094, -0.3755315707815668},
+				{-6.289004201049174, 3.6457745530683687, -0.3024988357857887},
+				{-5.361752376015582, 3.1048707819430112, -0.23533644057958925},
+				{-4.499556782469063, 2.6030219916949835, -0.17297773729196592},
+				{-3.689329082315295, 2.1324745429204763, -0.1144721248894083},
+				{-2.9190150273876303, 1.6860605269609867, -0.058938851809035034},
+				{-2.1770085328985895, 1.2568523370510407, -0.005524423227273955},
+				{-1.4515866024059447, 0.8378284290294902, 0.04663871559733899},
+				{-0.7303802335821126, 0.42156103030416414, 0.09847084139767857},
+				{-4.2356815924310047e-14, 2.9055699502159875e-14, 0.15097163922832518},
+			},
+		},
+		{
+			{
+				{-40.307081706816014, 7.471819744395169, 0.4254893131443626},
+				{18.843167846272223, -23.24796177729777, 4.078848216646946},
+				{29.160359129650477, -28.816036057131537, 4.770265116196456},
+				{32.61710810794899, -30.513862529022184, 4.970559818197709},
+				{34.691642725568876, -31.453167989914533, 5.075969405675159},
+				{36.26480014917264, -32.139712143495544, 5.151465009583981},
+				{37.620755457584515, -32.734801670106286, 5.217661627096611},
+				{38.89018084003912, -33.31147342788921, 5.283825032306855},
+				{40.146976150499164, -33.91055907728337, 5.355112129032436},
+				{41.43984708627741, -34.55894071621107, 5.434926908263119},
+				{42.80573654766326, -35.27730703069341, 5.525921952828561},
+				{44.276785042751214, -36.08419908009385, 5.630523902750075},
+				{45.884543229073245, -36.99849946723124, 5.75126089063896},
+				{47.662970620074375, -38.04125300066925, 5.891006797213266},
+				{49.651058337373854, -39.23731559897786, 6.0532076501745715},
+				{51.89567283464315, -40.61720143736469, 6.242140270283313},
+			},
+			{
+				{-3.8992654980526877, -7.259147140407176, 1.846107614408486},
+				{13.312411659935954, -16.72755681069221, 3.017239628882875},
+				{19.644473039208496, -20.246091937267252, 3.4598111947130388},
+				{22.71736556937805, -21.90637535716667, 3.6669454513954673},
+				{24.75963717028722, -22.981477982837635, 3.7999553624413345},
+				{26.37338690821164, -23.821199111811662, 3.9037395758782094},
+				{27.781553919195883, -24.55546730542554, 3.995034393270752},
+				{29.091682466451232, -25.246952552919304, 4.081926894082227},
+				{30.366451304525313, -25.932231438494078, 4.169152223195176},
+				{31.648150658921665, -26.636129209235094, 4.2599451178073915},
+				{32.969155591128676, -27.377877701947565, 4.356837463454194},
+				{34.35728716082021, -28.17428983772929, 4.462072022930363},
+				{35.83905622319586, -29.04170765827436, 4.577859308583182},
+				{37.44198469014094, -29.997426853618833, 4.70656868906788},
+				{39.196592793709264, -31.060948078933944, 4.850899591292553},
+				{41.13843610412636, -32.25529023211384, 5.014064299400009},
+			},
+			{
+				{-0.029166046343782243, -7.363650700292352, 1.719641905649651},
+				{9.366464522888753, -12.628008510065918, 2.3762042076572176},
+				{14.10812564779234, -15.306116505746337, 2.7148655813482465},
+				{16.85554115276225, -16.842290054680063, 2.9095132391033194},
+				{18.783424356509496, -17.907515370858803, 3.044492540162962},
+				{20.33429146254201, -18.759586938748267, 3.152720113221258},
+				{21.694694993138114, -19.50805663796203, 3.2482801603432656},
+				{22.958727855639705, -20.208429649429796, 3.3383419492458577},
+				{24.18206663606662, -20.89370709024527, 3.4271907081614317},
+				{25.402487102041913, -21.586442330093945, 3.5177767032951097},
+				{26.648915124972348, -22.30408784727718, 3.6124053708032715},
+				{27.946058279525097, -23.061750277736714, 3.7130951969507087},
+				{29.317172079803463, -23.873855119059122, 3.8217962971194677},
+				{30.786013650051352, -24.755343328192822, 3.94055004670781},
+				{32.37848923874858, -25.722701915446788, 4.07162913687989},
+				{34.124302629429515, -26.795015503794897, 4.217682895337198},
+			},
+			{
+				{-0.08403425320160585, -6.028138391386231, 1.470395405890782},
+				{6.384082456628342, -9.691438890773895, 1.9286777954921779},
+				{10.250142111311373, -11.897881370116945, 2.2082673096673466},
+				{12.751466352556898, -13.321322219096853, 2.389678572468521},
+				{14.576018309128775, -14.35356769360751, 2.521673818547605},
+				{16.059682531506077, -15.190293606517677, 2.629071586694567},
+				{17.363355886070117, -15.926253183578064, 2.7240009504979272},
+				{18.572605663797027, -16.612181382135795, 2.8129978068259858},
+				{19.738983764061977, -17.27883411107592, 2.900049471971908},
+				{20.89758535748332, -17.947305425560177, 2.9879118834328784},
+				{22.075215614836694, -18.63385903464513, 3.078730132060154},
+				{23.294641873949516, -19.352461767080605, 3.1743660804985216},
+				{24.577123962206493, -20.116306374178002, 3.276597319487224},
+				{25.944177906096158, -20.93888852224149, 3.387259999350975},
+				{27.419041255341114, -21.834917411705717, 3.508371768410261},
+				{29.028116690574286, -22.821227856856403, 3.6422569675975494},
+			},
+			{
+				{-1.0388164041471675, -4.5374157456722735, 1.228389655893367},
+				{3.9566948175315697, -7.388501003829875, 1.5854171437090425},
+				{7.267096287705333, -9.291663001159053, 1.8266439552305616},
+				{9.579938006362193, -10.621979669427288, 1.9965057494395804},
+				{11.32218656507984, -11.621431988649832, 2.124760659539963},
+				{12.75029749802914, -12.439147602013502, 2.230170930183872},
+				{14.00488521448012, -13.158001751181477, 2.3232868744673114},
+				{15.165525641941121, -13.825291429730434, 2.4101768379446766},
+				{16.28136966257384, -14.470435105008946, 2.4946422699289736},
+				{17.386078917825348, -15.113729046734242, 2.5793267734965486},
+				{18.505300109041634, -15.77076808477705, 2.666280486648349},
+				{19.66069358652302, -16.45484678496717, 2.7572690102648196},
+				{20.8723526376026, -17.17841474513716, 2.8539625731735634},
+				{22.160479388381376, -17.95409717190354, 2.958070614264877},
+				{23.546760499910437, -18.795544956808083, 3.071455838901337},
+				{25.055706678797396, -19.71827392917622, 3.19624869342541},
+			},
+			{
+				{-2.265609302514732, -3.0923255644975356, 1.0075144628481267},
+				{1.8634840513550088, -5.462947287637116, 1.3043022333386778},
+				{4.793462978095913, -7.1565885435191445, 1.5187852777149453},
+				{6.957781456645192, -8.410316391571632, 1.678836624836091},
+				{8.636560943638004, -9.382056116038665, 1.8036267596997089},
+				{10.022873615191873, -10.183649026250997, 1.9070858118131397},
+				{11.239173613541364, -10.887217684541469, 1.998333837915047},
+				{12.360310971235519, -11.537294590763006, 2.083055986229055},
+				{13.43410494147572, -12.162549602130827, 2.1649414315480873},
+				{14.493664685809305, -12.782977489931376, 2.2465841574829613},
+				{15.564198546936375, -13.413918167605551, 2.3299924533717253},
+				{16.666882551446065, -14.068361145198022, 2.4168836742837265},
+				{17.821238182806358, -14.758374701153683, 2.5088690143681833},
+				{19.046785213766345, -15.496109972803408, 2.607585174546477},
+				{20.36438919325889, -16.294629540367396, 2.714804874841294},
+				{21.797562266469885, -17.168716479995453, 2.832546546143889},
+			},
+			{
+				{-3.594921842706298, -1.713631134180664, 0.8049100745853375},
+				{-0.025372276654779893, -3.772824602820951, 1.0624073715256241},
+				{2.632635674854145, -5.315755055661995, 1.257464010730445},
+				{4.677878270951464, -6.5063775921051015, 1.409228742552618},
+				{6.307476853214008, -7.4554498016752335, 1.5309858924243862},
+				{7.664825984455898, -8.245577009001515, 1.6328985814982928},
+				{8.853904019558438, -8.937850631417513, 1.7226266805483494},
+				{9.944973249601567, -9.574066896476399, 1.8054738784720465},
+				{10.985138888994863, -10.182488563923723, 1.885058927082667},
+				{12.007616730585998, -10.78318985534997, 1.9639740375112118},
+				{13.037762703423294, -11.391601769878946, 2.0442298596038277},
+				{14.096781453553856, -12.020717555085975, 2.1275356307665443},
+				{15.20409887704354, -12.682516948355813, 2.2154822338903553},
+				{16.379036532864195, -13.38898105373497, 2.309674517806576},
+				{17.64217698124557, -14.152928282354189, 2.411842130851638},
+				{19.016675348333795, -14.988824754259987, 2.5239487408750185},
+			},
+			{
+				{-4.980878526340689, -0.3846399649531082, 0.6154502931874503},
+				{-1.7940330115668839, -2.230351664749408, 0.8458051896040025},
+				{0.6646965495003826, -3.662416150321551, 1.0263967842838166},
+				{2.615075411864082, -4.801897064829946, 1.1712809459200546},
+				{4.205526790130964, -5.732122871270688, 1.2903526345240002},
+				{5.544609050421037, -6.515300007803993, 1.3911663188967414},
+				{6.717146082234328, -7.201040778533952, 1.4798748331178224},
+				{7.787518656155937, -7.8275802348668915, 1.5612931812035635},
+				{8.802172855221233, -8.422782499172014, 1.6389681320280671},
+				{9.794905756341242, -9.007076954540763, 1.715523799377728},
+				{10.791734812661288, -9.596292774266336, 1.7930118139913938},
+				{11.814361883491214, -10.20371138126232, 1.8731696918966076},
+				{12.882545499550533, -10.84148767100067, 1.9576022755496443},
+				{14.015827738471426, -11.521694204118765, 2.047917047414387},
+				{15.234955811185554, -12.257188136641556, 2.145838371586891},
+				{16.56324737416204, -13.06244979155384, 2.253319820853644},
+			},
+			{
+				{-6.419081908474126, 0.9190939746240399, 0.4342399961878237},
+				{-3.502758303730721, -0.7756191450019635, 0.6451973134271335},
+				{-1.1913882240131086, -2.1255461611774145, 0.8148936500324422},
+				{0.6852065328055706, -3.224857927047281, 0.954209273049257},
+				{2.2446493709563367, -4.139657568609117, 1.070928176408099},
+				{3.5732050502395056, -4.919229253757893, 1.1709665711313857},
+				{4.738946578814678, -5.603171233833796, 1.2591731942102768},
+				{5.798033893997327, -6.224714103256875, 1.3396911306932884},
+				{6.795286285826286, -6.810710762465144, 1.4159135893656338},
+				{7.765230359901705, -7.382023517176657, 1.4905039156688196},
+				{8.734992852902066, -7.9551477495971, 1.565587493063585},
+				{9.727238939836175, -8.543936649036386, 1.642965671687637},
+				{10.762490107087464, -9.160984715341318, 1.7242908987310208},
+				{11.86091709728166, -9.818708851512241, 1.8112058657322168},
+				{13.043851399116251, -10.530266591920242, 1.9054637275353787},
+				{14.335246384009086, -11.310448523939217, 2.009046874866015},
+			},
+			{
+				{-7.922286613484447, 2.2233913409115247, 0.256877814676179},
+				{-5.199447968895097, 0.6364817813155224, 0.4537820689606109},
+				{-2.996092044411113, -0.6532895627841714, 0.6153027417058331},
+				{-1.174568900166202, -1.7225088360377252, 0.7502591015622345},
+				{0.36212457359746036, -2.6258203870960135, 0.8650416126253603},
+				{1.6857304289035482, -3.4041839068809185, 0.9645196398946024},
+				{2.85248000817788, -4.090180306418116, 1.0526342775104602},
+				{3.9095450598501187, -4.711579142672549, 1.1328055806689243},
+				{4.89790987023332, -5.292857614282663, 1.2080971298396854},
+				{5.852163744805058, -5.854888127700309, 1.281153889924061},
+				{6.8007865498827, -6.414974346751161, 1.3541905346865295},
+				{7.767876055137227, -6.987826332207742, 1.4291070674194937},
+				{8.775189789250865, -7.586768084084311, 1.5076396261592033},
+				{9.84397180622715, -8.2248344074592, 1.5915005208930852},
+				{10.996579000732227, -8.915754926365175, 1.68250615656623},
+				{12.25808225571268, -9.674926799495312, 1.7827055968816077},
+			},
+			{
+				{-9.512886591783221, 3.5549506445934127, 0.07925395073523377},
+				{-6.9266157261284285, 2.0436479762865276, 0.2660784842288458},
+				{-4.799584053738904, 0.7961280583173309, 0.4216260014883199},
+				{-3.0160463819066385, -0.2524364342326904, 0.5533525353286637},
+				{-1.4931903564545497, -1.1488721120831273, 0.6667067777433044},
+				{-0.16920257875912212, -1.9285099972774264, 0.7658556473981956},
+				{1.0042946072728365, -2.6193344294925343, 0.8541489742832001},
+				{2.0674591647315044, -3.2448854086306236, 0.934450327941889},
+				{3.0558999607691835, -3.826328363416969, 1.0093774444892547},
+				{4.002302810679965, -4.383327835847642, 1.0813986264504072},
+				{4.936015977132509, -4.933687482851544, 1.1527746831896886},
+				{5.88290988772158, -5.4931782089108765, 1.225527464743552},
+				{6.8665112619842885, -6.076179245397565, 1.3015164028101536},
+				{7.90966844521341, -6.696668448054684, 1.3825634613832456},
+				{9.036279173700601, -7.369262334057237, 1.4705872657782089},
+				{10.273075560687431, -8.110294671213547, 1.567744581227389},
+			},
+			{
+				{-11.221442823321098, 4.942691884674545, -0.10275794780649247},
+				{-8.726432250167473, 3.4810588631585286, 0.07717778077252062},
+				{-6.648437046652363, 2.260157217994776, 0.2286643664957663},
+				{-4.886575660403227, 1.2230339219335715, 0.35825914264627795},
+				{-3.3678378691407826, 0.32818447063317935, 0.4707785351365358},
+				{-2.0373075481921377, -0.45586901090914705, 0.5699132263335288},
+				{-0.8519407359327229, -1.1540347865946157, 0.6586194063915801},
+				{0.22370506263678305, -1.787043528474305, 0.7393916525642401},
+				{1.2206687422637477, -2.3732652280852156, 0.8144741325084572},
+				{2.1680332442237775, -2.9301172913825075, 0.8860275283952672},
+				{3.0941471620220575, -3.4747444839087183, 0.9562077401409894},
+				{4.0262547092417025, -4.023724371986902, 1.0271218312523342},
+				{4.990111154674277, -4.592769799677336, 1.1007845799940945},
+				{6.010826560076074, -5.197203206575203, 1.1791760495697379},
+				{7.114500019367182, -5.8529297949574595, 1.2643658644434288},
+				{8.330200113439528, -6.577626740362481, 1.3586679074667067},
+			},
+			{
+				{-13.088160442533415, 6.4200686106107465, -0.29366502201758415},
+				{-10.645307962031017, 4.985375976398241, -0.11783261391156544},
+				{-8.591032927412915, 3.7763424478082, 0.031388990024381155},
+				{-6.834360076112464, 2.741138068225061, 0.15998464165456466},
+				{-5.308879941498846, 1.8417482032306105, 0.27236490481659825},
+				{-3.964357947306408, 1.0492448192197055, 0.3719100487330025},
+				{-2.7613548609925913, 0.34075318580684383, 0.46131785561447614},
+				{-1.6675466621507915, -0.3026314979619841, 0.5428430356689306},
+				{-0.654987439581286, -0.8974080078621456, 0.6184789796323077},
+				{0.3021412602444495, -1.4589730654383073, 0.6901123761629925},
+				{1.2294669382103585, -2.0027564661694734, 0.7596606636053},
+				{2.1536568201383894, -2.5449319921877316, 0.8291583968213747},
+				{3.1022957661518507, -3.1022921217229587, 0.9007382948394113},
+				{4.103393268641615, -3.6918912918318996, 0.9765831720512278},
+				{5.186139052975343, -4.331463967612194, 1.0589781952760562},
+				{6.382857884013259, -5.040583812127687, 1.1504604142935437},
+			},
+			{
+				{-15.166741392230563, 8.028484395828261, -0.49881747655965397},
+				{-12.73915633390897, 6.598907652389889, -0.3244284232560771},
+				{-10.683133494516893, 5.386627043756735, -0.17563871298593517},
+				{-8.913854035054019, 4.342839633834492, -0.0467918555769905},
+				{-7.369007157234177, 3.431579701280851, 0.0662922174633084},
+				{-6.001275999209645, 2.625425037161809, 0.16681500532865956},
+				{-4.77348160503791, 1.9027202024062557, 0.25732102787432853},
+				{-3.655261345402107, 1.2456727951022186, 0.3399185368950092},
+				{-2.620623190261375, 0.6389439832483523, 0.41644396833574415},
+				{-1.6459525257660388, 0.06848868796506839, 0.488599189562881},
+				{-0.7081770604303858, -0.4795253166852624, 0.5580820263890709},
+				{0.21705757254359578, -1.0198016779867463, 0.626719827270921},
+				{1.1569272548028462, -1.5688247308461936, 0.6965829345564364},
+				{2.1418354908011317, -2.145060498315539, 0.7700095505947078},
+				{3.205004719246959, -2.7686436579966944, 0.8495658082501931},
+				{4.383354969972497, -3.461851539629107, 0.9381070938926787},
+			},
+			{
+				{-17.531367998645038, 9.822584210774865, -0.7250742955234689},
+				{-15.080707784025316, 8.375024664023432, -0.54933484755795},
+				{-12.995062794217054, 7.142656127538631, -0.39894505992158663},
+				{-11.192619367601337, 6.077914852101541, -0.26837225879818094},
+				{-9.613022286169835, 5.145640035030544, -0.1535195280017634},
+				{-8.210337078453273, 4.31900203622221, -0.0512519178766765},
+				{-6.948478626721177, 3.576855503794344, 0.040911674193352864},
+				{-5.798083997120634, 2.901925703433409, 0.12500997777768774},
+				{-4.73422900599115, 2.2794797001384546, 0.20279500651542243},
+				{-3.7345998644562988, 1.6962559594065802, 0.275858676159616},
+				{-2.77783073032695, 1.1394827993915846, 0.345750732902938},
+				{-1.8417577590756948, 0.5958387644735664, 0.4141059464250038},
+				{-0.9013933532286559, 0.05024099956965297, 0.482794695608569},
+				{0.07327448566548017, -0.5154641978173155, 0.5540875955244288},
+				{1.118716672676771, -1.1233170443942349, 0.630759468672811},
+				{2.2779699009237526, -1.7992147653196329, 0.7160879154868487},
+			},
+			{
+				{-20.289076840846842, 11.879108877806669, -0.9818816678962415},
+				{-17.771187701927126, 10.386509666596943, -0.8015486067709783},
+				{-15.622671670098386, 9.113651098086326, -0.6471143562544586},
+				{-13.761638199587656, 8.012411097024055, -0.5129650936483678},
+				{-12.127582510260039, 7.047190974092216, -0.3949430309754257},
+				{-10.674443636335369, 6.1908491107196975, -0.28987235368603365},
+				{-9.366092558306104, 5.422057034241332, -0.19525075235547587},
+				{-8.173253795816104, 4.7234937873691765, -0.10903840010310448},
+				{-7.071277605872566, 4.080536782704406, -0.029504392244257494},
+				{-6.038389428870314, 3.48022976324425, 0.04489517022127896},
+				{-5.054140369618815, 2.9103649410090404, 0.11562686088684662},
+				{-4.0978066910460855, 2.3585299847422956, 0.18419547476765802},
+				{-3.1464550921231402, 1.8109518894538377, 0.2522861559835457},
+				{-2.1723407716579772, 1.2509421733576163, 0.32195753749000344},
+				{-1.1394486990715684, 0.6568426159522903, 0.3958988165584393},
+				{-8.18573033040247e-14, 5.436873077672195e-14, 0.4776853802927917},
+			},
+		},
+		{
+			{
+				{-24.46549244775925, -2.530686981351023, 2.103780443699447},
+				{29.52697912555397, -31.75177376367872, 5.79392170671246},
+				{36.64084201794666, -35.51361779481996, 6.269874810317931},
+				{39.67705403717786, -36.95247133439367, 6.439760797860037},
+				{41.75213048775253, -37.8845660230055, 6.54625588981366},
+				{43.47632417489982, -38.662719224321926, 6.636359134062123},
+				{45.08120986846972, -39.418481722112794, 6.727434461973054},
+				{46.68590644947376, -40.2186829345797, 6.82825786092418},
+				{48.365779108244745, -41.10572011248129, 6.9444302611807345},
+				{50.17871542708167, -42.11298990734413, 7.080455977617946},
+				{52.17805966544589, -43.27258492635044, 7.240790997370467},
+				{54.42036514126766, -44.620028820729296, 7.430501578302121},
+				{56.971419940103374, -46.19810530721261, 7.655812562497138},
+				{59.91263699941042, -48.06108710628994, 7.9247291691058575},
+				{63.34956711185829, -50.2805276554422, 8.247902389859513},
+				{67.42469574173293, -52.954100869543545, 8.63996089629125},
+			},
+			{
+				{5.319461748372296, -13.97346949976326, 3.1540047922485765},
+				{20.197491716773058, -22.40790335172477, 4.252084033377902},
+				{25.21306974913268, -25.220358975207898, 4.620176696199582},
+				{27.994935182056786, -26.735451466039045, 4.81682584082915},
+				{30.057102113093045, -27.84435499846071, 4.960747035080065},
+				{31.818125130984097, -28.79507879753281, 5.085192534784133},
+				{33.45244740215815, -29.691077609783772, 5.204072425858682},
+				{35.05492285688674, -30.588791897573802, 5.325014593091319},
+				{36.688146825451, -31.5258355480301, 5.453154065716877},
+				{38.40084636521572, -32.532004898866646, 5.592620454220513},
+				{40.23701292129887, -33.63481373533091, 5.747294154510939},
+				{42.24156706018947, -34.863005061417866, 5.921295592728393},
+				{44.46479287523063, -36.24938374812082, 6.119389803168832},
+				{46.96678027519911, -37.833736448161666, 6.3474129748825945},
+				{49.822896645222755, -39.66650057429476, 6.6128164415811765},
+				{53.131488118569365, -41.813988097119, 6.925447550695831},
+			},
+			{
+				{6.394003020663886, -12.364544259933618, 2.7828258947062325},
+				{14.793764220899213, -17.20431671310325, 3.417068789729888},
+				{18.818564327469492, -19.52009403603569, 3.7236235387672982},
+				{21.334940561807574, -20.952407847901135, 3.9137893470694087},
+				{23.26797779180026, -22.047707835219516, 4.059910655684483},
+				{24.939242981337536, -22.998022718787137, 4.187659798885422},
+				{26.49300382933681, -23.890003934048885, 4.308693600845865},
+				{28.00996531868749, -24.77245393271465, 4.429627135217228},
+				{29.544227121601345, -25.6784325090266, 4.554990390086622},
+				{31.13818832762235, -26.634229744477334, 4.688435864315111},
+				{32.82996958814421, -27.66388260399946, 4.833352980861999},
+				{34.65801982159428, -28.792031101698797, 4.99326379386744},
+				{36.6647295784323, -30.0462144082387, 5.172148658015869},
+				{38.8999994533493, -31.459191838738462, 5.374782626228847},
+				{41.42550297111669, -33.07176150098194, 5.607150114017768},
+				{44.32048905456809, -34.93663402937649, 5.87701969419558},
+			},
+			{
+				{4.996289874776665, -10.155800334868319, 2.402138669794466},
+				{10.985857641441566, -13.643351642442944, 2.860287908892649},
+				{14.432220062524427, -15.655854942224096, 3.127870468727563},
+				{16.75031778084948, -17.00438535631392, 3.308419486644228},
+				{18.56842411551012, -18.060458012383698, 3.450743955666568},
+				{20.149278498697743, -18.9815300350179, 3.5757859979058186},
+				{21.61959948633416, -19.844155910742888, 3.693810879415422},
+				{23.052082814859954, -20.692601743323745, 3.8108060280029843},
+				{24.495891894639243, -21.55710833669319, 3.9309072568640877},
+				{25.98966036760892, -22.461739183216306, 4.0574493102730225},
+				{27.568066972395854, -23.42838846705062, 4.193508251542734},
+				{29.26591281710686, -24.479305805062662, 4.342249181125606},
+				{31.12131251360306, -25.639113019611674, 4.507210360556847},
+				{33.17883213154141, -26.936825941385077, 4.692594140363024},
+				{35.493197875256506, -28.40827669822125, 4.903620763697164},
+				{38.134270186576416, -30.09938851300956, 5.147010952591976},
+			},
+			{
+				{3.2104996305999394, -8.108564888989525, 2.0756489419356483},
+				{7.980438021309072, -10.907466611077325, 2.443448511228572},
+				{11.051879944082764, -12.718422450581565, 2.68449318160737},
+				{13.228509414934608, -14.001240857948632, 2.8567449559658216},
+				{14.959082610177303, -15.021050108306131, 2.9947092488838436},
+				{16.466374119498283, -15.911574415404246, 3.116056912595631},
+				{17.86624561327304, -16.74303184109092, 3.230158335438519},
+				{19.227055442133373, -17.5572016430305, 3.3426419098947346},
+				{20.595381274256876, -18.38282215096733, 3.45742258237116},
+				{22.007835801386378, -19.24272980128951, 3.577651430051565},
+				{23.497221434901427, -20.157614191484903, 3.706220936020551},
+				{25.096369819476873, -21.14839244206158, 3.8460900604640216},
+				{26.84114257699316, -22.238096574806374, 4.000548433815241},
+				{28.773378891079084, -23.453757085795473, 4.1734855871824355},
+				{30.94437299263963, -24.82864986944033, 4.369716948825998},
+				{33.41952069057852, -26.405317706762272, 4.595426193238028},
+			},
+			{
+				{1.3679091420152723, -6.244680689951963, 1.7923774922543834},
+				{5.4187158954051595, -8.635656958752403, 2.1061792233362793},
+				{8.231435119435211, -10.305229020303308, 2.328183675062759},
+				{10.309696300538633, -11.540600297028012, 2.4940597029165334},
+				{11.979019504840734, -12.533493369923915, 2.6284596388243},
+				{13.43130201714417, -13.399108354945769, 2.7464853568566236},
+				{14.775407262446032, -14.203534844423098, 2.856903482549643},
+				{16.0778048136675, -14.987484384701068, 2.965170195102407},
+				{17.384198369379657, -15.779199180182976, 3.0751133765992718},
+				{18.730479083796833, -16.601067785217353, 3.1898058089058985},
+				{20.148702464604877, -17.473272103987924, 3.3120527879488466},
+				{21.67087773839658, -18.41612750712189, 3.4447105300409455},
+				{23.331936424802453, -19.451941999039093, 3.5909447519833937},
+				{25.172650818245717, -20.60686754308008, 3.7544935684369327},
+				{27.243086032282832, -21.913109012767084, 3.9399858947148156},
+				{29.607225779429523, -23.411898583815926, 4.153374108740583},
+			},
+			{
+				{-0.48543714844671865, -4.506887177096421, 1.5381419774472418},
+				{3.1047814909624716, -6.635694536182986, 1.816799518485367},
+				{5.73302057279146, -8.20326666448019, 2.024702452130866},
+				{7.744610443620957, -9.406044069819153, 2.185872689249432},
+				{9.376378915217058, -10.382761308800914, 2.317873600112268},
+				{10.791706768739346, -11.231315427487115, 2.4334058920457053},
+				{12.094223382785431, -12.014647792813758, 2.54075683340754},
+				{13.350257674477195, -12.77342972152562, 2.645343083023588},
+				{14.606046936805138, -13.536252896026427, 2.7510164935979398},
+				{15.89786768719501, -14.325734472053181, 2.8608618936977144},
+				{17.257967450265447, -15.162133673750295, 2.9776770114895568},
+				{18.718441310587373, -16.06574191477626, 3.104295485403397},
+				{20.314295772617122, -17.058793992435394, 3.2438508876118735},
+				{22.086470065412193, -18.167371741196, 3.4000452340719636},
+				{24.0854276673232, -19.423681774494558, 3.577475125213681},
+				{26.376003096401067, -20.869139741373747, 3.782077462179649},
+			},
+			{
+				{-2.368962584004153, -2.835831228035778, 1.301563625963235},
+				{0.9139566953255677, -4.789279780247546, 1.5562433338448614},
+				{3.410976273234145, -6.283725874219262, 1.7536540097086044},
+				{5.378500754601052, -7.46488085826316, 1.9113437547188792},
+				{6.993478487422263, -8.435774850023936, 2.042120895780345},
+				{8.38976024349031, -9.276239523115532, 2.15619531630569},
+				{9.664749370603001, -10.04536385689829, 2.26127227928934},
+				{10.885645774990476, -10.784361667574565, 2.3627988890024736},
+				{12.10047748792334, -11.522935421866347, 2.4647482252119524},
+				{13.346983547195368, -12.284594223533478, 2.570303747909988},
+				{14.658509245612185, -13.09023980038869, 2.682330641456098},
+				{16.068072135110928, -13.960668174088982, 2.8037112680754066},
+				{17.611639166496193, -14.918605925720449, 2.9376243768256276},
+				{19.33137953707139, -15.990746741943024, 3.087830267446454},
+				{21.27955753010483, -17.21019956013514, 3.2590188545215004},
+				{23.52383847177254, -18.619832055701796, 3.4572895231991247},
+			},
+			{
+				{-4.322282526404667, -1.1776409509408257, 1.0735556733777225},
+				{-1.2454486743946103, -3.013288129395684, 1.3115685842019404},
+				{1.162393005649073, -4.457823948043951, 1.5013469717951131},
+				{3.1035934869613793, -5.626222431593305, 1.6565184598154474},
+				{4.718297456226905, -6.599729511062561, 1.7869981473863756},
+				{6.113477087847397, -7.441709349453712, 1.9007401327999136},
+				{7.3759759031942185, -8.204638330581705, 2.0044950564704465},
+				{8.573198783081896, -8.929808195287388, 2.103667159386772},
+				{9.755951936771815, -9.648624703264042, 2.202421771482399},
+				{10.964583388595068, -10.386195891910914, 2.3041305737166176},
+				{12.234537702693272, -11.164689976869383, 2.4118067481784813},
+				{13.60064831586629, -12.00596779542632, 2.5284546114494098},
+				{15.10074315469893, -12.933814104774534, 2.6573729640546917},
+				{16.779272480473683, -13.976195054462142, 2.802468607804023},
+				{18.691688997365326, -15.167990699105324, 2.9686418083685306},
+				{20.910491488993486, -16.55476768444008, 3.162323693217643},
+			},
+			{
+				{-6.396265355458168, 0.5192840843241716, 0.8462716480275929},
+				{-3.4517841034685888, -1.240655976670232, 1.0728428575048141},
+				{-1.0973862663279976, -2.6552795388952104, 1.2573822545117057},
+				{0.834608655350308, -3.8198799763087194, 1.4109907725344295},
+				{2.461612854574476, -4.802349354601788, 1.5418007089120862},
+				{3.8720019607745524, -5.654714114052307, 1.6562104104405768},
+				{5.138807761405643, -6.420799635243528, 1.7597522240962669},
+				{6.325597692166276, -7.139401237230149, 1.8574343780723108},
+				{7.485688681856673, -7.8434261357105886, 1.9535750906945764},
+				{8.663151758460078, -8.560263532943921, 2.0518163100368696},
+				{9.89674508302242, -9.31410289577648, 2.155413867963265},
+				{11.224207946847336, -10.128549685207966, 2.267580316281532},
+				{12.686277377832784, -11.029099168988104, 2.3918178648647452},
+				{14.330861028803247, -12.045717424174228, 2.532273456625061},
+				{16.218142643861178, -13.216001685598636, 2.6941806195405693},
+				{18.42772988630726, -14.58960173829403, 2.8844839723599667},
+			},
+			{
+				{-8.654642073266041, 2.3096366057650415, 0.6121447948320716},
+				{-5.782968996284538, 0.5912703597347425, 0.8313590521196234},
+				{-3.449121150075234, -0.8120237085484241, 1.0127876949250378},
+				{-1.507889114141485, -1.9828221843658764, 1.1658709541275623},
+				{0.14353350676526241, -2.9804767610850456, 1.297578279029616},
+				{1.5821946901380457, -3.850153195079881, 1.4133487756772514},
+				{2.870308884512372, -4.628919717698734, 1.517759709052359},
+				{4.063087600554585, -5.350234110772227, 1.6150464847402854},
+				{5.212488454932055, -6.046035855841233, 1.709339120785463},
+				{6.366246227072356, -6.745929114339592, 1.8045266759155274},
+				{7.56779222054971, -7.476964606025174, 1.904208280632378},
+				{8.859236002453311, -8.265403240130198, 2.01191730110914},
+				{10.285606898169338, -9.13930735780427, 2.1314657129288483},
+				{11.899744695194531, -10.131546289777404, 2.267353025662534},
+				{13.768453733138257, -11.28357690455196, 2.4252893526518853},
+				{15.981277640735488, -12.650822885026699, 2.6129478311274696},
+			},
+			{
+				{-11.181220744237685, 4.258242043109327, 0.3629168593308138},
+				{-8.328230700640946, 2.550249016871406, 0.5783305838208568},
+				{-5.981656808782421, 1.1393458416052442, 0.758700854704915},
+				{-4.010094659284322, -0.04931898002861693, 0.91242045863685},
+				{-2.319821859042305, -1.0698136845736932, 1.0457042629106394},
+				{-0.8406167782759466, -1.9631818656422169, 1.1633856595613616},
+				{0.4833508799587078, -2.7625064791978566, 1.269455528247531},
+				{1.7001535470438047, -3.496692712939644, 1.3674872930620283},
+				{2.855561337608839, -4.19366639720418, 1.4610171721595417},
+				{3.99659536043514, -4.882454363138806, 1.553796346665884},
+				{5.17126017685328, -5.592876546468497, 1.6497368855264687},
+				{6.427843845418735, -6.354969212225463, 1.7528256122223744},
+				{7.817697345133046, -7.200618004911414, 1.8673366778887839},
+				{9.400669720539337, -8.166864829749352, 1.9982758932803453},
+				{11.252718363752933, -9.300546301069977, 2.1520145980210357},
+				{13.477099232538281, -10.665093147415039, 2.3372297287784676},
+			},
+			{
+				{-14.093991653573225, 6.4507548514402355, 0.08835899229773703},
+				{-11.203123086077849, 4.72019855015613, 0.30355112833345843},
+				{-8.806246065393978, 3.280113483649067, 0.4850792767272646},
+				{-6.77852458276915, 2.0591022406423294, 0.6408138866494355},
+				{-5.030776142540428, 1.0056722190549858, 0.7765476298039358},
+				{-3.49629562658848, 0.08089990177736699, 0.8967564641620022},
+				{-2.122580994054143, -0.7461999196921565, 1.0050856035457167},
+				{-0.8655319340183619, -1.5019717623638174, 1.1047084666608833},
+				{0.31529413288075975, -2.2108830706452123, 1.1986446694467001},
+				{1.461295346121842, -2.8982809174503483, 1.2900956830117563},
+				{2.6191982385161423, -3.59303044218323, 1.3827779818104986},
+				{3.842785854203099, -4.328478265047993, 1.4810449526925176},
+				{5.192387544503273, -5.141974964188457, 1.5898233550557996},
+				{6.738172265665618, -6.076765893606969, 1.714866453378421},
+				{8.56903402941152, -7.1873396026820835, 1.8634754372441367},
+				{10.807361910932796, -8.548320677080879, 2.045711690591244},
+			},
+			{
+				{-17.57255059896881, 9.012872769940898, -0.22590840305249796},
+				{-14.574576524966309, 7.218715782304893, -0.006584285914982684},
+				{-12.07926494824832, 5.721400298463064, 0.1789071251655312},
+				{-9.960863623031734, 4.448407118922217, 0.3384820979613641},
+				{-8.130019609821474, 3.3479292462969625, 0.4778674122918603},
+				{-6.520331658665839, 2.381192516090441, 0.60142124117355},
+				{-5.08009215226798, 1.5177493873922925, 0.7126332460642121},
+				{-3.766719746351603, 0.7322909599575385, 0.8144675394297751},
+				{-2.5424681917391663, 0.0021550446622778646, 0.9096407465532548},
+				{-1.3704119032148365, -0.695054687335174, 1.000902288887764},
+				{-0.2097752675159915, -1.3842925178772731, 1.0913837956726506},
+				{0.9901088458987178, -2.0967527132100936, 1.1850695291450737},
+				{2.2957420087077485, -2.873437933496183, 1.2872615983190463},
+				{3.791891604245151, -3.7664209223591794, 1.4047539842300292},
+				{5.586970141811688, -4.841829117630221, 1.5462406763700387},
+				{7.831147246489127, -6.190572005142383, 1.7237577002668918},
+			},
+			{
+				{-21.915628215380845, 12.149676979294288, -0.6028169510218219},
+				{-18.710982013417716, 10.23151950844532, -0.3729177972162089},
+				{-16.046434700085037, 8.634721883783676, -0.1791674477950296},
+				{-13.785808032483402, 7.27973361603003, -0.012895818956555363},
+				{-11.833419693367924, 6.110554138858236, 0.13203503979683243},
+				{-10.119044025201465, 5.085953497910558, 0.26018566631868995},
+				{-8.58880812738663, 4.174164034384607, 0.37511558245307125},
+				{-7.199250913458851, 3.3494163630350493, 0.47976027982260194},
+				{-5.913010071236276, 2.589414252054862, 0.5767102323855344},
+				{-4.695121093542825, 1.8731453394805726, 0.6684612963442098},
+				{-3.5090025261335525, 1.1784728758299527, 0.7577029472114404},
+				{-2.310900824307048, 0.47876800604753034, 0.8477366907729498},
+				{-1.0408819548348254, -0.2625681145693445, 0.9431717565274047},
+				{0.39125940610808446, -1.1006133719010702, 1.0510197178062342},
+				{2.120509861365175, -2.1173831768830462, 1.1818028162672993},
+				{4.34149141591116, -3.430010484642092, 1.3506605093022614},
+			},
+			{
+				{-27.67557056811466, 16.236690708025623, -1.0839036763474412},
+				{-24.097175545124408, 14.091103635351768, -0.8322697147578685},
+				{-21.143658519709525, 12.321521246913424, -0.6225458169123386},
+				{-18.653303598763127, 10.83199958735598, -0.4442727306729234},
+				{-16.51442647500461, 9.556272083095864, -0.29020481833549927},
+				{-14.646487690823179, 8.446535099755723, -0.15509218779386572},
+				{-12.98891376892529, 7.46681204610007, -0.03495825009538435},
+				{-11.494012362464078, 6.588748165872808, 0.07336006361346803},
+				{-10.122079042228409, 5.788704491459558, 0.17253300013253323},
+				{-8.83754496486296, 5.045465024783458, 0.264995909552044},
+				{-7.605256175346061, 4.338012854712946, 0.3532072292248719},
+				{-6.385787606113142, 3.6427158595857754, 0.4399860034690635},
+				{-5.127843081108932, 2.928750429646766, 0.5290739812353713},
+				{-3.753506645712954, 2.149228329941878, 0.6262399449964671},
+				{-2.127245340825081, 1.2226473265710136, 0.7416063404223768},
+				{-1.9943012997945226e-13, 1.3907044065222248e-13, 0.8938140096034137},
+			},
+		},
+		{
+			{
+				{-2.328956853607531, -17.58430034306419, 4.661527898829892},
+				{40.861286266555275, -41.67410174671923, 7.852342031403394},
+				{46.32045911343519, -44.40854637207833, 8.192662516726969},
+				{49.1823417663746, -45.69631541760517, 8.34096808657048},
+				{51.34266006019, -46.65162979788909, 8.450823908155108},
+				{53.28360128727357, -47.55165764749225, 8.559934166266313},
+				{55.21305646981155, -48.51345032186554, 8.684041282844474},
+				{57.249097526543956, -49.60325774935651, 8.832077669799514},
+				{59.47795978835112, -50.87089257091278, 9.010936994538541},
+				{61.97948381745067, -52.36503061421384, 9.227626601744907},
+				{64.84105929582637, -54.14184576295773, 9.490513472794225},
+				{68.1684702174485, -56.272059379493406, 9.81037988467954},
+				{72.09854771022032, -58.84956012028111, 10.201754229136077},
+				{76.81784126552604, -62.00448216362111, 10.684962891308643},
+				{82.59328348267931, -65.92498355076373, 11.289573682929587},
+				{89.8265408572449, -70.89611279841878, 12.06056549461456},
+			},
+			{
+				{16.14294204261147, -22.437104135658707, 4.816188719063785},
+				{27.95768270815954, -29.26706161631713, 5.7426765727350295},
+				{32.19110924109232, -31.643709836317914, 6.0635683462673295},
+				{34.91208298252087, -33.141281982236926, 6.265463600382373},
+				{37.112855939089656, -34.35514098780739, 6.430758554075103},
+				{39.11121239802687, -35.476212512751744, 6.585965162079021},
+				{41.058917003415715, -36.5959394477645, 6.743848734003165},
+				{43.0492556057593, -37.77114019680071, 6.912435129495091},
+				{45.15267021131799, -39.04561717133288, 7.098028315412242},
+				{47.433040643649, -40.46017770021168, 7.306629260786082},
+				{49.95736602391186, -42.05875864856566, 7.5448248252353425},
+				{52.803418513124235, -43.89344303647665, 7.820538462246222},
+				{56.0679662063433, -46.030019703162765, 8.143883258426882},
+				{59.877867916118845, -48.55562941901891, 8.528352027923232},
+				{64.40707500525455, -51.59060073580112, 8.992672923278278},
+				{69.90479457367626, -55.30816364493745, 9.563912365603443},
+			},
+			{
+				{14.081568087893755, -18.719511790547788, 4.118155342370883},
+				{21.181848819156606, -22.900167970012397, 4.690551536799833},
+				{24.716501977821586, -24.964848718094604, 4.975643706240449},
+				{27.186390022664405, -26.40050133733412, 5.17541063037938},
+				{29.242930455741227, -27.60119545363874, 5.344233966052194},
+				{31.12767647702563, -28.714050209201293, 5.502567295725919},
+				{32.96252966639991, -29.81386849482934, 5.660901766363606},
+				{34.82504202983054, -30.948829953972933, 5.826084347320694},
+				{36.77497420709629, -32.15671678566276, 6.003577147370225},
+				{38.866652889339726, -33.472562382898694, 6.198536887451452},
+				{41.156515329026746, -34.93343119169724, 6.416505607025473},
+				{43.70921176918236, -36.58239942929397, 6.664002671929732},
+				{46.60407967806915, -38.47288451306432, 6.9491840896770425},
+				{49.943587469555254, -40.67437890578499, 7.282728228556335},
+				{53.86583646142212, -43.281009387396736, 7.679167879971533},
+				{58.564591042080714, -46.42531169763907, 8.159042802551665},
+			},
+			{
+				{11.301865570235389, -15.540586487461933, 3.582867472280436},
+				{16.629617680927016, -18.71979356350947, 4.020476771050898},
+				{19.738594999174232, -20.57463212304071, 4.279080713159014},
+				{22.013704115425256, -21.932031936043973, 4.470337285363254},
+				{23.936758033296748, -23.084863785424687, 4.6344740783597835},
+				{25.708241581461426, -24.15610793680638, 4.788545812213866},
+				{27.433715029943343, -25.211096406053212, 4.941705650666679},
+				{29.181761487895415, -26.292789238967917, 5.100054726873407},
+				{31.0059190966607, -27.435232734629544, 5.268507947825287},
+				{32.95512922962726, -28.670047254204615, 5.451705833397204},
+				{35.080208621329405, -30.030526600348036, 5.654604065830984},
+				{37.43917701943487, -31.555095520520304, 5.882984712577582},
+				{40.10294649285578, -33.291077327660986, 6.144026173074788},
+				{43.16268250946994, -35.29962930938225, 6.447060350172505},
+				{46.74055657641994, -37.662999270999165, 6.804694398372993},
+				{51.006728261416406, -40.49602627398213, 7.234596124238461},
+			},
+			{
+				{8.710777508055967, -12.921253895115317, 3.1665832728497127},
+				{13.132229592091232, -15.586358106928964, 3.534414558850851},
+				{15.970575549664348, -17.302783741105404, 3.7747934249518313},
+				{18.10735545229022, -18.597703203230544, 3.958306978920614},
+				{19.926583005623858, -19.70526237915638, 4.116927729236362},
+				{21.60514100674415, -20.73446497919509, 4.26571048345413},
+				{23.239797692733273, -21.745510993594312, 4.413062862214997},
+				{24.89435596661106, -22.778541684897935, 4.564668474318414},
+				{26.61900800684616, -23.865550664035492, 4.725129575900163},
+				{28.45985362846942, -25.036308074100667, 4.8987958965172975},
+				{30.464879009205443, -26.32214566037703, 5.090306392928005},
+				{32.68894793743965, -27.75918108989183, 5.305063428023571},
+				{35.199197846044896, -29.391861134332366, 5.54976552069959},
+				{38.082055515449404, -31.27761340557338, 5.833116450529199},
+				{41.45348229773397, -33.49367336924077, 6.166873882174258},
+				{45.4751259688111, -36.147875187804544, 6.567514053837884},
+			},
+			{
+				{6.301294270990652, -10.656878269858675, 2.8234464878105787},
+				{10.190129948811839, -13.019218754876492, 3.149702962283134},
+				{12.85656120222526, -14.647062549240717, 3.3780190011750664},
+				{14.901826100326067, -15.899602690179254, 3.5559428382081446},
+				{16.646044418810586, -16.972346503286925, 3.709973685923129},
+				{18.25257509303021, -17.966282445217992, 3.8539851308868367},
+				{19.81434470589043, -18.939422599729298, 3.9960430524859603},
+				{21.39334128002686, -19.930869814913677, 4.141659661374918},
+				{23.038486423838364, -20.971834904958012, 4.295303122245293},
+				{24.794812336413322, -22.0913576248377, 4.4611937644752855},
+				{26.709326894827, -23.320017408351315, 4.643832479752759},
+				{28.83597083727145, -24.693122118185247, 4.84846710618255},
+				{31.241050291226212, -26.25424800802701, 5.081621530319036},
+				{34.010380662751935, -28.059929285206458, 5.351806085920555},
+				{37.25980654078559, -30.186588540266527, 5.67057534214138},
+				{41.15190827340876, -32.741561923775215, 6.054218357324612},
+			},
+			{
+				{3.9822871189392313, -8.594875898621707, 2.5241855987904507},
+				{7.537831007466201, -10.767882618373623, 2.82395571338772},
+				{10.100327323905208, -12.343216221240548, 3.044758392322602},
+				{12.094433200876237, -13.573657650874448, 3.2195403668849316},
+				{13.790544308353331, -14.624302009771228, 3.3704577766914223},
+				{15.344393985373436, -15.591610486180773, 3.510671971541601},
+				{16.8488071215306, -16.53367197627407, 3.648213270961917},
+				{18.366434972722658, -17.490074509087016, 3.7886309368177753},
+				{19.946690460845375, -18.49234638010829, 3.936411894251472},
+				{21.63499149866602, -19.569723141582717, 4.095778247411905},
+				{23.47881559501612, -20.752974694922006, 4.271229024177642},
+				{25.53287934828413, -22.077723423114016, 4.46802254844949},
+				{27.86488945793925, -23.588165074604, 4.692729082749659},
+				{30.563217058997473, -25.342060243355114, 4.953982051441468},
+				{33.748361909243016, -27.418207851199547, 5.263611268380612},
+				{37.59142453337381, -29.92849823276834, 5.638478929204875},
+			},
+			{
+				{1.6600275827231852, -6.623116136503752, 2.249103452605109},
+				{5.0069604019012806, -8.678297464790647, 2.5318054076244785},
+				{7.51425318457955, -10.227660636660893, 2.7484096504096134},
+				{9.49385536267241, -11.455935194443628, 2.9225285504874643},
+				{11.168970110187198, -12.498965741426884, 3.0721337063381187},
+				{12.689239725713476, -13.449472666138034, 3.2097602998263204},
+				{14.150335490644254, -14.367414743224375, 3.3436368369997833},
+				{15.617984831367394, -15.294367432527226, 3.4795506149764215},
+				{17.143872822627223, -16.26329996641276, 3.6221599826689306},
+				{18.77522013382683, -17.304548911123945, 3.775813641248266},
+				{20.561309097352872, -18.44993768102738, 3.9451295870713396},
+				{22.559148196487822, -19.73639062784012, 4.135516775123129},
+				{24.839838047157475, -21.21002390441827, 4.353778405531924},
+				{27.49720540430885, -22.931712431977886, 4.608943815693617},
+				{30.66094672726319, -24.98557378846871, 4.913545873037356},
+				{34.51825185831906, -27.492928106725138, 5.2857328492538},
+			},
+			{
+				{-0.759537405942642, -4.648800941327849, 1.9832311100506088},
+				{2.4686241535311084, -6.6381495376076876, 2.2555683371556703},
+				{4.958816007347265, -8.182666343018175, 2.470528811232934},
+				{6.956497333929939, -9.42709764563985, 2.6462251997680992},
+				{8.640272268948735, -10.47942846929527, 2.7966526845769812},
+				{10.148436944133145, -11.425135376026999, 2.933210983977663},
+				{11.580688951862378, -12.326712603844122, 3.0643939927813886},
+				{13.008325300665158, -13.22928019486932, 3.196428066895874},
+				{14.487395158081892, -14.168574161871112, 3.3343185416597803},
+				{16.06856242920197, -15.177080281404262, 3.482681130607417},
+				{17.804365026138463, -16.288606838168597, 3.6463787026840166},
+				{19.755671083289023, -17.54238688783658, 3.83110827978521},
+				{21.99902330939784, -18.987766928529297, 4.044086709464901},
+				{24.63677035569963, -20.69068492270127, 4.295010873758321},
+				{27.812844193448996, -22.7437579342547, 4.5975646049190075},
+				{31.7394273372781, -25.28332238207225, 4.97197699452362},
+			},
+			{
+				{-3.381296021740301, -2.581947115157446, 1.7133112294317676},
+				{-0.19795644265357054, -4.54860728231388, 1.9806356233867455},
+				{2.3114260334322863, -6.10886995066736, 2.196345335359019},
+				{4.354558703589181, -7.3848966175218695, 2.37538412038825},
+				{6.078704001832332, -8.465088268384386, 2.528926783878678},
+				{7.602020434145625, -9.42197130264959, 2.6664422756532353},
+				{9.023619364609871, -10.317517429949785, 2.7962342275040304},
+				{10.421965660729386, -11.201359943941291, 2.9250752985799933},
+				{11.860070187073013, -12.11364792809977, 3.0585288503949033},
+				{13.394236265712198, -13.090419833843448, 3.201660706165457},
+				{15.082104713533342, -14.168650654833801, 3.359733342032995},
+				{16.990262465080605, -15.391065233839738, 3.538887184314766},
+				{19.203055446976766, -16.811728618709513, 3.7469498365261855},
+				{21.834965247709803, -18.503895671820015, 3.9945884666906393},
+				{25.050397515240743, -20.572546113838346, 4.297165244708333},
+				{29.09827306897237, -23.176265885370956, 4.6779938833429275},
+			},
+			{
+				{-6.337530054068897, -0.31978025176074654, 1.4254800900428997},
+				{-3.128903761285955, -2.3048652778228655, 1.692592482153508},
+				{-0.5597565188938138, -3.9043190875638443, 1.9116461139491572},
+				{1.5563111810819144, -5.227509529508521, 2.095666463383751},
+				{3.3501022313785294, -6.352551440440789, 2.2542654119539},
+				{4.9221997652242395, -7.34063530969029, 2.3952099822201425},
+				{6.360193632727832, -8.246113630619371, 2.525615599712496},
+				{7.745106632894458, -9.120076690581909, 2.6523409575316292},
+				{9.148869482929072, -10.008300707720196, 2.7816429575998103},
+				{10.636521024252813, -10.95235356068091, 2.9192878025617097},
+				{12.273527662560157, -11.994119090599986, 3.071159977186996},
+				{14.134729062591791, -13.181422712792832, 3.2440475867219165},
+				{16.31528805030915, -14.57493341880493, 3.446628798626162},
+				{18.946427269648687, -16.258065359196802, 3.690908453672656},
+				{22.221365508928756, -18.35325599926316, 3.994601152425454},
+				{26.442568412662954, -21.051552396684954, 4.385489017247708},
+			},
+			{
+				{-9.815047558928416, 2.2736838287477608, 1.1027730512754328},
+				{-6.503733578230711, 0.22477283824172356, 1.374549029953845},
+				{-3.8243890843603174, -1.4432211166544857, 1.5999860196179378},
+				{-1.6001366804929678, -2.833761205265371, 1.7909928689646053},
+				{0.2928312760421702, -4.020452227417467, 1.9563419700088067},
+				{1.9471466062428728, -5.059241019319098, 2.1029122257600976},
+				{3.4389196594065305, -5.996869990634528, 2.2366294999735397},
+				{4.839488615338833, -6.8779184548470775, 2.3633193495013627},
+				{6.2228728732077085, -7.749287681147019, 2.489266855312743},
+				{7.6647877114183265, -8.659279799407695, 2.621059855679624},
+				{9.2427336181836, -9.657382579828415, 2.765538962222984},
+				{11.043843786215422, -10.79909743313388, 2.930459337764181},
+				{13.178428959907473, -12.154409271861274, 3.1256823159409475},
+				{15.800769528523261, -13.820766254090547, 3.3650307209265864},
+				{19.144719292650844, -15.945218474964511, 3.6694855109481295},
+				{23.591949064103673, -18.76670159376236, 4.073334314828944},
+			},
+			{
+				{-14.106655508879939, 5.402811586555206, 0.7214162043751496},
+				{-10.592610476782436, 3.231712040465459, 1.0034909413110962},
+				{-7.73346472389246, 1.454770922669976, 1.2391451111425773},
+				{-5.350491670755581, -0.03209278626435592, 1.4398189619131159},
+				{-3.3191245199708868, -1.302546749414424, 1.6139330656274107},
+				{-1.5476458031364762, -2.4116111843711634, 1.7679921945312773},
+				{0.03627562215651977, -3.4032940365746733, 1.9073651830320961},
+				{1.495556211465793, -4.316441629634719, 2.0369408884017397},
+				{2.8931241736306554, -5.190480531641181, 2.1618333355406327},
+				{4.302097106358869, -6.071755223281689, 2.2882073277619353},
+				{5.811681633713446, -7.0170841783718645, 2.423751463626477},
+				{7.527775407782177, -8.09378823899206, 2.577683183310463},
+				{9.583313143320241, -9.385896623188295, 2.76161353291028},
+				{12.16524213714559, -11.010804900748631, 2.9918853214791956},
+				{15.565798893102414, -13.150857749275303, 3.294051683215308},
+				{20.28751595999188, -16.11769475569181, 3.7120780159778404},
+			},
+			{
+				{-19.725111750745498, 9.415002902197271, 0.24374259848195765},
+				{-15.855408027521952, 7.033236758753496, 0.5436922316624592},
+				{-12.708196462139005, 5.08509102165866, 0.7948345024822816},
+				{-10.08709126637118, 3.4567874224139383, 1.0089317672023612},
+				{-7.857060241946677, 2.0690213568561835, 1.1945346801863406},
+				{-5.920319994690366, 0.8636094463210756, 1.3581603720534776},
+				{-4.201981082371503, -0.20456254702614582, 1.505039845689919},
+				{-2.6403522025130117, -1.1731108677161637, 1.6396810076567971},
+				{-1.1790170714108315, -2.076856757667186, 1.766394805586851},
+				{0.2418190640456361, -2.9531369379661854, 1.889947875681698},
+				{1.6980202267308866, -3.849752461730926, 2.016598555025123},
+				{3.300823846057666, -4.837073149807174, 2.1557173530281353},
+				{5.214578046887454, -6.018766557173934, 2.321253789968609},
+				{7.677311340745533, -7.543706920898871, 2.5334036562875144},
+				{11.069308099784235, -9.647444512330654, 2.824367325955622},
+				{16.08655413502759, -12.75721847666839, 3.2530250628470956},
+			},
+			{
+				{-27.714443828539128, 15.00203598354311, -0.4002632595034834},
+				{-23.196712358961474, 12.23914138505076, -0.06852978137446743},
+				{-19.562199415927196, 10.005835824066446, 0.20678096047621014},
+				{-16.560778474097464, 8.156404833993466, 0.4400605166530627},
+				{-14.027545723096212, 6.594369690574111, 0.6410276343691494},
+				{-11.846923736836857, 5.251581533445325, 0.816765611190489},
+				{-9.933288001946455, 4.077094248418034, 0.9727601043667319},
+				{-8.21924408295543, 3.030448553906769, 1.1135254922864595},
+				{-6.647371740179568, 2.076877688603689, 1.2430807871122598},
+				{-5.162904619158148, 1.182946035185025, 1.3654288146597564},
+				{-3.704712842305315, 0.31103376569794655, 1.485222027371178},
+				{-2.189714329620364, -0.5903422595480782, 1.6089963522906856},
+				{-0.4787022047318684, -1.6078140589797185, 1.7479466602436489},
+				{1.7008363057474885, -2.9108726067260293, 1.9242111100388275},
+				{4.876610553609191, -4.824870405580032, 2.180623295942346},
+				{10.086115032011294, -7.980184887822554, 2.6009909895071734},
+			},
+			{
+				{-40.81416218640066, 23.96084239514633, -1.386846364453671},
+				{-34.8627302897556, 20.339602964897395, -0.9798341187882408},
+				{-30.239451146117432, 17.523697718159987, -0.6554545081696221},
+				{-26.524628105858827, 15.262316451869648, -0.3888455176387506},
+				{-23.46091702190376, 13.402064728521497, -0.1647966867925351},
+				{-20.87959680253773, 11.842634661884388, 0.026669426753438936},
+				{-18.66333665797022, 10.514440753837041, 0.19253359907353562},
+				{-16.725628328195064, 9.36630270504126, 0.3380110124481508},
+				{-14.99823670509336, 8.357969732715443, 0.46729832304378},
+				{-13.422289321539411, 7.454837990458894, 0.5841110708244154},
+				{-11.940106771526231, 6.623102131250775, 0.6922044297785765},
+				{-10.484462697397532, 5.823332881208846, 0.7961122341036128},
+				{-8.958082021789332, 4.998117025963145, 0.9026361975661312},
+				{-7.17999849575856, 4.039579974210693, 1.0248415533520312},
+				{-4.698330539245771, 2.6761434883854274, 1.196125741618178},
+				{-4.9991156525728e-13, 3.36434260177292e-13, 1.5294813607531168},
+			},
+		},
+		{
+			{
+				{20.470894827904615, -34.086329354550436, 7.561778284835368},
+				{51.7932596370669, -51.704473297329834, 9.953932070338125},
+				{56.55853418462892, -53.920977751013304, 10.215866017051495},
+				{59.366440275821624, -55.10456074201249, 10.34488827122547},
+				{61.64413800046692, -56.08940208160111, 10.457475014663318},
+				{63.821794983081624, -57.11865898340448, 10.58683724115641},
+				{66.09864482055461, -58.30238770589091, 10.74781453519095},
+				{68.59907643892302, -59.71025854046282, 10.949971820519382},
+				{71.42894937814788, -61.405338676487155, 11.20241816333286},
+				{74.70131861426331, -63.45988740781063, 11.516110798461332},
+				{78.55306492286833, -65.96607380348404, 11.905484295406318},
+				{83.16318557212661, -69.0485051628686, 12.390435927631536},
+				{88.78030586235053, -72.88378953998038, 12.999494345800867},
+				{95.76930805402407, -77.73428672821744, 13.775341359428488},
+				{104.69735319318228, -84.01088404976325, 14.785130962226564},
+				{116.50807801494913, -92.40066879678889, 16.141531452720272},
+			},
+			{
+				{26.071621153441857, -30.661433779198852, 6.471107350787974},
+				{35.3426162376191, -36.04923999304503, 7.220876495856709},
+				{39.28995473208617, -38.267003502364794, 7.527676126094418},
+				{42.095212390427584, -39.83136423944965, 7.745790188476703},
+				{44.49952367327059, -41.191515960690694, 7.938954483475469},
+				{46.777270295302884, -42.51383616529849, 8.13085949221914},
+				{49.07381520249951, -43.88713483916555, 8.33427803557054},
+				{51.48806624130686, -45.37303193609721, 8.55824583396574},
+				{54.10412330911417, -47.025509268671314, 8.810882189619296},
+				{57.00770717945629, -48.90133560828234, 9.100931993776292},
+				{60.297660890475136, -51.06765249725337, 9.43892762594213},
+				{64.09756659406874, -53.609929577987884, 9.838446205131264},
+				{68.57139846756522, -56.642940034993345, 10.317875482191447},
+				{73.94803593137917, -60.32815797641309, 10.903237404119542},
+				{80.56310701954536, -64.90364408187253, 11.633060737752235},
+				{88.93591838911621, -70.73920032733797, 12.567399440347767},
+			},
+			{
+				{21.32534435089526, -24.991512692085113, 5.450206770050486},
+				{27.344890998875712, -28.58327578779204, 5.958663269140895},
+				{30.701712967673778, -30.573021317905667, 6.24358482758205},
+				{33.2611306984182, -32.09468619969664, 6.464393191367174},
+				{35.51259888950266, -33.44881099436966, 6.663778934503596},
+				{37.65922071720966, -34.760965960372026, 6.859730355976377},
+				{39.81550136663964, -36.1027832047956, 7.062647327230208},
+				{42.06279142441423, -37.526105132095026, 7.280201278472859},
+				{44.47147090075566, -39.07680745773846, 7.519326931488385},
+				{47.11304130107987, -40.80251414274856, 7.787361506567722},
+				{50.06907217775561, -42.758495338439246, 8.092944903874873},
+				{53.44026846606342, -45.01382578666134, 8.446987269209433},
+				{57.3582773689588, -47.65955396099776, 8.863974836380878},
+				{62.00351782644239, -50.82115648260437, 9.363978075179872},
+				{67.63454307986049, -54.679152785216594, 9.975991033080414},
+				{74.63982506802769, -59.50557434633764, 10.743856429529558},
+			},
+			{
+				{17.48575443555493, -21.018338070087452, 4.783173705020151},
+				{22.20620427446792, -23.888379982724754, 5.193921791529274},
+				{25.185688449611902, -25.703266125216413, 5.457920076565075},
+				{27.54303720313567, -27.14754931717348, 5.671083119913498},
+				{29.647119480552636, -28.449544475437587, 5.865787127416784},
+				{31.66402224185249, -29.712985151978497, 6.056898343562583},
+				{33.69126478959429, -30.999544276099584, 6.253384867293079},
+				{35.80011220926176, -32.35505499381249, 6.462037046412006},
+				{38.053267880480874, -33.82059784361248, 6.6890613319290475},
+				{40.51483000519625, -35.43887151710602, 6.941018747855235},
+				{43.25796244284154, -37.2592398225923, 7.225592553451153},
+				{46.37285101868151, -39.3430949447072, 7.552426678220197},
+				{49.977038346688346, -41.77092757086029, 7.934249492632752},
+				{54.230841792868254, -44.65295397941499, 8.388577516948954},
+				{59.36239478365701, -48.14644409132331, 8.940506465169861},
+				{65.71112966495535, -52.485882421806174, 9.627583970634502},
+			},
+			{
+				{14.344285857057713, -17.992599840338887, 4.30378565282627},
+				{18.387906443635913, -20.485868684508073, 4.663171052106638},
+				{21.124131717468902, -22.181955858940906, 4.912144409834895},
+				{23.334245699516135, -23.561148222076632, 5.117686987814225},
+				{25.32211889758065, -24.812649845114418, 5.3065503230564435},
+				{27.233785393542426, -26.028302471280426, 5.491875684206153},
+				{29.15752017634012, -27.264305686117737, 5.681826929433201},
+				{31.159075271110705, -28.56315446863892, 5.882691304515114},
+				{33.29710787357734, -29.963351982005985, 6.100271972924987},
+				{35.632105040781724, -31.505133214264816, 6.340729131124822},
+				{38.23348525356242, -33.23512850107507, 6.611287190843586},
+				{41.187170318435896, -35.211434644792945, 6.9210241219649316},
+				{44.605542196884926, -37.51036064175005, 7.281938189450727},
+				{48.64234002347324, -40.236578215118655, 7.710566635895916},
+				{53.516865414288816, -43.53966270408247, 8.230635881925394},
+				{59.556024924312275, -47.64287277392745, 8.877686969501436},
+			},
+			{
+				{11.602529631964321, -15.496664001282484, 3.9307032014633294},
+				{15.259447125924396, -17.776452671543225, 4.260831029391421},
+				{17.844015492181708, -19.398949168484776, 4.500373515990824},
+				{19.953249378886866, -20.732363438994287, 4.7003655144054814},
+				{21.854761730922718, -21.944108009784504, 4.884383243435135},
+				{23.684731641672187, -23.120280863318214, 5.0647113444126415},
+				{25.527273126720704, -24.31468610964965, 5.249146194632069},
+				{27.44576679650918, -25.568411015278848, 5.4437498403969835},
+				{29.497296780056985, -26.91894959447325, 5.654153769374453},
+				{31.741226516358733, -28.4057126330076, 5.886366381523735},
+				{34.246206925891286, -30.074621177927487, 6.147467340366865},
+				{37.09785440539423, -31.983218929605272, 6.446400008356468},
+				{40.409043762660794, -34.2075862417731, 6.795059388066891},
+				{44.335503338271266, -36.852859105506965, 7.20996085264743},
+				{49.101427149561026, -40.07053892010455, 7.714999219848408},
+				{55.04448050153993, -44.08894412692491, 8.346318321353863},
+			},
+			{
+				{9.055081044725949, -13.292148762427775, 3.6201840792423567},
+				{12.491821171884373, -15.453872764832472, 3.9340252111774032},
+				{14.995448212832756, -17.04112005061319, 4.169180685318098},
+				{17.044222313173595, -18.349287660502274, 4.366242477330877},
+				{18.88599773388547, -19.533987506207826, 4.547008269840507},
+				{20.654408361425766, -20.680066434188166, 4.7235354299599},
+				{22.433441439700808, -21.84144272768916, 4.903607464027261},
+				{24.286669904508322, -23.059414229223968, 5.093293043471665},
+				{26.271585423733185, -24.37174380142337, 5.298238751964774},
+				{28.44835494899676, -25.818293047997898, 5.5244916666941615},
+				{30.887133990639093, -27.445806093586313, 5.779219681029608},
+				{33.67627753404813, -29.313347313643416, 6.071550519597217},
+				{36.933598703134265, -31.499805588601763, 6.413746457818899},
+				{40.82376058526331, -34.11551852829814, 6.823038480093261},
+				{45.58738421515878, -37.32175016581261, 7.324714448412197},
+				{51.593287513548056, -41.3656687681292, 7.957683995358915},
+			},
+			{
+				{6.547575359540858, -11.221226397920093, 3.345030297317117},
+				{9.874249774483392, -13.329147444780089, 3.6512929641755787},
+				{12.358460246393651, -14.91667001494233, 3.8868503889447323},
+				{14.386387518966671, -16.222010779640286, 4.084019388132282},
+				{16.194226765113566, -17.393787134878146, 4.263462287542936},
+				{17.91907528575445, -18.519334816592423, 4.437521694422794},
+				{19.64868567703677, -19.655153129013804, 4.614311661684014},
+				{21.44936957779906, -20.844385452095135, 4.800138103919175},
+				{23.380925855698028, -22.12630113010155, 5.000839883537348},
+				{25.50608640503419, -23.54237745468358, 5.222672338641612},
+				{27.898547067388943, -25.141541298823036, 5.473092524438872},
+				{30.65217035449418, -26.986232240916614, 5.761689650332369},
+				{33.893908610449955, -29.160954500127172, 6.1015160894297695},
+				{37.80426502080793, -31.785838080002602, 6.511214625372949},
+				{42.652450710302574, -35.039950070092814, 7.018693331782244},
+				{48.86141479121877, -39.204419484338445, 7.667949542796698},
+			},
+			{
+				{3.9405389100544204, -9.157529947828227, 3.085161690689208},
+				{7.241046319058764, -11.261577840526991, 3.3905201443381388},
+				{9.762987354103013, -12.883697777687035, 3.6311056935812083},
+				{11.814461274442799, -14.21301858218949, 3.8320827053626005},
+				{13.617578161850698, -15.38924847990395, 4.012650883621525},
+				{15.317351961206482, -16.50496910719556, 4.185793201489899},
+				{17.0096584643885, -17.622052790269, 4.36033418696979},
+				{18.766606620452137, -18.787473298050177, 4.543091594909198},
+				{20.65250179462612, -20.04344815775407, 4.740298073716919},
+				{22.73453700611685, -21.434323452955116, 4.9585941651081855},
+				{25.092046789476353, -23.012599418378134, 5.205924741641019},
+				{27.82727814544516, -24.845991506142713, 5.492615223076534},
+				{31.08088000675144, -27.027611200612608, 5.8329453646808105},
+				{35.05715602517979, -29.692571316527307, 6.247736575804416},
+				{40.06894617152391, -33.04748977016171, 6.7689730377374175},
+				{46.62403826828338, -37.42727305064485, 7.448732820776247},
+			},
+			{
+				{1.079473590447894, -6.975403674676512, 2.822358679207385},
+				{4.431779943949345, -9.122978744799294, 3.1329957297864146},
+				{7.044984311750058, -10.81252716232734, 3.3829317321678576},
+				{9.172908819344148, -12.198871810209708, 3.5922537126580796},
+				{11.010568145570177, -13.404067077124298, 3.7774090160879874},
+				{12.70905182814254, -14.52456550584132, 3.951762974941907},
+				{14.37694087998452, -15.63054592568829, 4.125231357642232},
+				{16.096341796190295, -16.775552787254835, 4.3055148220959305},
+				{17.939107813219827, -18.006754050605384, 4.499513622346371},
+				{19.97930087046565, -19.372957565666724, 4.714471905383149},
+				{22.304344167161318, -20.93185495943463, 4.959045207701395},
+				{25.028165727852258, -22.758597204803152, 5.244595980590289},
+				{28.310532922249042, -24.9584206540971, 5.587129109828567},
+				{32.38965477723224, -27.68792303249594, 6.010580443330821},
+				{37.64262098708402, -31.194463496486488, 6.552940982156303},
+				{44.707955216801466, -35.8959793840528, 7.278722215534873},
+			},
+			{
+				{-2.2405268239026306, -4.520710793224884, 2.5360043861054393},
+				{1.251791739601307, -6.766332398439234, 2.8587787392635975},
+				{4.014233343863916, -8.559339348244448, 3.122627692054681},
+				{6.276556707804434, -10.039304826337323, 3.345172923869684},
+				{8.205307899683152, -11.309538673726228, 3.539931617245749},
+				{9.941149875876537, -12.459343262532467, 3.719015615409288},
+				{11.604503815127195, -13.566503773131394, 3.8932619703680267},
+				{13.292779488951115, -14.694590273436948, 4.071702733292396},
+				{15.09017230287887, -15.898885101225796, 4.262329784287963},
+				{17.081249258580836, -17.235070565170407, 4.473314047768243},
+				{19.364786583914224, -18.768189680777482, 4.714308892798939},
+				{22.070472524314315, -20.583495556091258, 4.998069917006237},
+				{25.3840191250011, -22.802749407352092, 5.342926675260135},
+				{29.59115136648904, -25.612715320286597, 5.777142869020868},
+				{35.1635241825285, -29.320703808663772, 6.34746847829162},
+				{42.94499976440107, -34.47479196632215, 7.137752242878788},
+			},
+			{
+				{-6.33503673594793, -1.5670417632769313, 2.197528288622573},
+				{-2.585479908161222, -3.9836370054279904, 2.5411606224633303},
+				{0.40640053949785304, -5.930316214037885, 2.825022368576373},
+				{2.868120800594148, -7.54486984496035, 3.0659529661672735},
+				{4.957477843363228, -8.924519899139177, 3.276254977721058},
+				{6.7968795316891795, -10.146120560360325, 3.465995394062923},
+				{8.498281110568996, -11.281456745114191, 3.64491690851473},
+				{10.171551910898737, -12.402110646699114, 3.8230080117500873},
+				{11.919585043450603, -13.575660027827709, 4.009884149136164},
+				{13.843823589295624, -14.868901160628303, 4.21520004164001},
+				{16.05960188397115, -16.357694830221703, 4.45005564499246},
+				{18.717904214930872, -18.14103531447487, 4.7290633252227785},
+				{22.039286839721797, -20.362940324866294, 5.07362010890674},
+				{26.375940217978982, -23.252360491578123, 5.517934073188081},
+				{32.341015712500806, -27.20607224479625, 6.121630640801472},
+				{41.11475631796147, -32.98434632080926, 6.999704840306723},
+			},
+			{
+				{-11.76031788074009, 2.273850044840114, 1.7600146962934564},
+				{-7.571496151394006, -0.4265351630716466, 2.1370816778268242},
+				{-4.221869533966675, -2.6069878184877973, 2.4501492761422314},
+				{-1.4646497897434343, -4.4163017962536415, 2.716583570662373},
+				{0.8673822463519455, -5.956934021415872, 2.948823632418783},
+				{2.896109919022455, -7.304718677154961, 3.1564000711352977},
+				{4.720223166772075, -8.522084664449752, 3.3474331248875178},
+				{6.43422716523752, -9.669918465917931, 3.530127348025892},
+				{8.145717353422393, -10.81861157532056, 3.714254678378495},
+				{9.97859133741273, -12.049762198054855, 3.911365140664411},
+				{12.074553616265456, -13.456628133729176, 4.134838608940724},
+				{14.615037926708064, -15.158049164596964, 4.401893798090019},
+				{17.867618728794508, -17.328210891411622, 4.737950212913765},
+				{22.277575404295863, -20.255111065587414, 5.1852939127028845},
+				{28.67506973306443, -24.47216421023426, 5.822749415985026},
+				{38.8273954131968, -31.10739338671919, 6.818297646390174},
+			},
+			{
+				{-19.654773068607664, 7.780389653571673, 1.1338306020950963},
+				{-14.690276508006589, 4.590879128110196, 1.5648684186010227},
+				{-10.746948810454, 2.0313286902936447, 1.9223776160230703},
+				{-7.522239603587151, -0.07885354844870883, 2.225831312310858},
+				{-4.816325234576339, -1.8610686592697465, 2.4890485707586203},
+				{-2.4892258545576413, -3.4016055940657273, 2.7222706888025696},
+				{-0.43584987820799964, -4.766119415605969, 2.9335802929753503},
+				{1.4308286538437878, -6.009729307037603, 3.130008814106925},
+				{3.19475476588014, -7.186384560526463, 3.3186876073561034},
+				{4.956908800878524, -8.361873621014938, 3.5085913301317175},
+				{6.866226911125678, -9.63400242063944, 3.7133483130320744},
+				{9.14624322002997, -11.14939912958376, 3.953631633525624},
+				{12.132566175569732, -13.126467015807176, 4.260477495904922},
+				{16.40130109925444, -15.936411678086218, 4.686986595216659},
+				{23.12291805250266, -20.325913735047834, 5.340740806446921},
+				{35.18332060336351, -28.117134708702743, 6.486771217086425},
+			},
+			{
+				{-32.88591020761334, 16.86048330676943, 0.11887667311925118},
+				{-26.357786997946775, 12.718037447132016, 0.6407883655740385},
+				{-21.28803974940754, 9.461318895153005, 1.0704861207889476},
+				{-17.222417660484318, 6.8267213343669715, 1.4315454350170966},
+				{-13.874628855593171, 4.644154351534841, 1.7407696758663935},
+				{-11.05279816044277, 2.797524368091621, 2.010479837204337},
+				{-8.621011529652618, 1.203502457419492, 2.249996403481942},
+				{-6.476591167222523, -0.20148752286457877, 2.466742516194584},
+				{-4.534674170849059, -1.470413155650001, 2.667149782492902},
+				{-2.7147878748773366, -2.6538919976289552, 2.857600496251273},
+				{-0.9232814319374341, -3.8113549408562535, 3.045847346747723},
+				{0.9825027346858908, -5.034198671697693, 3.244119374777105},
+				{3.294494363014735, -6.510744545545632, 3.4779942501340306},
+				{6.723650633813948, -8.698741810750924, 3.8101201374300104},
+				{12.98952286546002, -12.689839492579642, 4.39088104492468},
+				{27.377986036260452, -21.776985305636924, 5.679294163414301},
+			},
+			{
+				{-62.680345171018956, 36.69529184881515, -1.919427823517544},
+				{-51.17142746303461, 29.586113454434805, -1.1630385844635043},
+				{-43.062713574045915, 24.533098013741494, -0.5926123701180448},
+				{-36.98104858247041, 20.714308960604537, -0.1362808318550254},
+				{-32.23617677915714, 17.721002324785427, 0.24060072340768104},
+				{-28.438358355822132, 15.325035719105749, 0.5568713361143712},
+				{-25.345471853164597, 13.385600301751534, 0.8243268234367339},
+				{-22.79521027012415, 11.808709446110365, 1.0512629840912437},
+				{-20.672470342095423, 10.528755463394623, 1.243765462958739},
+				{-18.890492705882814, 9.498275877527032, 1.4063613875680776},
+				{-17.37636296653482, 8.680206183334846, 1.5426148789085647},
+				{-16.054240422999257, 8.038910619025941, 1.655997583126324},
+				{-14.813862292322465, 7.522856378441854, 1.7518177355832782},
+				{-13.417215791735641, 7.0108627809606725, 1.8435630829495961},
+				{-11.038806211881507, 6.036981292741378, 1.9862426458780935},
+				{-2.302051363865016e-12, 1.5952026554731197e-12, 2.7452511792022456},
+			},
+		},
+		{
+			{
+				{34.96735977694018, -45.12422204413995, 9.567363670747728},
+				{59.16033542223115, -58.583587130637696, 11.397304798905962},
+				{63.65878428249488, -60.54852028248194, 11.615724894460365},
+				{66.45105422066395, -61.66270466375962, 11.729993978123813},
+				{68.81330327132122, -62.665075279885244, 11.843414748345008},
+				{71.15760675130979, -63.78453152031408, 11.987066558737896},
+				{73.68079756177309, -65.12774809246727, 12.175235966338068},
+				{76.51507151165362, -66.76877360474613, 12.418199665718465},
+				{79.78432131599108, -68.78304215536008, 12.727148145015065},
+				{83.6302885933643, -71.26361238419237, 13.116605141589513},
+				{88.23275667675853, -74.3345415572928, 13.60652621732602},
+				{93.83645632909587, -78.1698239342134, 14.225387028006265},
+				{100.79532638517517, -83.02552415233352, 15.015515742810956},
+				{109.65228982004815, -89.29849431399083, 16.04291283510594},
+				{121.2971909847729, -97.64334305351258, 17.41688466713776},
+				{137.31623501441408, -109.23217118070573, 19.33374046343427},
+			},
+			{
+				{31.985778167026275, -35.79632745797101, 7.532093273439528},
+				{40.14583319725779, -40.533373220045654, 8.199074799786116},
+				{44.043734781206865, -42.7276384350007, 8.507169691604071},
+				{46.94980230666911, -44.36458652938382, 8.740272655199234},
+				{49.514958972590364, -45.84040898993607, 8.955300178101226},
+				{51.99944472599572, -47.31371336104571, 9.175147899458295},
+				{54.54889304039601, -48.87427922552569, 9.412957423270408},
+				{57.268763966606826, -50.588806796312376, 9.678734123042148},
+				{60.25522106710911, -52.52025406886891, 9.982195109001875},
+				{63.61221745397759, -54.73890450789273, 10.33445205114939},
+				{67.4649638726568, -57.33147888759614, 10.749451502962572},
+				{71.97565890400332, -60.41215703040387, 11.24576675151574},
+				{77.36682246478443, -64.13924241440328, 11.849345088437012},
+				{83.96011497913415, -68.74315186216167, 12.59815341402888},
+				{92.24631502919208, -74.57713328430464, 13.550628134998595},
+				{103.02217900846634, -82.21678878616078, 14.802299128546808},
+			},
+			{
+				{25.81020150414606, -29.00815011452387, 6.316291780215137},
+				{31.357579146830997, -32.341726771170045, 6.797411457378465},
+				{34.70342145799165, -34.34659311482675, 7.09110969057887},
+				{37.36876163855889, -35.95652517577422, 7.330891368847314},
+				{39.778838529814976, -37.434609923752454, 7.5547043633028075},
+				{42.123451474076944, -38.89926170339789, 7.7798081506912435},
+				{44.51661639587814, -40.42271152403928, 8.016911841144118},
+				{47.04472874664187, -42.06093180245623, 8.27450328738155},
+				{49.787593147736075, -43.866926732197776, 8.560806744167964},
+				{52.831010118640165, -45.898932939272306, 8.885029544257089},
+				{56.27724181382479, -48.22748778099247, 9.258476076207737},
+				{60.25702872402838, -50.94376973894454, 9.695896896961225},
+				{64.94673969192654, -54.171679518574706, 10.217469432543671},
+				{70.59588639381087, -58.08736709854589, 10.852020850661162},
+				{77.57476336013843, -62.95318361597939, 11.642653398824315},
+				{86.46308739412879, -69.1810262141787, 12.657267815322651},
+			},
+			{
+				{21.441320731084407, -24.616144178849872, 5.578376245835377},
+				{25.883330935298442, -27.348184555277168, 5.978857185167146},
+				{28.865169936993688, -29.191491537785318, 6.254289747110323},
+				{31.32528557103917, -30.726749530051094, 6.487549005680984},
+				{33.582616661766934, -32.153254980701924, 6.707391362445547},
+				{35.79059131324398, -33.56789752337073, 6.927975553218374},
+				{38.04571566241329, -35.03257835318854, 7.158512103532001},
+				{40.42355839017721, -36.59673510047681, 7.406517424180289},
+				{42.99516484575543, -38.30778025923387, 7.679350269593112},
+				{45.83740484437157, -40.217862856609145, 7.985238885364594},
+				{49.0420260239612, -42.38996598034233, 8.334239645872831},
+				{52.726218211606955, -44.90517928541917, 8.739408632976776},
+				{57.04757915948212, -47.87312255016005, 9.21850277532798},
+				{62.22782048467715, -51.44855287801343, 9.7967081138556},
+				{68.59321890058472, -55.8597947421611, 10.511328585356827},
+				{76.64859237152676, -61.460842643868354, 11.420402374917389},
+			},
+			{
+				{18.06871402698622, -21.417725477105197, 5.074660834179935},
+				{21.922362158574778, -23.828955456310027, 5.431997226759467},
+				{24.666353477041948, -25.559906902372454, 5.693927845139805},
+				{26.975881540441858, -27.03104112179242, 5.920301956110109},
+				{29.113834304743705, -28.407882383671506, 6.134979233138047},
+				{31.2139051892732, -29.775565919663666, 6.350404758206779},
+				{33.3629495061269, -31.190234566658106, 6.57493876517406},
+				{35.6306666395232, -32.69773350274115, 6.815552434228793},
+				{38.08370722395121, -34.34263004883879, 7.079154554790307},
+				{40.794942685698565, -36.17428540055501, 7.373513427909633},
+				{43.85195445132361, -38.25255853960514, 7.708152045600158},
+				{47.36721773873968, -40.65476899401182, 8.095466056763613},
+				{51.49266248176466, -43.485742586791346, 8.552356734782565},
+				{56.44279605898741, -46.89383411746869, 9.10285241142265},
+				{62.534214254305304, -51.0983718547662, 9.782616293501938},
+				{70.25797589761703, -56.44000889916392, 10.647240044842007},
+			},
+			{
+				{15.239528483189332, -18.87325361605896, 4.702270921856314},
+				{18.759069096758722, -21.105789697671298, 5.035850183557152},
+				{21.352838914524103, -22.766971218572312, 5.289597596237662},
+				{23.558325116307607, -24.19329306491952, 5.511240377380249},
+				{25.608692744910197, -25.532498189936298, 5.722034695596467},
+				{27.627797036830014, -26.86397751880216, 5.933575250027001},
+				{29.697899295588222, -28.241206200823065, 6.15381975213076},
+				{31.88615386043706, -29.708515619050065, 6.389501777313223},
+				{34.257708864945975, -31.30950983423317, 6.647367427032086},
+				{36.88462215219903, -33.092917631055585, 6.935063335254142},
+				{39.854361443479725, -35.11829155227545, 7.262029635944719},
+				{43.28032540995866, -37.46315926336688, 7.640644761098264},
+				{47.31717049137654, -40.23351232586236, 8.08792411503674},
+				{52.185470866172146, -43.58073535692412, 8.628279146706179},
+				{58.21440254946826, -47.73095801782942, 9.298320254715081},
+				{65.92111019754277, -53.039694116808455, 10.155824125868183},
+			},
+			{
+				{12.688761030124414, -16.697149330458558, 4.409292682491348},
+				{16.030175646684967, -18.841172884392815, 4.731664983034057},
+				{18.542293709694654, -20.47013102783471, 4.982377882130597},
+				{20.683614006049492, -21.872291393583794, 5.202117928548915},
+				{22.674021488440943, -23.187743602081447, 5.410982354233171},
+				{24.63470238065715, -24.494599448612906, 5.620356367541393},
+				{26.64723454685573, -25.84613248570444, 5.838153338343948},
+				{28.77884764160908, -27.286847004803192, 6.071119053967443},
+				{31.095443108672477, -28.860877646673014, 6.326063778484405},
+				{33.67072240301339, -30.61799051098228, 6.610771719415591},
+				{36.59519072115035, -32.61960739092687, 6.934943062454309},
+				{39.9876584346529, -34.94658333355531, 7.311435137432689},
+				{44.0124109325919, -37.71087435696592, 7.7581450068953375},
+				{48.90742528967146, -41.07475254581326, 8.301129122781488},
+				{55.03431410808317, -45.284853132236506, 8.980153976055565},
+				{62.97366370219797, -50.7372168162804, 9.859334324249478},
+			},
+			{
+				{10.231983566235188, -14.708899431051448, 4.1650224384172505},
+				{13.50887839881712, -16.832540035939896, 4.485821948811824},
+				{16.001842064554488, -18.466480631912816, 4.738857202593251},
+				{18.117169171548895, -19.866857713976046, 4.9600173385405375},
+				{20.073195338479838, -21.173415980128624, 5.169265800901964},
+				{21.994823903887355, -22.467052569001154, 5.378356261622723},
+				{23.96668049623875, -23.80324930677837, 5.595505326011405},
+				{26.058562263827625, -25.228320570626618, 5.827711297754971},
+				{28.33920658237221, -26.788327467919338, 6.082057833603706},
+				{30.886189934392032, -28.535593431721892, 6.366698923659743},
+				{33.795956785280694, -30.535400853205438, 6.691903082643425},
+				{37.197019809095, -32.87487649447832, 7.071466236788047},
+				{41.270235283362204, -35.676684929967635, 7.524912332461931},
+				{46.28307580218412, -39.122203495534976, 8.08124078635498},
+				{52.65221962550846, -43.49386657603549, 8.785803692423915},
+				{61.067592087763245, -49.259120835519866, 9.713990625844449},
+			},
+			{
+				{7.710836200035307, -12.76936930629277, 3.948001241695252},
+				{11.017760076921189, -14.931360939713523, 4.2756088929827785},
+				{13.55787972946086, -16.612135024809582, 4.537133255452885},
+				{15.690258648956515, -18.038043291700387, 4.763917451869965},
+				{17.639269134457063, -19.353186301423296, 4.976415766069517},
+				{19.54029686165157, -20.645620257884573, 5.187361626940181},
+				{21.485402891222396, -21.9759180936156, 5.405685778440249},
+				{23.549845611028953, -23.394133530083664, 5.638918487388154},
+				{25.807477700342933, -24.949797200141145, 5.894644243174264},
+				{28.34208884000141, -26.699389089694666, 6.181626529736365},
+				{31.259112404610324, -28.714140629585973, 6.5110192771032125},
+				{34.70145301603014, -31.090645262671757, 6.8980466257275275},
+				{38.87458049655814, -33.96771451983034, 7.364699404710517},
+				{44.09046312150903, -37.555900614372554, 7.944487714755881},
+				{50.851137392663745, -42.19365378153007, 8.691523973440546},
+				{60.02283823624539, -48.46434577394572, 9.699529047167772},
+			},
+			{
+				{4.953210296986937, -10.742553235920793, 3.7395878038994654},
+				{8.380353413706898, -13.000701480902462, 4.0822517858885154},
+				{11.044204600480466, -14.778441655304091, 4.359697331513065},
+				{13.251028000871816, -16.26798843501102, 4.59801507636747},
+				{15.228897952571439, -17.615965262622634, 4.817794455437083},
+				{17.1307191566807, -18.92215836580074, 5.033363874471052},
+				{19.062020139759746, -20.25623508824636, 5.254930335622362},
+				{21.107577343971293, -21.674719377960788, 5.490946290531635},
+				{23.349320622344056, -23.23263363625305, 5.749790158703741},
+				{25.880037275773134, -24.992532373095003, 6.041116804095802},
+				{28.817677380324938, -27.0340206355648, 6.377326370999037},
+				{32.32512481043508, -29.46696174610389, 6.775643274186525},
+				{36.64263893753829, -32.45316587756976, 7.261566587941862},
+				{42.14712861604015, -36.24600093536074, 7.875213203156518},
+				{49.47091449666995, -41.26971152551245, 8.684085958983287},
+				{59.76600079999803, -48.29586850315634, 9.811614219882038},
+			},
+			{
+				{1.7249723065727438, -8.457326628925207, 3.5184998149515674},
+				{5.379055783773218, -10.881657128531176, 3.88609952086697},
+				{8.254936881853395, -12.815507036198197, 4.188197136914495},
+				{10.61922481662648, -14.425041211693461, 4.446720085976771},
+				{12.683651143488026, -15.845770782073986, 4.68031076998593},
+				{14.619154887249222, -17.189388336181285, 4.904822696243554},
+				{16.552868902613984, -18.540072016850292, 5.132478586589871},
+				{18.585664703854263, -19.965266437799613, 5.373280244567467},
+				{20.81214717983789, -21.528644762799694, 5.636851324801363},
+				{23.33789839362066, -23.301476904382437, 5.934119098247544},
+				{26.297781944828223, -25.374795026446815, 6.279174845305353},
+				{29.881647146109113, -27.87651735370117, 6.691948496662663},
+				{34.37805655158793, -31.00056453102078, 7.2028081469358884},
+				{40.25840062762163, -35.06279409648139, 7.861458633880765},
+				{48.35695218087094, -40.62057738248535, 8.756076692292128},
+				{60.30782840829205, -48.7627488482848, 10.059976524266332},
+			},
+			{
+				{-2.355119710059222, -5.646339609511964, 3.253076964778917},
+				{1.6814219009544589, -8.339980658625082, 3.6598055992857947},
+				{4.884176558866939, -10.5078084874884, 3.997855319214305},
+				{7.51688195368892, -12.313596023336315, 4.288194962570519},
+				{9.768701522988744, -13.877244682151858, 4.546774485855249},
+				{11.803972175408733, -15.305553518535763, 4.788401178792725},
+				{13.772414657919066, -16.69774548722829, 5.027279318419143},
+				{15.801146339482136, -18.13906326198727, 5.275902976265235},
+				{18.005859695865023, -19.70762870106138, 5.545958397945018},
+				{20.51114460010277, -21.487787117679318, 5.850265013425506},
+				{23.474768453776676, -23.586233318674758, 6.2052091851492515},
+				{27.122459663578592, -26.155145663905916, 6.634318305222762},
+				{31.809286166100325, -29.432930689818047, 7.174636142699115},
+				{38.14524646982515, -33.82738324706966, 7.889837618553955},
+				{47.28802916441693, -40.10908358667942, 8.900934480212674},
+				{61.74188319648005, -49.93822474133624, 10.470506805219937},
+			},
+			{
+				{-8.016065780650173, -1.806839062617364, 2.8845091054201895},
+				{-3.3308016075429108, -4.946083332296816, 3.3536253424271476},
+				{0.3902289564081069, -7.477463433217992, 3.7457774830921786},
+				{3.4453541321992276, -9.585852913981267, 4.083769218488196},
+				{6.034703354652802, -11.397388516203698, 4.383770710233097},
+				{8.308066120355498, -13.008271191100524, 4.658598026847225},
+				{10.40186463879624, -14.508174469231422, 4.920711354741481},
+				{12.462087520781095, -15.995011501895823, 5.18420121742914},
+				{14.63794623860239, -17.56993819073754, 5.463977642725935},
+				{17.085579055090292, -19.33920863664383, 5.775991382890268},
+				{19.995539579222164, -21.432310771056603, 6.1399232754804745},
+				{23.640962598548043, -24.033988023630663, 6.584053434427513},
+				{28.467045058369457, -27.443553784121235, 7.154414448656725},
+				{35.28820188452758, -32.205017867776995, 7.935055860571924},
+				{45.802300959469264, -39.445111367485005, 9.101101342396653},
+				{64.24717882517015, -51.95853356247436, 11.090596881128807},
+			},
+			{
+				{-16.935779812869903, 4.210326408775881, 2.278826621361539},
+				{-11.049266510716723, 0.2643524111183295, 2.8547002510438295},
+				{-6.423034018503682, -2.889132806097867, 3.335018504108504},
+				{-2.658330760591731, -5.496058334737623, 3.748328344428319},
+				{0.5002574031392616, -7.716730488045091, 4.1141833438059345},
+				{3.2286668248276023, -9.663381900876962, 4.446869799382051},
+				{5.659951006118132, -11.422388480197986, 4.757856042473149},
+				{7.911618305725949, -13.071606031866112, 5.057940993236827},
+				{10.11843211654383, -14.702188378440459, 5.3602662984308775},
+				{12.46460978670156, -16.440242672102414, 5.683447580963033},
+				{15.191737354000765, -18.451439687986568, 6.05229163611226},
+				{18.637811722837856, -20.96636157394559, 6.5016223407572},
+				{23.364071970732045, -24.364540174984555, 7.0889870186826505},
+				{30.47683136378006, -29.38667886109376, 7.9270429090785175},
+				{42.607624240817266, -37.77631331474861, 9.28239117216191},
+				{67.98539834798287, -54.927407509800304, 11.988102971724459},
+			},
+			{
+				{-34.231349833104325, 15.86773849518028, 1.0458350889626387},
+				{-25.581605057503896, 10.126430086881292, 1.8328620676180372},
+				{-19.0209239141382, 5.682298615647704, 2.479363480355204},
+				{-13.830940712778558, 2.1026069712128153, 3.028094803467157},
+				{-9.581998693445605, -0.8786959649095143, 3.508211883692834},
+				{-5.998820686527805, -3.435464139851721, 3.940129452896347},
+				{-2.8928580951733975, -5.688836767358819, 4.338926094889949},
+				{-0.12455854970992619, -7.7300301158734515, 4.716621090305802},
+				{2.421489315261099, -9.635890430679204, 5.0839379417870365},
+				{4.859490138322528, -11.483880889883395, 5.452237335904285},
+				{7.339639752089002, -13.37727459665773, 5.8370673648808},
+				{10.146527907637866, -15.512567880077233, 6.267951223457681},
+				{13.916427963460734, -18.326646947779896, 6.809946208833579},
+				{20.07831266139514, -22.78705734362845, 7.606483268298528},
+				{32.74512901031825, -31.627377613594582, 9.062623214185823},
+				{71.5947076488553, -57.639746861245435, 13.09334565952813},
+			},
+			{
+				{-89.54759059057615, 52.07596403382456, -2.4747561324064495},
+				{-68.04398726354037, 38.647502802598176, -1.1100950005030767},
+				{-54.39855106160339, 29.883639444586926, -0.09519990921755166},
+				{-44.671912812058686, 23.448704113054163, 0.7445376102171561},
+				{-37.351005566031695, 18.486618177032312, 1.4577197890929525},
+				{-31.67237090112314, 14.563016941890536, 2.0715302846805863},
+				{-27.17662882444491, 11.403995078037745, 2.6098402818097544},
+				{-23.57917400369576, 8.836983985343831, 3.090499658879345},
+				{-20.706162902346094, 6.75897453908045, 3.5255918819296697},
+				{-18.462606203230276, 5.121032993159991, 3.92194816290401},
+				{-16.820966949803253, 3.926403352175381, 4.280715133460249},
+				{-15.82783438267856, 3.244549883525589, 4.595079148630448},
+				{-15.636444522989828, 3.2545181989979146, 4.84393318688192},
+				{-16.58438885173389, 4.356945285542011, 4.975306345010228},
+				{-19.225191968183232, 7.413826131245634, 4.867371182884155},
+				{-2.0396292964079777e-11, 1.3497064912013707e-11, 5.977080004640934},
+			},
+		},
+		{
+			{
+				{39.48236636967446, -48.66898328723262, 10.224817300230063},
+				{61.6166011776507, -60.88999491350392, 11.881183836441016},
+				{66.04296782034936, -62.77765169895261, 12.085382130649352},
+				{68.83075040618345, -63.86725243081157, 12.194220810996585},
+				{71.22119981110693, -64.87461835238445, 12.307689482125854},
+				{73.62185233347626, -66.02453434268845, 12.456198539338654},
+				{76.22912496605113, -67.42265878623648, 12.653845429272955},
+				{79.17843613382637, -69.14488334984583, 12.911201398952896},
+				{82.60052507817667, -71.27138683381929, 13.240262083213906},
+				{86.64784885598158, -73.90308446954988, 13.656914865716493},
+				{91.516381511098, -77.17619559579067, 14.183247213833752},
+				{97.47618120306214, -81.28392940951855, 14.851112293214475},
+				{104.92278410825115, -86.51402342361402, 15.708402860254328},
+				{114.47160963337566, -93.31857391925664, 16.830806199030697},
+				{127.14927312647788, -102.45636030780699, 18.345843907383017},
+				{144.82968556334959, -115.31840009142306, 20.487981912377123},
+			},
+			{
+				{33.82188277514622, -37.426259434421574, 7.873159528758608},
+				{41.711363315646025, -42.003072122523456, 8.51971954534792},
+				{45.60861946312236, -44.198992931753956, 8.829523731407539},
+				{48.55357290084473, -45.86365680950078, 9.068181172087577},
+				{51.17550993799173, -47.3805201906687, 9.290976051836138},
+				{53.73158993228134, -48.90656208629162, 9.520663425910177},
+				{56.36809353683728, -50.53223091660055, 9.770556564418209},
+				{59.19309547364496, -52.32622332812705, 10.051034785084214},
+				{62.30726138862344, -54.354860276082405, 10.37241628771097},
+				{65.82122958945702, -56.69342275902861, 10.746697046548832},
+				{69.86989297455636, -59.43589078231988, 11.18910507904802},
+				{74.62980104581024, -62.707218394733765, 11.720112559719507},
+				{80.34561757665055, -66.68233291204203, 12.368590589309733},
+				{87.37488075048225, -71.61856430579118, 13.177228116547909},
+				{96.27006128788197, -77.9153902032367, 14.21255144506216},
+				{107.94228863570193, -86.23402736524885, 15.585030754012356},
+			},
+			{
+				{27.23155892820721, -30.300299528918753, 6.59696354965475},
+				{32.66443811697722, -33.572572095075785, 7.072118154360851},
+				{36.01988201874701, -35.59079893289138, 7.369940345592888},
+				{38.726307058460485, -37.23423960617653, 7.616743363189997},
+				{41.19302897578308, -38.7567008703441, 7.849305267853804},
+				{43.60685871132806, -40.27507556050368, 8.084756365521859},
+				{46.082198736587046, -41.86214237270884, 8.333959247641959},
+				{48.7074912953117, -43.575508469719985, 8.60571744815303},
+				{51.56606164625504, -45.470846452676966, 8.908739403565939},
+				{54.7489538866608, -47.61031541451563, 9.252930665200575},
+				{58.36601029954109, -50.07010001593529, 9.650593767580693},
+				{62.5590665364444, -52.949628130331895, 10.117932105658763},
+				{67.52124806404586, -56.38523800729557, 10.677307659837046},
+				{73.52844087655642, -60.572623670100484, 11.360971910663036},
+				{80.99459953434138, -65.80643934126087, 12.21767337930704},
+				{90.57641510144744, -72.55544930692497, 13.325228301855928},
+			},
+			{
+				{22.713178918423466, -25.786977313939353, 5.838478510750387},
+				{27.088188648650657, -28.48812588039215, 6.237490279479787},
+				{30.0828917531472, -30.348730016190473, 6.517917901557531},
+				{32.58369007536194, -31.918994355644315, 6.758706015657028},
+				{34.89669909345407, -33.390756366851754, 6.987681743356471},
+				{37.17244242171889, -34.85941401254541, 7.218872412856035},
+				{39.50765108627646, -36.38730418085743, 7.461622082319367},
+				{41.979695689695575, -38.025326180882615, 7.72373326282306},
+				{44.66277055379052, -39.82328650033236, 8.013001591177872},
+				{47.63846785987845, -41.83685686017865, 8.338283366566621},
+				{51.005392793447015, -44.13409764625203, 8.710531600151455},
+				{54.89076806305623, -46.80349765189487, 9.144102863504834},
+				{59.46726147379022, -49.96575967136689, 9.658696110953002},
+				{64.98008002757389, -53.79287951072035, 10.282510651792037},
+				{71.79387892924206, -58.54128071987417, 11.057751156176652},
+				{80.47995989426389, -64.613535952504, 12.050909317899846},
+			},
+			{
+				{19.28430090348332, -22.547250145478067, 5.3298037390149835},
+				{23.09211175288542, -24.941570452071808, 5.687858173895848},
+				{25.85039604508164, -26.69197697235688, 5.955353526699589},
+				{28.20046980191757, -28.199293863920182, 6.189685115744938},
+				{30.39420688801096, -29.622654314958666, 6.413928438794496},
+				{32.56240115796047, -31.045688770069543, 6.640393145297489},
+				{34.79202946701637, -32.524904910020105, 6.877561875820331},
+				{37.15451176420274, -34.10755260135934, 7.1326828337322645},
+				{39.71956787201487, -35.84053138754593, 7.41309124338694},
+				{42.564711558932885, -37.77665361812146, 7.727170484610018},
+				{45.78431467164114, -39.980778963127975, 8.085325099152701},
+				{49.50084192352605, -42.537545296292365, 8.501233796770729},
+				{53.88128053788565, -45.562769233519404, 8.99371834965027},
+				{59.163662261777034, -49.22192511298425, 9.589791148604531},
+				{65.70306223798653, -53.762268346861774, 10.329973006631384},
+				{74.05728713750635, -59.5727694807397, 11.278242131881154},
+			},
+			{
+				{16.447492393829382, -20.004824378768536, 4.961435851601737},
+				{19.932794738428615, -22.22874008412637, 5.297199799114891},
+				{22.541398087183822, -23.910985262259334, 5.557052218593215},
+				{24.78773044489922, -25.374987232792403, 5.787177300894578},
+				{26.89506679628582, -26.762781684378087, 6.008146899010826},
+				{28.984256515352033, -28.152186144380437, 6.231412844540853},
+				{31.137585978436835, -29.5969848754782, 6.465049565733712},
+				{33.42393887303083, -31.142927990810378, 6.716072292663808},
+				{35.91163193605662, -32.83602491112455, 6.991667247343777},
+				{38.67757071087661, -34.728597621549035, 7.300120765749802},
+				{41.816384000789334, -36.8854498326973, 7.651796766085662},
+				{45.45210861053873, -39.391861797445024, 8.06042927761051},
+				{49.75559349546372, -42.365573269480336, 8.54508059195054},
+				{54.972975196499654, -45.97644714725015, 9.133373518202609},
+				{61.475747547540664, -50.48110172164268, 9.867206114628132},
+				{69.85555783600849, -56.28855531783358, 10.813615558982013},
+			},
+			{
+				{13.921789833898565, -17.861145068540022, 4.6792231316146475},
+				{17.237107371326307, -20.003130777245804, 5.005132798634546},
+				{19.763889566084448, -21.654585867700256, 5.262566111518435},
+				{21.946585800805067, -23.096347105702954, 5.491470636920197},
+				{23.996042613645248, -24.463354233331884, 5.711350496134846},
+				{26.030212734735283, -25.832017797665443, 5.933437132443398},
+				{28.130567093110802, -27.255854859851578, 6.165757113621034},
+				{30.3661241202408, -28.78084906667125, 6.415352764880257},
+				{32.80619065440169, -30.453720525010507, 6.689507962678493},
+				{35.52976129243443, -32.3281515068414, 6.99670341400084},
+				{38.6352623481951, -34.47135483794905, 7.347656038095396},
+				{42.253432487466426, -36.97284422705339, 7.756733339227222},
+				{46.56698887871496, -39.95788558780492, 8.244144764750734},
+				{51.84351574961986, -43.61003729035837, 8.839635157736327},
+				{58.49467832472035, -48.211776410395665, 9.58916854011606},
+				{67.1915397716928, -54.22368266530063, 10.567997176316648},
+			},
+			{
+				{11.516132147808758, -15.930921316865922, 4.451864449888169},
+				{14.775814971764024, -18.06025439227111, 4.777896399034761},
+				{17.282734182077647, -19.71827322284419, 5.038443791439069},
+				{19.439855230210284, -21.16057225298581, 5.269655478219867},
+				{21.45768265538117, -22.52258896851091, 5.491045303782333},
+				{23.457565675148306, -23.883356703028085, 5.714204021314073},
+				{25.52381094805439, -25.298500479037642, 5.947457576438679},
+				{27.728080871499742, -26.815902573553103, 6.198124004239527},
+				{30.142877322972897, -28.484489731220048, 6.473813505636537},
+				{32.85176094732518, -30.361012051667373, 6.783467172081076},
+				{35.9602835011638, -32.517399924581284, 7.138518896531934},
+				{39.61091479263303, -35.05088565524758, 7.554523145268707},
+				{44.006516270922326, -38.099969669150944, 8.05374774157684},
+				{49.45076743810745, -41.871950293752484, 8.669670609634348},
+				{56.423402706272114, -46.69418933597792, 9.455389337672036},
+				{65.73275046328423, -53.11810800370949, 10.500742134478068},
+			},
+			{
+				{9.069159687740438, -14.073891118312766, 4.25816865578042},
+				{12.372882795873277, -16.25355094394204, 4.593559733268954},
+				{14.926854782957797, -17.961164040883908, 4.863794722594373},
+				{17.100900880493548, -19.43169468818995, 5.10179645173187},
+				{19.114781330051255, -20.807161892684118, 5.327926263209569},
+				{21.10011919024062, -22.173709368647692, 5.554772365265177},
+				{23.148323844821153, -23.591900640878148, 5.791370586132893},
+				{25.336565826271162, -25.11345041553553, 6.045600886828434},
+				{27.74293399959036, -26.791136256892713, 6.325648555999938},
+				{30.458175213664553, -28.68659590578859, 6.641195427504308},
+				{33.5985460224508, -30.87896356200416, 7.004779783805553},
+				{37.32390577866326, -33.4770963550221, 7.433756090284008},
+				{41.86714514384045, -36.63949807844582, 7.953519091697815},
+				{47.58677610048672, -40.609940073497086, 8.603302519774408},
+				{55.06914255235479, -45.7867009295767, 9.447502977579301},
+				{65.34714027002515, -52.87077412359657, 10.600019229056267},
+			},
+			{
+				{6.408129248235467, -12.155597451304953, 4.08052313630347},
+				{9.854184857767812, -14.449995045052276, 4.434821410318653},
+				{12.536967424679299, -16.261980088378287, 4.723215141178324},
+				{14.78483183465887, -17.799690457654442, 4.9743950937477095},
+				{16.830302162330696, -19.21380472837634, 5.209787449368598},
+				{18.823538480205745, -20.603026741721155, 5.443737182747475},
+				{20.86903030054686, -22.036873458402603, 5.686566390669871},
+				{23.053411415535887, -23.573595205305, 5.947113335302832},
+				{25.463404316194513, -25.271940708542893, 6.234456068962083},
+				{28.200063995273933, -27.200629374790413, 6.55935197687424},
+				{31.3945858366241, -29.448964186034054, 6.935901394630712},
+				{35.23117662812549, -32.14224641331621, 7.3840079749691485},
+				{39.98568574644145, -35.46783281797258, 7.9335780433789},
+				{46.09785141576296, -39.72385394502523, 8.63242118940724},
+				{54.31973270570397, -45.419270826707724, 9.562560163101073},
+				{66.0567906133814, -53.50378846671066, 10.877890648210746},
+			},
+			{
+				{3.2984874783501015, -10.007939262765715, 3.8994179121478436},
+				{7.0031055827174065, -12.495347580869334, 4.284222883359843},
+				{9.916889032695018, -14.482015234910753, 4.601696640925941},
+				{12.325351848287566, -16.147711213375377, 4.87597220738249},
+				{14.459086389698411, -17.641646075742507, 5.1279363821803186},
+				{16.493331193875143, -19.079367649887207, 5.374236232992862},
+				{18.55474657839187, -20.545492598494317, 5.627349494726666},
+				{20.74589260095539, -22.1092570266982, 5.897719471116335},
+				{23.16672477564052, -23.83861550999005, 6.195797879709799},
+				{25.932788398580936, -25.812356217543027, 6.53387070312073},
+				{29.195584702023154, -28.133772683894463, 6.928186858415135},
+				{33.17268673562089, -30.950930210174768, 7.402174963004288},
+				{38.20076159821753, -34.49233621602358, 7.9921629708287085},
+				{44.840406666602846, -39.1373926545241, 8.758751090921175},
+				{54.10743823717675, -45.57167323214147, 9.812024641078743},
+				{68.05721605218181, -55.17903321630267, 11.37559404783691},
+			},
+			{
+				{-0.6476163558986116, -7.3642918950003065, 3.6855008733708208},
+				{3.4876178170063117, -10.162074048195946, 4.117938338963875},
+				{6.766291485027484, -12.417246608144856, 4.479037976762155},
+				{9.465688846552474, -14.303565255350419, 4.791429788112365},
+				{11.792849615343714, -15.953865262134157, 5.073159088307525},
+				{13.932942799653315, -17.490082104102697, 5.3414989791933305},
+				{16.043895303656967, -19.018080325683652, 5.61189990629601},
+				{18.25567965602133, -20.626024537687066, 5.897550790496646},
+				{20.68985113814981, -22.396998577556875, 6.211152491353392},
+				{23.482998583652158, -24.42462428409922, 6.567241463153263},
+				{26.814225294269434, -26.831530774204232, 6.985036650012846},
+				{30.946484710988855, -29.797174446988112, 7.492821263898168},
+				{36.30262682523667, -33.60894231956503, 8.136083396474515},
+				{43.62637904749985, -38.77010050271864, 8.994839742964574},
+				{54.372021102632885, -46.26059639955789, 10.225773158646437},
+				{71.82520268865858, -58.286319415617854, 12.18416208260617},
+			},
+			{
+				{-6.192536133743257, -3.707088610929913, 3.381591978235254},
+				{-1.3232756019985514, -7.0221875896973325, 3.890896419505994},
+				{2.542178455815365, -9.701807994138294, 4.319276302813415},
+				{5.72034881187156, -11.944085383800141, 4.691687217170845},
+				{8.424219811969428, -13.884787390804465, 5.025928731706734},
+				{10.819735887297496, -15.632082950052098, 5.336809593152454},
+				{13.069876583340083, -17.295018918101533, 5.639941277665492},
+				{15.34084635103857, -18.986864311830285, 5.9520738653994645},
+				{17.792362279751696, -20.817617087501116, 6.2898851867394505},
+				{20.59508515016681, -22.905321635340314, 6.671619709290334},
+				{23.9671134843453, -25.400629759001188, 7.120822815969709},
+				{28.232999762034986, -28.526635471902754, 7.672546189410368},
+				{33.937615444023194, -32.65551212665735, 8.385445904612261},
+				{42.10816688438744, -38.484198378723164, 9.369750088999666},
+				{54.972288742606295, -47.51421090143593, 10.864245931784057},
+				{78.44793567690056, -63.71108154477454, 13.504989108508267},
+			},
+			{
+				{-15.167613007973276, 2.2071968221623464, 2.8470527221226125},
+				{-8.911350818355311, -2.065428549847982, 3.491872674269498},
+				{-3.9970387681351456, -5.490740862057967, 4.033999501652712},
+				{0.010835717395471855, -8.34083717359597, 4.505990606978381},
+				{3.3890231933208534, -10.791876263817509, 4.930021550826141},
+				{6.328291125602018, -12.96762214295497, 5.3224309704665345},
+				{8.976284808823781, -14.966028598928824, 5.69681227519608},
+				{11.476026346217987, -16.884497519680515, 6.067370068173734},
+				{14.008437838837043, -18.848814786415183, 6.453087560784295},
+				{16.799780787081716, -21.017818937319774, 6.878453806749898},
+				{20.131432509331095, -23.58934207538012, 7.374436941278263},
+				{24.413232672523588, -26.849903938551957, 7.986156935178569},
+				{30.358569144694894, -31.293405771322092, 8.791392333547652},
+				{39.43442050956971, -37.92431317676586, 9.948278543952062},
+				{55.32733995947465, -49.23868237303099, 11.851027599073898},
+				{90.77329858184774, -73.76835111293283, 15.860046244651706},
+			},
+			{
+				{-33.58944929244957, 14.455005607442526, 1.619865269167842},
+				{-23.976459314443836, 7.9356456305684375, 2.551867053097434},
+				{-16.715191230896018, 2.8860261619290344, 3.3228112395993983},
+				{-10.95970675355702, -1.2141499151397828, 3.987434704051806},
+				{-6.214734697900464, -4.678149951660722, 4.5820826125657685},
+				{-2.166296024996177, -7.7094756981323815, 5.132266639922981},
+				{1.4007049433791976, -10.451308331857796, 5.657380698855734},
+				{4.6479572580599084, -13.014816314645994, 6.173798794701247},
+				{7.715014189558443, -15.49957529477968, 6.697454641430081},
+				{10.754601847166567, -18.01797473726738, 7.247362423835588},
+				{14.012547310919693, -20.751979834682412, 7.854234790549841},
+				{17.973968059995833, -24.056893989062843, 8.576541366977573},
+				{23.556668713526275, -28.595753414635148, 9.5218637678522},
+				{32.82237159687884, -35.82780932506522, 10.9231179649055},
+				{52.23614173858154, -50.21909704965107, 13.483370336854382},
+				{119.52942636499859, -97.16117064779442, 21.212319586672763},
+			},
+			{
+				{-103.62293217290716, 59.996586200076564, -2.699784475880827},
+				{-75.31761657677065, 42.230769184124064, -0.9168230609627064},
+				{-58.09018758416214, 30.925409846521926, 0.44945078530137106},
+				{-45.93649515397099, 22.583159999232958, 1.6227065584480087},
+				{-36.83134982518742, 16.10179656898089, 2.6424529428605217},
+				{-29.693072288418545, 10.83518567412438, 3.560363324389563},
+				{-23.87778364346161, 6.369789150234369, 4.423364532108321},
+				{-18.980746972845957, 2.4289182062096693, 5.2715037871309125},
+				{-14.730715836867637, -1.189906827588581, 6.14321035680941},
+				{-10.933287514419186, -4.654143500824719, 7.081847113919977},
+				{-7.437468887950743, -8.127006634247632, 8.14525491128921},
+				{-4.1142951109544805, -11.802041786590934, 9.42358055173241},
+				{-0.8516123336332011, -15.955177949374725, 11.07994594949695},
+				{2.3625953830302944, -21.019225978355944, 13.463771236213988},
+				{4.364882717212766, -27.223076746794174, 17.504729653170838},
+				{-1.0964261244345454e-09, 7.288798808672686e-10, 22.738000198290237},
+			},
+		},
+		{
+			{
+				{39.83322808403391, -48.94718114741971, 10.27675722120576},
+				{61.812627277326925, -61.07428360187603, 11.919844261082304},
+				{66.23348628939739, -62.95584677173948, 12.122904730027205},
+				{69.02090526398881, -64.0434428390288, 12.231297493863922},
+				{71.41359853611148, -65.05118596662355, 12.344764624571868},
+				{73.81876197438835, -66.20354017768481, 12.493663182080411},
+				{76.43278650243407, -67.60608454777204, 12.692075362418848},
+				{79.39136099992366, -69.33486624440242, 12.950597217176854},
+				{82.82578899327557, -71.4704562905868, 13.281291105370157},
+				{86.8894083187054, -74.11441763675643, 13.700157191413984},
+				{91.77952855348614, -77.40397559339539, 14.22945983371225},
+				{97.76830911157093, -81.53393749021986, 14.901333951907347},
+				{105.2547604110148, -86.79469242644105, 15.764139516632754},
+				{114.86040762386294, -93.64299993131556, 16.89438382537535},
+				{127.62351569670298, -102.84653151351155, 18.421147683512388},
+				{145.44288899252385, -115.81528573734367, 20.58221439006691},
+			},
+			{
+				{33.96579807785257, -37.55468266691639, 7.900110780045985},
+				{41.835506668533526, -42.11975898220245, 8.545177234363539},
+				{45.73298366756794, -44.31597737186311, 8.855140530711662},
+				{48.68113571326532, -45.98291864746015, 9.094251990072376},
+				{51.30766431971862, -47.503106365867275, 9.317676468377677},
+				{53.86951092681599, -49.033406115883544, 9.548159965593623},
+				{56.51301821353476, -50.66432627202522, 9.79903041014642},
+				{59.346478480575435, -52.464729223892775, 10.080698064800329},
+				{62.47092683275911, -54.50121228625088, 10.403530597034136},
+				{65.99754819273998, -56.84946602978305, 10.779596655169861},
+				{70.06202790527834, -59.60406610706875, 11.224229737257776},
+				{74.84209411851087, -62.89085370003618, 11.758058873540811},
+				{80.5842380011855, -66.88613140664717, 12.410197829210098},
+				{87.64897625182385, -71.84946896684393, 13.223729789368361},
+				{96.5939402945725, -78.18422059194538, 14.265861586677127},
+				{108.33992313445951, -86.55885396843527, 15.648332701361564},
+			},
+			{
+				{27.34348716527194, -30.40241148769248, 6.619182141015326},
+				{32.76804068311614, -33.67026858013478, 7.093923054833772},
+				{36.12448742757655, -35.68971296552928, 7.392096759633706},
+				{38.83429805829658, -37.33590562033781, 7.639473824905551},
+				{41.305613601455455, -38.8619693105618, 7.87274632667769},
+				{43.725031814814564, -40.384690721980085, 8.109036311653975},
+				{46.20699615840203, -41.97689882042229, 8.359219602343488},
+				{48.84011964152571, -43.69633660288927, 8.63212585861069},
+				{51.70801656124594, -45.59889595259189, 8.936503354138752},
+				{54.902159848534126, -47.7470574548696, 9.282315024644904},
+				{58.533011838911705, -50.217468911065836, 9.681945533265452},
+				{62.743318032204535, -53.11023649724575, 10.151718149237835},
+				{67.7275833738382, -56.56272514590573, 10.714175817309245},
+				{73.76387603393522, -60.77225029107003, 11.401855108774509},
+				{81.26978580344273, -66.0361629996092, 12.263977587601495},
+				{90.90846507216922, -72.82801374187913, 13.37919693677618},
+			},
+			{
+				{22.813677682634125, -25.87976083433995, 5.859116846228833},
+				{27.18385927873006, -28.578754726278714, 6.258053019492408},
+				{30.179813384650448, -30.440887910331266, 6.538902492533474},
+				{32.6839809788269, -32.01403783037712, 6.780308272871185},
+				{35.00152502170147, -33.48948833336491, 7.0100286658767015},
+				{37.28276099523928, -34.96253274464357, 7.242082464788286},
+				{39.62444839128903, -36.495549605608936, 7.48582543073008},
+				{42.104109103866534, -38.13956031682811, 7.749083858446212},
+				{44.796196739228684, -39.94456866327664, 8.03968943410594},
+				{47.78269068331821, -41.96653586265908, 8.366550220051275},
+				{51.16275834681498, -44.27393971257806, 8.74069320097556},
+				{55.06444668999483, -46.95587865061201, 9.17658250452309},
+				{59.6616659052594, -50.13397186668773, 9.694078279145378},
+				{65.20156892147014, -53.98164714460168, 10.32163110884357},
+				{72.05200812454068, -58.75767581163476, 11.101856722536635},
+				{80.7898657454331, -64.8687200591229, 12.101961204848276},
+			},
+			{
+				{19.38070964188586, -22.63706254673606, 5.3501115351985655},
+				{23.18524655196165, -25.030269504246146, 5.708256396681809},
+				{25.94489975967131, -26.782385338189883, 5.976221625863816},
+				{28.298356098274297, -28.292702633544746, 6.2112095768051905},
+				{30.496663979709684, -29.719875685640172, 6.436237063728479},
+				{32.670408681337726, -31.14742884723536, 6.663604839838535},
+				{34.90658820036955, -32.63190649454442, 6.901806484914862},
+				{37.2767682093652, -34.22067674461331, 7.158113392981431},
+				{39.85092245377354, -35.96083228523645, 7.439896151916335},
+				{42.70694694349169, -37.90547033538501, 7.755589072801915},
+				{45.93977249755043, -40.11986344447067, 8.115669761695512},
+				{49.67268769012771, -42.68925497384451, 8.533923520096387},
+				{54.073925682968714, -45.730373950266376, 9.02933222504245},
+				{59.38347358846143, -49.410122364576694, 9.629158175577933},
+				{65.95962362925297, -53.97809813155496, 10.374331367117568},
+				{74.36580561773711, -59.827355493900164, 11.329541807840142},
+			},
+			{
+				{16.543718996909284, -20.09517486071864, 4.982145216977849},
+				{20.026585187817407, -22.318592096977046, 5.318112102959084},
+				{22.636616313957347, -24.00269218926507, 5.578481871481156},
+				{24.88639002033943, -25.469844122291846, 5.80931162068881},
+				{26.99843035881829, -26.86164983601597, 6.0311211628545855},
+				{29.093369112859637, -28.255815049859017, 6.255352820287665},
+				{31.253507595397473, -29.70615737653817, 6.490091698920971},
+				{33.54787762941215, -31.258547600657838, 6.742376827585668},
+				{36.045059828300495, -32.95919569291796, 7.019431180713111},
+				{38.82236116907317, -34.86072202161062, 7.329594403749134},
+				{41.975003220348384, -37.02836641629584, 7.6833074633640095},
+				{45.627904991034484, -39.548054312753585, 8.094417716825793},
+				{49.95326274214326, -42.53850650801408, 8.582159179300703},
+				{55.199349831192905, -46.17113615611554, 9.17442534412198},
+				{61.74121914409847, -50.705135040191266, 9.913562680204974},
+				{70.17683490449004, -56.554074361698376, 10.867400019169361},
+			},
+			{
+				{14.020552218578295, -17.954570306847142, 4.7008995314235085},
+				{17.333994821878754, -20.096536631605115, 5.0271161882702895},
+				{19.86221505885157, -21.74998611119496, 5.28512223495555},
+				{22.048443269033374, -23.195092871750067, 5.514795000128737},
+				{24.102824755803535, -24.566395777685937, 5.735592190835233},
+				{26.143075391360586, -25.94018273417913, 5.958734795689965},
+				{28.250672604043448, -27.370000465787932, 6.192260051308952},
+				{30.494790393557555, -28.901960175412505, 6.443235644435901},
+				{32.945021683945846, -30.58299947190165, 6.718985363751638},
+				{35.68080266132023, -32.46712838488694, 7.028048442751693},
+				{38.80122003963641, -34.62204436600706, 7.381227294002572},
+				{42.43800882209485, -37.13798722750089, 7.79301670726598},
+				{46.77543109757236, -40.14134300679966, 8.283821745173935},
+				{52.083565005531455, -43.81747037831249, 8.883700827681608},
+				{58.77831989715503, -48.4518978929008, 9.639148358189418},
+				{67.53850011588824, -54.510749403385084, 10.626378256467268},
+			},
+			{
+				{11.619715774780124, -16.029657457629593, 4.47504245289206},
+				{14.878112493553218, -18.15952488037151, 4.801511667383389},
+				{17.386434756425317, -19.81969226520256, 5.062702959456919},
+				{19.547186790089423, -21.26558089768732, 5.294766704843263},
+				{21.5702364833916, -22.632274196643554, 5.517178891214971},
+				{23.576666939857102, -23.99866757427305, 5.741518777833269},
+				{25.650776933078223, -25.42040963149737, 5.9761230213795855},
+				{27.86439458713881, -26.945520371707772, 6.228337441722189},
+				{30.290341759186582, -28.62317048918126, 6.5058171491896575},
+				{33.01268317698368, -30.510484342875472, 6.817570089018709},
+				{36.137736888044756, -32.6799540940318, 7.175129170401149},
+				{39.809149249184, -35.22966776232236, 7.594198629414028},
+				{44.23164357555797, -38.299471790967324, 8.097280884313594},
+				{49.71197943659128, -42.09887970164635, 8.718238840997646},
+				{56.7352952742798, -46.959121297098285, 9.510840820466935},
+				{66.12017204680595, -53.43892213868435, 10.566189023448205},
+			},
+			{
+				{9.179724296769336, -14.180188452337822, 4.2834277241992655},
+				{12.483141052827976, -16.361271333921422, 4.619456774368201},
+				{15.03849605114261, -18.071266914489772, 4.890439506948484},
+				{17.21624710741135, -19.545682176266816, 5.129404859771302},
+				{19.23570728988854, -20.926316353005188, 5.356698759736182},
+				{21.22820451851201, -22.299163391954515, 5.584898244732957},
+				{23.28511567532836, -23.724799888099, 5.82304987320337},
+				{25.48378670305355, -25.255090319340525, 6.079064648747925},
+				{27.902672413525313, -26.94309316850633, 6.361179937280924},
+				{30.633113260597028, -28.850888133995102, 6.679156685833955},
+				{33.792287345545326, -31.05828566712453, 7.0456523930024995},
+				{37.54150017050103, -33.67519438270732, 7.478205399594557},
+				{42.11599056179476, -36.86181330133576, 8.00250546111411},
+				{47.87828025365021, -40.86479068811004, 8.658285339337988},
+				{55.42203828847676, -46.087630629374715, 9.51084584773467},
+				{65.79481853015041, -53.24172522127057, 10.675881238533323},
+			},
+			{
+				{6.527933189934393, -12.271978265614166, 4.1085607652869855},
+				{9.975387468777102, -14.569281728617206, 4.463816006839725},
+				{12.659857198006547, -16.38420947697318, 4.753142349715488},
+				{14.911459008283558, -17.926180267818456, 5.005442142770192},
+				{16.962888601743725, -19.346077256345033, 5.242191823603772},
+				{18.96404813718091, -20.742492344584694, 5.477734220138421},
+				{21.019360115030654, -22.184942626628043, 5.722402930639065},
+				{23.215637338038373, -23.731839878906467, 5.985071417607005},
+				{25.640026129358013, -25.442262718826044, 6.274879756501711},
+				{28.39429953117645, -27.38546865078214, 6.6026821687940505},
+				{31.61079876955342, -29.651604590203082, 6.9827280676972405},
+				{35.47557767297372, -32.36731731739286, 7.435156470718243},
+				{40.26758143991206, -35.72219954372859, 7.990262517351529},
+				{46.432050516240444, -40.01832601971354, 8.69653897112114},
+				{54.73163480525575, -45.772196696037966, 9.637310710449196},
+				{66.59454913160474, -53.94960472790332, 10.969245713065},
+			},
+			{
+				{3.4300534371180205, -10.13745175508852, 3.9311400315752345},
+				{7.138663883422099, -12.630020871455937, 4.317383417116841},
+				{10.05554661616715, -14.621112472759794, 4.636163285802946},
+				{12.467982146983523, -16.291764554376506, 4.911818109468516},
+				{14.608018901162785, -17.79225988677988, 5.165413683969957},
+				{16.651079326184334, -19.238351890624134, 5.413646889831888},
+				{18.723765813413614, -20.71468192756275, 5.669015401756447},
+				{20.92880995672843, -22.290658933085247, 5.942003715074186},
+				{23.366657455260007, -24.0346295027879, 6.243139350266446},
+				{26.153741886933147, -26.026055999945996, 6.5848310259364204},
+				{29.44303651798055, -28.36932461474216, 6.983521725937882},
+				{33.454567381599965, -31.214296608267063, 7.462954987664221},
+				{38.52927539008011, -34.79257522495487, 8.059997394978339},
+				{45.23574327132723, -39.489292659693774, 8.836241001505009},
+				{54.606231609281345, -46.00171994895792, 9.903788596012058},
+				{68.73497894701802, -55.74113661894462, 11.490967827336933},
+			},
+			{
+				{-0.5017314704804272, -7.510576908484933, 3.722123427979232},
+				{3.6415404413440626, -10.317055256084778, 4.156753049847078},
+				{6.926503860431953, -12.579640342520523, 4.51984446606832},
+				{9.631611537169531, -14.47295294073512, 4.834172866624445},
+				{11.96565954319245, -16.131097653724463, 5.117989120236815},
+				{14.115499861475378, -17.67724937499572, 5.388772323243099},
+				{16.239541599763925, -19.217696951880505, 5.6620601835809925},
+				{18.46798196484604, -20.840846272516274, 5.95110143928238},
+				{20.9229364052307, -22.630253924323412, 6.268693501694537},
+				{23.742109306403655, -24.680409804313353, 6.629534843611648},
+				{27.106558612782, -27.115416021896223, 7.053109362642031},
+				{31.28263668089747, -30.11724337565692, 7.568144158320554},
+				{36.699401501791485, -33.977807215513465, 8.220917687808303},
+				{44.11292709600085, -39.20926942507223, 9.092987564308398},
+				{55.005267120271, -46.81136019578204, 10.344445994925834},
+				{72.73707290732584, -59.04280799525588, 12.339646149075886},
+			},
+			{
+				{-6.0313649478710545, -3.8736366975200047, 3.4247071152452704},
+				{-1.1467487900909585, -7.2037465329271155, 3.937521695977578},
+				{2.7309845335362786, -9.89625487563285, 4.369116248435844},
+				{5.919841163069449, -12.150393333270644, 4.744628502916431},
+				{8.633926631047325, -14.102778941961246, 5.082004573653201},
+				{11.040883261605515, -15.862837128351655, 5.396262515449279},
+				{13.306204700334536, -17.54146504301906, 5.7033192200389164},
+				{15.597537867227121, -19.25307336234883, 6.020134425136787},
+				{18.075449712437997, -21.108406448971117, 6.363543169180415},
+				{20.91202580037406, -23.226675773128825, 6.752019818808828},
+				{24.328048258047637, -25.760619173089676, 7.20949661558234},
+				{28.653021438533106, -28.937071856297557, 7.771697352985579},
+				{34.441403615103994, -33.13530458290042, 8.498528925311744},
+				{42.741010245978664, -39.06724936749079, 9.502827799477076},
+				{55.83164062362437, -48.271762280043504, 11.029820860946701},
+				{79.80244208305226, -64.83502909622244, 13.736260893154144},
+			},
+			{
+				{-15.000455189725349, 2.022455744911712, 2.898211056915239},
+				{-8.712522222441892, -2.278796904622923, 3.549243524530408},
+				{-3.772977246021457, -5.728485902118243, 4.097055794579985},
+				{0.256747880575417, -8.600921689780968, 4.574556364772849},
+				{3.6551998625932374, -11.073631546635882, 5.004157486867621},
+				{6.614457826117965, -13.27143110244494, 5.402394420837421},
+				{9.283660897167774, -15.29347989959612, 5.783088252199406},
+				{11.808955585509457, -17.239574882891873, 6.160862064161479},
+				{14.375907676606408, -19.23905249760217, 6.555316776657328},
+				{17.214196195443524, -21.453550661719007, 6.991482376150317},
+				{20.609238293460788, -24.084256010407245, 7.500994197910014},
+				{24.97860326082304, -27.423822362359246, 8.130131152796165},
+				{31.051822948732923, -31.978525265092028, 8.95892048677119},
+				{40.334058708508415, -38.78127493170538, 10.150527585737821},
+				{56.62527993688317, -50.41041569642047, 12.113439160683106},
+				{93.17103255884487, -75.75819592923567, 16.26987169235741},
+			},
+			{
+				{-33.49727980034666, 14.301970283871745, 1.6754761985733795},
+				{-23.79880168897213, 7.7119090677856645, 2.621137384393063},
+				{-16.473816195134628, 2.605717660817858, 3.404127959142873},
+				{-10.665118885138268, -1.5447989439864096, 4.0803209371375315},
+				{-5.8716083668943, -5.05724178347174, 4.686766545764825},
+				{-1.7757390699384525, -8.137988500756212, 5.249515765641761},
+				{1.8402201253612631, -10.932564119008363, 5.78846677183474},
+				{5.140414396498559, -13.554511783055904, 6.320555561042075},
+				{8.26746201133795, -16.106440307339692, 6.862450071597509},
+				{11.379965812688047, -18.706124650385892, 7.434361924401901},
+				{14.73936760783262, -21.548460834171536, 8.069519943374774},
+				{18.854736462755557, -25.00869870126786, 8.830312475777651},
+				{24.67847083618501, -29.77919406897274, 9.830268057693573},
+				{34.358981329421525, -37.38907059119276, 11.315687031420437},
+				{54.67722451329647, -52.544865505431886, 14.032977854579865},
+				{125.96557105877906, -102.50184098910474, 22.312993969961454},
+			},
+			{
+				{-104.93523329592158, 60.72976706070631, -2.7180959586426656},
+				{-75.93562295992734, 42.5188804981824, -0.8916456598141538},
+				{-58.34228942448897, 30.946413356639887, 0.5141606479502142},
+				{-45.938215587956996, 22.400548301064575, 1.7251858605132417},
+				{-36.64206169339579, 15.75018486187169, 2.780856383604899},
+				{-29.334935986347947, 10.322197458650734, 3.736815323359454},
+				{-23.350731545122557, 5.684583581305768, 4.643577416583402},
+				{-18.266513959165064, 1.5430668080814451, 5.545420902704278},
+				{-13.79026022662869, -2.3274194039748775, 6.486922847763569},
+				{-9.696971536256578, -6.129825118872024, 7.5216756078959275},
+				{-5.781754088567226, -10.091820854166865, 8.726562825597098},
+				{-1.8052166918960724, -14.541035778911066, 10.231829614404894},
+				{2.613766066764459, -20.08359667142914, 12.300217531802206},
+				{8.335123242379154, -28.205706791595976, 15.602079708044409},
+				{18.57841101067821, -44.68611839179584, 22.786032851189393},
+				{-19.832273713392127, 12.87830516784968, 75674.04065101262},
+			},
+		},
+	},
+}
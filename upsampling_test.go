@@ -0,0 +1,172 @@
+package colorful
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"math"
+	"math/rand"
+	"os"
+	"testing"
+)
+
+func TestSpectrumRoundtrip(t *testing.T) {
+	colors := []Color{
+		{0.0, 0.0, 0.0}, {1.0, 1.0, 1.0}, {0.5, 0.5, 0.5},
+		{1.0, 0.0, 0.0}, {0.0, 1.0, 0.0}, {0.0, 0.0, 1.0},
+		{0.0, 1.0, 1.0}, {1.0, 0.0, 1.0}, {1.0, 1.0, 0.0},
+		{0.2, 0.4, 0.8}, {0.9, 0.6, 0.1}, {0.01, 0.02, 0.015},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		colors = append(colors, Color{rnd.Float64(), rnd.Float64(), rnd.Float64()})
+	}
+
+	for _, c := range colors {
+		s := c.Spectrum()
+		if got := s.ReflectanceColor(); !got.AlmostEqualRgb(c) || got.DistanceLab(c) > 1e-9 {
+			t.Errorf("%v.Spectrum().ReflectanceColor() => %v", c, got)
+		}
+		for i, v := range s.Values {
+			if v < 0.0 || v > 1.0 {
+				t.Errorf("%v.Spectrum() has %v at %v nm", c, v, s.Wavelengths[i])
+			}
+		}
+
+		if got := c.SmitsSpectrum().ReflectanceColor(); !got.AlmostEqualRgb(c) || got.DistanceLab(c) > 1e-9 {
+			t.Errorf("%v.SmitsSpectrum().ReflectanceColor() => %v", c, got)
+		}
+	}
+}
+
+func TestSpectrumShape(t *testing.T) {
+	// A red reflects long and a blue short wavelengths.
+	red := Color{0.8, 0.1, 0.1}.Spectrum()
+	if red.At(650) < 0.5 || red.At(450) > 0.2 {
+		t.Errorf("Red spectrum %v", red.Values)
+	}
+	blue := Color{0.1, 0.1, 0.8}.SmitsSpectrum()
+	if blue.At(450) < 0.5 || blue.At(650) > 0.2 {
+		t.Errorf("Blue Smits spectrum %v", blue.Values)
+	}
+
+	// Grays beyond white are clamped, as reflectances are at most 1.
+	for _, c := range []Color{{1.2, 1.2, 1.2}, {-0.1, -0.1, -0.1}} {
+		for i, v := range c.Spectrum().Values {
+			if v < 0.0 || v > 1.0 {
+				t.Errorf("%v.Spectrum() has %v at %v", c, v, i)
+			}
+		}
+	}
+}
+
+var updateJhTable = flag.Bool("update-jh-table", false, "rewrite upsampling_data.go with the computed table")
+
+// jhComputeTable computes the table of coefficients, indexed by the largest
+// component of the linear RGB color, its value and the ratios of the other
+// two to it, continuing each solution from that of its neighbor like Jakob and
+// Hanika do.
+func jhComputeTable() *[3][jhResolution][jhResolution][jhResolution][3]float64 {
+	table := new([3][jhResolution][jhResolution][jhResolution][3]float64)
+	start := jhResolution / 5
+	for l := 0; l < 3; l++ {
+		for j := 0; j < jhResolution; j++ {
+			y := float64(j) / (jhResolution - 1)
+			for i := 0; i < jhResolution; i++ {
+				x := float64(i) / (jhResolution - 1)
+				solve := func(k int, coeffs [3]float64) [3]float64 {
+					var target [3]float64
+					z := jhScale(k)
+					target[l], target[(l+1)%3], target[(l+2)%3] = z, x*z, y*z
+					coeffs = jhSolve(target, coeffs, 20)
+					table[l][k][j][i] = coeffs
+					return coeffs
+				}
+
+				var coeffs [3]float64
+				for k := start; k < jhResolution; k++ {
+					coeffs = solve(k, coeffs)
+				}
+				coeffs = table[l][start][j][i]
+				for k := start - 1; k >= 0; k-- {
+					coeffs = solve(k, coeffs)
+				}
+			}
+		}
+	}
+	return table
+}
+
+// TestJhTable tests that every spectrum of the table of upsampling_data.go is
+// in [0..1] and is the color it is for again. With -update-jh-table, it
+// instead computes the table anew and rewrites upsampling_data.go if it changed.
+func TestJhTable(t *testing.T) {
+	if *updateJhTable {
+		writeJhTable(t)
+		return
+	}
+
+	n := float64(len(jhWeights) - 1)
+	for l := range jhTable {
+		for k := range jhTable[l] {
+			z := jhScale(k)
+			for j := range jhTable[l][k] {
+				y := float64(j) / (jhResolution - 1)
+				for i, coeffs := range jhTable[l][k][j] {
+					x := float64(i) / (jhResolution - 1)
+					var target [3]float64
+					target[l], target[(l+1)%3], target[(l+2)%3] = z, x*z, y*z
+					if res, _ := jhResidual(coeffs, target); math.Abs(res[0]) > 1e-9 || math.Abs(res[1]) > 1e-9 || math.Abs(res[2]) > 1e-9 {
+						t.Errorf("jhTable[%v][%v][%v][%v] is off the color %v by %v, run go generate", l, k, j, i, target, res)
+					}
+					for w := range jhWeights {
+						tw := float64(w) / n
+						if v := jhSigmoid((coeffs[0]*tw+coeffs[1])*tw + coeffs[2]); v < 0.0 || v > 1.0 {
+							t.Errorf("jhTable[%v][%v][%v][%v] has %v at sample %v", l, k, j, i, v, w)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func writeJhTable(t *testing.T) {
+	table := jhComputeTable()
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by go test -run TestJhTable -update-jh-table. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package colorful")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// The coefficients of the spectra of Jakob and Hanika (2019), indexed by the")
+	fmt.Fprintln(&buf, "// largest component of the linear RGB color, the slice of its value and the")
+	fmt.Fprintln(&buf, "// ratios of the other two components to it.")
+	fmt.Fprintln(&buf, "var jhTable = [3][jhResolution][jhResolution][jhResolution][3]float64{")
+	for l := range table {
+		fmt.Fprintln(&buf, "{")
+		for k := range table[l] {
+			fmt.Fprintln(&buf, "{")
+			for j := range table[l][k] {
+				fmt.Fprintln(&buf, "{")
+				for _, c := range table[l][k][j] {
+					fmt.Fprintf(&buf, "{%v, %v, %v},\n", c[0], c[1], c[2])
+				}
+				fmt.Fprintln(&buf, "},")
+			}
+			fmt.Fprintln(&buf, "},")
+		}
+		fmt.Fprintln(&buf, "},")
+	}
+	fmt.Fprintln(&buf, "}")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if old, err := os.ReadFile("upsampling_data.go"); err == nil && bytes.Equal(old, src) {
+		return
+	}
+	if err := os.WriteFile("upsampling_data.go", src, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,94 @@
+package colorful
+
+import "math"
+
+/// u'v' and uv ///
+///////////////////
+// The chromaticity coordinates of the CIE 1976 UCS, u'v', in which distances
+// between chromaticities are about perceptually uniform, and those of its
+// predecessor, the CIE 1960 UCS, uv, in which v is 2/3 of v'. The latter is
+// still used for the correlated color temperature.
+// https://en.wikipedia.org/wiki/CIELUV#The_forward_transformation
+
+// XyToUv converts a chromaticity from CIE 1931 xy to CIE 1976 u'v'.
+func XyToUv(x, y float64) (u, v float64) {
+	d := -2.0*x + 12.0*y + 3.0
+	return 4.0 * x / d, 9.0 * y / d
+}
+
+// UvToXy converts a chromaticity from CIE 1976 u'v' to CIE 1931 xy.
+func UvToXy(u, v float64) (x, y float64) {
+	d := 6.0*u - 16.0*v + 12.0
+	return 9.0 * u / d, 4.0 * v / d
+}
+
+// XyToUv1960 converts a chromaticity from CIE 1931 xy to CIE 1960 uv.
+func XyToUv1960(x, y float64) (u, v float64) {
+	u, v = XyToUv(x, y)
+	return u, v * 2.0 / 3.0
+}
+
+// Uv1960ToXy converts a chromaticity from CIE 1960 uv to CIE 1931 xy.
+func Uv1960ToXy(u, v float64) (x, y float64) {
+	return UvToXy(u, v*1.5)
+}
+
+// XyzToUv returns the CIE 1976 u'v' chromaticity of the color given in XYZ.
+// As for xyY, black has the chromaticity of D65.
+func XyzToUv(x, y, z float64) (u, v float64) {
+	xc, yc, _ := XyzToXyy(x, y, z)
+	return XyToUv(xc, yc)
+}
+
+// UvToXyz returns the XYZ of the color of the given CIE 1976 u'v'
+// chromaticity and luminance Y.
+func UvToXyz(u, v, Y float64) (x, y, z float64) {
+	xc, yc := UvToXy(u, v)
+	return XyyToXyz(xc, yc, Y)
+}
+
+// XyzToUv1960 returns the CIE 1960 uv chromaticity of the color given in XYZ.
+// As for xyY, black has the chromaticity of D65.
+func XyzToUv1960(x, y, z float64) (u, v float64) {
+	xc, yc, _ := XyzToXyy(x, y, z)
+	return XyToUv1960(xc, yc)
+}
+
+// Uv1960ToXyz returns the XYZ of the color of the given CIE 1960 uv
+// chromaticity and luminance Y.
+func Uv1960ToXyz(u, v, Y float64) (x, y, z float64) {
+	xc, yc := Uv1960ToXy(u, v)
+	return XyyToXyz(xc, yc, Y)
+}
+
+// Uv returns the CIE 1976 u'v' chromaticity of the color.
+// u' is in [0..0.63] and v' in [0..0.6]
+func (col Color) Uv() (u, v float64) {
+	return XyzToUv(col.Xyz())
+}
+
+// Uv generates a color from its CIE 1976 u'v' chromaticity and its luminance Y in [0..1].
+func Uv(u, v, Y float64) Color {
+	return Xyz(UvToXyz(u, v, Y))
+}
+
+// Uv1960 returns the CIE 1960 uv chromaticity of the color.
+// u is in [0..0.63] and v in [0..0.4]
+func (col Color) Uv1960() (u, v float64) {
+	return XyzToUv1960(col.Xyz())
+}
+
+// Uv1960 generates a color from its CIE 1960 uv chromaticity and its luminance Y in [0..1].
+func Uv1960(u, v, Y float64) Color {
+	return Xyz(Uv1960ToXyz(u, v, Y))
+}
+
+// DistanceUv returns Δu'v', the distance between the CIE 1976 u'v'
+// chromaticities of the colors, which ignores their luminance. It is used,
+// for example, to specify the color uniformity of displays and lamps, where
+// 0.004 is about a just noticeable difference.
+func (c1 Color) DistanceUv(c2 Color) float64 {
+	u1, v1 := c1.Uv()
+	u2, v2 := c2.Uv()
+	return math.Sqrt(sq(u1-u2) + sq(v1-v2))
+}
@@ -0,0 +1,63 @@
+package colorful
+
+import (
+	"testing"
+)
+
+func TestUv(t *testing.T) {
+	white := Color{1.0, 1.0, 1.0}
+	if u, v := white.Uv(); !almosteq_eps(u, 0.1978, 1e-3) || !almosteq_eps(v, 0.4683, 1e-3) {
+		t.Errorf("white.Uv() => (%v, %v), want (0.1978, 0.4683)", u, v)
+	}
+	if u, v := white.Uv1960(); !almosteq_eps(u, 0.1978, 1e-3) || !almosteq_eps(v, 0.3122, 1e-3) {
+		t.Errorf("white.Uv1960() => (%v, %v), want (0.1978, 0.3122)", u, v)
+	}
+
+	for _, tt := range vals {
+		_, y, _ := tt.c.Xyz()
+		u, v := tt.c.Uv()
+		if c := Uv(u, v, y); y > 0.0 && !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v.Uv() => (%v, %v), but Uv(%v, %v, %v) => %v", tt.c, u, v, u, v, y, c)
+		}
+		u, v = tt.c.Uv1960()
+		if c := Uv1960(u, v, y); y > 0.0 && !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v.Uv1960() => (%v, %v), but Uv1960(%v, %v, %v) => %v", tt.c, u, v, u, v, y, c)
+		}
+
+		x, yc, _ := tt.c.Xyy()
+		if x2, y2 := UvToXy(XyToUv(x, yc)); !almosteq(x, x2) || !almosteq(yc, y2) {
+			t.Errorf("UvToXy(XyToUv(%v, %v)) => (%v, %v)", x, yc, x2, y2)
+		}
+		if x2, y2 := Uv1960ToXy(XyToUv1960(x, yc)); !almosteq(x, x2) || !almosteq(yc, y2) {
+			t.Errorf("Uv1960ToXy(XyToUv1960(%v, %v)) => (%v, %v)", x, yc, x2, y2)
+		}
+	}
+}
+
+func TestDistanceUv(t *testing.T) {
+	// The distance ignores luminance, and D65 is 0.0228 from D50.
+	white := Color{1.0, 1.0, 1.0}
+	if d := white.DistanceUv(Color{0.2, 0.2, 0.2}); !almosteq(d, 0.0) {
+		t.Errorf("white.DistanceUv(gray) => %v, want 0", d)
+	}
+	u, v := XyToUv(0.3457, 0.3585)
+	if d := white.DistanceUv(Uv(u, v, 1.0)); !almosteq_eps(d, 0.0228, 1e-2) {
+		t.Errorf("white.DistanceUv(D50) => %v, want 0.0228", d)
+	}
+}
+
+func TestLuvUv(t *testing.T) {
+	// L*u*v* is built on the same u'v' as XyzToUv, so that
+	// u' = u*/(13 L*) + u'n and v' = v*/(13 L*) + v'n.
+	un, vn := XyzToUv(D65[0], D65[1], D65[2])
+	for _, tt := range vals {
+		x, y, z := tt.c.Xyz()
+		l, u, v := XyzToLuv(x, y, z)
+		if up, vp := XyzToUv(x, y, z); l > 0.0 && (!almosteq(u/(13.0*l)+un, up) || !almosteq(v/(13.0*l)+vn, vp)) {
+			t.Errorf("XyzToLuv(%v) => (%v, %v, %v), not at u'v' (%v, %v)", tt.c, l, u, v, up, vp)
+		}
+	}
+	if l, u, v := XyzToLuv(0.0, 0.0, 0.0); l != 0.0 || u != 0.0 || v != 0.0 {
+		t.Errorf("XyzToLuv(black) => (%v, %v, %v), want (0, 0, 0)", l, u, v)
+	}
+}
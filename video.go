@@ -0,0 +1,198 @@
+// This file provides the luma/chroma color models used for video and images.
+
+package colorful
+
+import (
+	"image/color"
+	"math"
+)
+
+/// YCbCr ///
+/////////////
+// https://en.wikipedia.org/wiki/YCbCr
+// YCbCr is computed from the gamma-encoded RGB values, so these are not
+// colorimetric conversions. Y is in [0..1], Cb and Cr are in [-0.5..0.5].
+
+// A YCbCrMatrix holds the luma coefficients defining one of the standard
+// YCbCr variants. The coefficient of green is 1-Kr-Kb.
+type YCbCrMatrix struct {
+	Kr, Kb float64
+}
+
+// The luma coefficients of the ITU-R recommendations for SD, HD and UHD video.
+var (
+	BT601  = YCbCrMatrix{0.299, 0.114}
+	BT709  = YCbCrMatrix{0.2126, 0.0722}
+	BT2020 = YCbCrMatrix{0.2627, 0.0593}
+)
+
+// RgbToYCbCr converts gamma-encoded R'G'B' values in [0..1] to YCbCr using the given matrix.
+func RgbToYCbCr(r, g, b float64, m YCbCrMatrix) (y, cb, cr float64) {
+	y = m.Kr*r + (1.0-m.Kr-m.Kb)*g + m.Kb*b
+	cb = 0.5 * (b - y) / (1.0 - m.Kb)
+	cr = 0.5 * (r - y) / (1.0 - m.Kr)
+	return
+}
+
+// YCbCrToRgb converts YCbCr to gamma-encoded R'G'B' values using the given matrix.
+func YCbCrToRgb(y, cb, cr float64, m YCbCrMatrix) (r, g, b float64) {
+	r = y + 2.0*(1.0-m.Kr)*cr
+	b = y + 2.0*(1.0-m.Kb)*cb
+	g = (y - m.Kr*r - m.Kb*b) / (1.0 - m.Kr - m.Kb)
+	return
+}
+
+// YCbCr returns the Y in [0..1] and Cb, Cr in [-0.5..0.5] of the color using
+// the given matrix. The sRGB values are used as the R'G'B' input, as is done
+// for BT.601 in JPEG and for BT.709 on most computer displays.
+func (col Color) YCbCr(m YCbCrMatrix) (y, cb, cr float64) {
+	return RgbToYCbCr(col.R, col.G, col.B, m)
+}
+
+// YCbCr creates a new Color given Y in [0..1] and Cb, Cr in [-0.5..0.5] using
+// the given matrix. The result is interpreted as sRGB.
+// WARNING: many combinations of `y`, `cb`, and `cr` values do not have
+// corresponding valid RGB values.
+func YCbCr(y, cb, cr float64, m YCbCrMatrix) Color {
+	r, g, b := YCbCrToRgb(y, cb, cr, m)
+	return Color{r, g, b}
+}
+
+// YCbCrRec2020 returns the non-constant luminance BT.2020 YCbCr of the color,
+// computed from its Rec. 2020 R'G'B' values.
+func (col Color) YCbCrRec2020() (y, cb, cr float64) {
+	r, g, b := col.Rec2020()
+	return RgbToYCbCr(r, g, b, BT2020)
+}
+
+// YCbCrRec2020 creates a new Color given non-constant luminance BT.2020 YCbCr values.
+func YCbCrRec2020(y, cb, cr float64) Color {
+	return Rec2020(YCbCrToRgb(y, cb, cr, BT2020))
+}
+
+// QuantizeYCbCr converts YCbCr values to integer code values with the given
+// bit depth, which must be at least 8 and usually is 8, 10 or 12. If limited
+// is true, the "studio" range is used (for 8 bits, 16-235 for Y and 16-240 for
+// Cb and Cr), otherwise the full range is used. The results are rounded and
+// clamped to the valid codes.
+func QuantizeYCbCr(y, cb, cr float64, bits uint, limited bool) (yq, cbq, crq uint16) {
+	max := float64(uint32(1)<<bits - 1)
+	quantize := func(v float64) uint16 {
+		return uint16(math.Max(0.0, math.Min(math.Floor(v+0.5), max)))
+	}
+
+	if limited {
+		scale := float64(uint32(1) << (bits - 8))
+		return quantize((219.0*y + 16.0) * scale), quantize((224.0*cb + 128.0) * scale), quantize((224.0*cr + 128.0) * scale)
+	}
+	offset := float64(uint32(1) << (bits - 1))
+	return quantize(max * y), quantize(max*cb + offset), quantize(max*cr + offset)
+}
+
+// DequantizeYCbCr is the inverse of QuantizeYCbCr.
+func DequantizeYCbCr(yq, cbq, crq uint16, bits uint, limited bool) (y, cb, cr float64) {
+	if limited {
+		scale := float64(uint32(1) << (bits - 8))
+		y = (float64(yq)/scale - 16.0) / 219.0
+		cb = (float64(cbq)/scale - 128.0) / 224.0
+		cr = (float64(crq)/scale - 128.0) / 224.0
+		return
+	}
+	max := float64(uint32(1)<<bits - 1)
+	offset := float64(uint32(1) << (bits - 1))
+	y = float64(yq) / max
+	cb = (float64(cbq) - offset) / max
+	cr = (float64(crq) - offset) / max
+	return
+}
+
+// YCbCr255 converts the color to the image/color.YCbCr type, which is the
+// full-range BT.601 variant used by JPEG. Going the other way is done by MakeColor.
+func (col Color) YCbCr255() color.YCbCr {
+	y, cb, cr := col.YCbCr(BT601)
+	yq, cbq, crq := QuantizeYCbCr(y, cb, cr, 8, false)
+	return color.YCbCr{Y: uint8(yq), Cb: uint8(cbq), Cr: uint8(crq)}
+}
+
+/// YUV ///
+///////////
+// https://en.wikipedia.org/wiki/Y%E2%80%B2UV
+// The analog color model of PAL, using the BT.601 luma coefficients.
+// Y is in [0..1], U in [-0.436..0.436] and V in [-0.615..0.615].
+
+const (
+	yuvUMax = 0.436
+	yuvVMax = 0.615
+)
+
+// Yuv returns the Y, U and V values of the color.
+func (col Color) Yuv() (y, u, v float64) {
+	y, cb, cr := col.YCbCr(BT601)
+	return y, 2.0 * yuvUMax * cb, 2.0 * yuvVMax * cr
+}
+
+// Yuv creates a new Color given Y in [0..1], U in [-0.436..0.436] and V in [-0.615..0.615].
+func Yuv(y, u, v float64) Color {
+	return YCbCr(y, u/(2.0*yuvUMax), v/(2.0*yuvVMax), BT601)
+}
+
+/// YIQ ///
+///////////
+// https://en.wikipedia.org/wiki/YIQ
+// The analog color model of NTSC, which is YUV with the chroma plane rotated by 33°.
+// Y is in [0..1], I in about [-0.596..0.596] and Q in about [-0.523..0.523].
+
+var yiqSin, yiqCos = math.Sincos(33.0 * math.Pi / 180.0)
+
+// Yiq returns the Y, I and Q values of the color.
+func (col Color) Yiq() (y, i, q float64) {
+	y, u, v := col.Yuv()
+	i = v*yiqCos - u*yiqSin
+	q = v*yiqSin + u*yiqCos
+	return
+}
+
+// Yiq creates a new Color given Y in [0..1], I in about [-0.596..0.596] and Q in about [-0.523..0.523].
+func Yiq(y, i, q float64) Color {
+	u := q*yiqCos - i*yiqSin
+	v := q*yiqSin + i*yiqCos
+	return Yuv(y, u, v)
+}
+
+/// YCoCg ///
+/////////////
+// https://en.wikipedia.org/wiki/YCoCg
+// Y is in [0..1], Co and Cg are in [-0.5..0.5].
+
+// YCoCg returns the luma Y, orange chroma Co and green chroma Cg of the color.
+func (col Color) YCoCg() (y, co, cg float64) {
+	y = 0.25*col.R + 0.5*col.G + 0.25*col.B
+	co = 0.5*col.R - 0.5*col.B
+	cg = -0.25*col.R + 0.5*col.G - 0.25*col.B
+	return
+}
+
+// YCoCg creates a new Color given Y in [0..1] and Co, Cg in [-0.5..0.5].
+func YCoCg(y, co, cg float64) Color {
+	tmp := y - cg
+	return Color{tmp + co, y + cg, tmp - co}
+}
+
+// RgbToYCoCgR converts integer RGB values to the lossless YCoCg-R
+// representation. Co and Cg need one more bit than the inputs.
+func RgbToYCoCgR(r, g, b int) (y, co, cg int) {
+	co = r - b
+	tmp := b + co>>1
+	cg = g - tmp
+	y = tmp + cg>>1
+	return
+}
+
+// YCoCgRToRgb is the exact inverse of RgbToYCoCgR.
+func YCoCgRToRgb(y, co, cg int) (r, g, b int) {
+	tmp := y - cg>>1
+	g = cg + tmp
+	b = tmp - co>>1
+	r = b + co
+	return
+}
@@ -0,0 +1,189 @@
+package colorful
+
+import (
+	"image/color"
+	"testing"
+)
+
+/// YCbCr ///
+/////////////
+
+var ycbcrvals = []struct {
+	c   Color
+	m   YCbCrMatrix
+	ycc [3]float64
+}{
+	{Color{1.0, 1.0, 1.0}, BT601, [3]float64{1.0, 0.0, 0.0}},
+	{Color{0.0, 0.0, 0.0}, BT709, [3]float64{0.0, 0.0, 0.0}},
+	{Color{1.0, 0.0, 0.0}, BT601, [3]float64{0.299, -0.168736, 0.5}},
+	{Color{0.0, 1.0, 0.0}, BT601, [3]float64{0.587, -0.331264, -0.418688}},
+	{Color{0.0, 0.0, 1.0}, BT601, [3]float64{0.114, 0.5, -0.081312}},
+	{Color{1.0, 0.0, 0.0}, BT709, [3]float64{0.2126, -0.114572, 0.5}},
+	{Color{0.0, 1.0, 0.0}, BT709, [3]float64{0.7152, -0.385428, -0.454153}},
+	{Color{0.0, 0.0, 1.0}, BT709, [3]float64{0.0722, 0.5, -0.045847}},
+	{Color{1.0, 0.0, 0.0}, BT2020, [3]float64{0.2627, -0.139630, 0.5}},
+	{Color{0.0, 0.0, 1.0}, BT2020, [3]float64{0.0593, 0.5, -0.040200}},
+}
+
+func TestYCbCrConversion(t *testing.T) {
+	for i, tt := range ycbcrvals {
+		y, cb, cr := tt.c.YCbCr(tt.m)
+		if !almosteq(y, tt.ycc[0]) || !almosteq(cb, tt.ycc[1]) || !almosteq(cr, tt.ycc[2]) {
+			t.Errorf("%v. %v.YCbCr(%v) => (%v), want %v (delta %v)", i, tt.c, tt.m, []float64{y, cb, cr}, tt.ycc, delta)
+		}
+	}
+}
+
+func TestYCbCrCreation(t *testing.T) {
+	for i, tt := range ycbcrvals {
+		c := YCbCr(tt.ycc[0], tt.ycc[1], tt.ycc[2], tt.m)
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. YCbCr(%v, %v) => (%v), want %v (delta %v)", i, tt.ycc, tt.m, c, tt.c, delta)
+		}
+	}
+}
+
+func TestYCbCrRec2020Roundtrip(t *testing.T) {
+	for i, tt := range vals {
+		c := YCbCrRec2020(tt.c.YCbCrRec2020())
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. %v -> YCbCrRec2020 -> sRGB = %v", i, tt.c, c)
+		}
+	}
+
+	// White and black stay at the ends of the luma range.
+	if y, cb, cr := (Color{1, 1, 1}).YCbCrRec2020(); !almosteq(y, 1.0) || !almosteq(cb+1, 1.0) || !almosteq(cr+1, 1.0) {
+		t.Errorf("White.YCbCrRec2020() => (%v, %v, %v), want (1, 0, 0)", y, cb, cr)
+	}
+}
+
+func TestQuantizeYCbCr(t *testing.T) {
+	for i, tt := range []struct {
+		ycc     [3]float64
+		bits    uint
+		limited bool
+		q       [3]uint16
+	}{
+		{[3]float64{1.0, 0.0, 0.0}, 8, true, [3]uint16{235, 128, 128}},
+		{[3]float64{0.0, -0.5, 0.5}, 8, true, [3]uint16{16, 16, 240}},
+		{[3]float64{1.0, 0.0, 0.0}, 10, true, [3]uint16{940, 512, 512}},
+		{[3]float64{0.0, -0.5, 0.5}, 10, true, [3]uint16{64, 64, 960}},
+		{[3]float64{1.0, 0.0, 0.0}, 12, true, [3]uint16{3760, 2048, 2048}},
+		{[3]float64{1.0, -0.5, 0.5}, 8, false, [3]uint16{255, 1, 255}},
+		{[3]float64{1.0, 0.0, 0.0}, 10, false, [3]uint16{1023, 512, 512}},
+		{[3]float64{1.5, -1.0, 1.0}, 10, true, [3]uint16{1023, 0, 1023}}, // Clamped
+	} {
+		y, cb, cr := QuantizeYCbCr(tt.ycc[0], tt.ycc[1], tt.ycc[2], tt.bits, tt.limited)
+		if y != tt.q[0] || cb != tt.q[1] || cr != tt.q[2] {
+			t.Errorf("%v. QuantizeYCbCr(%v, %v, %v) => %v, want %v", i, tt.ycc, tt.bits, tt.limited, []uint16{y, cb, cr}, tt.q)
+		}
+	}
+
+	for _, bits := range []uint{8, 10, 12} {
+		for _, limited := range []bool{true, false} {
+			for i, tt := range vals {
+				y, cb, cr := tt.c.YCbCr(BT709)
+				yq, cbq, crq := QuantizeYCbCr(y, cb, cr, bits, limited)
+				y, cb, cr = DequantizeYCbCr(yq, cbq, crq, bits, limited)
+				c := YCbCr(y, cb, cr, BT709)
+				if !c.AlmostEqualRgb(tt.c) {
+					t.Errorf("%v. %v quantized to %v bits (limited: %v) => %v", i, tt.c, bits, limited, c)
+				}
+			}
+		}
+	}
+}
+
+func TestYCbCr255(t *testing.T) {
+	for i, tt := range vals {
+		c8, _ := Hex(tt.hex)
+		want := color.YCbCrModel.Convert(c8).(color.YCbCr)
+		got := c8.YCbCr255()
+		if absdiff8(got.Y, want.Y) > 1 || absdiff8(got.Cb, want.Cb) > 1 || absdiff8(got.Cr, want.Cr) > 1 {
+			t.Errorf("%v. %v.YCbCr255() => %v, want %v", i, c8, got, want)
+		}
+
+		c, ok := MakeColor(got)
+		if !ok || !c.AlmostEqualRgb(c8) {
+			t.Errorf("%v. MakeColor(%v.YCbCr255()) => %v, want %v", i, c8, c, c8)
+		}
+	}
+}
+
+func absdiff8(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+/// YUV and YIQ ///
+///////////////////
+
+func TestYuvConversion(t *testing.T) {
+	y, u, v := Color{1.0, 0.0, 0.0}.Yuv()
+	if !almosteq(y, 0.299) || !almosteq(u, -0.14713) || !almosteq(v, 0.615) {
+		t.Errorf("Red.Yuv() => (%v, %v, %v), want (0.299, -0.14713, 0.615)", y, u, v)
+	}
+
+	for i, tt := range vals {
+		c := Yuv(tt.c.Yuv())
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. %v -> Yuv -> sRGB = %v", i, tt.c, c)
+		}
+	}
+}
+
+func TestYiqConversion(t *testing.T) {
+	// Coefficients from the FCC NTSC standard.
+	for i, tt := range []struct {
+		c   Color
+		yiq [3]float64
+	}{
+		{Color{1.0, 0.0, 0.0}, [3]float64{0.299, 0.5959, 0.2115}},
+		{Color{0.0, 1.0, 0.0}, [3]float64{0.587, -0.2746, -0.5227}},
+		{Color{0.0, 0.0, 1.0}, [3]float64{0.114, -0.3213, 0.3112}},
+	} {
+		y, iq, q := tt.c.Yiq()
+		if !almosteq(y, tt.yiq[0]) || !almosteq(iq, tt.yiq[1]) || !almosteq(q, tt.yiq[2]) {
+			t.Errorf("%v. %v.Yiq() => (%v), want %v (delta %v)", i, tt.c, []float64{y, iq, q}, tt.yiq, delta)
+		}
+	}
+
+	for i, tt := range vals {
+		c := Yiq(tt.c.Yiq())
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. %v -> Yiq -> sRGB = %v", i, tt.c, c)
+		}
+	}
+}
+
+/// YCoCg ///
+/////////////
+
+func TestYCoCgConversion(t *testing.T) {
+	y, co, cg := Color{1.0, 0.0, 0.0}.YCoCg()
+	if y != 0.25 || co != 0.5 || cg != -0.25 {
+		t.Errorf("Red.YCoCg() => (%v, %v, %v), want (0.25, 0.5, -0.25)", y, co, cg)
+	}
+
+	for i, tt := range vals {
+		c := YCoCg(tt.c.YCoCg())
+		if !c.AlmostEqualRgb(tt.c) {
+			t.Errorf("%v. %v -> YCoCg -> sRGB = %v", i, tt.c, c)
+		}
+	}
+}
+
+func TestYCoCgRLossless(t *testing.T) {
+	for r := 0; r < 256; r += 3 {
+		for g := 0; g < 256; g += 5 {
+			for b := 0; b < 256; b += 7 {
+				r2, g2, b2 := YCoCgRToRgb(RgbToYCoCgR(r, g, b))
+				if r2 != r || g2 != g || b2 != b {
+					t.Fatalf("(%v, %v, %v) -> YCoCg-R -> (%v, %v, %v)", r, g, b, r2, g2, b2)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package colorful
+
+import "math"
+
+// The spectral locus, which is the chromaticity of monochromatic light, and
+// the purple line joining its ends, which together bound all colors in the
+// CIE 1931 xy chromaticity diagram. Relative to a white point, colors have a
+// dominant wavelength, which is that of the spectral color in their direction,
+// and an excitation purity, which is how far they are towards it.
+// https://en.wikipedia.org/wiki/Dominant_wavelength
+
+// The ends of the spectral locus in nm, which are those of the standard observer.
+const (
+	SpectralLocusMinWavelength = 380.0
+	SpectralLocusMaxWavelength = 780.0
+)
+
+// SpectralLocusXy returns the chromaticity of monochromatic light of the given
+// wavelength in nm, as seen by the CIE 1931 standard observer.
+func SpectralLocusXy(wavelength float64) (x, y float64) {
+	xs, ys, zs := CIE1931.X.At(wavelength), CIE1931.Y.At(wavelength), CIE1931.Z.At(wavelength)
+	sum := xs + ys + zs
+	return xs / sum, ys / sum
+}
+
+// SpectralLocus returns the xy chromaticities of the spectral locus from
+// SpectralLocusMinWavelength to SpectralLocusMaxWavelength in steps of step nm.
+func SpectralLocus(step float64) [][2]float64 {
+	var locus [][2]float64
+	for w := SpectralLocusMinWavelength; w <= SpectralLocusMaxWavelength+1e-9; w += step {
+		x, y := SpectralLocusXy(w)
+		locus = append(locus, [2]float64{x, y})
+	}
+	return locus
+}
+
+// PurpleLine returns n >= 2 equally spaced xy chromaticities on the purple
+// line, from the violet to the red end of the spectral locus.
+func PurpleLine(n int) [][2]float64 {
+	x0, y0 := SpectralLocusXy(SpectralLocusMinWavelength)
+	x1, y1 := SpectralLocusXy(SpectralLocusMaxWavelength)
+	line := make([][2]float64, n)
+	for i := range line {
+		t := float64(i) / float64(n-1)
+		line[i] = [2]float64{x0 + t*(x1-x0), y0 + t*(y1-y0)}
+	}
+	return line
+}
+
+// Wavelength returns the brightest color with the chromaticity of
+// monochromatic light of the given wavelength in nm.
+// WARNING: all spectral colors are outside of sRGB, so this returns an
+// invalid color, use Clamped if needed.
+func Wavelength(wavelength float64) Color {
+	return brightestWithChromaticity(SpectralLocusXy(wavelength))
+}
+
+// The spectral locus in 1 nm steps, for finding dominant wavelengths.
+var spectralLocus1nm = SpectralLocus(1.0)
+
+// rayIntersection returns the distance along the ray from (x0, y0) in the
+// direction (dx, dy) to the segment between a and b, in units of the
+// direction, or -1 if they do not intersect.
+func rayIntersection(x0, y0, dx, dy float64, a, b [2]float64) float64 {
+	ex, ey := b[0]-a[0], b[1]-a[1]
+	det := dx*ey - dy*ex
+	if det == 0.0 {
+		return -1.0
+	}
+	fx, fy := a[0]-x0, a[1]-y0
+	t := (fx*ey - fy*ex) / det
+	s := (fx*dy - fy*dx) / det
+	if t <= 0.0 || s < 0.0 || s > 1.0 {
+		return -1.0
+	}
+	return t
+}
+
+// spectralLocusIntersection returns the wavelength at which the ray from
+// (x0, y0) in the direction (dx, dy) crosses the spectral locus, and the
+// distance to it in units of the direction, or 0 and -1 if it crosses the
+// purple line instead, in which case purple is the distance to that.
+func spectralLocusIntersection(x0, y0, dx, dy float64) (wavelength, t, purple float64) {
+	for i := 1; i < len(spectralLocus1nm); i++ {
+		a, b := spectralLocus1nm[i-1], spectralLocus1nm[i]
+		if t := rayIntersection(x0, y0, dx, dy, a, b); t > 0.0 {
+			// The fraction of the segment, to interpolate the wavelength.
+			s := math.Hypot(x0+t*dx-a[0], y0+t*dy-a[1]) / math.Hypot(b[0]-a[0], b[1]-a[1])
+			return SpectralLocusMinWavelength + float64(i-1) + s, t, -1.0
+		}
+	}
+	ends := PurpleLine(2)
+	return 0.0, -1.0, rayIntersection(x0, y0, dx, dy, ends[0], ends[1])
+}
+
+// DominantWavelength returns the dominant wavelength in nm and the excitation
+// purity in [0..1] of the color relative to D65. For purple colors, which have
+// no dominant wavelength, the wavelength is the negative of the complementary
+// wavelength, as is the convention of CIE 15. Both are 0 for grays.
+func (col Color) DominantWavelength() (wavelength, purity float64) {
+	return col.DominantWavelengthWhiteRef(D65)
+}
+
+// DominantWavelengthWhiteRef is DominantWavelength relative to the given
+// reference white, for example one of the Illuminants.
+func (col Color) DominantWavelengthWhiteRef(wref [3]float64) (wavelength, purity float64) {
+	xc, yc, zc := col.Xyz()
+	x, y, _ := XyzToXyyWhiteRef(xc, yc, zc, wref)
+	return XyToDominantWavelength(x, y, wref)
+}
+
+// XyToDominantWavelength is DominantWavelengthWhiteRef for a chromaticity
+// given in CIE 1931 xy.
+func XyToDominantWavelength(x, y float64, wref [3]float64) (wavelength, purity float64) {
+	xw, yw, _ := XyzToXyy(wref[0], wref[1], wref[2])
+	dx, dy := x-xw, y-yw
+	// The tolerance is for the white of sRGB, which differs slightly from D65.
+	if math.Hypot(dx, dy) < 1e-4 {
+		return 0.0, 0.0
+	}
+
+	wavelength, t, purple := spectralLocusIntersection(xw, yw, dx, dy)
+	if t > 0.0 {
+		return wavelength, 1.0 / t
+	}
+	complementary, _, _ := spectralLocusIntersection(xw, yw, -dx, -dy)
+	return -complementary, 1.0 / purple
+}
+
+// ComplementaryWavelength returns the complementary wavelength in nm of the
+// color relative to D65, which is the dominant wavelength of the opposite
+// side of the white point. For colors whose opposite is purple, it is the
+// negative of their dominant wavelength. It is 0 for grays.
+func (col Color) ComplementaryWavelength() float64 {
+	return col.ComplementaryWavelengthWhiteRef(D65)
+}
+
+// ComplementaryWavelengthWhiteRef is ComplementaryWavelength relative to the
+// given reference white, for example one of the Illuminants.
+func (col Color) ComplementaryWavelengthWhiteRef(wref [3]float64) float64 {
+	xc, yc, zc := col.Xyz()
+	x, y, _ := XyzToXyyWhiteRef(xc, yc, zc, wref)
+	return XyToComplementaryWavelength(x, y, wref)
+}
+
+// XyToComplementaryWavelength is ComplementaryWavelengthWhiteRef for a
+// chromaticity given in CIE 1931 xy.
+func XyToComplementaryWavelength(x, y float64, wref [3]float64) float64 {
+	xw, yw, _ := XyzToXyy(wref[0], wref[1], wref[2])
+	// The dominant wavelength of the point mirrored at the white point.
+	wavelength, _ := XyToDominantWavelength(2.0*xw-x, 2.0*yw-y, wref)
+	return wavelength
+}
@@ -0,0 +1,62 @@
+package colorful
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDominantWavelength(t *testing.T) {
+	// From the documentation of the colour Python package, which uses 1 nm
+	// tables and rounds to the nearest of them.
+	wref := XyToWhiteRef(0.3127, 0.3290)
+	tests := []struct {
+		x, y                            float64
+		dominant, purity, complementary float64
+	}{
+		{0.54369557, 0.32107944, 616.0, 0.62288567, 492.0},
+		{0.35000, 0.25000, -520.0, 0.0, 520.0},
+	}
+	for _, tt := range tests {
+		dominant, purity := XyToDominantWavelength(tt.x, tt.y, wref)
+		if math.Abs(dominant-tt.dominant) > 1.0 || (tt.purity != 0.0 && !almosteq_eps(purity, tt.purity, 1e-4)) {
+			t.Errorf("XyToDominantWavelength(%v, %v) => (%v, %v), want (%v, %v)", tt.x, tt.y, dominant, purity, tt.dominant, tt.purity)
+		}
+		if complementary := XyToComplementaryWavelength(tt.x, tt.y, wref); math.Abs(complementary-tt.complementary) > 1.0 {
+			t.Errorf("XyToComplementaryWavelength(%v, %v) => %v, want %v", tt.x, tt.y, complementary, tt.complementary)
+		}
+	}
+
+	// Spectral colors have their own wavelength and are pure.
+	for _, w := range []float64{450.0, 500.0, 550.0, 600.0, 650.0} {
+		x, y := SpectralLocusXy(w)
+		if dominant, purity := XyToDominantWavelength(x, y, D65); !almosteq_eps(dominant, w, 1e-4) || !almosteq_eps(purity, 1.0, 1e-4) {
+			t.Errorf("XyToDominantWavelength(SpectralLocusXy(%v)) => (%v, %v)", w, dominant, purity)
+		}
+		if dominant, _ := Wavelength(w).DominantWavelength(); !almosteq_eps(dominant, w, 1e-3) {
+			t.Errorf("Wavelength(%v).DominantWavelength() => %v", w, dominant)
+		}
+	}
+
+	// Magenta is purple and its complement is green.
+	magenta := Color{1.0, 0.0, 1.0}
+	if dominant, _ := magenta.DominantWavelength(); dominant > -540.0 || dominant < -560.0 {
+		t.Errorf("magenta.DominantWavelength() => %v", dominant)
+	}
+	if complementary := magenta.ComplementaryWavelength(); complementary < 540.0 || complementary > 560.0 {
+		t.Errorf("magenta.ComplementaryWavelength() => %v", complementary)
+	}
+	if dominant, purity := (Color{0.5, 0.5, 0.5}).DominantWavelength(); dominant != 0.0 || purity != 0.0 {
+		t.Errorf("gray.DominantWavelength() => (%v, %v)", dominant, purity)
+	}
+}
+
+func TestPurpleLine(t *testing.T) {
+	locus := SpectralLocus(5.0)
+	if len(locus) != 81 {
+		t.Errorf("len(SpectralLocus(5)) => %v, want 81", len(locus))
+	}
+	line := PurpleLine(3)
+	if line[0] != locus[0] || line[2] != locus[len(locus)-1] {
+		t.Errorf("PurpleLine(3) => %v, does not join the ends of the spectral locus", line)
+	}
+}